@@ -22,14 +22,18 @@ const (
 )
 
 type RegisterMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullName      string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
-	UserName      string                 `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
-	EmailAddress  string                 `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FullName          string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName          string                 `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	EmailAddress      string                 `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	PhoneNumber       string                 `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Password          string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	DeviceFingerprint string                 `protobuf:"bytes,6,opt,name=deviceFingerprint,proto3" json:"deviceFingerprint,omitempty"`
+	InviteToken       string                 `protobuf:"bytes,7,opt,name=inviteToken,proto3" json:"inviteToken,omitempty"`
+	PowChallenge      string                 `protobuf:"bytes,8,opt,name=powChallenge,proto3" json:"powChallenge,omitempty"`
+	PowSolution       string                 `protobuf:"bytes,9,opt,name=powSolution,proto3" json:"powSolution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *RegisterMessageRequest) Reset() {
@@ -97,11 +101,42 @@ func (x *RegisterMessageRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterMessageRequest) GetDeviceFingerprint() string {
+	if x != nil {
+		return x.DeviceFingerprint
+	}
+	return ""
+}
+
+func (x *RegisterMessageRequest) GetInviteToken() string {
+	if x != nil {
+		return x.InviteToken
+	}
+	return ""
+}
+
+func (x *RegisterMessageRequest) GetPowChallenge() string {
+	if x != nil {
+		return x.PowChallenge
+	}
+	return ""
+}
+
+func (x *RegisterMessageRequest) GetPowSolution() string {
+	if x != nil {
+		return x.PowSolution
+	}
+	return ""
+}
+
 type RegisterMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserName      string                 `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	RiskAction    string                 `protobuf:"bytes,4,opt,name=riskAction,proto3" json:"riskAction,omitempty"`
+	PowChallenge  string                 `protobuf:"bytes,5,opt,name=powChallenge,proto3" json:"powChallenge,omitempty"`
+	PowDifficulty int32                  `protobuf:"varint,6,opt,name=powDifficulty,proto3" json:"powDifficulty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,11 +192,35 @@ func (x *RegisterMessageResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *RegisterMessageResponse) GetRiskAction() string {
+	if x != nil {
+		return x.RiskAction
+	}
+	return ""
+}
+
+func (x *RegisterMessageResponse) GetPowChallenge() string {
+	if x != nil {
+		return x.PowChallenge
+	}
+	return ""
+}
+
+func (x *RegisterMessageResponse) GetPowDifficulty() int32 {
+	if x != nil {
+		return x.PowDifficulty
+	}
+	return 0
+}
+
 type LoginMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Email             string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DeviceFingerprint string                 `protobuf:"bytes,2,opt,name=deviceFingerprint,proto3" json:"deviceFingerprint,omitempty"`
+	Password          string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Client            string                 `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoginMessageRequest) Reset() {
@@ -201,13 +260,40 @@ func (x *LoginMessageRequest) GetEmail() string {
 	return ""
 }
 
+func (x *LoginMessageRequest) GetDeviceFingerprint() string {
+	if x != nil {
+		return x.DeviceFingerprint
+	}
+	return ""
+}
+
+func (x *LoginMessageRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginMessageRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
 type LoginMessageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	UserName      string                 `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Email                string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	UserName             string                 `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	RiskAction           string                 `protobuf:"bytes,4,opt,name=riskAction,proto3" json:"riskAction,omitempty"`
+	UserId               string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+	AccessToken          string                 `protobuf:"bytes,6,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpiresAtUnix        int64                  `protobuf:"varint,7,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	Scopes               []string               `protobuf:"bytes,8,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RefreshToken         string                 `protobuf:"bytes,9,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	RefreshExpiresAtUnix int64                  `protobuf:"varint,10,opt,name=refreshExpiresAtUnix,proto3" json:"refreshExpiresAtUnix,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *LoginMessageResponse) Reset() {
@@ -254,75 +340,20507 @@ func (x *LoginMessageResponse) GetUserName() string {
 	return ""
 }
 
-func (x *LoginMessageResponse) GetPassword() string {
+func (x *LoginMessageResponse) GetRiskAction() string {
 	if x != nil {
-		return x.Password
+		return x.RiskAction
 	}
 	return ""
 }
 
-var File_user_proto protoreflect.FileDescriptor
+func (x *LoginMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
 
-const file_user_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"user.proto\x12\x04user\"\xb2\x01\n" +
-	"\x16RegisterMessageRequest\x12\x1a\n" +
-	"\bfullName\x18\x01 \x01(\tR\bfullName\x12\x1a\n" +
-	"\buserName\x18\x02 \x01(\tR\buserName\x12\"\n" +
-	"\femailAddress\x18\x03 \x01(\tR\femailAddress\x12 \n" +
-	"\vphoneNumber\x18\x04 \x01(\tR\vphoneNumber\x12\x1a\n" +
-	"\bpassword\x18\x05 \x01(\tR\bpassword\"i\n" +
-	"\x17RegisterMessageResponse\x12\x1a\n" +
-	"\buserName\x18\x01 \x01(\tR\buserName\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x03 \x01(\bR\asuccess\"+\n" +
-	"\x13LoginMessageRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"d\n" +
-	"\x14LoginMessageResponse\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\buserName\x18\x02 \x01(\tR\buserName\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword2\xa2\x01\n" +
-	"\vUserService\x12D\n" +
-	"\tLoginUser\x12\x19.user.LoginMessageRequest\x1a\x1a.user.LoginMessageResponse\"\x00\x12M\n" +
-	"\fRegisterUser\x12\x1c.user.RegisterMessageRequest\x1a\x1d.user.RegisterMessageResponse\"\x00B\n" +
-	"Z\bgen/userb\x06proto3"
+func (x *LoginMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
 
-var (
-	file_user_proto_rawDescOnce sync.Once
-	file_user_proto_rawDescData []byte
-)
+func (x *LoginMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
 
-func file_user_proto_rawDescGZIP() []byte {
-	file_user_proto_rawDescOnce.Do(func() {
-		file_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)))
-	})
-	return file_user_proto_rawDescData
+func (x *LoginMessageResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_user_proto_goTypes = []any{
-	(*RegisterMessageRequest)(nil),  // 0: user.RegisterMessageRequest
-	(*RegisterMessageResponse)(nil), // 1: user.RegisterMessageResponse
-	(*LoginMessageRequest)(nil),     // 2: user.LoginMessageRequest
-	(*LoginMessageResponse)(nil),    // 3: user.LoginMessageResponse
+func (x *LoginMessageResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
 }
-var file_user_proto_depIdxs = []int32{
-	2, // 0: user.UserService.LoginUser:input_type -> user.LoginMessageRequest
-	0, // 1: user.UserService.RegisterUser:input_type -> user.RegisterMessageRequest
-	3, // 2: user.UserService.LoginUser:output_type -> user.LoginMessageResponse
-	1, // 3: user.UserService.RegisterUser:output_type -> user.RegisterMessageResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *LoginMessageResponse) GetRefreshExpiresAtUnix() int64 {
+	if x != nil {
+		return x.RefreshExpiresAtUnix
+	}
+	return 0
 }
 
-func init() { file_user_proto_init() }
-func file_user_proto_init() {
-	if File_user_proto != nil {
-		return
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type BillingAddress struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RecipientName     string                 `protobuf:"bytes,1,opt,name=recipientName,proto3" json:"recipientName,omitempty"`
+	Line1             string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2             string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City              string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Region            string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode        string                 `protobuf:"bytes,6,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Country           string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	FormattedAddress  string                 `protobuf:"bytes,8,opt,name=formattedAddress,proto3" json:"formattedAddress,omitempty"`
+	Location          *GeoPoint              `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	County            string                 `protobuf:"bytes,10,opt,name=county,proto3" json:"county,omitempty"`
+	SubCounty         string                 `protobuf:"bytes,11,opt,name=subCounty,proto3" json:"subCounty,omitempty"`
+	Landmark          string                 `protobuf:"bytes,12,opt,name=landmark,proto3" json:"landmark,omitempty"`
+	RiderInstructions string                 `protobuf:"bytes,13,opt,name=riderInstructions,proto3" json:"riderInstructions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BillingAddress) Reset() {
+	*x = BillingAddress{}
+	mi := &file_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillingAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingAddress) ProtoMessage() {}
+
+func (x *BillingAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingAddress.ProtoReflect.Descriptor instead.
+func (*BillingAddress) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BillingAddress) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetFormattedAddress() string {
+	if x != nil {
+		return x.FormattedAddress
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *BillingAddress) GetCounty() string {
+	if x != nil {
+		return x.County
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetSubCounty() string {
+	if x != nil {
+		return x.SubCounty
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetLandmark() string {
+	if x != nil {
+		return x.Landmark
+	}
+	return ""
+}
+
+func (x *BillingAddress) GetRiderInstructions() string {
+	if x != nil {
+		return x.RiderInstructions
+	}
+	return ""
+}
+
+type TaxIdentifier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxIdentifier) Reset() {
+	*x = TaxIdentifier{}
+	mi := &file_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxIdentifier) ProtoMessage() {}
+
+func (x *TaxIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxIdentifier.ProtoReflect.Descriptor instead.
+func (*TaxIdentifier) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TaxIdentifier) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaxIdentifier) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetBillingProfileMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBillingProfileMessageRequest) Reset() {
+	*x = GetBillingProfileMessageRequest{}
+	mi := &file_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBillingProfileMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingProfileMessageRequest) ProtoMessage() {}
+
+func (x *GetBillingProfileMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingProfileMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetBillingProfileMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBillingProfileMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetBillingProfileMessageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	BillingAddress *BillingAddress        `protobuf:"bytes,2,opt,name=billingAddress,proto3" json:"billingAddress,omitempty"`
+	PaymentToken   string                 `protobuf:"bytes,3,opt,name=paymentToken,proto3" json:"paymentToken,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	TaxIdentifiers []*TaxIdentifier       `protobuf:"bytes,5,rep,name=taxIdentifiers,proto3" json:"taxIdentifiers,omitempty"`
+	Redacted       bool                   `protobuf:"varint,6,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	BillingUserId  string                 `protobuf:"bytes,7,opt,name=billingUserId,proto3" json:"billingUserId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBillingProfileMessageResponse) Reset() {
+	*x = GetBillingProfileMessageResponse{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBillingProfileMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingProfileMessageResponse) ProtoMessage() {}
+
+func (x *GetBillingProfileMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingProfileMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetBillingProfileMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBillingProfileMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBillingProfileMessageResponse) GetBillingAddress() *BillingAddress {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *GetBillingProfileMessageResponse) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *GetBillingProfileMessageResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetBillingProfileMessageResponse) GetTaxIdentifiers() []*TaxIdentifier {
+	if x != nil {
+		return x.TaxIdentifiers
+	}
+	return nil
+}
+
+func (x *GetBillingProfileMessageResponse) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+func (x *GetBillingProfileMessageResponse) GetBillingUserId() string {
+	if x != nil {
+		return x.BillingUserId
+	}
+	return ""
+}
+
+type UpdateBillingProfileMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	BillingAddress *BillingAddress        `protobuf:"bytes,2,opt,name=billingAddress,proto3" json:"billingAddress,omitempty"`
+	PaymentToken   string                 `protobuf:"bytes,3,opt,name=paymentToken,proto3" json:"paymentToken,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	TaxIdentifiers []*TaxIdentifier       `protobuf:"bytes,5,rep,name=taxIdentifiers,proto3" json:"taxIdentifiers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateBillingProfileMessageRequest) Reset() {
+	*x = UpdateBillingProfileMessageRequest{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBillingProfileMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBillingProfileMessageRequest) ProtoMessage() {}
+
+func (x *UpdateBillingProfileMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBillingProfileMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBillingProfileMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateBillingProfileMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateBillingProfileMessageRequest) GetBillingAddress() *BillingAddress {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *UpdateBillingProfileMessageRequest) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *UpdateBillingProfileMessageRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateBillingProfileMessageRequest) GetTaxIdentifiers() []*TaxIdentifier {
+	if x != nil {
+		return x.TaxIdentifiers
+	}
+	return nil
+}
+
+type UpdateBillingProfileMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBillingProfileMessageResponse) Reset() {
+	*x = UpdateBillingProfileMessageResponse{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBillingProfileMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBillingProfileMessageResponse) ProtoMessage() {}
+
+func (x *UpdateBillingProfileMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBillingProfileMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBillingProfileMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateBillingProfileMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateBillingProfileMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Demographics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgeBand       string                 `protobuf:"bytes,1,opt,name=ageBand,proto3" json:"ageBand,omitempty"`
+	Gender        string                 `protobuf:"bytes,2,opt,name=gender,proto3" json:"gender,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Demographics) Reset() {
+	*x = Demographics{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Demographics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Demographics) ProtoMessage() {}
+
+func (x *Demographics) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Demographics.ProtoReflect.Descriptor instead.
+func (*Demographics) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Demographics) GetAgeBand() string {
+	if x != nil {
+		return x.AgeBand
+	}
+	return ""
+}
+
+func (x *Demographics) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Demographics) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Demographics) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type GetUserSegmentsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSegmentsMessageRequest) Reset() {
+	*x = GetUserSegmentsMessageRequest{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSegmentsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSegmentsMessageRequest) ProtoMessage() {}
+
+func (x *GetUserSegmentsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSegmentsMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSegmentsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserSegmentsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserSegmentsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Segments      []string               `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Tier          string                 `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	Locale        string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	Demographics  *Demographics          `protobuf:"bytes,6,opt,name=demographics,proto3" json:"demographics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSegmentsMessageResponse) Reset() {
+	*x = GetUserSegmentsMessageResponse{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSegmentsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSegmentsMessageResponse) ProtoMessage() {}
+
+func (x *GetUserSegmentsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSegmentsMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetUserSegmentsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserSegmentsMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserSegmentsMessageResponse) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *GetUserSegmentsMessageResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetUserSegmentsMessageResponse) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *GetUserSegmentsMessageResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetUserSegmentsMessageResponse) GetDemographics() *Demographics {
+	if x != nil {
+		return x.Demographics
+	}
+	return nil
+}
+
+type PeriodCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodCount) Reset() {
+	*x = PeriodCount{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodCount) ProtoMessage() {}
+
+func (x *PeriodCount) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodCount.ProtoReflect.Descriptor instead.
+func (*PeriodCount) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PeriodCount) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PeriodCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUserStatsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserStatsMessageRequest) Reset() {
+	*x = GetUserStatsMessageRequest{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsMessageRequest) ProtoMessage() {}
+
+func (x *GetUserStatsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStatsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserStatsMessageRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetUserStatsMessageResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	DailyRegistrations    []*PeriodCount         `protobuf:"bytes,1,rep,name=dailyRegistrations,proto3" json:"dailyRegistrations,omitempty"`
+	WeeklyRegistrations   []*PeriodCount         `protobuf:"bytes,2,rep,name=weeklyRegistrations,proto3" json:"weeklyRegistrations,omitempty"`
+	TotalRegistrations    int64                  `protobuf:"varint,3,opt,name=totalRegistrations,proto3" json:"totalRegistrations,omitempty"`
+	VerifiedRegistrations int64                  `protobuf:"varint,4,opt,name=verifiedRegistrations,proto3" json:"verifiedRegistrations,omitempty"`
+	VerificationRate      float64                `protobuf:"fixed64,5,opt,name=verificationRate,proto3" json:"verificationRate,omitempty"`
+	DailyActiveUsers      int64                  `protobuf:"varint,6,opt,name=dailyActiveUsers,proto3" json:"dailyActiveUsers,omitempty"`
+	WeeklyActiveUsers     int64                  `protobuf:"varint,7,opt,name=weeklyActiveUsers,proto3" json:"weeklyActiveUsers,omitempty"`
+	MonthlyActiveUsers    int64                  `protobuf:"varint,8,opt,name=monthlyActiveUsers,proto3" json:"monthlyActiveUsers,omitempty"`
+	DailyDeletions        []*PeriodCount         `protobuf:"bytes,9,rep,name=dailyDeletions,proto3" json:"dailyDeletions,omitempty"`
+	TotalDeletions        int64                  `protobuf:"varint,10,opt,name=totalDeletions,proto3" json:"totalDeletions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetUserStatsMessageResponse) Reset() {
+	*x = GetUserStatsMessageResponse{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsMessageResponse) ProtoMessage() {}
+
+func (x *GetUserStatsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetUserStatsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserStatsMessageResponse) GetDailyRegistrations() []*PeriodCount {
+	if x != nil {
+		return x.DailyRegistrations
+	}
+	return nil
+}
+
+func (x *GetUserStatsMessageResponse) GetWeeklyRegistrations() []*PeriodCount {
+	if x != nil {
+		return x.WeeklyRegistrations
+	}
+	return nil
+}
+
+func (x *GetUserStatsMessageResponse) GetTotalRegistrations() int64 {
+	if x != nil {
+		return x.TotalRegistrations
+	}
+	return 0
+}
+
+func (x *GetUserStatsMessageResponse) GetVerifiedRegistrations() int64 {
+	if x != nil {
+		return x.VerifiedRegistrations
+	}
+	return 0
+}
+
+func (x *GetUserStatsMessageResponse) GetVerificationRate() float64 {
+	if x != nil {
+		return x.VerificationRate
+	}
+	return 0
+}
+
+func (x *GetUserStatsMessageResponse) GetDailyActiveUsers() int64 {
+	if x != nil {
+		return x.DailyActiveUsers
+	}
+	return 0
+}
+
+func (x *GetUserStatsMessageResponse) GetWeeklyActiveUsers() int64 {
+	if x != nil {
+		return x.WeeklyActiveUsers
+	}
+	return 0
+}
+
+func (x *GetUserStatsMessageResponse) GetMonthlyActiveUsers() int64 {
+	if x != nil {
+		return x.MonthlyActiveUsers
+	}
+	return 0
+}
+
+func (x *GetUserStatsMessageResponse) GetDailyDeletions() []*PeriodCount {
+	if x != nil {
+		return x.DailyDeletions
+	}
+	return nil
+}
+
+func (x *GetUserStatsMessageResponse) GetTotalDeletions() int64 {
+	if x != nil {
+		return x.TotalDeletions
+	}
+	return 0
+}
+
+type WatchUserMetricsMessageRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalSeconds int32                  `protobuf:"varint,1,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchUserMetricsMessageRequest) Reset() {
+	*x = WatchUserMetricsMessageRequest{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchUserMetricsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUserMetricsMessageRequest) ProtoMessage() {}
+
+func (x *WatchUserMetricsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUserMetricsMessageRequest.ProtoReflect.Descriptor instead.
+func (*WatchUserMetricsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchUserMetricsMessageRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type UserMetricsSnapshot struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TimestampUnix     int64                  `protobuf:"varint,1,opt,name=timestampUnix,proto3" json:"timestampUnix,omitempty"`
+	SignupsPerMinute  int64                  `protobuf:"varint,2,opt,name=signupsPerMinute,proto3" json:"signupsPerMinute,omitempty"`
+	LoginsPerMinute   int64                  `protobuf:"varint,3,opt,name=loginsPerMinute,proto3" json:"loginsPerMinute,omitempty"`
+	FailuresPerMinute int64                  `protobuf:"varint,4,opt,name=failuresPerMinute,proto3" json:"failuresPerMinute,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserMetricsSnapshot) Reset() {
+	*x = UserMetricsSnapshot{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserMetricsSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMetricsSnapshot) ProtoMessage() {}
+
+func (x *UserMetricsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMetricsSnapshot.ProtoReflect.Descriptor instead.
+func (*UserMetricsSnapshot) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserMetricsSnapshot) GetTimestampUnix() int64 {
+	if x != nil {
+		return x.TimestampUnix
+	}
+	return 0
+}
+
+func (x *UserMetricsSnapshot) GetSignupsPerMinute() int64 {
+	if x != nil {
+		return x.SignupsPerMinute
+	}
+	return 0
+}
+
+func (x *UserMetricsSnapshot) GetLoginsPerMinute() int64 {
+	if x != nil {
+		return x.LoginsPerMinute
+	}
+	return 0
+}
+
+func (x *UserMetricsSnapshot) GetFailuresPerMinute() int64 {
+	if x != nil {
+		return x.FailuresPerMinute
+	}
+	return 0
+}
+
+type OutboxEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AggregateId     string                 `protobuf:"bytes,2,opt,name=aggregateId,proto3" json:"aggregateId,omitempty"`
+	AggregateType   string                 `protobuf:"bytes,3,opt,name=aggregateType,proto3" json:"aggregateType,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Payload         string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAtUnix   int64                  `protobuf:"varint,6,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	PublishedAtUnix int64                  `protobuf:"varint,7,opt,name=publishedAtUnix,proto3" json:"publishedAtUnix,omitempty"`
+	Attempts        int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError       string                 `protobuf:"bytes,9,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OutboxEvent) Reset() {
+	*x = OutboxEvent{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEvent) ProtoMessage() {}
+
+func (x *OutboxEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEvent.ProtoReflect.Descriptor instead.
+func (*OutboxEvent) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OutboxEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetAggregateType() string {
+	if x != nil {
+		return x.AggregateType
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *OutboxEvent) GetPublishedAtUnix() int64 {
+	if x != nil {
+		return x.PublishedAtUnix
+	}
+	return 0
+}
+
+func (x *OutboxEvent) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OutboxEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type OutboxEventFilter struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AggregateId     string                 `protobuf:"bytes,1,opt,name=aggregateId,proto3" json:"aggregateId,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	FromUnix        int64                  `protobuf:"varint,3,opt,name=fromUnix,proto3" json:"fromUnix,omitempty"`
+	ToUnix          int64                  `protobuf:"varint,4,opt,name=toUnix,proto3" json:"toUnix,omitempty"`
+	UnpublishedOnly bool                   `protobuf:"varint,5,opt,name=unpublishedOnly,proto3" json:"unpublishedOnly,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OutboxEventFilter) Reset() {
+	*x = OutboxEventFilter{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxEventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEventFilter) ProtoMessage() {}
+
+func (x *OutboxEventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEventFilter.ProtoReflect.Descriptor instead.
+func (*OutboxEventFilter) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OutboxEventFilter) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *OutboxEventFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OutboxEventFilter) GetFromUnix() int64 {
+	if x != nil {
+		return x.FromUnix
+	}
+	return 0
+}
+
+func (x *OutboxEventFilter) GetToUnix() int64 {
+	if x != nil {
+		return x.ToUnix
+	}
+	return 0
+}
+
+func (x *OutboxEventFilter) GetUnpublishedOnly() bool {
+	if x != nil {
+		return x.UnpublishedOnly
+	}
+	return false
+}
+
+type ListOutboxEventsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *OutboxEventFilter     `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutboxEventsMessageRequest) Reset() {
+	*x = ListOutboxEventsMessageRequest{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutboxEventsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboxEventsMessageRequest) ProtoMessage() {}
+
+func (x *ListOutboxEventsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutboxEventsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListOutboxEventsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListOutboxEventsMessageRequest) GetFilter() *OutboxEventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListOutboxEventsMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListOutboxEventsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*OutboxEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutboxEventsMessageResponse) Reset() {
+	*x = ListOutboxEventsMessageResponse{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutboxEventsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboxEventsMessageResponse) ProtoMessage() {}
+
+func (x *ListOutboxEventsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutboxEventsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListOutboxEventsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListOutboxEventsMessageResponse) GetEvents() []*OutboxEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type RepublishOutboxEventsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *OutboxEventFilter     `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepublishOutboxEventsMessageRequest) Reset() {
+	*x = RepublishOutboxEventsMessageRequest{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepublishOutboxEventsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepublishOutboxEventsMessageRequest) ProtoMessage() {}
+
+func (x *RepublishOutboxEventsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepublishOutboxEventsMessageRequest.ProtoReflect.Descriptor instead.
+func (*RepublishOutboxEventsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RepublishOutboxEventsMessageRequest) GetFilter() *OutboxEventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type RepublishOutboxEventsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requeued      int64                  `protobuf:"varint,1,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepublishOutboxEventsMessageResponse) Reset() {
+	*x = RepublishOutboxEventsMessageResponse{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepublishOutboxEventsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepublishOutboxEventsMessageResponse) ProtoMessage() {}
+
+func (x *RepublishOutboxEventsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepublishOutboxEventsMessageResponse.ProtoReflect.Descriptor instead.
+func (*RepublishOutboxEventsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RepublishOutboxEventsMessageResponse) GetRequeued() int64 {
+	if x != nil {
+		return x.Requeued
+	}
+	return 0
+}
+
+type DeadLetter struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event              *OutboxEvent           `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	DeadLetteredAtUnix int64                  `protobuf:"varint,3,opt,name=deadLetteredAtUnix,proto3" json:"deadLetteredAtUnix,omitempty"`
+	Reason             string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEvent() *OutboxEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetDeadLetteredAtUnix() int64 {
+	if x != nil {
+		return x.DeadLetteredAtUnix
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListDeadLettersMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AggregateId   string                 `protobuf:"bytes,1,opt,name=aggregateId,proto3" json:"aggregateId,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersMessageRequest) Reset() {
+	*x = ListDeadLettersMessageRequest{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersMessageRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDeadLettersMessageRequest) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *ListDeadLettersMessageRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListDeadLettersMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeadLettersMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters   []*DeadLetter          `protobuf:"bytes,1,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersMessageResponse) Reset() {
+	*x = ListDeadLettersMessageResponse{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersMessageResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDeadLettersMessageResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type RequeueDeadLetterMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLetterMessageRequest) Reset() {
+	*x = RequeueDeadLetterMessageRequest{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLetterMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLetterMessageRequest) ProtoMessage() {}
+
+func (x *RequeueDeadLetterMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLetterMessageRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetterMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RequeueDeadLetterMessageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RequeueDeadLetterMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLetterMessageResponse) Reset() {
+	*x = RequeueDeadLetterMessageResponse{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLetterMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLetterMessageResponse) ProtoMessage() {}
+
+func (x *RequeueDeadLetterMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLetterMessageResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetterMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RequeueDeadLetterMessageResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Channels      []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *NotificationPreference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type SetNotificationPreferencesMessageRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        string                    `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationPreferencesMessageRequest) Reset() {
+	*x = SetNotificationPreferencesMessageRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationPreferencesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferencesMessageRequest) ProtoMessage() {}
+
+func (x *SetNotificationPreferencesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferencesMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferencesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetNotificationPreferencesMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetNotificationPreferencesMessageRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type SetNotificationPreferencesMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationPreferencesMessageResponse) Reset() {
+	*x = SetNotificationPreferencesMessageResponse{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationPreferencesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferencesMessageResponse) ProtoMessage() {}
+
+func (x *SetNotificationPreferencesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferencesMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferencesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetNotificationPreferencesMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetNotificationPreferencesMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RegisterPushTokenMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPushTokenMessageRequest) Reset() {
+	*x = RegisterPushTokenMessageRequest{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushTokenMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushTokenMessageRequest) ProtoMessage() {}
+
+func (x *RegisterPushTokenMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushTokenMessageRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPushTokenMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RegisterPushTokenMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterPushTokenMessageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RegisterPushTokenMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPushTokenMessageResponse) Reset() {
+	*x = RegisterPushTokenMessageResponse{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushTokenMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushTokenMessageResponse) ProtoMessage() {}
+
+func (x *RegisterPushTokenMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushTokenMessageResponse.ProtoReflect.Descriptor instead.
+func (*RegisterPushTokenMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RegisterPushTokenMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterPushTokenMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type VerifyEmailMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailMessageRequest) Reset() {
+	*x = VerifyEmailMessageRequest{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailMessageRequest) ProtoMessage() {}
+
+func (x *VerifyEmailMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailMessageRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VerifyEmailMessageRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *VerifyEmailMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyEmailMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailMessageResponse) Reset() {
+	*x = VerifyEmailMessageResponse{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailMessageResponse) ProtoMessage() {}
+
+func (x *VerifyEmailMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailMessageResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VerifyEmailMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyEmailMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RequestAccountDeletionMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccountDeletionMessageRequest) Reset() {
+	*x = RequestAccountDeletionMessageRequest{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountDeletionMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionMessageRequest) ProtoMessage() {}
+
+func (x *RequestAccountDeletionMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccountDeletionMessageRequest.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RequestAccountDeletionMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RequestAccountDeletionMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledForUnix int64                  `protobuf:"varint,1,opt,name=scheduledForUnix,proto3" json:"scheduledForUnix,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RequestAccountDeletionMessageResponse) Reset() {
+	*x = RequestAccountDeletionMessageResponse{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountDeletionMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionMessageResponse) ProtoMessage() {}
+
+func (x *RequestAccountDeletionMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccountDeletionMessageResponse.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RequestAccountDeletionMessageResponse) GetScheduledForUnix() int64 {
+	if x != nil {
+		return x.ScheduledForUnix
+	}
+	return 0
+}
+
+func (x *RequestAccountDeletionMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CancelAccountDeletionMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionMessageRequest) Reset() {
+	*x = CancelAccountDeletionMessageRequest{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionMessageRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelAccountDeletionMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelAccountDeletionMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionMessageResponse) Reset() {
+	*x = CancelAccountDeletionMessageResponse{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionMessageResponse) ProtoMessage() {}
+
+func (x *CancelAccountDeletionMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelAccountDeletionMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelAccountDeletionMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GenerateAccessReportMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateAccessReportMessageRequest) Reset() {
+	*x = GenerateAccessReportMessageRequest{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAccessReportMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAccessReportMessageRequest) ProtoMessage() {}
+
+func (x *GenerateAccessReportMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAccessReportMessageRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAccessReportMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GenerateAccessReportMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateAccessReportMessageRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GenerateAccessReportMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,3,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,4,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateAccessReportMessageResponse) Reset() {
+	*x = GenerateAccessReportMessageResponse{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAccessReportMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAccessReportMessageResponse) ProtoMessage() {}
+
+func (x *GenerateAccessReportMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAccessReportMessageResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAccessReportMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GenerateAccessReportMessageResponse) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *GenerateAccessReportMessageResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GenerateAccessReportMessageResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *GenerateAccessReportMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+type SetConsentMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Purpose       string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Granted       bool                   `protobuf:"varint,3,opt,name=granted,proto3" json:"granted,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConsentMessageRequest) Reset() {
+	*x = SetConsentMessageRequest{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConsentMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConsentMessageRequest) ProtoMessage() {}
+
+func (x *SetConsentMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConsentMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetConsentMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetConsentMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetConsentMessageRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *SetConsentMessageRequest) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *SetConsentMessageRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SetConsentMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConsentMessageResponse) Reset() {
+	*x = SetConsentMessageResponse{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConsentMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConsentMessageResponse) ProtoMessage() {}
+
+func (x *SetConsentMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConsentMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetConsentMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetConsentMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetConsentMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ExportComplianceRecordsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FromUnix      int64                  `protobuf:"varint,2,opt,name=fromUnix,proto3" json:"fromUnix,omitempty"`
+	ToUnix        int64                  `protobuf:"varint,3,opt,name=toUnix,proto3" json:"toUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportComplianceRecordsMessageRequest) Reset() {
+	*x = ExportComplianceRecordsMessageRequest{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportComplianceRecordsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportComplianceRecordsMessageRequest) ProtoMessage() {}
+
+func (x *ExportComplianceRecordsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportComplianceRecordsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ExportComplianceRecordsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportComplianceRecordsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportComplianceRecordsMessageRequest) GetFromUnix() int64 {
+	if x != nil {
+		return x.FromUnix
+	}
+	return 0
+}
+
+func (x *ExportComplianceRecordsMessageRequest) GetToUnix() int64 {
+	if x != nil {
+		return x.ToUnix
+	}
+	return 0
+}
+
+type ExportComplianceRecordsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=exportId,proto3" json:"exportId,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,2,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,3,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	RecordCount   int64                  `protobuf:"varint,4,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
+	HeadHash      string                 `protobuf:"bytes,5,opt,name=headHash,proto3" json:"headHash,omitempty"`
+	Signature     string                 `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,7,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportComplianceRecordsMessageResponse) Reset() {
+	*x = ExportComplianceRecordsMessageResponse{}
+	mi := &file_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportComplianceRecordsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportComplianceRecordsMessageResponse) ProtoMessage() {}
+
+func (x *ExportComplianceRecordsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportComplianceRecordsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ExportComplianceRecordsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportComplianceRecordsMessageResponse) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *ExportComplianceRecordsMessageResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ExportComplianceRecordsMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *ExportComplianceRecordsMessageResponse) GetRecordCount() int64 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *ExportComplianceRecordsMessageResponse) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *ExportComplianceRecordsMessageResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ExportComplianceRecordsMessageResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type IssueUserTokenMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Client        string                 `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueUserTokenMessageRequest) Reset() {
+	*x = IssueUserTokenMessageRequest{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueUserTokenMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueUserTokenMessageRequest) ProtoMessage() {}
+
+func (x *IssueUserTokenMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueUserTokenMessageRequest.ProtoReflect.Descriptor instead.
+func (*IssueUserTokenMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *IssueUserTokenMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IssueUserTokenMessageRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *IssueUserTokenMessageRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type IssueUserTokenMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueUserTokenMessageResponse) Reset() {
+	*x = IssueUserTokenMessageResponse{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueUserTokenMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueUserTokenMessageResponse) ProtoMessage() {}
+
+func (x *IssueUserTokenMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueUserTokenMessageResponse.ProtoReflect.Descriptor instead.
+func (*IssueUserTokenMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *IssueUserTokenMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *IssueUserTokenMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *IssueUserTokenMessageResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ValidateTokenMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Tenant        string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,4,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=clientIp,proto3" json:"clientIp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenMessageRequest) Reset() {
+	*x = ValidateTokenMessageRequest{}
+	mi := &file_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenMessageRequest) ProtoMessage() {}
+
+func (x *ValidateTokenMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenMessageRequest.ProtoReflect.Descriptor instead.
+func (*ValidateTokenMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ValidateTokenMessageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ValidateTokenMessageRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ValidateTokenMessageRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ValidateTokenMessageRequest) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *ValidateTokenMessageRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type ValidateTokenMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Client        string                 `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Tenant        string                 `protobuf:"bytes,5,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,6,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenMessageResponse) Reset() {
+	*x = ValidateTokenMessageResponse{}
+	mi := &file_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenMessageResponse) ProtoMessage() {}
+
+func (x *ValidateTokenMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenMessageResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTokenMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ValidateTokenMessageResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateTokenMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateTokenMessageResponse) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *ValidateTokenMessageResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ValidateTokenMessageResponse) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ValidateTokenMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *ValidateTokenMessageResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type IssueServiceTokenMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audience      string                 `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,3,opt,name=ttlSeconds,proto3" json:"ttlSeconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueServiceTokenMessageRequest) Reset() {
+	*x = IssueServiceTokenMessageRequest{}
+	mi := &file_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueServiceTokenMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueServiceTokenMessageRequest) ProtoMessage() {}
+
+func (x *IssueServiceTokenMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueServiceTokenMessageRequest.ProtoReflect.Descriptor instead.
+func (*IssueServiceTokenMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *IssueServiceTokenMessageRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *IssueServiceTokenMessageRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *IssueServiceTokenMessageRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type IssueServiceTokenMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueServiceTokenMessageResponse) Reset() {
+	*x = IssueServiceTokenMessageResponse{}
+	mi := &file_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueServiceTokenMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueServiceTokenMessageResponse) ProtoMessage() {}
+
+func (x *IssueServiceTokenMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueServiceTokenMessageResponse.ProtoReflect.Descriptor instead.
+func (*IssueServiceTokenMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *IssueServiceTokenMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *IssueServiceTokenMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *IssueServiceTokenMessageResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ReAuthenticateMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReAuthenticateMessageRequest) Reset() {
+	*x = ReAuthenticateMessageRequest{}
+	mi := &file_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReAuthenticateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReAuthenticateMessageRequest) ProtoMessage() {}
+
+func (x *ReAuthenticateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReAuthenticateMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReAuthenticateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReAuthenticateMessageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReAuthenticateMessageRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ReAuthenticateMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	AuthTimeUnix  int64                  `protobuf:"varint,3,opt,name=authTimeUnix,proto3" json:"authTimeUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReAuthenticateMessageResponse) Reset() {
+	*x = ReAuthenticateMessageResponse{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReAuthenticateMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReAuthenticateMessageResponse) ProtoMessage() {}
+
+func (x *ReAuthenticateMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReAuthenticateMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReAuthenticateMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReAuthenticateMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReAuthenticateMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *ReAuthenticateMessageResponse) GetAuthTimeUnix() int64 {
+	if x != nil {
+		return x.AuthTimeUnix
+	}
+	return 0
+}
+
+type KYCDocumentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DocumentType  string                 `protobuf:"bytes,2,opt,name=documentType,proto3" json:"documentType,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KYCDocumentInfo) Reset() {
+	*x = KYCDocumentInfo{}
+	mi := &file_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KYCDocumentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCDocumentInfo) ProtoMessage() {}
+
+func (x *KYCDocumentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCDocumentInfo.ProtoReflect.Descriptor instead.
+func (*KYCDocumentInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *KYCDocumentInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *KYCDocumentInfo) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *KYCDocumentInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *KYCDocumentInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadKYCDocumentMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadKYCDocumentMessageRequest_Info
+	//	*UploadKYCDocumentMessageRequest_Chunk
+	Payload       isUploadKYCDocumentMessageRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadKYCDocumentMessageRequest) Reset() {
+	*x = UploadKYCDocumentMessageRequest{}
+	mi := &file_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadKYCDocumentMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKYCDocumentMessageRequest) ProtoMessage() {}
+
+func (x *UploadKYCDocumentMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKYCDocumentMessageRequest.ProtoReflect.Descriptor instead.
+func (*UploadKYCDocumentMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UploadKYCDocumentMessageRequest) GetPayload() isUploadKYCDocumentMessageRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadKYCDocumentMessageRequest) GetInfo() *KYCDocumentInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadKYCDocumentMessageRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadKYCDocumentMessageRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadKYCDocumentMessageRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadKYCDocumentMessageRequest_Payload interface {
+	isUploadKYCDocumentMessageRequest_Payload()
+}
+
+type UploadKYCDocumentMessageRequest_Info struct {
+	Info *KYCDocumentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadKYCDocumentMessageRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadKYCDocumentMessageRequest_Info) isUploadKYCDocumentMessageRequest_Payload() {}
+
+func (*UploadKYCDocumentMessageRequest_Chunk) isUploadKYCDocumentMessageRequest_Payload() {}
+
+type UploadKYCDocumentMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	SellerStatus  string                 `protobuf:"bytes,2,opt,name=sellerStatus,proto3" json:"sellerStatus,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadKYCDocumentMessageResponse) Reset() {
+	*x = UploadKYCDocumentMessageResponse{}
+	mi := &file_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadKYCDocumentMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKYCDocumentMessageResponse) ProtoMessage() {}
+
+func (x *UploadKYCDocumentMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKYCDocumentMessageResponse.ProtoReflect.Descriptor instead.
+func (*UploadKYCDocumentMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UploadKYCDocumentMessageResponse) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *UploadKYCDocumentMessageResponse) GetSellerStatus() string {
+	if x != nil {
+		return x.SellerStatus
+	}
+	return ""
+}
+
+func (x *UploadKYCDocumentMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadKYCDocumentMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type KYCDocument struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentType   string                 `protobuf:"bytes,2,opt,name=documentType,proto3" json:"documentType,omitempty"`
+	FileName       string                 `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ContentType    string                 `protobuf:"bytes,4,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	SizeBytes      int64                  `protobuf:"varint,5,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	UploadedAtUnix int64                  `protobuf:"varint,7,opt,name=uploadedAtUnix,proto3" json:"uploadedAtUnix,omitempty"`
+	DownloadUrl    string                 `protobuf:"bytes,8,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *KYCDocument) Reset() {
+	*x = KYCDocument{}
+	mi := &file_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KYCDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCDocument) ProtoMessage() {}
+
+func (x *KYCDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCDocument.ProtoReflect.Descriptor instead.
+func (*KYCDocument) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *KYCDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KYCDocument) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *KYCDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *KYCDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KYCDocument) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *KYCDocument) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KYCDocument) GetUploadedAtUnix() int64 {
+	if x != nil {
+		return x.UploadedAtUnix
+	}
+	return 0
+}
+
+func (x *KYCDocument) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type KYCReviewItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName        string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	SubmittedAtUnix int64                  `protobuf:"varint,3,opt,name=submittedAtUnix,proto3" json:"submittedAtUnix,omitempty"`
+	Documents       []*KYCDocument         `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KYCReviewItem) Reset() {
+	*x = KYCReviewItem{}
+	mi := &file_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KYCReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCReviewItem) ProtoMessage() {}
+
+func (x *KYCReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCReviewItem.ProtoReflect.Descriptor instead.
+func (*KYCReviewItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *KYCReviewItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *KYCReviewItem) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *KYCReviewItem) GetSubmittedAtUnix() int64 {
+	if x != nil {
+		return x.SubmittedAtUnix
+	}
+	return 0
+}
+
+func (x *KYCReviewItem) GetDocuments() []*KYCDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type ListKYCReviewQueueMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKYCReviewQueueMessageRequest) Reset() {
+	*x = ListKYCReviewQueueMessageRequest{}
+	mi := &file_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKYCReviewQueueMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKYCReviewQueueMessageRequest) ProtoMessage() {}
+
+func (x *ListKYCReviewQueueMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKYCReviewQueueMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListKYCReviewQueueMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListKYCReviewQueueMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListKYCReviewQueueMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*KYCReviewItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKYCReviewQueueMessageResponse) Reset() {
+	*x = ListKYCReviewQueueMessageResponse{}
+	mi := &file_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKYCReviewQueueMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKYCReviewQueueMessageResponse) ProtoMessage() {}
+
+func (x *ListKYCReviewQueueMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKYCReviewQueueMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListKYCReviewQueueMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListKYCReviewQueueMessageResponse) GetItems() []*KYCReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ApproveKYCMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Reviewer      string                 `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveKYCMessageRequest) Reset() {
+	*x = ApproveKYCMessageRequest{}
+	mi := &file_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveKYCMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveKYCMessageRequest) ProtoMessage() {}
+
+func (x *ApproveKYCMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveKYCMessageRequest.ProtoReflect.Descriptor instead.
+func (*ApproveKYCMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ApproveKYCMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ApproveKYCMessageRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+type ApproveKYCMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveKYCMessageResponse) Reset() {
+	*x = ApproveKYCMessageResponse{}
+	mi := &file_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveKYCMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveKYCMessageResponse) ProtoMessage() {}
+
+func (x *ApproveKYCMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveKYCMessageResponse.ProtoReflect.Descriptor instead.
+func (*ApproveKYCMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ApproveKYCMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApproveKYCMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RejectKYCMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Reviewer      string                 `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectKYCMessageRequest) Reset() {
+	*x = RejectKYCMessageRequest{}
+	mi := &file_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectKYCMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectKYCMessageRequest) ProtoMessage() {}
+
+func (x *RejectKYCMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectKYCMessageRequest.ProtoReflect.Descriptor instead.
+func (*RejectKYCMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RejectKYCMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RejectKYCMessageRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *RejectKYCMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectKYCMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectKYCMessageResponse) Reset() {
+	*x = RejectKYCMessageResponse{}
+	mi := &file_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectKYCMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectKYCMessageResponse) ProtoMessage() {}
+
+func (x *RejectKYCMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectKYCMessageResponse.ProtoReflect.Descriptor instead.
+func (*RejectKYCMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RejectKYCMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RejectKYCMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type StartIdentityVerificationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartIdentityVerificationMessageRequest) Reset() {
+	*x = StartIdentityVerificationMessageRequest{}
+	mi := &file_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartIdentityVerificationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartIdentityVerificationMessageRequest) ProtoMessage() {}
+
+func (x *StartIdentityVerificationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartIdentityVerificationMessageRequest.ProtoReflect.Descriptor instead.
+func (*StartIdentityVerificationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StartIdentityVerificationMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartIdentityVerificationMessageRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type StartIdentityVerificationMessageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	VerificationUrl string                 `protobuf:"bytes,2,opt,name=verificationUrl,proto3" json:"verificationUrl,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StartIdentityVerificationMessageResponse) Reset() {
+	*x = StartIdentityVerificationMessageResponse{}
+	mi := &file_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartIdentityVerificationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartIdentityVerificationMessageResponse) ProtoMessage() {}
+
+func (x *StartIdentityVerificationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartIdentityVerificationMessageResponse.ProtoReflect.Descriptor instead.
+func (*StartIdentityVerificationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StartIdentityVerificationMessageResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StartIdentityVerificationMessageResponse) GetVerificationUrl() string {
+	if x != nil {
+		return x.VerificationUrl
+	}
+	return ""
+}
+
+func (x *StartIdentityVerificationMessageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetIdentityVerificationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityVerificationMessageRequest) Reset() {
+	*x = GetIdentityVerificationMessageRequest{}
+	mi := &file_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityVerificationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityVerificationMessageRequest) ProtoMessage() {}
+
+func (x *GetIdentityVerificationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityVerificationMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentityVerificationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetIdentityVerificationMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetIdentityVerificationMessageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Provider        string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	StartedAtUnix   int64                  `protobuf:"varint,3,opt,name=startedAtUnix,proto3" json:"startedAtUnix,omitempty"`
+	CompletedAtUnix int64                  `protobuf:"varint,4,opt,name=completedAtUnix,proto3" json:"completedAtUnix,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetIdentityVerificationMessageResponse) Reset() {
+	*x = GetIdentityVerificationMessageResponse{}
+	mi := &file_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityVerificationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityVerificationMessageResponse) ProtoMessage() {}
+
+func (x *GetIdentityVerificationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityVerificationMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentityVerificationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetIdentityVerificationMessageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetIdentityVerificationMessageResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetIdentityVerificationMessageResponse) GetStartedAtUnix() int64 {
+	if x != nil {
+		return x.StartedAtUnix
+	}
+	return 0
+}
+
+func (x *GetIdentityVerificationMessageResponse) GetCompletedAtUnix() int64 {
+	if x != nil {
+		return x.CompletedAtUnix
+	}
+	return 0
+}
+
+func (x *GetIdentityVerificationMessageResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VerifyPayoutAccountMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPayoutAccountMessageRequest) Reset() {
+	*x = VerifyPayoutAccountMessageRequest{}
+	mi := &file_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPayoutAccountMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPayoutAccountMessageRequest) ProtoMessage() {}
+
+func (x *VerifyPayoutAccountMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPayoutAccountMessageRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPayoutAccountMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *VerifyPayoutAccountMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type VerifyPayoutAccountMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPayoutAccountMessageResponse) Reset() {
+	*x = VerifyPayoutAccountMessageResponse{}
+	mi := &file_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPayoutAccountMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPayoutAccountMessageResponse) ProtoMessage() {}
+
+func (x *VerifyPayoutAccountMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPayoutAccountMessageResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPayoutAccountMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *VerifyPayoutAccountMessageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VerifyPayoutAccountMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPayoutVerificationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutVerificationMessageRequest) Reset() {
+	*x = GetPayoutVerificationMessageRequest{}
+	mi := &file_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutVerificationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutVerificationMessageRequest) ProtoMessage() {}
+
+func (x *GetPayoutVerificationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutVerificationMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutVerificationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetPayoutVerificationMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPayoutVerificationMessageResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Status            string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Phone             string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	MaskedAccountName string                 `protobuf:"bytes,3,opt,name=maskedAccountName,proto3" json:"maskedAccountName,omitempty"`
+	CompletedAtUnix   int64                  `protobuf:"varint,4,opt,name=completedAtUnix,proto3" json:"completedAtUnix,omitempty"`
+	Reason            string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetPayoutVerificationMessageResponse) Reset() {
+	*x = GetPayoutVerificationMessageResponse{}
+	mi := &file_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutVerificationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutVerificationMessageResponse) ProtoMessage() {}
+
+func (x *GetPayoutVerificationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutVerificationMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutVerificationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetPayoutVerificationMessageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetPayoutVerificationMessageResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *GetPayoutVerificationMessageResponse) GetMaskedAccountName() string {
+	if x != nil {
+		return x.MaskedAccountName
+	}
+	return ""
+}
+
+func (x *GetPayoutVerificationMessageResponse) GetCompletedAtUnix() int64 {
+	if x != nil {
+		return x.CompletedAtUnix
+	}
+	return 0
+}
+
+func (x *GetPayoutVerificationMessageResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WalletTransaction struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	AmountMinor       int64                  `protobuf:"varint,3,opt,name=amountMinor,proto3" json:"amountMinor,omitempty"`
+	Currency          string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	BalanceAfterMinor int64                  `protobuf:"varint,5,opt,name=balanceAfterMinor,proto3" json:"balanceAfterMinor,omitempty"`
+	Source            string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	Reference         string                 `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description       string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAtUnix     int64                  `protobuf:"varint,9,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
+	mi := &file_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction) ProtoMessage() {}
+
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *WalletTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetBalanceAfterMinor() int64 {
+	if x != nil {
+		return x.BalanceAfterMinor
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+type CreditWalletMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	AmountMinor    int64                  `protobuf:"varint,2,opt,name=amountMinor,proto3" json:"amountMinor,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Source         string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Reference      string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreditWalletMessageRequest) Reset() {
+	*x = CreditWalletMessageRequest{}
+	mi := &file_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditWalletMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditWalletMessageRequest) ProtoMessage() {}
+
+func (x *CreditWalletMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditWalletMessageRequest.ProtoReflect.Descriptor instead.
+func (*CreditWalletMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreditWalletMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreditWalletMessageRequest) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *CreditWalletMessageRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreditWalletMessageRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CreditWalletMessageRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CreditWalletMessageRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreditWalletMessageRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreditWalletMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	BalanceMinor  int64                  `protobuf:"varint,2,opt,name=balanceMinor,proto3" json:"balanceMinor,omitempty"`
+	Replayed      bool                   `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditWalletMessageResponse) Reset() {
+	*x = CreditWalletMessageResponse{}
+	mi := &file_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditWalletMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditWalletMessageResponse) ProtoMessage() {}
+
+func (x *CreditWalletMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditWalletMessageResponse.ProtoReflect.Descriptor instead.
+func (*CreditWalletMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreditWalletMessageResponse) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *CreditWalletMessageResponse) GetBalanceMinor() int64 {
+	if x != nil {
+		return x.BalanceMinor
+	}
+	return 0
+}
+
+func (x *CreditWalletMessageResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type DebitWalletMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	AmountMinor    int64                  `protobuf:"varint,2,opt,name=amountMinor,proto3" json:"amountMinor,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Source         string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Reference      string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DebitWalletMessageRequest) Reset() {
+	*x = DebitWalletMessageRequest{}
+	mi := &file_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebitWalletMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebitWalletMessageRequest) ProtoMessage() {}
+
+func (x *DebitWalletMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebitWalletMessageRequest.ProtoReflect.Descriptor instead.
+func (*DebitWalletMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DebitWalletMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DebitWalletMessageRequest) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *DebitWalletMessageRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DebitWalletMessageRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DebitWalletMessageRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *DebitWalletMessageRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DebitWalletMessageRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type DebitWalletMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	BalanceMinor  int64                  `protobuf:"varint,2,opt,name=balanceMinor,proto3" json:"balanceMinor,omitempty"`
+	Replayed      bool                   `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebitWalletMessageResponse) Reset() {
+	*x = DebitWalletMessageResponse{}
+	mi := &file_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebitWalletMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebitWalletMessageResponse) ProtoMessage() {}
+
+func (x *DebitWalletMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebitWalletMessageResponse.ProtoReflect.Descriptor instead.
+func (*DebitWalletMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DebitWalletMessageResponse) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *DebitWalletMessageResponse) GetBalanceMinor() int64 {
+	if x != nil {
+		return x.BalanceMinor
+	}
+	return 0
+}
+
+func (x *DebitWalletMessageResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type GetWalletMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletMessageRequest) Reset() {
+	*x = GetWalletMessageRequest{}
+	mi := &file_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletMessageRequest) ProtoMessage() {}
+
+func (x *GetWalletMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetWalletMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetWalletMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetWalletMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BalanceMinor  int64                  `protobuf:"varint,1,opt,name=balanceMinor,proto3" json:"balanceMinor,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Transactions  []*WalletTransaction   `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletMessageResponse) Reset() {
+	*x = GetWalletMessageResponse{}
+	mi := &file_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletMessageResponse) ProtoMessage() {}
+
+func (x *GetWalletMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetWalletMessageResponse) GetBalanceMinor() int64 {
+	if x != nil {
+		return x.BalanceMinor
+	}
+	return 0
+}
+
+func (x *GetWalletMessageResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetWalletMessageResponse) GetTransactions() []*WalletTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GiftCard struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaskedCode           string                 `protobuf:"bytes,2,opt,name=maskedCode,proto3" json:"maskedCode,omitempty"`
+	Status               string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	BalanceMinor         int64                  `protobuf:"varint,4,opt,name=balanceMinor,proto3" json:"balanceMinor,omitempty"`
+	Currency             string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpiresAtUnix        int64                  `protobuf:"varint,6,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	AttachedAtUnix       int64                  `protobuf:"varint,7,opt,name=attachedAtUnix,proto3" json:"attachedAtUnix,omitempty"`
+	BalanceCheckedAtUnix int64                  `protobuf:"varint,8,opt,name=balanceCheckedAtUnix,proto3" json:"balanceCheckedAtUnix,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GiftCard) Reset() {
+	*x = GiftCard{}
+	mi := &file_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCard) ProtoMessage() {}
+
+func (x *GiftCard) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCard.ProtoReflect.Descriptor instead.
+func (*GiftCard) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GiftCard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GiftCard) GetMaskedCode() string {
+	if x != nil {
+		return x.MaskedCode
+	}
+	return ""
+}
+
+func (x *GiftCard) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GiftCard) GetBalanceMinor() int64 {
+	if x != nil {
+		return x.BalanceMinor
+	}
+	return 0
+}
+
+func (x *GiftCard) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GiftCard) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *GiftCard) GetAttachedAtUnix() int64 {
+	if x != nil {
+		return x.AttachedAtUnix
+	}
+	return 0
+}
+
+func (x *GiftCard) GetBalanceCheckedAtUnix() int64 {
+	if x != nil {
+		return x.BalanceCheckedAtUnix
+	}
+	return 0
+}
+
+type AttachGiftCardMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachGiftCardMessageRequest) Reset() {
+	*x = AttachGiftCardMessageRequest{}
+	mi := &file_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachGiftCardMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachGiftCardMessageRequest) ProtoMessage() {}
+
+func (x *AttachGiftCardMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachGiftCardMessageRequest.ProtoReflect.Descriptor instead.
+func (*AttachGiftCardMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AttachGiftCardMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AttachGiftCardMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type AttachGiftCardMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GiftCard      *GiftCard              `protobuf:"bytes,1,opt,name=giftCard,proto3" json:"giftCard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachGiftCardMessageResponse) Reset() {
+	*x = AttachGiftCardMessageResponse{}
+	mi := &file_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachGiftCardMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachGiftCardMessageResponse) ProtoMessage() {}
+
+func (x *AttachGiftCardMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachGiftCardMessageResponse.ProtoReflect.Descriptor instead.
+func (*AttachGiftCardMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AttachGiftCardMessageResponse) GetGiftCard() *GiftCard {
+	if x != nil {
+		return x.GiftCard
+	}
+	return nil
+}
+
+type ListGiftCardsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGiftCardsMessageRequest) Reset() {
+	*x = ListGiftCardsMessageRequest{}
+	mi := &file_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiftCardsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftCardsMessageRequest) ProtoMessage() {}
+
+func (x *ListGiftCardsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftCardsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListGiftCardsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListGiftCardsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListGiftCardsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GiftCards     []*GiftCard            `protobuf:"bytes,1,rep,name=giftCards,proto3" json:"giftCards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGiftCardsMessageResponse) Reset() {
+	*x = ListGiftCardsMessageResponse{}
+	mi := &file_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiftCardsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftCardsMessageResponse) ProtoMessage() {}
+
+func (x *ListGiftCardsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftCardsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListGiftCardsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListGiftCardsMessageResponse) GetGiftCards() []*GiftCard {
+	if x != nil {
+		return x.GiftCards
+	}
+	return nil
+}
+
+type GetGiftCardBalanceMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GiftCardId    string                 `protobuf:"bytes,2,opt,name=giftCardId,proto3" json:"giftCardId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiftCardBalanceMessageRequest) Reset() {
+	*x = GetGiftCardBalanceMessageRequest{}
+	mi := &file_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiftCardBalanceMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftCardBalanceMessageRequest) ProtoMessage() {}
+
+func (x *GetGiftCardBalanceMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftCardBalanceMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetGiftCardBalanceMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetGiftCardBalanceMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetGiftCardBalanceMessageRequest) GetGiftCardId() string {
+	if x != nil {
+		return x.GiftCardId
+	}
+	return ""
+}
+
+type GetGiftCardBalanceMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GiftCard      *GiftCard              `protobuf:"bytes,1,opt,name=giftCard,proto3" json:"giftCard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiftCardBalanceMessageResponse) Reset() {
+	*x = GetGiftCardBalanceMessageResponse{}
+	mi := &file_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiftCardBalanceMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftCardBalanceMessageResponse) ProtoMessage() {}
+
+func (x *GetGiftCardBalanceMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftCardBalanceMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetGiftCardBalanceMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetGiftCardBalanceMessageResponse) GetGiftCard() *GiftCard {
+	if x != nil {
+		return x.GiftCard
+	}
+	return nil
+}
+
+type Coupon struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Source         string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	PercentOff     int32                  `protobuf:"varint,4,opt,name=percentOff,proto3" json:"percentOff,omitempty"`
+	AmountOffMinor int64                  `protobuf:"varint,5,opt,name=amountOffMinor,proto3" json:"amountOffMinor,omitempty"`
+	Currency       string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	MinOrderMinor  int64                  `protobuf:"varint,7,opt,name=minOrderMinor,proto3" json:"minOrderMinor,omitempty"`
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAtUnix  int64                  `protobuf:"varint,9,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	OrderId        string                 `protobuf:"bytes,10,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	RedeemedAtUnix int64                  `protobuf:"varint,11,opt,name=redeemedAtUnix,proto3" json:"redeemedAtUnix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Coupon) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Coupon) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Coupon) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *Coupon) GetAmountOffMinor() int64 {
+	if x != nil {
+		return x.AmountOffMinor
+	}
+	return 0
+}
+
+func (x *Coupon) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Coupon) GetMinOrderMinor() int64 {
+	if x != nil {
+		return x.MinOrderMinor
+	}
+	return 0
+}
+
+func (x *Coupon) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Coupon) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *Coupon) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Coupon) GetRedeemedAtUnix() int64 {
+	if x != nil {
+		return x.RedeemedAtUnix
+	}
+	return 0
+}
+
+type GrantCouponMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Source         string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	PercentOff     int32                  `protobuf:"varint,3,opt,name=percentOff,proto3" json:"percentOff,omitempty"`
+	AmountOffMinor int64                  `protobuf:"varint,4,opt,name=amountOffMinor,proto3" json:"amountOffMinor,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	MinOrderMinor  int64                  `protobuf:"varint,6,opt,name=minOrderMinor,proto3" json:"minOrderMinor,omitempty"`
+	ValidDays      int32                  `protobuf:"varint,7,opt,name=validDays,proto3" json:"validDays,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GrantCouponMessageRequest) Reset() {
+	*x = GrantCouponMessageRequest{}
+	mi := &file_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantCouponMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantCouponMessageRequest) ProtoMessage() {}
+
+func (x *GrantCouponMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantCouponMessageRequest.ProtoReflect.Descriptor instead.
+func (*GrantCouponMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GrantCouponMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GrantCouponMessageRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GrantCouponMessageRequest) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *GrantCouponMessageRequest) GetAmountOffMinor() int64 {
+	if x != nil {
+		return x.AmountOffMinor
+	}
+	return 0
+}
+
+func (x *GrantCouponMessageRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GrantCouponMessageRequest) GetMinOrderMinor() int64 {
+	if x != nil {
+		return x.MinOrderMinor
+	}
+	return 0
+}
+
+func (x *GrantCouponMessageRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+type GrantCouponMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantCouponMessageResponse) Reset() {
+	*x = GrantCouponMessageResponse{}
+	mi := &file_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantCouponMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantCouponMessageResponse) ProtoMessage() {}
+
+func (x *GrantCouponMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantCouponMessageResponse.ProtoReflect.Descriptor instead.
+func (*GrantCouponMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GrantCouponMessageResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type ListCouponsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsMessageRequest) Reset() {
+	*x = ListCouponsMessageRequest{}
+	mi := &file_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsMessageRequest) ProtoMessage() {}
+
+func (x *ListCouponsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListCouponsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCouponsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupons       []*Coupon              `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsMessageResponse) Reset() {
+	*x = ListCouponsMessageResponse{}
+	mi := &file_user_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsMessageResponse) ProtoMessage() {}
+
+func (x *ListCouponsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListCouponsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListCouponsMessageResponse) GetCoupons() []*Coupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+type ReserveCouponMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveCouponMessageRequest) Reset() {
+	*x = ReserveCouponMessageRequest{}
+	mi := &file_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveCouponMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveCouponMessageRequest) ProtoMessage() {}
+
+func (x *ReserveCouponMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveCouponMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReserveCouponMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ReserveCouponMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReserveCouponMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReserveCouponMessageRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReserveCouponMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveCouponMessageResponse) Reset() {
+	*x = ReserveCouponMessageResponse{}
+	mi := &file_user_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveCouponMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveCouponMessageResponse) ProtoMessage() {}
+
+func (x *ReserveCouponMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveCouponMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReserveCouponMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ReserveCouponMessageResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type RedeemCouponMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCouponMessageRequest) Reset() {
+	*x = RedeemCouponMessageRequest{}
+	mi := &file_user_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCouponMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCouponMessageRequest) ProtoMessage() {}
+
+func (x *RedeemCouponMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCouponMessageRequest.ProtoReflect.Descriptor instead.
+func (*RedeemCouponMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RedeemCouponMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RedeemCouponMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemCouponMessageRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type RedeemCouponMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCouponMessageResponse) Reset() {
+	*x = RedeemCouponMessageResponse{}
+	mi := &file_user_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCouponMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCouponMessageResponse) ProtoMessage() {}
+
+func (x *RedeemCouponMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCouponMessageResponse.ProtoReflect.Descriptor instead.
+func (*RedeemCouponMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RedeemCouponMessageResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type ReleaseCouponMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCouponMessageRequest) Reset() {
+	*x = ReleaseCouponMessageRequest{}
+	mi := &file_user_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCouponMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCouponMessageRequest) ProtoMessage() {}
+
+func (x *ReleaseCouponMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCouponMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseCouponMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ReleaseCouponMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReleaseCouponMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReleaseCouponMessageRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReleaseCouponMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCouponMessageResponse) Reset() {
+	*x = ReleaseCouponMessageResponse{}
+	mi := &file_user_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCouponMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCouponMessageResponse) ProtoMessage() {}
+
+func (x *ReleaseCouponMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCouponMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseCouponMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ReleaseCouponMessageResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type SetTimezoneMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimezoneMessageRequest) Reset() {
+	*x = SetTimezoneMessageRequest{}
+	mi := &file_user_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimezoneMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimezoneMessageRequest) ProtoMessage() {}
+
+func (x *SetTimezoneMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimezoneMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetTimezoneMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetTimezoneMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetTimezoneMessageRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type SetTimezoneMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimezoneMessageResponse) Reset() {
+	*x = SetTimezoneMessageResponse{}
+	mi := &file_user_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimezoneMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimezoneMessageResponse) ProtoMessage() {}
+
+func (x *SetTimezoneMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimezoneMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetTimezoneMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SetTimezoneMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetTimezoneMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SubmitFeedbackMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	OrderId       string                 `protobuf:"bytes,5,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFeedbackMessageRequest) Reset() {
+	*x = SubmitFeedbackMessageRequest{}
+	mi := &file_user_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFeedbackMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFeedbackMessageRequest) ProtoMessage() {}
+
+func (x *SubmitFeedbackMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFeedbackMessageRequest.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SubmitFeedbackMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitFeedbackMessageRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmitFeedbackMessageRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SubmitFeedbackMessageRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SubmitFeedbackMessageRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type SubmitFeedbackMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFeedbackMessageResponse) Reset() {
+	*x = SubmitFeedbackMessageResponse{}
+	mi := &file_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFeedbackMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFeedbackMessageResponse) ProtoMessage() {}
+
+func (x *SubmitFeedbackMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFeedbackMessageResponse.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SubmitFeedbackMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitFeedbackMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetFeedbackSummaryMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedbackSummaryMessageRequest) Reset() {
+	*x = GetFeedbackSummaryMessageRequest{}
+	mi := &file_user_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedbackSummaryMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedbackSummaryMessageRequest) ProtoMessage() {}
+
+func (x *GetFeedbackSummaryMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedbackSummaryMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedbackSummaryMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetFeedbackSummaryMessageRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type GetFeedbackSummaryMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Responses     int64                  `protobuf:"varint,2,opt,name=responses,proto3" json:"responses,omitempty"`
+	Promoters     int64                  `protobuf:"varint,3,opt,name=promoters,proto3" json:"promoters,omitempty"`
+	Passives      int64                  `protobuf:"varint,4,opt,name=passives,proto3" json:"passives,omitempty"`
+	Detractors    int64                  `protobuf:"varint,5,opt,name=detractors,proto3" json:"detractors,omitempty"`
+	Nps           float64                `protobuf:"fixed64,6,opt,name=nps,proto3" json:"nps,omitempty"`
+	AverageScore  float64                `protobuf:"fixed64,7,opt,name=averageScore,proto3" json:"averageScore,omitempty"`
+	Comments      int64                  `protobuf:"varint,8,opt,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedbackSummaryMessageResponse) Reset() {
+	*x = GetFeedbackSummaryMessageResponse{}
+	mi := &file_user_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedbackSummaryMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedbackSummaryMessageResponse) ProtoMessage() {}
+
+func (x *GetFeedbackSummaryMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedbackSummaryMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedbackSummaryMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetResponses() int64 {
+	if x != nil {
+		return x.Responses
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetPromoters() int64 {
+	if x != nil {
+		return x.Promoters
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetPassives() int64 {
+	if x != nil {
+		return x.Passives
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetDetractors() int64 {
+	if x != nil {
+		return x.Detractors
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetNps() float64 {
+	if x != nil {
+		return x.Nps
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryMessageResponse) GetComments() int64 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+type SupportTicket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	System        string                 `protobuf:"bytes,2,opt,name=system,proto3" json:"system,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=externalId,proto3" json:"externalId,omitempty"`
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	LinkedAtUnix  int64                  `protobuf:"varint,7,opt,name=linkedAtUnix,proto3" json:"linkedAtUnix,omitempty"`
+	UpdatedAtUnix int64                  `protobuf:"varint,8,opt,name=updatedAtUnix,proto3" json:"updatedAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportTicket) Reset() {
+	*x = SupportTicket{}
+	mi := &file_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportTicket) ProtoMessage() {}
+
+func (x *SupportTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportTicket.ProtoReflect.Descriptor instead.
+func (*SupportTicket) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SupportTicket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetLinkedAtUnix() int64 {
+	if x != nil {
+		return x.LinkedAtUnix
+	}
+	return 0
+}
+
+func (x *SupportTicket) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+type SupportCustomer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName        string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName        string                 `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Email           string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber     string                 `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Tier            string                 `protobuf:"bytes,6,opt,name=tier,proto3" json:"tier,omitempty"`
+	EmailVerified   bool                   `protobuf:"varint,7,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
+	SellerStatus    string                 `protobuf:"bytes,8,opt,name=sellerStatus,proto3" json:"sellerStatus,omitempty"`
+	CreatedAtUnix   int64                  `protobuf:"varint,9,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	LastLoginAtUnix int64                  `protobuf:"varint,10,opt,name=lastLoginAtUnix,proto3" json:"lastLoginAtUnix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SupportCustomer) Reset() {
+	*x = SupportCustomer{}
+	mi := &file_user_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportCustomer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportCustomer) ProtoMessage() {}
+
+func (x *SupportCustomer) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportCustomer.ProtoReflect.Descriptor instead.
+func (*SupportCustomer) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SupportCustomer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SupportCustomer) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *SupportCustomer) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SupportCustomer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SupportCustomer) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *SupportCustomer) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *SupportCustomer) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *SupportCustomer) GetSellerStatus() string {
+	if x != nil {
+		return x.SellerStatus
+	}
+	return ""
+}
+
+func (x *SupportCustomer) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *SupportCustomer) GetLastLoginAtUnix() int64 {
+	if x != nil {
+		return x.LastLoginAtUnix
+	}
+	return 0
+}
+
+type LinkTicketMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	System        string                 `protobuf:"bytes,2,opt,name=system,proto3" json:"system,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=externalId,proto3" json:"externalId,omitempty"`
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkTicketMessageRequest) Reset() {
+	*x = LinkTicketMessageRequest{}
+	mi := &file_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkTicketMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkTicketMessageRequest) ProtoMessage() {}
+
+func (x *LinkTicketMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkTicketMessageRequest.ProtoReflect.Descriptor instead.
+func (*LinkTicketMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *LinkTicketMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkTicketMessageRequest) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *LinkTicketMessageRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *LinkTicketMessageRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LinkTicketMessageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LinkTicketMessageRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type LinkTicketMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *SupportTicket         `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkTicketMessageResponse) Reset() {
+	*x = LinkTicketMessageResponse{}
+	mi := &file_user_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkTicketMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkTicketMessageResponse) ProtoMessage() {}
+
+func (x *LinkTicketMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkTicketMessageResponse.ProtoReflect.Descriptor instead.
+func (*LinkTicketMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *LinkTicketMessageResponse) GetTicket() *SupportTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type ListTicketsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsMessageRequest) Reset() {
+	*x = ListTicketsMessageRequest{}
+	mi := &file_user_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsMessageRequest) ProtoMessage() {}
+
+func (x *ListTicketsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListTicketsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTicketsMessageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListTicketsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *SupportCustomer       `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Tickets       []*SupportTicket       `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsMessageResponse) Reset() {
+	*x = ListTicketsMessageResponse{}
+	mi := &file_user_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsMessageResponse) ProtoMessage() {}
+
+func (x *ListTicketsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListTicketsMessageResponse) GetCustomer() *SupportCustomer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *ListTicketsMessageResponse) GetTickets() []*SupportTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type UpdatePresenceMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePresenceMessageRequest) Reset() {
+	*x = UpdatePresenceMessageRequest{}
+	mi := &file_user_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePresenceMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePresenceMessageRequest) ProtoMessage() {}
+
+func (x *UpdatePresenceMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePresenceMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePresenceMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdatePresenceMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePresenceMessageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdatePresenceMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePresenceMessageResponse) Reset() {
+	*x = UpdatePresenceMessageResponse{}
+	mi := &file_user_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePresenceMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePresenceMessageResponse) ProtoMessage() {}
+
+func (x *UpdatePresenceMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePresenceMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePresenceMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UpdatePresenceMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UserPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	LastSeenUnix  int64                  `protobuf:"varint,4,opt,name=lastSeenUnix,proto3" json:"lastSeenUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPresence) Reset() {
+	*x = UserPresence{}
+	mi := &file_user_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPresence) ProtoMessage() {}
+
+func (x *UserPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPresence.ProtoReflect.Descriptor instead.
+func (*UserPresence) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UserPresence) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserPresence) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *UserPresence) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserPresence) GetLastSeenUnix() int64 {
+	if x != nil {
+		return x.LastSeenUnix
+	}
+	return 0
+}
+
+type GetPresenceMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=userIds,proto3" json:"userIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceMessageRequest) Reset() {
+	*x = GetPresenceMessageRequest{}
+	mi := &file_user_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceMessageRequest) ProtoMessage() {}
+
+func (x *GetPresenceMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetPresenceMessageRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetPresenceMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presences     []*UserPresence        `protobuf:"bytes,1,rep,name=presences,proto3" json:"presences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceMessageResponse) Reset() {
+	*x = GetPresenceMessageResponse{}
+	mi := &file_user_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceMessageResponse) ProtoMessage() {}
+
+func (x *GetPresenceMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetPresenceMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetPresenceMessageResponse) GetPresences() []*UserPresence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+type SuggestUsersMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestUsersMessageRequest) Reset() {
+	*x = SuggestUsersMessageRequest{}
+	mi := &file_user_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestUsersMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestUsersMessageRequest) ProtoMessage() {}
+
+func (x *SuggestUsersMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestUsersMessageRequest.ProtoReflect.Descriptor instead.
+func (*SuggestUsersMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SuggestUsersMessageRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SuggestUsersMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UserSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	EmailAddress  string                 `protobuf:"bytes,4,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSuggestion) Reset() {
+	*x = UserSuggestion{}
+	mi := &file_user_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSuggestion) ProtoMessage() {}
+
+func (x *UserSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSuggestion.ProtoReflect.Descriptor instead.
+func (*UserSuggestion) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *UserSuggestion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSuggestion) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *UserSuggestion) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserSuggestion) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+type SuggestUsersMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*UserSuggestion      `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestUsersMessageResponse) Reset() {
+	*x = SuggestUsersMessageResponse{}
+	mi := &file_user_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestUsersMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestUsersMessageResponse) ProtoMessage() {}
+
+func (x *SuggestUsersMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestUsersMessageResponse.ProtoReflect.Descriptor instead.
+func (*SuggestUsersMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SuggestUsersMessageResponse) GetSuggestions() []*UserSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ListDuplicateCandidatesMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MinScore      float64                `protobuf:"fixed64,2,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateCandidatesMessageRequest) Reset() {
+	*x = ListDuplicateCandidatesMessageRequest{}
+	mi := &file_user_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateCandidatesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateCandidatesMessageRequest) ProtoMessage() {}
+
+func (x *ListDuplicateCandidatesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateCandidatesMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateCandidatesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListDuplicateCandidatesMessageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDuplicateCandidatesMessageRequest) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *ListDuplicateCandidatesMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DuplicateUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	EmailAddress  string                 `protobuf:"bytes,4,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	CreatedAtUnix int64                  `protobuf:"varint,6,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateUser) Reset() {
+	*x = DuplicateUser{}
+	mi := &file_user_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateUser) ProtoMessage() {}
+
+func (x *DuplicateUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateUser.ProtoReflect.Descriptor instead.
+func (*DuplicateUser) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DuplicateUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DuplicateUser) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *DuplicateUser) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *DuplicateUser) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *DuplicateUser) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *DuplicateUser) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+type DuplicateCandidate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserA          *DuplicateUser         `protobuf:"bytes,2,opt,name=userA,proto3" json:"userA,omitempty"`
+	UserB          *DuplicateUser         `protobuf:"bytes,3,opt,name=userB,proto3" json:"userB,omitempty"`
+	Score          float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	Reasons        []string               `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	DetectedAtUnix int64                  `protobuf:"varint,7,opt,name=detectedAtUnix,proto3" json:"detectedAtUnix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DuplicateCandidate) Reset() {
+	*x = DuplicateCandidate{}
+	mi := &file_user_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCandidate) ProtoMessage() {}
+
+func (x *DuplicateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCandidate.ProtoReflect.Descriptor instead.
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DuplicateCandidate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DuplicateCandidate) GetUserA() *DuplicateUser {
+	if x != nil {
+		return x.UserA
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetUserB() *DuplicateUser {
+	if x != nil {
+		return x.UserB
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DuplicateCandidate) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DuplicateCandidate) GetDetectedAtUnix() int64 {
+	if x != nil {
+		return x.DetectedAtUnix
+	}
+	return 0
+}
+
+type ListDuplicateCandidatesMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*DuplicateCandidate  `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateCandidatesMessageResponse) Reset() {
+	*x = ListDuplicateCandidatesMessageResponse{}
+	mi := &file_user_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateCandidatesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateCandidatesMessageResponse) ProtoMessage() {}
+
+func (x *ListDuplicateCandidatesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateCandidatesMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateCandidatesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListDuplicateCandidatesMessageResponse) GetCandidates() []*DuplicateCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type ResolveDuplicateCandidateMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDuplicateCandidateMessageRequest) Reset() {
+	*x = ResolveDuplicateCandidateMessageRequest{}
+	mi := &file_user_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDuplicateCandidateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDuplicateCandidateMessageRequest) ProtoMessage() {}
+
+func (x *ResolveDuplicateCandidateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDuplicateCandidateMessageRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDuplicateCandidateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ResolveDuplicateCandidateMessageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveDuplicateCandidateMessageRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *ResolveDuplicateCandidateMessageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ResolveDuplicateCandidateMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Diff          *DryRunDiff            `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDuplicateCandidateMessageResponse) Reset() {
+	*x = ResolveDuplicateCandidateMessageResponse{}
+	mi := &file_user_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDuplicateCandidateMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDuplicateCandidateMessageResponse) ProtoMessage() {}
+
+func (x *ResolveDuplicateCandidateMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDuplicateCandidateMessageResponse.ProtoReflect.Descriptor instead.
+func (*ResolveDuplicateCandidateMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ResolveDuplicateCandidateMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResolveDuplicateCandidateMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResolveDuplicateCandidateMessageResponse) GetDiff() *DryRunDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type Operation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind           string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Done           bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Progress       map[string]int64       `protobuf:"bytes,5,rep,name=progress,proto3" json:"progress,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Result         map[string]string      `protobuf:"bytes,6,rep,name=result,proto3" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error          string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	RequestedBy    string                 `protobuf:"bytes,8,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
+	CreatedAtUnix  int64                  `protobuf:"varint,9,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	StartedAtUnix  int64                  `protobuf:"varint,10,opt,name=startedAtUnix,proto3" json:"startedAtUnix,omitempty"`
+	FinishedAtUnix int64                  `protobuf:"varint,11,opt,name=finishedAtUnix,proto3" json:"finishedAtUnix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_user_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *Operation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Operation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Operation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Operation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *Operation) GetProgress() map[string]int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Operation) GetResult() map[string]string {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Operation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Operation) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *Operation) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *Operation) GetStartedAtUnix() int64 {
+	if x != nil {
+		return x.StartedAtUnix
+	}
+	return 0
+}
+
+func (x *Operation) GetFinishedAtUnix() int64 {
+	if x != nil {
+		return x.FinishedAtUnix
+	}
+	return 0
+}
+
+type GetOperationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationMessageRequest) Reset() {
+	*x = GetOperationMessageRequest{}
+	mi := &file_user_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationMessageRequest) ProtoMessage() {}
+
+func (x *GetOperationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetOperationMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetOperationMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationMessageResponse) Reset() {
+	*x = GetOperationMessageResponse{}
+	mi := &file_user_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationMessageResponse) ProtoMessage() {}
+
+func (x *GetOperationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetOperationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetOperationMessageResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type ListOperationsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsMessageRequest) Reset() {
+	*x = ListOperationsMessageRequest{}
+	mi := &file_user_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsMessageRequest) ProtoMessage() {}
+
+func (x *ListOperationsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListOperationsMessageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListOperationsMessageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOperationsMessageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOperationsMessageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOperationsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operations    []*Operation           `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsMessageResponse) Reset() {
+	*x = ListOperationsMessageResponse{}
+	mi := &file_user_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsMessageResponse) ProtoMessage() {}
+
+func (x *ListOperationsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListOperationsMessageResponse) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *ListOperationsMessageResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelOperationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOperationMessageRequest) Reset() {
+	*x = CancelOperationMessageRequest{}
+	mi := &file_user_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOperationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationMessageRequest) ProtoMessage() {}
+
+func (x *CancelOperationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelOperationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CancelOperationMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CancelOperationMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOperationMessageResponse) Reset() {
+	*x = CancelOperationMessageResponse{}
+	mi := &file_user_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOperationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationMessageResponse) ProtoMessage() {}
+
+func (x *CancelOperationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelOperationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CancelOperationMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelOperationMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type StartComplianceExportMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FromUnix      int64                  `protobuf:"varint,2,opt,name=fromUnix,proto3" json:"fromUnix,omitempty"`
+	ToUnix        int64                  `protobuf:"varint,3,opt,name=toUnix,proto3" json:"toUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartComplianceExportMessageRequest) Reset() {
+	*x = StartComplianceExportMessageRequest{}
+	mi := &file_user_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartComplianceExportMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartComplianceExportMessageRequest) ProtoMessage() {}
+
+func (x *StartComplianceExportMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartComplianceExportMessageRequest.ProtoReflect.Descriptor instead.
+func (*StartComplianceExportMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *StartComplianceExportMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartComplianceExportMessageRequest) GetFromUnix() int64 {
+	if x != nil {
+		return x.FromUnix
+	}
+	return 0
+}
+
+func (x *StartComplianceExportMessageRequest) GetToUnix() int64 {
+	if x != nil {
+		return x.ToUnix
+	}
+	return 0
+}
+
+type StartComplianceExportMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartComplianceExportMessageResponse) Reset() {
+	*x = StartComplianceExportMessageResponse{}
+	mi := &file_user_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartComplianceExportMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartComplianceExportMessageResponse) ProtoMessage() {}
+
+func (x *StartComplianceExportMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartComplianceExportMessageResponse.ProtoReflect.Descriptor instead.
+func (*StartComplianceExportMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *StartComplianceExportMessageResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type StartUserErasureMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartUserErasureMessageRequest) Reset() {
+	*x = StartUserErasureMessageRequest{}
+	mi := &file_user_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartUserErasureMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartUserErasureMessageRequest) ProtoMessage() {}
+
+func (x *StartUserErasureMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartUserErasureMessageRequest.ProtoReflect.Descriptor instead.
+func (*StartUserErasureMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *StartUserErasureMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartUserErasureMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StartUserErasureMessageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type StartUserErasureMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Diff          *DryRunDiff            `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartUserErasureMessageResponse) Reset() {
+	*x = StartUserErasureMessageResponse{}
+	mi := &file_user_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartUserErasureMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartUserErasureMessageResponse) ProtoMessage() {}
+
+func (x *StartUserErasureMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartUserErasureMessageResponse.ProtoReflect.Descriptor instead.
+func (*StartUserErasureMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *StartUserErasureMessageResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *StartUserErasureMessageResponse) GetDiff() *DryRunDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type StartUserImportMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceKey     string                 `protobuf:"bytes,1,opt,name=sourceKey,proto3" json:"sourceKey,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	MappingJson   string                 `protobuf:"bytes,3,opt,name=mappingJson,proto3" json:"mappingJson,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartUserImportMessageRequest) Reset() {
+	*x = StartUserImportMessageRequest{}
+	mi := &file_user_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartUserImportMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartUserImportMessageRequest) ProtoMessage() {}
+
+func (x *StartUserImportMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartUserImportMessageRequest.ProtoReflect.Descriptor instead.
+func (*StartUserImportMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *StartUserImportMessageRequest) GetSourceKey() string {
+	if x != nil {
+		return x.SourceKey
+	}
+	return ""
+}
+
+func (x *StartUserImportMessageRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *StartUserImportMessageRequest) GetMappingJson() string {
+	if x != nil {
+		return x.MappingJson
+	}
+	return ""
+}
+
+func (x *StartUserImportMessageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type StartUserImportMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartUserImportMessageResponse) Reset() {
+	*x = StartUserImportMessageResponse{}
+	mi := &file_user_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartUserImportMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartUserImportMessageResponse) ProtoMessage() {}
+
+func (x *StartUserImportMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartUserImportMessageResponse.ProtoReflect.Descriptor instead.
+func (*StartUserImportMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *StartUserImportMessageResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type BulkUserFilter struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserIds             []string               `protobuf:"bytes,1,rep,name=userIds,proto3" json:"userIds,omitempty"`
+	Tags                []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Tiers               []string               `protobuf:"bytes,3,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	Countries           []string               `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"`
+	Locales             []string               `protobuf:"bytes,5,rep,name=locales,proto3" json:"locales,omitempty"`
+	SellerStatuses      []string               `protobuf:"bytes,6,rep,name=sellerStatuses,proto3" json:"sellerStatuses,omitempty"`
+	CreatedAfterUnix    int64                  `protobuf:"varint,7,opt,name=createdAfterUnix,proto3" json:"createdAfterUnix,omitempty"`
+	CreatedBeforeUnix   int64                  `protobuf:"varint,8,opt,name=createdBeforeUnix,proto3" json:"createdBeforeUnix,omitempty"`
+	LastLoginBeforeUnix int64                  `protobuf:"varint,9,opt,name=lastLoginBeforeUnix,proto3" json:"lastLoginBeforeUnix,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BulkUserFilter) Reset() {
+	*x = BulkUserFilter{}
+	mi := &file_user_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUserFilter) ProtoMessage() {}
+
+func (x *BulkUserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUserFilter.ProtoReflect.Descriptor instead.
+func (*BulkUserFilter) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *BulkUserFilter) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BulkUserFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BulkUserFilter) GetTiers() []string {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *BulkUserFilter) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *BulkUserFilter) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+func (x *BulkUserFilter) GetSellerStatuses() []string {
+	if x != nil {
+		return x.SellerStatuses
+	}
+	return nil
+}
+
+func (x *BulkUserFilter) GetCreatedAfterUnix() int64 {
+	if x != nil {
+		return x.CreatedAfterUnix
+	}
+	return 0
+}
+
+func (x *BulkUserFilter) GetCreatedBeforeUnix() int64 {
+	if x != nil {
+		return x.CreatedBeforeUnix
+	}
+	return 0
+}
+
+func (x *BulkUserFilter) GetLastLoginBeforeUnix() int64 {
+	if x != nil {
+		return x.LastLoginBeforeUnix
+	}
+	return 0
+}
+
+type BulkUserPatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddTags       []string               `protobuf:"bytes,1,rep,name=addTags,proto3" json:"addTags,omitempty"`
+	RemoveTags    []string               `protobuf:"bytes,2,rep,name=removeTags,proto3" json:"removeTags,omitempty"`
+	Tier          string                 `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUserPatch) Reset() {
+	*x = BulkUserPatch{}
+	mi := &file_user_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUserPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUserPatch) ProtoMessage() {}
+
+func (x *BulkUserPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUserPatch.ProtoReflect.Descriptor instead.
+func (*BulkUserPatch) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *BulkUserPatch) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *BulkUserPatch) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+func (x *BulkUserPatch) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *BulkUserPatch) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type BulkUpdateUsersMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *BulkUserFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Patch         *BulkUserPatch         `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	UpdateMask    []string               `protobuf:"bytes,3,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateUsersMessageRequest) Reset() {
+	*x = BulkUpdateUsersMessageRequest{}
+	mi := &file_user_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateUsersMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateUsersMessageRequest) ProtoMessage() {}
+
+func (x *BulkUpdateUsersMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateUsersMessageRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateUsersMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *BulkUpdateUsersMessageRequest) GetFilter() *BulkUserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BulkUpdateUsersMessageRequest) GetPatch() *BulkUserPatch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *BulkUpdateUsersMessageRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *BulkUpdateUsersMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BulkUpdateUsersMessageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BulkUpdateUsersMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Diff          *DryRunDiff            `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateUsersMessageResponse) Reset() {
+	*x = BulkUpdateUsersMessageResponse{}
+	mi := &file_user_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateUsersMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateUsersMessageResponse) ProtoMessage() {}
+
+func (x *BulkUpdateUsersMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateUsersMessageResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateUsersMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *BulkUpdateUsersMessageResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *BulkUpdateUsersMessageResponse) GetDiff() *DryRunDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type GetServerInfoMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoMessageRequest) Reset() {
+	*x = GetServerInfoMessageRequest{}
+	mi := &file_user_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoMessageRequest) ProtoMessage() {}
+
+func (x *GetServerInfoMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetServerInfoMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{142}
+}
+
+type GetServerInfoMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GitSha        string                 `protobuf:"bytes,1,opt,name=gitSha,proto3" json:"gitSha,omitempty"`
+	BuildTimeUnix int64                  `protobuf:"varint,2,opt,name=buildTimeUnix,proto3" json:"buildTimeUnix,omitempty"`
+	ProtoVersion  string                 `protobuf:"bytes,3,opt,name=protoVersion,proto3" json:"protoVersion,omitempty"`
+	GoVersion     string                 `protobuf:"bytes,4,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
+	StartedAtUnix int64                  `protobuf:"varint,5,opt,name=startedAtUnix,proto3" json:"startedAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoMessageResponse) Reset() {
+	*x = GetServerInfoMessageResponse{}
+	mi := &file_user_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoMessageResponse) ProtoMessage() {}
+
+func (x *GetServerInfoMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetServerInfoMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetServerInfoMessageResponse) GetGitSha() string {
+	if x != nil {
+		return x.GitSha
+	}
+	return ""
+}
+
+func (x *GetServerInfoMessageResponse) GetBuildTimeUnix() int64 {
+	if x != nil {
+		return x.BuildTimeUnix
+	}
+	return 0
+}
+
+func (x *GetServerInfoMessageResponse) GetProtoVersion() string {
+	if x != nil {
+		return x.ProtoVersion
+	}
+	return ""
+}
+
+func (x *GetServerInfoMessageResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *GetServerInfoMessageResponse) GetStartedAtUnix() int64 {
+	if x != nil {
+		return x.StartedAtUnix
+	}
+	return 0
+}
+
+type GetSLOStatusMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSLOStatusMessageRequest) Reset() {
+	*x = GetSLOStatusMessageRequest{}
+	mi := &file_user_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSLOStatusMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSLOStatusMessageRequest) ProtoMessage() {}
+
+func (x *GetSLOStatusMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSLOStatusMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetSLOStatusMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetSLOStatusMessageRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type SLOWindow struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Window               string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Requests             int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Availability         float64                `protobuf:"fixed64,3,opt,name=availability,proto3" json:"availability,omitempty"`
+	LatencyCompliance    float64                `protobuf:"fixed64,4,opt,name=latencyCompliance,proto3" json:"latencyCompliance,omitempty"`
+	AvailabilityBurnRate float64                `protobuf:"fixed64,5,opt,name=availabilityBurnRate,proto3" json:"availabilityBurnRate,omitempty"`
+	LatencyBurnRate      float64                `protobuf:"fixed64,6,opt,name=latencyBurnRate,proto3" json:"latencyBurnRate,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SLOWindow) Reset() {
+	*x = SLOWindow{}
+	mi := &file_user_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLOWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLOWindow) ProtoMessage() {}
+
+func (x *SLOWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLOWindow.ProtoReflect.Descriptor instead.
+func (*SLOWindow) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SLOWindow) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *SLOWindow) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *SLOWindow) GetAvailability() float64 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *SLOWindow) GetLatencyCompliance() float64 {
+	if x != nil {
+		return x.LatencyCompliance
+	}
+	return 0
+}
+
+func (x *SLOWindow) GetAvailabilityBurnRate() float64 {
+	if x != nil {
+		return x.AvailabilityBurnRate
+	}
+	return 0
+}
+
+func (x *SLOWindow) GetLatencyBurnRate() float64 {
+	if x != nil {
+		return x.LatencyBurnRate
+	}
+	return 0
+}
+
+type MethodSLOStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Method             string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	LatencyThresholdMs int64                  `protobuf:"varint,2,opt,name=latencyThresholdMs,proto3" json:"latencyThresholdMs,omitempty"`
+	Windows            []*SLOWindow           `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MethodSLOStatus) Reset() {
+	*x = MethodSLOStatus{}
+	mi := &file_user_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodSLOStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodSLOStatus) ProtoMessage() {}
+
+func (x *MethodSLOStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodSLOStatus.ProtoReflect.Descriptor instead.
+func (*MethodSLOStatus) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *MethodSLOStatus) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MethodSLOStatus) GetLatencyThresholdMs() int64 {
+	if x != nil {
+		return x.LatencyThresholdMs
+	}
+	return 0
+}
+
+func (x *MethodSLOStatus) GetWindows() []*SLOWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type GetSLOStatusMessageResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	AvailabilityObjective float64                `protobuf:"fixed64,1,opt,name=availabilityObjective,proto3" json:"availabilityObjective,omitempty"`
+	LatencyObjective      float64                `protobuf:"fixed64,2,opt,name=latencyObjective,proto3" json:"latencyObjective,omitempty"`
+	Methods               []*MethodSLOStatus     `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	SinceUnix             int64                  `protobuf:"varint,4,opt,name=sinceUnix,proto3" json:"sinceUnix,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetSLOStatusMessageResponse) Reset() {
+	*x = GetSLOStatusMessageResponse{}
+	mi := &file_user_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSLOStatusMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSLOStatusMessageResponse) ProtoMessage() {}
+
+func (x *GetSLOStatusMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSLOStatusMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetSLOStatusMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetSLOStatusMessageResponse) GetAvailabilityObjective() float64 {
+	if x != nil {
+		return x.AvailabilityObjective
+	}
+	return 0
+}
+
+func (x *GetSLOStatusMessageResponse) GetLatencyObjective() float64 {
+	if x != nil {
+		return x.LatencyObjective
+	}
+	return 0
+}
+
+func (x *GetSLOStatusMessageResponse) GetMethods() []*MethodSLOStatus {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *GetSLOStatusMessageResponse) GetSinceUnix() int64 {
+	if x != nil {
+		return x.SinceUnix
+	}
+	return 0
+}
+
+type SubAccountRestrictions struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SharedPayment        bool                   `protobuf:"varint,1,opt,name=sharedPayment,proto3" json:"sharedPayment,omitempty"`
+	RestrictedCategories []string               `protobuf:"bytes,2,rep,name=restrictedCategories,proto3" json:"restrictedCategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SubAccountRestrictions) Reset() {
+	*x = SubAccountRestrictions{}
+	mi := &file_user_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubAccountRestrictions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAccountRestrictions) ProtoMessage() {}
+
+func (x *SubAccountRestrictions) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAccountRestrictions.ProtoReflect.Descriptor instead.
+func (*SubAccountRestrictions) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *SubAccountRestrictions) GetSharedPayment() bool {
+	if x != nil {
+		return x.SharedPayment
+	}
+	return false
+}
+
+func (x *SubAccountRestrictions) GetRestrictedCategories() []string {
+	if x != nil {
+		return x.RestrictedCategories
+	}
+	return nil
+}
+
+type SubAccount struct {
+	state                     protoimpl.MessageState  `protogen:"open.v1"`
+	UserId                    string                  `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ParentUserId              string                  `protobuf:"bytes,2,opt,name=parentUserId,proto3" json:"parentUserId,omitempty"`
+	FullName                  string                  `protobuf:"bytes,3,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName                  string                  `protobuf:"bytes,4,opt,name=userName,proto3" json:"userName,omitempty"`
+	Relationship              string                  `protobuf:"bytes,5,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Restrictions              *SubAccountRestrictions `protobuf:"bytes,6,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	CreatedAtUnix             int64                   `protobuf:"varint,7,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	RestrictionsUpdatedAtUnix int64                   `protobuf:"varint,8,opt,name=restrictionsUpdatedAtUnix,proto3" json:"restrictionsUpdatedAtUnix,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *SubAccount) Reset() {
+	*x = SubAccount{}
+	mi := &file_user_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubAccount) ProtoMessage() {}
+
+func (x *SubAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubAccount.ProtoReflect.Descriptor instead.
+func (*SubAccount) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SubAccount) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubAccount) GetParentUserId() string {
+	if x != nil {
+		return x.ParentUserId
+	}
+	return ""
+}
+
+func (x *SubAccount) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *SubAccount) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SubAccount) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *SubAccount) GetRestrictions() *SubAccountRestrictions {
+	if x != nil {
+		return x.Restrictions
+	}
+	return nil
+}
+
+func (x *SubAccount) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *SubAccount) GetRestrictionsUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.RestrictionsUpdatedAtUnix
+	}
+	return 0
+}
+
+type CreateSubAccountMessageRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ParentUserId  string                  `protobuf:"bytes,1,opt,name=parentUserId,proto3" json:"parentUserId,omitempty"`
+	FullName      string                  `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName      string                  `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	EmailAddress  string                  `protobuf:"bytes,4,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	PhoneNumber   string                  `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Password      string                  `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	Relationship  string                  `protobuf:"bytes,7,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Restrictions  *SubAccountRestrictions `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubAccountMessageRequest) Reset() {
+	*x = CreateSubAccountMessageRequest{}
+	mi := &file_user_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubAccountMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubAccountMessageRequest) ProtoMessage() {}
+
+func (x *CreateSubAccountMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubAccountMessageRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubAccountMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *CreateSubAccountMessageRequest) GetParentUserId() string {
+	if x != nil {
+		return x.ParentUserId
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageRequest) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageRequest) GetRestrictions() *SubAccountRestrictions {
+	if x != nil {
+		return x.Restrictions
+	}
+	return nil
+}
+
+type CreateSubAccountMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubAccount    *SubAccount            `protobuf:"bytes,1,opt,name=subAccount,proto3" json:"subAccount,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubAccountMessageResponse) Reset() {
+	*x = CreateSubAccountMessageResponse{}
+	mi := &file_user_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubAccountMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubAccountMessageResponse) ProtoMessage() {}
+
+func (x *CreateSubAccountMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubAccountMessageResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubAccountMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CreateSubAccountMessageResponse) GetSubAccount() *SubAccount {
+	if x != nil {
+		return x.SubAccount
+	}
+	return nil
+}
+
+func (x *CreateSubAccountMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateSubAccountMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSubAccountsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentUserId  string                 `protobuf:"bytes,1,opt,name=parentUserId,proto3" json:"parentUserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubAccountsMessageRequest) Reset() {
+	*x = ListSubAccountsMessageRequest{}
+	mi := &file_user_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubAccountsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubAccountsMessageRequest) ProtoMessage() {}
+
+func (x *ListSubAccountsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubAccountsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListSubAccountsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListSubAccountsMessageRequest) GetParentUserId() string {
+	if x != nil {
+		return x.ParentUserId
+	}
+	return ""
+}
+
+type ListSubAccountsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubAccounts   []*SubAccount          `protobuf:"bytes,1,rep,name=subAccounts,proto3" json:"subAccounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubAccountsMessageResponse) Reset() {
+	*x = ListSubAccountsMessageResponse{}
+	mi := &file_user_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubAccountsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubAccountsMessageResponse) ProtoMessage() {}
+
+func (x *ListSubAccountsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubAccountsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListSubAccountsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ListSubAccountsMessageResponse) GetSubAccounts() []*SubAccount {
+	if x != nil {
+		return x.SubAccounts
+	}
+	return nil
+}
+
+type SetSubAccountRestrictionsMessageRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ParentUserId  string                  `protobuf:"bytes,1,opt,name=parentUserId,proto3" json:"parentUserId,omitempty"`
+	SubAccountId  string                  `protobuf:"bytes,2,opt,name=subAccountId,proto3" json:"subAccountId,omitempty"`
+	Restrictions  *SubAccountRestrictions `protobuf:"bytes,3,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubAccountRestrictionsMessageRequest) Reset() {
+	*x = SetSubAccountRestrictionsMessageRequest{}
+	mi := &file_user_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubAccountRestrictionsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubAccountRestrictionsMessageRequest) ProtoMessage() {}
+
+func (x *SetSubAccountRestrictionsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubAccountRestrictionsMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetSubAccountRestrictionsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SetSubAccountRestrictionsMessageRequest) GetParentUserId() string {
+	if x != nil {
+		return x.ParentUserId
+	}
+	return ""
+}
+
+func (x *SetSubAccountRestrictionsMessageRequest) GetSubAccountId() string {
+	if x != nil {
+		return x.SubAccountId
+	}
+	return ""
+}
+
+func (x *SetSubAccountRestrictionsMessageRequest) GetRestrictions() *SubAccountRestrictions {
+	if x != nil {
+		return x.Restrictions
+	}
+	return nil
+}
+
+type SetSubAccountRestrictionsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubAccount    *SubAccount            `protobuf:"bytes,1,opt,name=subAccount,proto3" json:"subAccount,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubAccountRestrictionsMessageResponse) Reset() {
+	*x = SetSubAccountRestrictionsMessageResponse{}
+	mi := &file_user_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubAccountRestrictionsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubAccountRestrictionsMessageResponse) ProtoMessage() {}
+
+func (x *SetSubAccountRestrictionsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubAccountRestrictionsMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetSubAccountRestrictionsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SetSubAccountRestrictionsMessageResponse) GetSubAccount() *SubAccount {
+	if x != nil {
+		return x.SubAccount
+	}
+	return nil
+}
+
+func (x *SetSubAccountRestrictionsMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetSubAccountRestrictionsMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Organization struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BillingEmail    string                 `protobuf:"bytes,3,opt,name=billingEmail,proto3" json:"billingEmail,omitempty"`
+	CreatedByUserId string                 `protobuf:"bytes,4,opt,name=createdByUserId,proto3" json:"createdByUserId,omitempty"`
+	CreatedAtUnix   int64                  `protobuf:"varint,5,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_user_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetBillingEmail() string {
+	if x != nil {
+		return x.BillingEmail
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedByUserId() string {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+type OrgMember struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName        string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName        string                 `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Role            string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	InvitedByUserId string                 `protobuf:"bytes,6,opt,name=invitedByUserId,proto3" json:"invitedByUserId,omitempty"`
+	InvitedAtUnix   int64                  `protobuf:"varint,7,opt,name=invitedAtUnix,proto3" json:"invitedAtUnix,omitempty"`
+	JoinedAtUnix    int64                  `protobuf:"varint,8,opt,name=joinedAtUnix,proto3" json:"joinedAtUnix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrgMember) Reset() {
+	*x = OrgMember{}
+	mi := &file_user_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgMember) ProtoMessage() {}
+
+func (x *OrgMember) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgMember.ProtoReflect.Descriptor instead.
+func (*OrgMember) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *OrgMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrgMember) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *OrgMember) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *OrgMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrgMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrgMember) GetInvitedByUserId() string {
+	if x != nil {
+		return x.InvitedByUserId
+	}
+	return ""
+}
+
+func (x *OrgMember) GetInvitedAtUnix() int64 {
+	if x != nil {
+		return x.InvitedAtUnix
+	}
+	return 0
+}
+
+func (x *OrgMember) GetJoinedAtUnix() int64 {
+	if x != nil {
+		return x.JoinedAtUnix
+	}
+	return 0
+}
+
+type OrgMembership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrgMembership) Reset() {
+	*x = OrgMembership{}
+	mi := &file_user_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgMembership) ProtoMessage() {}
+
+func (x *OrgMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgMembership.ProtoReflect.Descriptor instead.
+func (*OrgMembership) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *OrgMembership) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *OrgMembership) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrgMembership) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateOrganizationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BillingEmail  string                 `protobuf:"bytes,3,opt,name=billingEmail,proto3" json:"billingEmail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationMessageRequest) Reset() {
+	*x = CreateOrganizationMessageRequest{}
+	mi := &file_user_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationMessageRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationMessageRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CreateOrganizationMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOrganizationMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizationMessageRequest) GetBillingEmail() string {
+	if x != nil {
+		return x.BillingEmail
+	}
+	return ""
+}
+
+type CreateOrganizationMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationMessageResponse) Reset() {
+	*x = CreateOrganizationMessageResponse{}
+	mi := &file_user_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationMessageResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationMessageResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *CreateOrganizationMessageResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *CreateOrganizationMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateOrganizationMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type InviteOrgMemberMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	EmailAddress  string                 `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteOrgMemberMessageRequest) Reset() {
+	*x = InviteOrgMemberMessageRequest{}
+	mi := &file_user_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteOrgMemberMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteOrgMemberMessageRequest) ProtoMessage() {}
+
+func (x *InviteOrgMemberMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteOrgMemberMessageRequest.ProtoReflect.Descriptor instead.
+func (*InviteOrgMemberMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *InviteOrgMemberMessageRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InviteOrgMemberMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteOrgMemberMessageRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *InviteOrgMemberMessageRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type InviteOrgMemberMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *OrgMember             `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteOrgMemberMessageResponse) Reset() {
+	*x = InviteOrgMemberMessageResponse{}
+	mi := &file_user_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteOrgMemberMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteOrgMemberMessageResponse) ProtoMessage() {}
+
+func (x *InviteOrgMemberMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteOrgMemberMessageResponse.ProtoReflect.Descriptor instead.
+func (*InviteOrgMemberMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *InviteOrgMemberMessageResponse) GetMember() *OrgMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *InviteOrgMemberMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InviteOrgMemberMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AcceptOrgInviteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrgInviteMessageRequest) Reset() {
+	*x = AcceptOrgInviteMessageRequest{}
+	mi := &file_user_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrgInviteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrgInviteMessageRequest) ProtoMessage() {}
+
+func (x *AcceptOrgInviteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrgInviteMessageRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrgInviteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *AcceptOrgInviteMessageRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *AcceptOrgInviteMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AcceptOrgInviteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *OrgMember             `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrgInviteMessageResponse) Reset() {
+	*x = AcceptOrgInviteMessageResponse{}
+	mi := &file_user_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrgInviteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrgInviteMessageResponse) ProtoMessage() {}
+
+func (x *AcceptOrgInviteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrgInviteMessageResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOrgInviteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *AcceptOrgInviteMessageResponse) GetMember() *OrgMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *AcceptOrgInviteMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AcceptOrgInviteMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetOrgMemberRoleMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	MemberUserId  string                 `protobuf:"bytes,3,opt,name=memberUserId,proto3" json:"memberUserId,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrgMemberRoleMessageRequest) Reset() {
+	*x = SetOrgMemberRoleMessageRequest{}
+	mi := &file_user_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrgMemberRoleMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgMemberRoleMessageRequest) ProtoMessage() {}
+
+func (x *SetOrgMemberRoleMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgMemberRoleMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetOrgMemberRoleMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SetOrgMemberRoleMessageRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SetOrgMemberRoleMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetOrgMemberRoleMessageRequest) GetMemberUserId() string {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return ""
+}
+
+func (x *SetOrgMemberRoleMessageRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SetOrgMemberRoleMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *OrgMember             `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrgMemberRoleMessageResponse) Reset() {
+	*x = SetOrgMemberRoleMessageResponse{}
+	mi := &file_user_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrgMemberRoleMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgMemberRoleMessageResponse) ProtoMessage() {}
+
+func (x *SetOrgMemberRoleMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgMemberRoleMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetOrgMemberRoleMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *SetOrgMemberRoleMessageResponse) GetMember() *OrgMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *SetOrgMemberRoleMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetOrgMemberRoleMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveOrgMemberMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	MemberUserId  string                 `protobuf:"bytes,3,opt,name=memberUserId,proto3" json:"memberUserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrgMemberMessageRequest) Reset() {
+	*x = RemoveOrgMemberMessageRequest{}
+	mi := &file_user_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrgMemberMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberMessageRequest) ProtoMessage() {}
+
+func (x *RemoveOrgMemberMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberMessageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *RemoveOrgMemberMessageRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RemoveOrgMemberMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveOrgMemberMessageRequest) GetMemberUserId() string {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return ""
+}
+
+type RemoveOrgMemberMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrgMemberMessageResponse) Reset() {
+	*x = RemoveOrgMemberMessageResponse{}
+	mi := &file_user_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrgMemberMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberMessageResponse) ProtoMessage() {}
+
+func (x *RemoveOrgMemberMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberMessageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *RemoveOrgMemberMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveOrgMemberMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListOrgMembersMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrgMembersMessageRequest) Reset() {
+	*x = ListOrgMembersMessageRequest{}
+	mi := &file_user_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrgMembersMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersMessageRequest) ProtoMessage() {}
+
+func (x *ListOrgMembersMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ListOrgMembersMessageRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListOrgMembersMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListOrgMembersMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*OrgMember           `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrgMembersMessageResponse) Reset() {
+	*x = ListOrgMembersMessageResponse{}
+	mi := &file_user_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrgMembersMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersMessageResponse) ProtoMessage() {}
+
+func (x *ListOrgMembersMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *ListOrgMembersMessageResponse) GetMembers() []*OrgMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListUserOrganizationsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrganizationsMessageRequest) Reset() {
+	*x = ListUserOrganizationsMessageRequest{}
+	mi := &file_user_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrganizationsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrganizationsMessageRequest) ProtoMessage() {}
+
+func (x *ListUserOrganizationsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrganizationsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListUserOrganizationsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ListUserOrganizationsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListUserOrganizationsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memberships   []*OrgMembership       `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrganizationsMessageResponse) Reset() {
+	*x = ListUserOrganizationsMessageResponse{}
+	mi := &file_user_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrganizationsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrganizationsMessageResponse) ProtoMessage() {}
+
+func (x *ListUserOrganizationsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrganizationsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListUserOrganizationsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *ListUserOrganizationsMessageResponse) GetMemberships() []*OrgMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	OrgId         string                 `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,4,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	InviterUserId string                 `protobuf:"bytes,6,opt,name=inviterUserId,proto3" json:"inviterUserId,omitempty"`
+	InviterName   string                 `protobuf:"bytes,7,opt,name=inviterName,proto3" json:"inviterName,omitempty"`
+	EmailAddress  string                 `protobuf:"bytes,8,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	FullName      string                 `protobuf:"bytes,9,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,10,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,11,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	Uses          int32                  `protobuf:"varint,12,opt,name=uses,proto3" json:"uses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_user_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *Invite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invite) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Invite) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Invite) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *Invite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invite) GetInviterUserId() string {
+	if x != nil {
+		return x.InviterUserId
+	}
+	return ""
+}
+
+func (x *Invite) GetInviterName() string {
+	if x != nil {
+		return x.InviterName
+	}
+	return ""
+}
+
+func (x *Invite) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *Invite) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+type CreateInviteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	OrgId         string                 `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	EmailAddress  string                 `protobuf:"bytes,5,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	FullName      string                 `protobuf:"bytes,6,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,8,opt,name=ttlSeconds,proto3" json:"ttlSeconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteMessageRequest) Reset() {
+	*x = CreateInviteMessageRequest{}
+	mi := &file_user_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteMessageRequest) ProtoMessage() {}
+
+func (x *CreateInviteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteMessageRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *CreateInviteMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteMessageRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type CreateInviteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteMessageResponse) Reset() {
+	*x = CreateInviteMessageResponse{}
+	mi := &file_user_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteMessageResponse) ProtoMessage() {}
+
+func (x *CreateInviteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteMessageResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *CreateInviteMessageResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *CreateInviteMessageResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateInviteMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetInviteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteMessageRequest) Reset() {
+	*x = GetInviteMessageRequest{}
+	mi := &file_user_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteMessageRequest) ProtoMessage() {}
+
+func (x *GetInviteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetInviteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *GetInviteMessageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetInviteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteMessageResponse) Reset() {
+	*x = GetInviteMessageResponse{}
+	mi := &file_user_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteMessageResponse) ProtoMessage() {}
+
+func (x *GetInviteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetInviteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *GetInviteMessageResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type AcceptInviteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteMessageRequest) Reset() {
+	*x = AcceptInviteMessageRequest{}
+	mi := &file_user_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteMessageRequest) ProtoMessage() {}
+
+func (x *AcceptInviteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteMessageRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInviteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *AcceptInviteMessageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInviteMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AcceptInviteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteMessageResponse) Reset() {
+	*x = AcceptInviteMessageResponse{}
+	mi := &file_user_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteMessageResponse) ProtoMessage() {}
+
+func (x *AcceptInviteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteMessageResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInviteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *AcceptInviteMessageResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *AcceptInviteMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AcceptInviteMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SavedSearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Filters       map[string]string      `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Notify        bool                   `protobuf:"varint,5,opt,name=notify,proto3" json:"notify,omitempty"`
+	CreatedAtUnix int64                  `protobuf:"varint,6,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	UpdatedAtUnix int64                  `protobuf:"varint,7,opt,name=updatedAtUnix,proto3" json:"updatedAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_user_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *SavedSearch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *SavedSearch) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+type SaveSearchMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	SearchId      string                 `protobuf:"bytes,2,opt,name=searchId,proto3" json:"searchId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Filters       map[string]string      `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Notify        bool                   `protobuf:"varint,6,opt,name=notify,proto3" json:"notify,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSearchMessageRequest) Reset() {
+	*x = SaveSearchMessageRequest{}
+	mi := &file_user_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSearchMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchMessageRequest) ProtoMessage() {}
+
+func (x *SaveSearchMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchMessageRequest.ProtoReflect.Descriptor instead.
+func (*SaveSearchMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SaveSearchMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveSearchMessageRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *SaveSearchMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSearchMessageRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SaveSearchMessageRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SaveSearchMessageRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+type SaveSearchMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearch   *SavedSearch           `protobuf:"bytes,1,opt,name=savedSearch,proto3" json:"savedSearch,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSearchMessageResponse) Reset() {
+	*x = SaveSearchMessageResponse{}
+	mi := &file_user_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSearchMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchMessageResponse) ProtoMessage() {}
+
+func (x *SaveSearchMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchMessageResponse.ProtoReflect.Descriptor instead.
+func (*SaveSearchMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SaveSearchMessageResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+func (x *SaveSearchMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SaveSearchMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSavedSearchesMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesMessageRequest) Reset() {
+	*x = ListSavedSearchesMessageRequest{}
+	mi := &file_user_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesMessageRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *ListSavedSearchesMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSavedSearchesMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearches []*SavedSearch         `protobuf:"bytes,1,rep,name=savedSearches,proto3" json:"savedSearches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesMessageResponse) Reset() {
+	*x = ListSavedSearchesMessageResponse{}
+	mi := &file_user_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesMessageResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *ListSavedSearchesMessageResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type DeleteSavedSearchMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	SearchId      string                 `protobuf:"bytes,2,opt,name=searchId,proto3" json:"searchId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchMessageRequest) Reset() {
+	*x = DeleteSavedSearchMessageRequest{}
+	mi := &file_user_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchMessageRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *DeleteSavedSearchMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteSavedSearchMessageRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+type DeleteSavedSearchMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchMessageResponse) Reset() {
+	*x = DeleteSavedSearchMessageResponse{}
+	mi := &file_user_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchMessageResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *DeleteSavedSearchMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteSavedSearchMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ProductAlert struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId        string                 `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	TargetPriceMinor int64                  `protobuf:"varint,4,opt,name=targetPriceMinor,proto3" json:"targetPriceMinor,omitempty"`
+	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAtUnix    int64                  `protobuf:"varint,6,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProductAlert) Reset() {
+	*x = ProductAlert{}
+	mi := &file_user_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAlert) ProtoMessage() {}
+
+func (x *ProductAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAlert.ProtoReflect.Descriptor instead.
+func (*ProductAlert) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ProductAlert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductAlert) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductAlert) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ProductAlert) GetTargetPriceMinor() int64 {
+	if x != nil {
+		return x.TargetPriceMinor
+	}
+	return 0
+}
+
+func (x *ProductAlert) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ProductAlert) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+type SubscribeProductAlertMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ProductId        string                 `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	TargetPriceMinor int64                  `protobuf:"varint,4,opt,name=targetPriceMinor,proto3" json:"targetPriceMinor,omitempty"`
+	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubscribeProductAlertMessageRequest) Reset() {
+	*x = SubscribeProductAlertMessageRequest{}
+	mi := &file_user_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeProductAlertMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeProductAlertMessageRequest) ProtoMessage() {}
+
+func (x *SubscribeProductAlertMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeProductAlertMessageRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeProductAlertMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *SubscribeProductAlertMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubscribeProductAlertMessageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubscribeProductAlertMessageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SubscribeProductAlertMessageRequest) GetTargetPriceMinor() int64 {
+	if x != nil {
+		return x.TargetPriceMinor
+	}
+	return 0
+}
+
+func (x *SubscribeProductAlertMessageRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SubscribeProductAlertMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *ProductAlert          `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeProductAlertMessageResponse) Reset() {
+	*x = SubscribeProductAlertMessageResponse{}
+	mi := &file_user_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeProductAlertMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeProductAlertMessageResponse) ProtoMessage() {}
+
+func (x *SubscribeProductAlertMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeProductAlertMessageResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeProductAlertMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *SubscribeProductAlertMessageResponse) GetAlert() *ProductAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *SubscribeProductAlertMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubscribeProductAlertMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListProductAlertsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductAlertsMessageRequest) Reset() {
+	*x = ListProductAlertsMessageRequest{}
+	mi := &file_user_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductAlertsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductAlertsMessageRequest) ProtoMessage() {}
+
+func (x *ListProductAlertsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductAlertsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListProductAlertsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *ListProductAlertsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListProductAlertsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*ProductAlert        `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductAlertsMessageResponse) Reset() {
+	*x = ListProductAlertsMessageResponse{}
+	mi := &file_user_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductAlertsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductAlertsMessageResponse) ProtoMessage() {}
+
+func (x *ListProductAlertsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductAlertsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListProductAlertsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ListProductAlertsMessageResponse) GetAlerts() []*ProductAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type DeleteProductAlertMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	AlertId       string                 `protobuf:"bytes,2,opt,name=alertId,proto3" json:"alertId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAlertMessageRequest) Reset() {
+	*x = DeleteProductAlertMessageRequest{}
+	mi := &file_user_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAlertMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAlertMessageRequest) ProtoMessage() {}
+
+func (x *DeleteProductAlertMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAlertMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductAlertMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *DeleteProductAlertMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteProductAlertMessageRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+type DeleteProductAlertMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAlertMessageResponse) Reset() {
+	*x = DeleteProductAlertMessageResponse{}
+	mi := &file_user_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAlertMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAlertMessageResponse) ProtoMessage() {}
+
+func (x *DeleteProductAlertMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAlertMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductAlertMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *DeleteProductAlertMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteProductAlertMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RecordProductViewMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProductViewMessageRequest) Reset() {
+	*x = RecordProductViewMessageRequest{}
+	mi := &file_user_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductViewMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductViewMessageRequest) ProtoMessage() {}
+
+func (x *RecordProductViewMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProductViewMessageRequest.ProtoReflect.Descriptor instead.
+func (*RecordProductViewMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *RecordProductViewMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordProductViewMessageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RecordProductViewMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProductViewMessageResponse) Reset() {
+	*x = RecordProductViewMessageResponse{}
+	mi := &file_user_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductViewMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductViewMessageResponse) ProtoMessage() {}
+
+func (x *RecordProductViewMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProductViewMessageResponse.ProtoReflect.Descriptor instead.
+func (*RecordProductViewMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *RecordProductViewMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ViewedProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	ViewedAtUnix  int64                  `protobuf:"varint,2,opt,name=viewedAtUnix,proto3" json:"viewedAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewedProduct) Reset() {
+	*x = ViewedProduct{}
+	mi := &file_user_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewedProduct) ProtoMessage() {}
+
+func (x *ViewedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewedProduct.ProtoReflect.Descriptor instead.
+func (*ViewedProduct) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *ViewedProduct) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ViewedProduct) GetViewedAtUnix() int64 {
+	if x != nil {
+		return x.ViewedAtUnix
+	}
+	return 0
+}
+
+type GetRecentlyViewedMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentlyViewedMessageRequest) Reset() {
+	*x = GetRecentlyViewedMessageRequest{}
+	mi := &file_user_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentlyViewedMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlyViewedMessageRequest) ProtoMessage() {}
+
+func (x *GetRecentlyViewedMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlyViewedMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *GetRecentlyViewedMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRecentlyViewedMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecentlyViewedMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ViewedProduct       `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentlyViewedMessageResponse) Reset() {
+	*x = GetRecentlyViewedMessageResponse{}
+	mi := &file_user_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentlyViewedMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlyViewedMessageResponse) ProtoMessage() {}
+
+func (x *GetRecentlyViewedMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlyViewedMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *GetRecentlyViewedMessageResponse) GetProducts() []*ViewedProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type UpdateDisplayNameMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDisplayNameMessageRequest) Reset() {
+	*x = UpdateDisplayNameMessageRequest{}
+	mi := &file_user_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDisplayNameMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDisplayNameMessageRequest) ProtoMessage() {}
+
+func (x *UpdateDisplayNameMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDisplayNameMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDisplayNameMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *UpdateDisplayNameMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateDisplayNameMessageRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type UpdateDisplayNameMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ModerationStatus string                 `protobuf:"bytes,1,opt,name=moderationStatus,proto3" json:"moderationStatus,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success          bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateDisplayNameMessageResponse) Reset() {
+	*x = UpdateDisplayNameMessageResponse{}
+	mi := &file_user_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDisplayNameMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDisplayNameMessageResponse) ProtoMessage() {}
+
+func (x *UpdateDisplayNameMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDisplayNameMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDisplayNameMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *UpdateDisplayNameMessageResponse) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *UpdateDisplayNameMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateDisplayNameMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AvatarInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarInfo) Reset() {
+	*x = AvatarInfo{}
+	mi := &file_user_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarInfo) ProtoMessage() {}
+
+func (x *AvatarInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarInfo.ProtoReflect.Descriptor instead.
+func (*AvatarInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *AvatarInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AvatarInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadAvatarMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAvatarMessageRequest_Info
+	//	*UploadAvatarMessageRequest_Chunk
+	Payload       isUploadAvatarMessageRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarMessageRequest) Reset() {
+	*x = UploadAvatarMessageRequest{}
+	mi := &file_user_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarMessageRequest) ProtoMessage() {}
+
+func (x *UploadAvatarMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarMessageRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *UploadAvatarMessageRequest) GetPayload() isUploadAvatarMessageRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAvatarMessageRequest) GetInfo() *AvatarInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAvatarMessageRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAvatarMessageRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAvatarMessageRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAvatarMessageRequest_Payload interface {
+	isUploadAvatarMessageRequest_Payload()
+}
+
+type UploadAvatarMessageRequest_Info struct {
+	Info *AvatarInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAvatarMessageRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAvatarMessageRequest_Info) isUploadAvatarMessageRequest_Payload() {}
+
+func (*UploadAvatarMessageRequest_Chunk) isUploadAvatarMessageRequest_Payload() {}
+
+type UploadAvatarMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ModerationStatus string                 `protobuf:"bytes,1,opt,name=moderationStatus,proto3" json:"moderationStatus,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success          bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UploadAvatarMessageResponse) Reset() {
+	*x = UploadAvatarMessageResponse{}
+	mi := &file_user_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarMessageResponse) ProtoMessage() {}
+
+func (x *UploadAvatarMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarMessageResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *UploadAvatarMessageResponse) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *UploadAvatarMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadAvatarMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ModerationItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Field           string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value           string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	SubmittedAtUnix int64                  `protobuf:"varint,4,opt,name=submittedAtUnix,proto3" json:"submittedAtUnix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerationItem) Reset() {
+	*x = ModerationItem{}
+	mi := &file_user_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationItem) ProtoMessage() {}
+
+func (x *ModerationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationItem.ProtoReflect.Descriptor instead.
+func (*ModerationItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ModerationItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetSubmittedAtUnix() int64 {
+	if x != nil {
+		return x.SubmittedAtUnix
+	}
+	return 0
+}
+
+type ListModerationQueueMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueMessageRequest) Reset() {
+	*x = ListModerationQueueMessageRequest{}
+	mi := &file_user_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueMessageRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *ListModerationQueueMessageRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ListModerationQueueMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListModerationQueueMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ModerationItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueMessageResponse) Reset() {
+	*x = ListModerationQueueMessageResponse{}
+	mi := &file_user_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueMessageResponse) ProtoMessage() {}
+
+func (x *ListModerationQueueMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *ListModerationQueueMessageResponse) GetItems() []*ModerationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReviewModerationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reviewer      string                 `protobuf:"bytes,4,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewModerationMessageRequest) Reset() {
+	*x = ReviewModerationMessageRequest{}
+	mi := &file_user_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewModerationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewModerationMessageRequest) ProtoMessage() {}
+
+func (x *ReviewModerationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewModerationMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReviewModerationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *ReviewModerationMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewModerationMessageRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ReviewModerationMessageRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewModerationMessageRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ReviewModerationMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewModerationMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewModerationMessageResponse) Reset() {
+	*x = ReviewModerationMessageResponse{}
+	mi := &file_user_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewModerationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewModerationMessageResponse) ProtoMessage() {}
+
+func (x *ReviewModerationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewModerationMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReviewModerationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *ReviewModerationMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReviewModerationMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetPublicProfileMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewerId,proto3" json:"viewerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileMessageRequest) Reset() {
+	*x = GetPublicProfileMessageRequest{}
+	mi := &file_user_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileMessageRequest) ProtoMessage() {}
+
+func (x *GetPublicProfileMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *GetPublicProfileMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPublicProfileMessageRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type GetPublicProfileMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	Hidden        bool                   `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileMessageResponse) Reset() {
+	*x = GetPublicProfileMessageResponse{}
+	mi := &file_user_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileMessageResponse) ProtoMessage() {}
+
+func (x *GetPublicProfileMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *GetPublicProfileMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPublicProfileMessageResponse) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *GetPublicProfileMessageResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *GetPublicProfileMessageResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GetPublicProfileMessageResponse) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type PublicProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	Hidden        bool                   `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicProfile) Reset() {
+	*x = PublicProfile{}
+	mi := &file_user_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfile) ProtoMessage() {}
+
+func (x *PublicProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfile.ProtoReflect.Descriptor instead.
+func (*PublicProfile) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *PublicProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type GetPublicProfilesMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=userIds,proto3" json:"userIds,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewerId,proto3" json:"viewerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfilesMessageRequest) Reset() {
+	*x = GetPublicProfilesMessageRequest{}
+	mi := &file_user_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfilesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfilesMessageRequest) ProtoMessage() {}
+
+func (x *GetPublicProfilesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfilesMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfilesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *GetPublicProfilesMessageRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetPublicProfilesMessageRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type GetPublicProfilesMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*PublicProfile       `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfilesMessageResponse) Reset() {
+	*x = GetPublicProfilesMessageResponse{}
+	mi := &file_user_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfilesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfilesMessageResponse) ProtoMessage() {}
+
+func (x *GetPublicProfilesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfilesMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfilesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *GetPublicProfilesMessageResponse) GetProfiles() []*PublicProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type SetShadowBanMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Banned        bool                   `protobuf:"varint,2,opt,name=banned,proto3" json:"banned,omitempty"`
+	Moderator     string                 `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetShadowBanMessageRequest) Reset() {
+	*x = SetShadowBanMessageRequest{}
+	mi := &file_user_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShadowBanMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShadowBanMessageRequest) ProtoMessage() {}
+
+func (x *SetShadowBanMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShadowBanMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetShadowBanMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *SetShadowBanMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetShadowBanMessageRequest) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *SetShadowBanMessageRequest) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *SetShadowBanMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetShadowBanMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetShadowBanMessageResponse) Reset() {
+	*x = SetShadowBanMessageResponse{}
+	mi := &file_user_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShadowBanMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShadowBanMessageResponse) ProtoMessage() {}
+
+func (x *SetShadowBanMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShadowBanMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetShadowBanMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *SetShadowBanMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetShadowBanMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetUserProfileMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileMessageRequest) Reset() {
+	*x = GetUserProfileMessageRequest{}
+	mi := &file_user_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileMessageRequest) ProtoMessage() {}
+
+func (x *GetUserProfileMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *GetUserProfileMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserProfileMessageResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName          string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName          string                 `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	EmailAddress      string                 `protobuf:"bytes,4,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	PhoneNumber       string                 `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	EmailVerified     bool                   `protobuf:"varint,6,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
+	EmailStatus       string                 `protobuf:"bytes,7,opt,name=emailStatus,proto3" json:"emailStatus,omitempty"`
+	EmailStatusReason string                 `protobuf:"bytes,8,opt,name=emailStatusReason,proto3" json:"emailStatusReason,omitempty"`
+	CreatedAtUnix     int64                  `protobuf:"varint,9,opt,name=createdAtUnix,proto3" json:"createdAtUnix,omitempty"`
+	PhoneVerified     bool                   `protobuf:"varint,10,opt,name=phoneVerified,proto3" json:"phoneVerified,omitempty"`
+	PhoneReachable    bool                   `protobuf:"varint,11,opt,name=phoneReachable,proto3" json:"phoneReachable,omitempty"`
+	CompletenessScore int32                  `protobuf:"varint,12,opt,name=completenessScore,proto3" json:"completenessScore,omitempty"`
+	MissingFields     []string               `protobuf:"bytes,13,rep,name=missingFields,proto3" json:"missingFields,omitempty"`
+	AvatarUrl         string                 `protobuf:"bytes,14,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	PhoneType         string                 `protobuf:"bytes,15,opt,name=phoneType,proto3" json:"phoneType,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetUserProfileMessageResponse) Reset() {
+	*x = GetUserProfileMessageResponse{}
+	mi := &file_user_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileMessageResponse) ProtoMessage() {}
+
+func (x *GetUserProfileMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *GetUserProfileMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *GetUserProfileMessageResponse) GetEmailStatus() string {
+	if x != nil {
+		return x.EmailStatus
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetEmailStatusReason() string {
+	if x != nil {
+		return x.EmailStatusReason
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *GetUserProfileMessageResponse) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
+func (x *GetUserProfileMessageResponse) GetPhoneReachable() bool {
+	if x != nil {
+		return x.PhoneReachable
+	}
+	return false
+}
+
+func (x *GetUserProfileMessageResponse) GetCompletenessScore() int32 {
+	if x != nil {
+		return x.CompletenessScore
+	}
+	return 0
+}
+
+func (x *GetUserProfileMessageResponse) GetMissingFields() []string {
+	if x != nil {
+		return x.MissingFields
+	}
+	return nil
+}
+
+func (x *GetUserProfileMessageResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GetUserProfileMessageResponse) GetPhoneType() string {
+	if x != nil {
+		return x.PhoneType
+	}
+	return ""
+}
+
+type SendPhoneVerificationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPhoneVerificationMessageRequest) Reset() {
+	*x = SendPhoneVerificationMessageRequest{}
+	mi := &file_user_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPhoneVerificationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPhoneVerificationMessageRequest) ProtoMessage() {}
+
+func (x *SendPhoneVerificationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPhoneVerificationMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendPhoneVerificationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *SendPhoneVerificationMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SendPhoneVerificationMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPhoneVerificationMessageResponse) Reset() {
+	*x = SendPhoneVerificationMessageResponse{}
+	mi := &file_user_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPhoneVerificationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPhoneVerificationMessageResponse) ProtoMessage() {}
+
+func (x *SendPhoneVerificationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPhoneVerificationMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendPhoneVerificationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *SendPhoneVerificationMessageResponse) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SendPhoneVerificationMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *SendPhoneVerificationMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendPhoneVerificationMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type VerifyPhoneMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPhoneMessageRequest) Reset() {
+	*x = VerifyPhoneMessageRequest{}
+	mi := &file_user_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPhoneMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPhoneMessageRequest) ProtoMessage() {}
+
+func (x *VerifyPhoneMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPhoneMessageRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPhoneMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *VerifyPhoneMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyPhoneMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyPhoneMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPhoneMessageResponse) Reset() {
+	*x = VerifyPhoneMessageResponse{}
+	mi := &file_user_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPhoneMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPhoneMessageResponse) ProtoMessage() {}
+
+func (x *VerifyPhoneMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPhoneMessageResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPhoneMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *VerifyPhoneMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyPhoneMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DigestPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Frequency     string                 `protobuf:"bytes,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestPreference) Reset() {
+	*x = DigestPreference{}
+	mi := &file_user_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestPreference) ProtoMessage() {}
+
+func (x *DigestPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestPreference.ProtoReflect.Descriptor instead.
+func (*DigestPreference) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *DigestPreference) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DigestPreference) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+type SetDigestPreferencesMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Preferences   []*DigestPreference    `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestPreferencesMessageRequest) Reset() {
+	*x = SetDigestPreferencesMessageRequest{}
+	mi := &file_user_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestPreferencesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestPreferencesMessageRequest) ProtoMessage() {}
+
+func (x *SetDigestPreferencesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestPreferencesMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetDigestPreferencesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *SetDigestPreferencesMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetDigestPreferencesMessageRequest) GetPreferences() []*DigestPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type SetDigestPreferencesMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestPreferencesMessageResponse) Reset() {
+	*x = SetDigestPreferencesMessageResponse{}
+	mi := &file_user_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestPreferencesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestPreferencesMessageResponse) ProtoMessage() {}
+
+func (x *SetDigestPreferencesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestPreferencesMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetDigestPreferencesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *SetDigestPreferencesMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetDigestPreferencesMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetDigestPreferencesMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestPreferencesMessageRequest) Reset() {
+	*x = GetDigestPreferencesMessageRequest{}
+	mi := &file_user_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestPreferencesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestPreferencesMessageRequest) ProtoMessage() {}
+
+func (x *GetDigestPreferencesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestPreferencesMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestPreferencesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *GetDigestPreferencesMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDigestPreferencesMessageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Preferences    []*DigestPreference    `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	Timezone       string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	NextDailyUnix  int64                  `protobuf:"varint,3,opt,name=nextDailyUnix,proto3" json:"nextDailyUnix,omitempty"`
+	NextWeeklyUnix int64                  `protobuf:"varint,4,opt,name=nextWeeklyUnix,proto3" json:"nextWeeklyUnix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDigestPreferencesMessageResponse) Reset() {
+	*x = GetDigestPreferencesMessageResponse{}
+	mi := &file_user_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestPreferencesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestPreferencesMessageResponse) ProtoMessage() {}
+
+func (x *GetDigestPreferencesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestPreferencesMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetDigestPreferencesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *GetDigestPreferencesMessageResponse) GetPreferences() []*DigestPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *GetDigestPreferencesMessageResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetDigestPreferencesMessageResponse) GetNextDailyUnix() int64 {
+	if x != nil {
+		return x.NextDailyUnix
+	}
+	return 0
+}
+
+func (x *GetDigestPreferencesMessageResponse) GetNextWeeklyUnix() int64 {
+	if x != nil {
+		return x.NextWeeklyUnix
+	}
+	return 0
+}
+
+type DueDigest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Frequency     string                 `protobuf:"bytes,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Categories    []string               `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	DueAtUnix     int64                  `protobuf:"varint,4,opt,name=dueAtUnix,proto3" json:"dueAtUnix,omitempty"`
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DueDigest) Reset() {
+	*x = DueDigest{}
+	mi := &file_user_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DueDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueDigest) ProtoMessage() {}
+
+func (x *DueDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueDigest.ProtoReflect.Descriptor instead.
+func (*DueDigest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *DueDigest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DueDigest) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *DueDigest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *DueDigest) GetDueAtUnix() int64 {
+	if x != nil {
+		return x.DueAtUnix
+	}
+	return 0
+}
+
+func (x *DueDigest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetDueDigestsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDueDigestsMessageRequest) Reset() {
+	*x = GetDueDigestsMessageRequest{}
+	mi := &file_user_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDueDigestsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDueDigestsMessageRequest) ProtoMessage() {}
+
+func (x *GetDueDigestsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDueDigestsMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetDueDigestsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *GetDueDigestsMessageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDueDigestsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digests       []*DueDigest           `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDueDigestsMessageResponse) Reset() {
+	*x = GetDueDigestsMessageResponse{}
+	mi := &file_user_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDueDigestsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDueDigestsMessageResponse) ProtoMessage() {}
+
+func (x *GetDueDigestsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDueDigestsMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetDueDigestsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *GetDueDigestsMessageResponse) GetDigests() []*DueDigest {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+type ExperimentAssignment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Experiment     string                 `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	Variant        string                 `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	AssignedAtUnix int64                  `protobuf:"varint,3,opt,name=assignedAtUnix,proto3" json:"assignedAtUnix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExperimentAssignment) Reset() {
+	*x = ExperimentAssignment{}
+	mi := &file_user_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentAssignment) ProtoMessage() {}
+
+func (x *ExperimentAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentAssignment.ProtoReflect.Descriptor instead.
+func (*ExperimentAssignment) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *ExperimentAssignment) GetExperiment() string {
+	if x != nil {
+		return x.Experiment
+	}
+	return ""
+}
+
+func (x *ExperimentAssignment) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ExperimentAssignment) GetAssignedAtUnix() int64 {
+	if x != nil {
+		return x.AssignedAtUnix
+	}
+	return 0
+}
+
+type GetAssignmentsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Experiments   []string               `protobuf:"bytes,2,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentsMessageRequest) Reset() {
+	*x = GetAssignmentsMessageRequest{}
+	mi := &file_user_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentsMessageRequest) ProtoMessage() {}
+
+func (x *GetAssignmentsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentsMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *GetAssignmentsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAssignmentsMessageRequest) GetExperiments() []string {
+	if x != nil {
+		return x.Experiments
+	}
+	return nil
+}
+
+type GetAssignmentsMessageResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Assignments   []*ExperimentAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Flags         map[string]bool         `protobuf:"bytes,2,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentsMessageResponse) Reset() {
+	*x = GetAssignmentsMessageResponse{}
+	mi := &file_user_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentsMessageResponse) ProtoMessage() {}
+
+func (x *GetAssignmentsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentsMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *GetAssignmentsMessageResponse) GetAssignments() []*ExperimentAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *GetAssignmentsMessageResponse) GetFlags() map[string]bool {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type GetSecurityStatusMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityStatusMessageRequest) Reset() {
+	*x = GetSecurityStatusMessageRequest{}
+	mi := &file_user_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityStatusMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityStatusMessageRequest) ProtoMessage() {}
+
+func (x *GetSecurityStatusMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityStatusMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityStatusMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *GetSecurityStatusMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SecurityIssue struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Recommendation string                 `protobuf:"bytes,2,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	Severity       string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SecurityIssue) Reset() {
+	*x = SecurityIssue{}
+	mi := &file_user_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityIssue) ProtoMessage() {}
+
+func (x *SecurityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityIssue.ProtoReflect.Descriptor instead.
+func (*SecurityIssue) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *SecurityIssue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SecurityIssue) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *SecurityIssue) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type GetSecurityStatusMessageResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Score                 int32                  `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	Issues                []*SecurityIssue       `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	TwoFactorEnabled      bool                   `protobuf:"varint,3,opt,name=twoFactorEnabled,proto3" json:"twoFactorEnabled,omitempty"`
+	PasswordChangedAtUnix int64                  `protobuf:"varint,4,opt,name=passwordChangedAtUnix,proto3" json:"passwordChangedAtUnix,omitempty"`
+	LastSignInRisk        string                 `protobuf:"bytes,5,opt,name=lastSignInRisk,proto3" json:"lastSignInRisk,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetSecurityStatusMessageResponse) Reset() {
+	*x = GetSecurityStatusMessageResponse{}
+	mi := &file_user_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityStatusMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityStatusMessageResponse) ProtoMessage() {}
+
+func (x *GetSecurityStatusMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityStatusMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetSecurityStatusMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *GetSecurityStatusMessageResponse) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetSecurityStatusMessageResponse) GetIssues() []*SecurityIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *GetSecurityStatusMessageResponse) GetTwoFactorEnabled() bool {
+	if x != nil {
+		return x.TwoFactorEnabled
+	}
+	return false
+}
+
+func (x *GetSecurityStatusMessageResponse) GetPasswordChangedAtUnix() int64 {
+	if x != nil {
+		return x.PasswordChangedAtUnix
+	}
+	return 0
+}
+
+func (x *GetSecurityStatusMessageResponse) GetLastSignInRisk() string {
+	if x != nil {
+		return x.LastSignInRisk
+	}
+	return ""
+}
+
+type ExportSecurityEventsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FromUnix      int64                  `protobuf:"varint,2,opt,name=fromUnix,proto3" json:"fromUnix,omitempty"`
+	ToUnix        int64                  `protobuf:"varint,3,opt,name=toUnix,proto3" json:"toUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSecurityEventsMessageRequest) Reset() {
+	*x = ExportSecurityEventsMessageRequest{}
+	mi := &file_user_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSecurityEventsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSecurityEventsMessageRequest) ProtoMessage() {}
+
+func (x *ExportSecurityEventsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSecurityEventsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ExportSecurityEventsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *ExportSecurityEventsMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportSecurityEventsMessageRequest) GetFromUnix() int64 {
+	if x != nil {
+		return x.FromUnix
+	}
+	return 0
+}
+
+func (x *ExportSecurityEventsMessageRequest) GetToUnix() int64 {
+	if x != nil {
+		return x.ToUnix
+	}
+	return 0
+}
+
+type ExportSecurityEventsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSecurityEventsChunk) Reset() {
+	*x = ExportSecurityEventsChunk{}
+	mi := &file_user_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSecurityEventsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSecurityEventsChunk) ProtoMessage() {}
+
+func (x *ExportSecurityEventsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSecurityEventsChunk.ProtoReflect.Descriptor instead.
+func (*ExportSecurityEventsChunk) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *ExportSecurityEventsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetRecoveryContactMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecoveryContactMessageRequest) Reset() {
+	*x = SetRecoveryContactMessageRequest{}
+	mi := &file_user_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecoveryContactMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecoveryContactMessageRequest) ProtoMessage() {}
+
+func (x *SetRecoveryContactMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecoveryContactMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetRecoveryContactMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *SetRecoveryContactMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetRecoveryContactMessageRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SetRecoveryContactMessageRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type SetRecoveryContactMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecoveryContactMessageResponse) Reset() {
+	*x = SetRecoveryContactMessageResponse{}
+	mi := &file_user_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecoveryContactMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecoveryContactMessageResponse) ProtoMessage() {}
+
+func (x *SetRecoveryContactMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecoveryContactMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetRecoveryContactMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *SetRecoveryContactMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetRecoveryContactMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type VerifyRecoveryContactMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRecoveryContactMessageRequest) Reset() {
+	*x = VerifyRecoveryContactMessageRequest{}
+	mi := &file_user_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRecoveryContactMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRecoveryContactMessageRequest) ProtoMessage() {}
+
+func (x *VerifyRecoveryContactMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRecoveryContactMessageRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRecoveryContactMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *VerifyRecoveryContactMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyRecoveryContactMessageRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *VerifyRecoveryContactMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyRecoveryContactMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRecoveryContactMessageResponse) Reset() {
+	*x = VerifyRecoveryContactMessageResponse{}
+	mi := &file_user_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRecoveryContactMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRecoveryContactMessageResponse) ProtoMessage() {}
+
+func (x *VerifyRecoveryContactMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRecoveryContactMessageResponse.ProtoReflect.Descriptor instead.
+func (*VerifyRecoveryContactMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *VerifyRecoveryContactMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyRecoveryContactMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type StartAccountRecoveryMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartAccountRecoveryMessageRequest) Reset() {
+	*x = StartAccountRecoveryMessageRequest{}
+	mi := &file_user_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartAccountRecoveryMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAccountRecoveryMessageRequest) ProtoMessage() {}
+
+func (x *StartAccountRecoveryMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAccountRecoveryMessageRequest.ProtoReflect.Descriptor instead.
+func (*StartAccountRecoveryMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *StartAccountRecoveryMessageRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *StartAccountRecoveryMessageRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type StartAccountRecoveryMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryId    string                 `protobuf:"bytes,1,opt,name=recoveryId,proto3" json:"recoveryId,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartAccountRecoveryMessageResponse) Reset() {
+	*x = StartAccountRecoveryMessageResponse{}
+	mi := &file_user_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartAccountRecoveryMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAccountRecoveryMessageResponse) ProtoMessage() {}
+
+func (x *StartAccountRecoveryMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAccountRecoveryMessageResponse.ProtoReflect.Descriptor instead.
+func (*StartAccountRecoveryMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *StartAccountRecoveryMessageResponse) GetRecoveryId() string {
+	if x != nil {
+		return x.RecoveryId
+	}
+	return ""
+}
+
+func (x *StartAccountRecoveryMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartAccountRecoveryMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ConfirmAccountRecoveryMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryId    string                 `protobuf:"bytes,1,opt,name=recoveryId,proto3" json:"recoveryId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmAccountRecoveryMessageRequest) Reset() {
+	*x = ConfirmAccountRecoveryMessageRequest{}
+	mi := &file_user_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmAccountRecoveryMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmAccountRecoveryMessageRequest) ProtoMessage() {}
+
+func (x *ConfirmAccountRecoveryMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmAccountRecoveryMessageRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmAccountRecoveryMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *ConfirmAccountRecoveryMessageRequest) GetRecoveryId() string {
+	if x != nil {
+		return x.RecoveryId
+	}
+	return ""
+}
+
+func (x *ConfirmAccountRecoveryMessageRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmAccountRecoveryMessageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryToken   string                 `protobuf:"bytes,1,opt,name=recoveryToken,proto3" json:"recoveryToken,omitempty"`
+	AvailableAtUnix int64                  `protobuf:"varint,2,opt,name=availableAtUnix,proto3" json:"availableAtUnix,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success         bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConfirmAccountRecoveryMessageResponse) Reset() {
+	*x = ConfirmAccountRecoveryMessageResponse{}
+	mi := &file_user_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmAccountRecoveryMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmAccountRecoveryMessageResponse) ProtoMessage() {}
+
+func (x *ConfirmAccountRecoveryMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmAccountRecoveryMessageResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmAccountRecoveryMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *ConfirmAccountRecoveryMessageResponse) GetRecoveryToken() string {
+	if x != nil {
+		return x.RecoveryToken
+	}
+	return ""
+}
+
+func (x *ConfirmAccountRecoveryMessageResponse) GetAvailableAtUnix() int64 {
+	if x != nil {
+		return x.AvailableAtUnix
+	}
+	return 0
+}
+
+func (x *ConfirmAccountRecoveryMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfirmAccountRecoveryMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CompleteAccountRecoveryMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryToken string                 `protobuf:"bytes,1,opt,name=recoveryToken,proto3" json:"recoveryToken,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteAccountRecoveryMessageRequest) Reset() {
+	*x = CompleteAccountRecoveryMessageRequest{}
+	mi := &file_user_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteAccountRecoveryMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAccountRecoveryMessageRequest) ProtoMessage() {}
+
+func (x *CompleteAccountRecoveryMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAccountRecoveryMessageRequest.ProtoReflect.Descriptor instead.
+func (*CompleteAccountRecoveryMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *CompleteAccountRecoveryMessageRequest) GetRecoveryToken() string {
+	if x != nil {
+		return x.RecoveryToken
+	}
+	return ""
+}
+
+func (x *CompleteAccountRecoveryMessageRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type CompleteAccountRecoveryMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteAccountRecoveryMessageResponse) Reset() {
+	*x = CompleteAccountRecoveryMessageResponse{}
+	mi := &file_user_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteAccountRecoveryMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAccountRecoveryMessageResponse) ProtoMessage() {}
+
+func (x *CompleteAccountRecoveryMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAccountRecoveryMessageResponse.ProtoReflect.Descriptor instead.
+func (*CompleteAccountRecoveryMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *CompleteAccountRecoveryMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CompleteAccountRecoveryMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CancelAccountRecoveryMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountRecoveryMessageRequest) Reset() {
+	*x = CancelAccountRecoveryMessageRequest{}
+	mi := &file_user_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountRecoveryMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountRecoveryMessageRequest) ProtoMessage() {}
+
+func (x *CancelAccountRecoveryMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountRecoveryMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountRecoveryMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *CancelAccountRecoveryMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelAccountRecoveryMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountRecoveryMessageResponse) Reset() {
+	*x = CancelAccountRecoveryMessageResponse{}
+	mi := &file_user_proto_msgTypes[249]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountRecoveryMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountRecoveryMessageResponse) ProtoMessage() {}
+
+func (x *CancelAccountRecoveryMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[249]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountRecoveryMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelAccountRecoveryMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *CancelAccountRecoveryMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelAccountRecoveryMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SellerAwayMode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartsAtUnix  int64                  `protobuf:"varint,1,opt,name=startsAtUnix,proto3" json:"startsAtUnix,omitempty"`
+	EndsAtUnix    int64                  `protobuf:"varint,2,opt,name=endsAtUnix,proto3" json:"endsAtUnix,omitempty"`
+	AutoReply     string                 `protobuf:"bytes,3,opt,name=autoReply,proto3" json:"autoReply,omitempty"`
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellerAwayMode) Reset() {
+	*x = SellerAwayMode{}
+	mi := &file_user_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerAwayMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerAwayMode) ProtoMessage() {}
+
+func (x *SellerAwayMode) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerAwayMode.ProtoReflect.Descriptor instead.
+func (*SellerAwayMode) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *SellerAwayMode) GetStartsAtUnix() int64 {
+	if x != nil {
+		return x.StartsAtUnix
+	}
+	return 0
+}
+
+func (x *SellerAwayMode) GetEndsAtUnix() int64 {
+	if x != nil {
+		return x.EndsAtUnix
+	}
+	return 0
+}
+
+func (x *SellerAwayMode) GetAutoReply() string {
+	if x != nil {
+		return x.AutoReply
+	}
+	return ""
+}
+
+func (x *SellerAwayMode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SetAwayModeMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	StartsAtUnix  int64                  `protobuf:"varint,2,opt,name=startsAtUnix,proto3" json:"startsAtUnix,omitempty"`
+	EndsAtUnix    int64                  `protobuf:"varint,3,opt,name=endsAtUnix,proto3" json:"endsAtUnix,omitempty"`
+	AutoReply     string                 `protobuf:"bytes,4,opt,name=autoReply,proto3" json:"autoReply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAwayModeMessageRequest) Reset() {
+	*x = SetAwayModeMessageRequest{}
+	mi := &file_user_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAwayModeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAwayModeMessageRequest) ProtoMessage() {}
+
+func (x *SetAwayModeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[251]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAwayModeMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetAwayModeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *SetAwayModeMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetAwayModeMessageRequest) GetStartsAtUnix() int64 {
+	if x != nil {
+		return x.StartsAtUnix
+	}
+	return 0
+}
+
+func (x *SetAwayModeMessageRequest) GetEndsAtUnix() int64 {
+	if x != nil {
+		return x.EndsAtUnix
+	}
+	return 0
+}
+
+func (x *SetAwayModeMessageRequest) GetAutoReply() string {
+	if x != nil {
+		return x.AutoReply
+	}
+	return ""
+}
+
+type SetAwayModeMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AwayMode      *SellerAwayMode        `protobuf:"bytes,1,opt,name=awayMode,proto3" json:"awayMode,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAwayModeMessageResponse) Reset() {
+	*x = SetAwayModeMessageResponse{}
+	mi := &file_user_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAwayModeMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAwayModeMessageResponse) ProtoMessage() {}
+
+func (x *SetAwayModeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAwayModeMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetAwayModeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *SetAwayModeMessageResponse) GetAwayMode() *SellerAwayMode {
+	if x != nil {
+		return x.AwayMode
+	}
+	return nil
+}
+
+func (x *SetAwayModeMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetAwayModeMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ClearAwayModeMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAwayModeMessageRequest) Reset() {
+	*x = ClearAwayModeMessageRequest{}
+	mi := &file_user_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAwayModeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAwayModeMessageRequest) ProtoMessage() {}
+
+func (x *ClearAwayModeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAwayModeMessageRequest.ProtoReflect.Descriptor instead.
+func (*ClearAwayModeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *ClearAwayModeMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ClearAwayModeMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAwayModeMessageResponse) Reset() {
+	*x = ClearAwayModeMessageResponse{}
+	mi := &file_user_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAwayModeMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAwayModeMessageResponse) ProtoMessage() {}
+
+func (x *ClearAwayModeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[254]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAwayModeMessageResponse.ProtoReflect.Descriptor instead.
+func (*ClearAwayModeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *ClearAwayModeMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClearAwayModeMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetAwayModeMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAwayModeMessageRequest) Reset() {
+	*x = GetAwayModeMessageRequest{}
+	mi := &file_user_proto_msgTypes[255]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAwayModeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAwayModeMessageRequest) ProtoMessage() {}
+
+func (x *GetAwayModeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[255]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAwayModeMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetAwayModeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{255}
+}
+
+func (x *GetAwayModeMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAwayModeMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AwayMode      *SellerAwayMode        `protobuf:"bytes,1,opt,name=awayMode,proto3" json:"awayMode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAwayModeMessageResponse) Reset() {
+	*x = GetAwayModeMessageResponse{}
+	mi := &file_user_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAwayModeMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAwayModeMessageResponse) ProtoMessage() {}
+
+func (x *GetAwayModeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[256]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAwayModeMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetAwayModeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *GetAwayModeMessageResponse) GetAwayMode() *SellerAwayMode {
+	if x != nil {
+		return x.AwayMode
+	}
+	return nil
+}
+
+type SetTaxProfileMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	KraPin        string                 `protobuf:"bytes,2,opt,name=kraPin,proto3" json:"kraPin,omitempty"`
+	VatStatus     string                 `protobuf:"bytes,3,opt,name=vatStatus,proto3" json:"vatStatus,omitempty"`
+	BusinessName  string                 `protobuf:"bytes,4,opt,name=businessName,proto3" json:"businessName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxProfileMessageRequest) Reset() {
+	*x = SetTaxProfileMessageRequest{}
+	mi := &file_user_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxProfileMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxProfileMessageRequest) ProtoMessage() {}
+
+func (x *SetTaxProfileMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[257]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxProfileMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxProfileMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{257}
+}
+
+func (x *SetTaxProfileMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetTaxProfileMessageRequest) GetKraPin() string {
+	if x != nil {
+		return x.KraPin
+	}
+	return ""
+}
+
+func (x *SetTaxProfileMessageRequest) GetVatStatus() string {
+	if x != nil {
+		return x.VatStatus
+	}
+	return ""
+}
+
+func (x *SetTaxProfileMessageRequest) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+type SetTaxProfileMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxProfileMessageResponse) Reset() {
+	*x = SetTaxProfileMessageResponse{}
+	mi := &file_user_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxProfileMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxProfileMessageResponse) ProtoMessage() {}
+
+func (x *SetTaxProfileMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxProfileMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetTaxProfileMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *SetTaxProfileMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetTaxProfileMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetTaxProfileMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxProfileMessageRequest) Reset() {
+	*x = GetTaxProfileMessageRequest{}
+	mi := &file_user_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxProfileMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxProfileMessageRequest) ProtoMessage() {}
+
+func (x *GetTaxProfileMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[259]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxProfileMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxProfileMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{259}
+}
+
+func (x *GetTaxProfileMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetTaxProfileMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KraPin        string                 `protobuf:"bytes,1,opt,name=kraPin,proto3" json:"kraPin,omitempty"`
+	VatStatus     string                 `protobuf:"bytes,2,opt,name=vatStatus,proto3" json:"vatStatus,omitempty"`
+	BusinessName  string                 `protobuf:"bytes,3,opt,name=businessName,proto3" json:"businessName,omitempty"`
+	UpdatedAtUnix int64                  `protobuf:"varint,4,opt,name=updatedAtUnix,proto3" json:"updatedAtUnix,omitempty"`
+	Redacted      bool                   `protobuf:"varint,5,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxProfileMessageResponse) Reset() {
+	*x = GetTaxProfileMessageResponse{}
+	mi := &file_user_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxProfileMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxProfileMessageResponse) ProtoMessage() {}
+
+func (x *GetTaxProfileMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[260]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxProfileMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetTaxProfileMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *GetTaxProfileMessageResponse) GetKraPin() string {
+	if x != nil {
+		return x.KraPin
+	}
+	return ""
+}
+
+func (x *GetTaxProfileMessageResponse) GetVatStatus() string {
+	if x != nil {
+		return x.VatStatus
+	}
+	return ""
+}
+
+func (x *GetTaxProfileMessageResponse) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+func (x *GetTaxProfileMessageResponse) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+func (x *GetTaxProfileMessageResponse) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+type AttributeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Visibility    string                 `protobuf:"bytes,3,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Pattern       string                 `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Min           float64                `protobuf:"fixed64,5,opt,name=min,proto3" json:"min,omitempty"`
+	HasMin        bool                   `protobuf:"varint,6,opt,name=hasMin,proto3" json:"hasMin,omitempty"`
+	Max           float64                `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	HasMax        bool                   `protobuf:"varint,8,opt,name=hasMax,proto3" json:"hasMax,omitempty"`
+	Options       []string               `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	mi := &file_user_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[261]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{261}
+}
+
+func (x *AttributeDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *AttributeDefinition) GetHasMin() bool {
+	if x != nil {
+		return x.HasMin
+	}
+	return false
+}
+
+func (x *AttributeDefinition) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *AttributeDefinition) GetHasMax() bool {
+	if x != nil {
+		return x.HasMax
+	}
+	return false
+}
+
+func (x *AttributeDefinition) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DefineAttributeMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Definition    *AttributeDefinition   `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineAttributeMessageRequest) Reset() {
+	*x = DefineAttributeMessageRequest{}
+	mi := &file_user_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineAttributeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineAttributeMessageRequest) ProtoMessage() {}
+
+func (x *DefineAttributeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[262]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineAttributeMessageRequest.ProtoReflect.Descriptor instead.
+func (*DefineAttributeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{262}
+}
+
+func (x *DefineAttributeMessageRequest) GetDefinition() *AttributeDefinition {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+type DefineAttributeMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Definition    *AttributeDefinition   `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineAttributeMessageResponse) Reset() {
+	*x = DefineAttributeMessageResponse{}
+	mi := &file_user_proto_msgTypes[263]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineAttributeMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineAttributeMessageResponse) ProtoMessage() {}
+
+func (x *DefineAttributeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[263]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineAttributeMessageResponse.ProtoReflect.Descriptor instead.
+func (*DefineAttributeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{263}
+}
+
+func (x *DefineAttributeMessageResponse) GetDefinition() *AttributeDefinition {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+func (x *DefineAttributeMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DefineAttributeMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAttributeDefinitionsMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttributeDefinitionsMessageRequest) Reset() {
+	*x = ListAttributeDefinitionsMessageRequest{}
+	mi := &file_user_proto_msgTypes[264]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributeDefinitionsMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributeDefinitionsMessageRequest) ProtoMessage() {}
+
+func (x *ListAttributeDefinitionsMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[264]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributeDefinitionsMessageRequest.ProtoReflect.Descriptor instead.
+func (*ListAttributeDefinitionsMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{264}
+}
+
+type ListAttributeDefinitionsMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Definitions   []*AttributeDefinition `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttributeDefinitionsMessageResponse) Reset() {
+	*x = ListAttributeDefinitionsMessageResponse{}
+	mi := &file_user_proto_msgTypes[265]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributeDefinitionsMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributeDefinitionsMessageResponse) ProtoMessage() {}
+
+func (x *ListAttributeDefinitionsMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[265]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributeDefinitionsMessageResponse.ProtoReflect.Descriptor instead.
+func (*ListAttributeDefinitionsMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{265}
+}
+
+func (x *ListAttributeDefinitionsMessageResponse) GetDefinitions() []*AttributeDefinition {
+	if x != nil {
+		return x.Definitions
+	}
+	return nil
+}
+
+type SetAttributesMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributesMessageRequest) Reset() {
+	*x = SetAttributesMessageRequest{}
+	mi := &file_user_proto_msgTypes[266]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributesMessageRequest) ProtoMessage() {}
+
+func (x *SetAttributesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[266]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributesMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetAttributesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{266}
+}
+
+func (x *SetAttributesMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetAttributesMessageRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SetAttributesMessageRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type SetAttributesMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributesMessageResponse) Reset() {
+	*x = SetAttributesMessageResponse{}
+	mi := &file_user_proto_msgTypes[267]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributesMessageResponse) ProtoMessage() {}
+
+func (x *SetAttributesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[267]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributesMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetAttributesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{267}
+}
+
+func (x *SetAttributesMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetAttributesMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetAttributesMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewerId,proto3" json:"viewerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttributesMessageRequest) Reset() {
+	*x = GetAttributesMessageRequest{}
+	mi := &file_user_proto_msgTypes[268]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributesMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributesMessageRequest) ProtoMessage() {}
+
+func (x *GetAttributesMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[268]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributesMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetAttributesMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{268}
+}
+
+func (x *GetAttributesMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAttributesMessageRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type GetAttributesMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    map[string]string      `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttributesMessageResponse) Reset() {
+	*x = GetAttributesMessageResponse{}
+	mi := &file_user_proto_msgTypes[269]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributesMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributesMessageResponse) ProtoMessage() {}
+
+func (x *GetAttributesMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[269]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributesMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetAttributesMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{269}
+}
+
+func (x *GetAttributesMessageResponse) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_user_proto_msgTypes[270]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[270]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{270}
+}
+
+func (x *FieldChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type DryRunChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,2,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Fields        []*FieldChange         `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunChange) Reset() {
+	*x = DryRunChange{}
+	mi := &file_user_proto_msgTypes[271]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunChange) ProtoMessage() {}
+
+func (x *DryRunChange) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[271]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunChange.ProtoReflect.Descriptor instead.
+func (*DryRunChange) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{271}
+}
+
+func (x *DryRunChange) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *DryRunChange) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DryRunChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DryRunChange) GetFields() []*FieldChange {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DryRunDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*DryRunChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Counts        map[string]int64       `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunDiff) Reset() {
+	*x = DryRunDiff{}
+	mi := &file_user_proto_msgTypes[272]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunDiff) ProtoMessage() {}
+
+func (x *DryRunDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[272]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunDiff.ProtoReflect.Descriptor instead.
+func (*DryRunDiff) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{272}
+}
+
+func (x *DryRunDiff) GetChanges() []*DryRunChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DryRunDiff) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *DryRunDiff) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type GetProfileHistoryMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileHistoryMessageRequest) Reset() {
+	*x = GetProfileHistoryMessageRequest{}
+	mi := &file_user_proto_msgTypes[273]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileHistoryMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileHistoryMessageRequest) ProtoMessage() {}
+
+func (x *GetProfileHistoryMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[273]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileHistoryMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileHistoryMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{273}
+}
+
+func (x *GetProfileHistoryMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetProfileHistoryMessageRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *GetProfileHistoryMessageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetProfileHistoryMessageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ProfileChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=newValue,proto3" json:"newValue,omitempty"`
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,5,opt,name=actorUserId,proto3" json:"actorUserId,omitempty"`
+	Method        string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	ChangedAtUnix int64                  `protobuf:"varint,7,opt,name=changedAtUnix,proto3" json:"changedAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileChange) Reset() {
+	*x = ProfileChange{}
+	mi := &file_user_proto_msgTypes[274]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileChange) ProtoMessage() {}
+
+func (x *ProfileChange) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[274]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileChange.ProtoReflect.Descriptor instead.
+func (*ProfileChange) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{274}
+}
+
+func (x *ProfileChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ProfileChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ProfileChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *ProfileChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ProfileChange) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ProfileChange) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ProfileChange) GetChangedAtUnix() int64 {
+	if x != nil {
+		return x.ChangedAtUnix
+	}
+	return 0
+}
+
+type GetProfileHistoryMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*ProfileChange       `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileHistoryMessageResponse) Reset() {
+	*x = GetProfileHistoryMessageResponse{}
+	mi := &file_user_proto_msgTypes[275]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileHistoryMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileHistoryMessageResponse) ProtoMessage() {}
+
+func (x *GetProfileHistoryMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[275]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileHistoryMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileHistoryMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{275}
+}
+
+func (x *GetProfileHistoryMessageResponse) GetChanges() []*ProfileChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetProfileHistoryMessageResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDownloadURLMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadURLMessageRequest) Reset() {
+	*x = GetDownloadURLMessageRequest{}
+	mi := &file_user_proto_msgTypes[276]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadURLMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadURLMessageRequest) ProtoMessage() {}
+
+func (x *GetDownloadURLMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[276]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadURLMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadURLMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{276}
+}
+
+func (x *GetDownloadURLMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDownloadURLMessageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetDownloadURLMessageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDownloadURLMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadURLMessageResponse) Reset() {
+	*x = GetDownloadURLMessageResponse{}
+	mi := &file_user_proto_msgTypes[277]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadURLMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadURLMessageResponse) ProtoMessage() {}
+
+func (x *GetDownloadURLMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[277]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadURLMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadURLMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{277}
+}
+
+func (x *GetDownloadURLMessageResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetDownloadURLMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+type RegisterUSSDUserMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	Pin           string                 `protobuf:"bytes,3,opt,name=pin,proto3" json:"pin,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUSSDUserMessageRequest) Reset() {
+	*x = RegisterUSSDUserMessageRequest{}
+	mi := &file_user_proto_msgTypes[278]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUSSDUserMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUSSDUserMessageRequest) ProtoMessage() {}
+
+func (x *RegisterUSSDUserMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[278]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUSSDUserMessageRequest.ProtoReflect.Descriptor instead.
+func (*RegisterUSSDUserMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{278}
+}
+
+func (x *RegisterUSSDUserMessageRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *RegisterUSSDUserMessageRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *RegisterUSSDUserMessageRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+func (x *RegisterUSSDUserMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RegisterUSSDUserMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUSSDUserMessageResponse) Reset() {
+	*x = RegisterUSSDUserMessageResponse{}
+	mi := &file_user_proto_msgTypes[279]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUSSDUserMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUSSDUserMessageResponse) ProtoMessage() {}
+
+func (x *RegisterUSSDUserMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[279]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUSSDUserMessageResponse.ProtoReflect.Descriptor instead.
+func (*RegisterUSSDUserMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{279}
+}
+
+func (x *RegisterUSSDUserMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterUSSDUserMessageResponse) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *RegisterUSSDUserMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterUSSDUserMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type LoginUSSDUserMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Pin           string                 `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginUSSDUserMessageRequest) Reset() {
+	*x = LoginUSSDUserMessageRequest{}
+	mi := &file_user_proto_msgTypes[280]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginUSSDUserMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginUSSDUserMessageRequest) ProtoMessage() {}
+
+func (x *LoginUSSDUserMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[280]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginUSSDUserMessageRequest.ProtoReflect.Descriptor instead.
+func (*LoginUSSDUserMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{280}
+}
+
+func (x *LoginUSSDUserMessageRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *LoginUSSDUserMessageRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+func (x *LoginUSSDUserMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type LoginUSSDUserMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginUSSDUserMessageResponse) Reset() {
+	*x = LoginUSSDUserMessageResponse{}
+	mi := &file_user_proto_msgTypes[281]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginUSSDUserMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginUSSDUserMessageResponse) ProtoMessage() {}
+
+func (x *LoginUSSDUserMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[281]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginUSSDUserMessageResponse.ProtoReflect.Descriptor instead.
+func (*LoginUSSDUserMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{281}
+}
+
+func (x *LoginUSSDUserMessageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LoginUSSDUserMessageResponse) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *LoginUSSDUserMessageResponse) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *LoginUSSDUserMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LoginUSSDUserMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ChangeUSSDPINMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	CurrentPin    string                 `protobuf:"bytes,2,opt,name=currentPin,proto3" json:"currentPin,omitempty"`
+	NewPin        string                 `protobuf:"bytes,3,opt,name=newPin,proto3" json:"newPin,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUSSDPINMessageRequest) Reset() {
+	*x = ChangeUSSDPINMessageRequest{}
+	mi := &file_user_proto_msgTypes[282]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUSSDPINMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUSSDPINMessageRequest) ProtoMessage() {}
+
+func (x *ChangeUSSDPINMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[282]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUSSDPINMessageRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUSSDPINMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{282}
+}
+
+func (x *ChangeUSSDPINMessageRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ChangeUSSDPINMessageRequest) GetCurrentPin() string {
+	if x != nil {
+		return x.CurrentPin
+	}
+	return ""
+}
+
+func (x *ChangeUSSDPINMessageRequest) GetNewPin() string {
+	if x != nil {
+		return x.NewPin
+	}
+	return ""
+}
+
+func (x *ChangeUSSDPINMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ChangeUSSDPINMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUSSDPINMessageResponse) Reset() {
+	*x = ChangeUSSDPINMessageResponse{}
+	mi := &file_user_proto_msgTypes[283]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUSSDPINMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUSSDPINMessageResponse) ProtoMessage() {}
+
+func (x *ChangeUSSDPINMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[283]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUSSDPINMessageResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUSSDPINMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{283}
+}
+
+func (x *ChangeUSSDPINMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChangeUSSDPINMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetDevicePINMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	Pin           string                 `protobuf:"bytes,3,opt,name=pin,proto3" json:"pin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDevicePINMessageRequest) Reset() {
+	*x = SetDevicePINMessageRequest{}
+	mi := &file_user_proto_msgTypes[284]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDevicePINMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDevicePINMessageRequest) ProtoMessage() {}
+
+func (x *SetDevicePINMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[284]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDevicePINMessageRequest.ProtoReflect.Descriptor instead.
+func (*SetDevicePINMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{284}
+}
+
+func (x *SetDevicePINMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetDevicePINMessageRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SetDevicePINMessageRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type SetDevicePINMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDevicePINMessageResponse) Reset() {
+	*x = SetDevicePINMessageResponse{}
+	mi := &file_user_proto_msgTypes[285]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDevicePINMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDevicePINMessageResponse) ProtoMessage() {}
+
+func (x *SetDevicePINMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[285]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDevicePINMessageResponse.ProtoReflect.Descriptor instead.
+func (*SetDevicePINMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{285}
+}
+
+func (x *SetDevicePINMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetDevicePINMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveDevicePINMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDevicePINMessageRequest) Reset() {
+	*x = RemoveDevicePINMessageRequest{}
+	mi := &file_user_proto_msgTypes[286]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDevicePINMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDevicePINMessageRequest) ProtoMessage() {}
+
+func (x *RemoveDevicePINMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[286]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDevicePINMessageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDevicePINMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{286}
+}
+
+func (x *RemoveDevicePINMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveDevicePINMessageRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type RemoveDevicePINMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDevicePINMessageResponse) Reset() {
+	*x = RemoveDevicePINMessageResponse{}
+	mi := &file_user_proto_msgTypes[287]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDevicePINMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDevicePINMessageResponse) ProtoMessage() {}
+
+func (x *RemoveDevicePINMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[287]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDevicePINMessageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDevicePINMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{287}
+}
+
+func (x *RemoveDevicePINMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveDevicePINMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReAuthenticateWithPINMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	Pin           string                 `protobuf:"bytes,3,opt,name=pin,proto3" json:"pin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReAuthenticateWithPINMessageRequest) Reset() {
+	*x = ReAuthenticateWithPINMessageRequest{}
+	mi := &file_user_proto_msgTypes[288]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReAuthenticateWithPINMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReAuthenticateWithPINMessageRequest) ProtoMessage() {}
+
+func (x *ReAuthenticateWithPINMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[288]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReAuthenticateWithPINMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReAuthenticateWithPINMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{288}
+}
+
+func (x *ReAuthenticateWithPINMessageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReAuthenticateWithPINMessageRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ReAuthenticateWithPINMessageRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type ReAuthenticateWithPINMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	AuthTimeUnix  int64                  `protobuf:"varint,3,opt,name=authTimeUnix,proto3" json:"authTimeUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReAuthenticateWithPINMessageResponse) Reset() {
+	*x = ReAuthenticateWithPINMessageResponse{}
+	mi := &file_user_proto_msgTypes[289]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReAuthenticateWithPINMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReAuthenticateWithPINMessageResponse) ProtoMessage() {}
+
+func (x *ReAuthenticateWithPINMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[289]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReAuthenticateWithPINMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReAuthenticateWithPINMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{289}
+}
+
+func (x *ReAuthenticateWithPINMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReAuthenticateWithPINMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *ReAuthenticateWithPINMessageResponse) GetAuthTimeUnix() int64 {
+	if x != nil {
+		return x.AuthTimeUnix
+	}
+	return 0
+}
+
+type RegisterDeviceKeyMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	PublicKey     []byte                 `protobuf:"bytes,4,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceKeyMessageRequest) Reset() {
+	*x = RegisterDeviceKeyMessageRequest{}
+	mi := &file_user_proto_msgTypes[290]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceKeyMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceKeyMessageRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceKeyMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[290]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceKeyMessageRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceKeyMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{290}
+}
+
+func (x *RegisterDeviceKeyMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterDeviceKeyMessageRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RegisterDeviceKeyMessageRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *RegisterDeviceKeyMessageRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type RegisterDeviceKeyMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceKeyMessageResponse) Reset() {
+	*x = RegisterDeviceKeyMessageResponse{}
+	mi := &file_user_proto_msgTypes[291]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceKeyMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceKeyMessageResponse) ProtoMessage() {}
+
+func (x *RegisterDeviceKeyMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[291]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceKeyMessageResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceKeyMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{291}
+}
+
+func (x *RegisterDeviceKeyMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterDeviceKeyMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveDeviceKeyMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeviceKeyMessageRequest) Reset() {
+	*x = RemoveDeviceKeyMessageRequest{}
+	mi := &file_user_proto_msgTypes[292]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeviceKeyMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeviceKeyMessageRequest) ProtoMessage() {}
+
+func (x *RemoveDeviceKeyMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[292]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeviceKeyMessageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDeviceKeyMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{292}
+}
+
+func (x *RemoveDeviceKeyMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveDeviceKeyMessageRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type RemoveDeviceKeyMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeviceKeyMessageResponse) Reset() {
+	*x = RemoveDeviceKeyMessageResponse{}
+	mi := &file_user_proto_msgTypes[293]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeviceKeyMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeviceKeyMessageResponse) ProtoMessage() {}
+
+func (x *RemoveDeviceKeyMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[293]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeviceKeyMessageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDeviceKeyMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{293}
+}
+
+func (x *RemoveDeviceKeyMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveDeviceKeyMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type IssueBiometricChallengeMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueBiometricChallengeMessageRequest) Reset() {
+	*x = IssueBiometricChallengeMessageRequest{}
+	mi := &file_user_proto_msgTypes[294]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueBiometricChallengeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueBiometricChallengeMessageRequest) ProtoMessage() {}
+
+func (x *IssueBiometricChallengeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[294]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueBiometricChallengeMessageRequest.ProtoReflect.Descriptor instead.
+func (*IssueBiometricChallengeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{294}
+}
+
+func (x *IssueBiometricChallengeMessageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *IssueBiometricChallengeMessageRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type IssueBiometricChallengeMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueBiometricChallengeMessageResponse) Reset() {
+	*x = IssueBiometricChallengeMessageResponse{}
+	mi := &file_user_proto_msgTypes[295]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueBiometricChallengeMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueBiometricChallengeMessageResponse) ProtoMessage() {}
+
+func (x *IssueBiometricChallengeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[295]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueBiometricChallengeMessageResponse.ProtoReflect.Descriptor instead.
+func (*IssueBiometricChallengeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{295}
+}
+
+func (x *IssueBiometricChallengeMessageResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *IssueBiometricChallengeMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+type ReAuthenticateWithBiometricMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	Challenge     string                 `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReAuthenticateWithBiometricMessageRequest) Reset() {
+	*x = ReAuthenticateWithBiometricMessageRequest{}
+	mi := &file_user_proto_msgTypes[296]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReAuthenticateWithBiometricMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReAuthenticateWithBiometricMessageRequest) ProtoMessage() {}
+
+func (x *ReAuthenticateWithBiometricMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[296]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReAuthenticateWithBiometricMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReAuthenticateWithBiometricMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{296}
+}
+
+func (x *ReAuthenticateWithBiometricMessageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReAuthenticateWithBiometricMessageRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ReAuthenticateWithBiometricMessageRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *ReAuthenticateWithBiometricMessageRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ReAuthenticateWithBiometricMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	AuthTimeUnix  int64                  `protobuf:"varint,3,opt,name=authTimeUnix,proto3" json:"authTimeUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReAuthenticateWithBiometricMessageResponse) Reset() {
+	*x = ReAuthenticateWithBiometricMessageResponse{}
+	mi := &file_user_proto_msgTypes[297]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReAuthenticateWithBiometricMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReAuthenticateWithBiometricMessageResponse) ProtoMessage() {}
+
+func (x *ReAuthenticateWithBiometricMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[297]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReAuthenticateWithBiometricMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReAuthenticateWithBiometricMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{297}
+}
+
+func (x *ReAuthenticateWithBiometricMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReAuthenticateWithBiometricMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *ReAuthenticateWithBiometricMessageResponse) GetAuthTimeUnix() int64 {
+	if x != nil {
+		return x.AuthTimeUnix
+	}
+	return 0
+}
+
+type RefreshTokenMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenMessageRequest) Reset() {
+	*x = RefreshTokenMessageRequest{}
+	mi := &file_user_proto_msgTypes[298]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenMessageRequest) ProtoMessage() {}
+
+func (x *RefreshTokenMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[298]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenMessageRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{298}
+}
+
+func (x *RefreshTokenMessageRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenMessageResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken          string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpiresAtUnix        int64                  `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	RefreshToken         string                 `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	RefreshExpiresAtUnix int64                  `protobuf:"varint,4,opt,name=refreshExpiresAtUnix,proto3" json:"refreshExpiresAtUnix,omitempty"`
+	Scopes               []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RefreshTokenMessageResponse) Reset() {
+	*x = RefreshTokenMessageResponse{}
+	mi := &file_user_proto_msgTypes[299]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenMessageResponse) ProtoMessage() {}
+
+func (x *RefreshTokenMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[299]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenMessageResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{299}
+}
+
+func (x *RefreshTokenMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *RefreshTokenMessageResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenMessageResponse) GetRefreshExpiresAtUnix() int64 {
+	if x != nil {
+		return x.RefreshExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *RefreshTokenMessageResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ExchangeTokenMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubjectToken     string                 `protobuf:"bytes,1,opt,name=subjectToken,proto3" json:"subjectToken,omitempty"`
+	SubjectTokenType string                 `protobuf:"bytes,2,opt,name=subjectTokenType,proto3" json:"subjectTokenType,omitempty"`
+	Audience         string                 `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
+	Scopes           []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Tenant           string                 `protobuf:"bytes,5,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExchangeTokenMessageRequest) Reset() {
+	*x = ExchangeTokenMessageRequest{}
+	mi := &file_user_proto_msgTypes[300]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeTokenMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeTokenMessageRequest) ProtoMessage() {}
+
+func (x *ExchangeTokenMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[300]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeTokenMessageRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeTokenMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{300}
+}
+
+func (x *ExchangeTokenMessageRequest) GetSubjectToken() string {
+	if x != nil {
+		return x.SubjectToken
+	}
+	return ""
+}
+
+func (x *ExchangeTokenMessageRequest) GetSubjectTokenType() string {
+	if x != nil {
+		return x.SubjectTokenType
+	}
+	return ""
+}
+
+func (x *ExchangeTokenMessageRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *ExchangeTokenMessageRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ExchangeTokenMessageRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type ExchangeTokenMessageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken     string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	IssuedTokenType string                 `protobuf:"bytes,2,opt,name=issuedTokenType,proto3" json:"issuedTokenType,omitempty"`
+	ExpiresAtUnix   int64                  `protobuf:"varint,3,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	Scopes          []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExchangeTokenMessageResponse) Reset() {
+	*x = ExchangeTokenMessageResponse{}
+	mi := &file_user_proto_msgTypes[301]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeTokenMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeTokenMessageResponse) ProtoMessage() {}
+
+func (x *ExchangeTokenMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[301]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeTokenMessageResponse.ProtoReflect.Descriptor instead.
+func (*ExchangeTokenMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{301}
+}
+
+func (x *ExchangeTokenMessageResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExchangeTokenMessageResponse) GetIssuedTokenType() string {
+	if x != nil {
+		return x.IssuedTokenType
+	}
+	return ""
+}
+
+func (x *ExchangeTokenMessageResponse) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *ExchangeTokenMessageResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type GetQuotaUsageMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaUsageMessageRequest) Reset() {
+	*x = GetQuotaUsageMessageRequest{}
+	mi := &file_user_proto_msgTypes[302]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaUsageMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaUsageMessageRequest) ProtoMessage() {}
+
+func (x *GetQuotaUsageMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[302]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaUsageMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaUsageMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{302}
+}
+
+func (x *GetQuotaUsageMessageRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type QuotaPeriodUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Used          int64                  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	ResetsAtUnix  int64                  `protobuf:"varint,4,opt,name=resetsAtUnix,proto3" json:"resetsAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaPeriodUsage) Reset() {
+	*x = QuotaPeriodUsage{}
+	mi := &file_user_proto_msgTypes[303]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaPeriodUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaPeriodUsage) ProtoMessage() {}
+
+func (x *QuotaPeriodUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[303]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaPeriodUsage.ProtoReflect.Descriptor instead.
+func (*QuotaPeriodUsage) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{303}
+}
+
+func (x *QuotaPeriodUsage) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *QuotaPeriodUsage) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *QuotaPeriodUsage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QuotaPeriodUsage) GetResetsAtUnix() int64 {
+	if x != nil {
+		return x.ResetsAtUnix
+	}
+	return 0
+}
+
+type GetQuotaUsageMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Periods       []*QuotaPeriodUsage    `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaUsageMessageResponse) Reset() {
+	*x = GetQuotaUsageMessageResponse{}
+	mi := &file_user_proto_msgTypes[304]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaUsageMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaUsageMessageResponse) ProtoMessage() {}
+
+func (x *GetQuotaUsageMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[304]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaUsageMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaUsageMessageResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{304}
+}
+
+func (x *GetQuotaUsageMessageResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetQuotaUsageMessageResponse) GetPeriods() []*QuotaPeriodUsage {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+var File_user_proto protoreflect.FileDescriptor
+
+const file_user_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"user.proto\x12\x04user\"\xc8\x02\n" +
+	"\x16RegisterMessageRequest\x12\x1a\n" +
+	"\bfullName\x18\x01 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x02 \x01(\tR\buserName\x12\"\n" +
+	"\femailAddress\x18\x03 \x01(\tR\femailAddress\x12 \n" +
+	"\vphoneNumber\x18\x04 \x01(\tR\vphoneNumber\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12,\n" +
+	"\x11deviceFingerprint\x18\x06 \x01(\tR\x11deviceFingerprint\x12 \n" +
+	"\vinviteToken\x18\a \x01(\tR\vinviteToken\x12\"\n" +
+	"\fpowChallenge\x18\b \x01(\tR\fpowChallenge\x12 \n" +
+	"\vpowSolution\x18\t \x01(\tR\vpowSolution\"\xd3\x01\n" +
+	"\x17RegisterMessageResponse\x12\x1a\n" +
+	"\buserName\x18\x01 \x01(\tR\buserName\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x1e\n" +
+	"\n" +
+	"riskAction\x18\x04 \x01(\tR\n" +
+	"riskAction\x12\"\n" +
+	"\fpowChallenge\x18\x05 \x01(\tR\fpowChallenge\x12$\n" +
+	"\rpowDifficulty\x18\x06 \x01(\x05R\rpowDifficulty\"\x8d\x01\n" +
+	"\x13LoginMessageRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12,\n" +
+	"\x11deviceFingerprint\x18\x02 \x01(\tR\x11deviceFingerprint\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06client\x18\x04 \x01(\tR\x06client\"\xbe\x02\n" +
+	"\x14LoginMessageResponse\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\buserName\x18\x02 \x01(\tR\buserName\x12\x1e\n" +
+	"\n" +
+	"riskAction\x18\x04 \x01(\tR\n" +
+	"riskAction\x12\x16\n" +
+	"\x06userId\x18\x05 \x01(\tR\x06userId\x12 \n" +
+	"\vaccessToken\x18\x06 \x01(\tR\vaccessToken\x12$\n" +
+	"\rexpiresAtUnix\x18\a \x01(\x03R\rexpiresAtUnix\x12\x16\n" +
+	"\x06scopes\x18\b \x03(\tR\x06scopes\x12\"\n" +
+	"\frefreshToken\x18\t \x01(\tR\frefreshToken\x122\n" +
+	"\x14refreshExpiresAtUnix\x18\n" +
+	" \x01(\x03R\x14refreshExpiresAtUnixJ\x04\b\x03\x10\x04\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xa0\x03\n" +
+	"\x0eBillingAddress\x12$\n" +
+	"\rrecipientName\x18\x01 \x01(\tR\rrecipientName\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"postalCode\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12*\n" +
+	"\x10formattedAddress\x18\b \x01(\tR\x10formattedAddress\x12*\n" +
+	"\blocation\x18\t \x01(\v2\x0e.user.GeoPointR\blocation\x12\x16\n" +
+	"\x06county\x18\n" +
+	" \x01(\tR\x06county\x12\x1c\n" +
+	"\tsubCounty\x18\v \x01(\tR\tsubCounty\x12\x1a\n" +
+	"\blandmark\x18\f \x01(\tR\blandmark\x12,\n" +
+	"\x11riderInstructions\x18\r \x01(\tR\x11riderInstructions\"9\n" +
+	"\rTaxIdentifier\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"9\n" +
+	"\x1fGetBillingProfileMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xb7\x02\n" +
+	" GetBillingProfileMessageResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12<\n" +
+	"\x0ebillingAddress\x18\x02 \x01(\v2\x14.user.BillingAddressR\x0ebillingAddress\x12\"\n" +
+	"\fpaymentToken\x18\x03 \x01(\tR\fpaymentToken\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12;\n" +
+	"\x0etaxIdentifiers\x18\x05 \x03(\v2\x13.user.TaxIdentifierR\x0etaxIdentifiers\x12\x1a\n" +
+	"\bredacted\x18\x06 \x01(\bR\bredacted\x12$\n" +
+	"\rbillingUserId\x18\a \x01(\tR\rbillingUserId\"\xf7\x01\n" +
+	"\"UpdateBillingProfileMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12<\n" +
+	"\x0ebillingAddress\x18\x02 \x01(\v2\x14.user.BillingAddressR\x0ebillingAddress\x12\"\n" +
+	"\fpaymentToken\x18\x03 \x01(\tR\fpaymentToken\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12;\n" +
+	"\x0etaxIdentifiers\x18\x05 \x03(\v2\x13.user.TaxIdentifierR\x0etaxIdentifiers\"Y\n" +
+	"#UpdateBillingProfileMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"r\n" +
+	"\fDemographics\x12\x18\n" +
+	"\aageBand\x18\x01 \x01(\tR\aageBand\x12\x16\n" +
+	"\x06gender\x18\x02 \x01(\tR\x06gender\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\"7\n" +
+	"\x1dGetUserSegmentsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xcc\x01\n" +
+	"\x1eGetUserSegmentsMessageResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bsegments\x18\x02 \x03(\tR\bsegments\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04tier\x18\x04 \x01(\tR\x04tier\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x126\n" +
+	"\fdemographics\x18\x06 \x01(\v2\x12.user.DemographicsR\fdemographics\";\n" +
+	"\vPeriodCount\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"0\n" +
+	"\x1aGetUserStatsMessageRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"\xa4\x04\n" +
+	"\x1bGetUserStatsMessageResponse\x12A\n" +
+	"\x12dailyRegistrations\x18\x01 \x03(\v2\x11.user.PeriodCountR\x12dailyRegistrations\x12C\n" +
+	"\x13weeklyRegistrations\x18\x02 \x03(\v2\x11.user.PeriodCountR\x13weeklyRegistrations\x12.\n" +
+	"\x12totalRegistrations\x18\x03 \x01(\x03R\x12totalRegistrations\x124\n" +
+	"\x15verifiedRegistrations\x18\x04 \x01(\x03R\x15verifiedRegistrations\x12*\n" +
+	"\x10verificationRate\x18\x05 \x01(\x01R\x10verificationRate\x12*\n" +
+	"\x10dailyActiveUsers\x18\x06 \x01(\x03R\x10dailyActiveUsers\x12,\n" +
+	"\x11weeklyActiveUsers\x18\a \x01(\x03R\x11weeklyActiveUsers\x12.\n" +
+	"\x12monthlyActiveUsers\x18\b \x01(\x03R\x12monthlyActiveUsers\x129\n" +
+	"\x0edailyDeletions\x18\t \x03(\v2\x11.user.PeriodCountR\x0edailyDeletions\x12&\n" +
+	"\x0etotalDeletions\x18\n" +
+	" \x01(\x03R\x0etotalDeletions\"J\n" +
+	"\x1eWatchUserMetricsMessageRequest\x12(\n" +
+	"\x0fintervalSeconds\x18\x01 \x01(\x05R\x0fintervalSeconds\"\xbf\x01\n" +
+	"\x13UserMetricsSnapshot\x12$\n" +
+	"\rtimestampUnix\x18\x01 \x01(\x03R\rtimestampUnix\x12*\n" +
+	"\x10signupsPerMinute\x18\x02 \x01(\x03R\x10signupsPerMinute\x12(\n" +
+	"\x0floginsPerMinute\x18\x03 \x01(\x03R\x0floginsPerMinute\x12,\n" +
+	"\x11failuresPerMinute\x18\x04 \x01(\x03R\x11failuresPerMinute\"\x9d\x02\n" +
+	"\vOutboxEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vaggregateId\x18\x02 \x01(\tR\vaggregateId\x12$\n" +
+	"\raggregateType\x18\x03 \x01(\tR\raggregateType\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12$\n" +
+	"\rcreatedAtUnix\x18\x06 \x01(\x03R\rcreatedAtUnix\x12(\n" +
+	"\x0fpublishedAtUnix\x18\a \x01(\x03R\x0fpublishedAtUnix\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1c\n" +
+	"\tlastError\x18\t \x01(\tR\tlastError\"\xa7\x01\n" +
+	"\x11OutboxEventFilter\x12 \n" +
+	"\vaggregateId\x18\x01 \x01(\tR\vaggregateId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bfromUnix\x18\x03 \x01(\x03R\bfromUnix\x12\x16\n" +
+	"\x06toUnix\x18\x04 \x01(\x03R\x06toUnix\x12(\n" +
+	"\x0funpublishedOnly\x18\x05 \x01(\bR\x0funpublishedOnly\"g\n" +
+	"\x1eListOutboxEventsMessageRequest\x12/\n" +
+	"\x06filter\x18\x01 \x01(\v2\x17.user.OutboxEventFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"L\n" +
+	"\x1fListOutboxEventsMessageResponse\x12)\n" +
+	"\x06events\x18\x01 \x03(\v2\x11.user.OutboxEventR\x06events\"V\n" +
+	"#RepublishOutboxEventsMessageRequest\x12/\n" +
+	"\x06filter\x18\x01 \x01(\v2\x17.user.OutboxEventFilterR\x06filter\"B\n" +
+	"$RepublishOutboxEventsMessageResponse\x12\x1a\n" +
+	"\brequeued\x18\x01 \x01(\x03R\brequeued\"\x8d\x01\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x05event\x18\x02 \x01(\v2\x11.user.OutboxEventR\x05event\x12.\n" +
+	"\x12deadLetteredAtUnix\x18\x03 \x01(\x03R\x12deadLetteredAtUnix\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"k\n" +
+	"\x1dListDeadLettersMessageRequest\x12 \n" +
+	"\vaggregateId\x18\x01 \x01(\tR\vaggregateId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"T\n" +
+	"\x1eListDeadLettersMessageResponse\x122\n" +
+	"\vdeadLetters\x18\x01 \x03(\v2\x10.user.DeadLetterR\vdeadLetters\"1\n" +
+	"\x1fRequeueDeadLetterMessageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	" RequeueDeadLetterMessageResponse\x12\x18\n" +
+	"\aeventId\x18\x01 \x01(\tR\aeventId\"H\n" +
+	"\x16NotificationPreference\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\"\x82\x01\n" +
+	"(SetNotificationPreferencesMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12>\n" +
+	"\vpreferences\x18\x02 \x03(\v2\x1c.user.NotificationPreferenceR\vpreferences\"_\n" +
+	")SetNotificationPreferencesMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"O\n" +
+	"\x1fRegisterPushTokenMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"V\n" +
+	" RegisterPushTokenMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"E\n" +
+	"\x19VerifyEmailMessageRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"P\n" +
+	"\x1aVerifyEmailMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\">\n" +
+	"$RequestAccountDeletionMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"m\n" +
+	"%RequestAccountDeletionMessageResponse\x12*\n" +
+	"\x10scheduledForUnix\x18\x01 \x01(\x03R\x10scheduledForUnix\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"=\n" +
+	"#CancelAccountDeletionMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"Z\n" +
+	"$CancelAccountDeletionMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"T\n" +
+	"\"GenerateAccessReportMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\xa1\x01\n" +
+	"#GenerateAccessReportMessageResponse\x12\x1a\n" +
+	"\breportId\x18\x01 \x01(\tR\breportId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12 \n" +
+	"\vdownloadUrl\x18\x03 \x01(\tR\vdownloadUrl\x12$\n" +
+	"\rexpiresAtUnix\x18\x04 \x01(\x03R\rexpiresAtUnix\"~\n" +
+	"\x18SetConsentMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\x18\n" +
+	"\agranted\x18\x03 \x01(\bR\agranted\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"O\n" +
+	"\x19SetConsentMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"s\n" +
+	"%ExportComplianceRecordsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfromUnix\x18\x02 \x01(\x03R\bfromUnix\x12\x16\n" +
+	"\x06toUnix\x18\x03 \x01(\x03R\x06toUnix\"\x86\x02\n" +
+	"&ExportComplianceRecordsMessageResponse\x12\x1a\n" +
+	"\bexportId\x18\x01 \x01(\tR\bexportId\x12 \n" +
+	"\vdownloadUrl\x18\x02 \x01(\tR\vdownloadUrl\x12$\n" +
+	"\rexpiresAtUnix\x18\x03 \x01(\x03R\rexpiresAtUnix\x12 \n" +
+	"\vrecordCount\x18\x04 \x01(\x03R\vrecordCount\x12\x1a\n" +
+	"\bheadHash\x18\x05 \x01(\tR\bheadHash\x12\x1c\n" +
+	"\tsignature\x18\x06 \x01(\tR\tsignature\x12\x1c\n" +
+	"\tpublicKey\x18\a \x01(\tR\tpublicKey\"f\n" +
+	"\x1cIssueUserTokenMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06client\x18\x02 \x01(\tR\x06client\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\"\x7f\n" +
+	"\x1dIssueUserTokenMessageResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\"\xb1\x01\n" +
+	"\x1bValidateTokenMessageRequest\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\x16\n" +
+	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12\x1c\n" +
+	"\tuserAgent\x18\x03 \x01(\tR\tuserAgent\x12\x1e\n" +
+	"\n" +
+	"appVersion\x18\x04 \x01(\tR\n" +
+	"appVersion\x12\x1a\n" +
+	"\bclientIp\x18\x05 \x01(\tR\bclientIp\"\xd2\x01\n" +
+	"\x1cValidateTokenMessageResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06client\x18\x03 \x01(\tR\x06client\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x16\n" +
+	"\x06tenant\x18\x05 \x01(\tR\x06tenant\x12$\n" +
+	"\rexpiresAtUnix\x18\x06 \x01(\x03R\rexpiresAtUnix\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"u\n" +
+	"\x1fIssueServiceTokenMessageRequest\x12\x1a\n" +
+	"\baudience\x18\x01 \x01(\tR\baudience\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x1e\n" +
+	"\n" +
+	"ttlSeconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\"\x82\x01\n" +
+	" IssueServiceTokenMessageResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\"\\\n" +
+	"\x1cReAuthenticateMessageRequest\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x8b\x01\n" +
+	"\x1dReAuthenticateMessageResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\x12\"\n" +
+	"\fauthTimeUnix\x18\x03 \x01(\x03R\fauthTimeUnix\"\x8b\x01\n" +
+	"\x0fKYCDocumentInfo\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\fdocumentType\x18\x02 \x01(\tR\fdocumentType\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\x12 \n" +
+	"\vcontentType\x18\x04 \x01(\tR\vcontentType\"q\n" +
+	"\x1fUploadKYCDocumentMessageRequest\x12+\n" +
+	"\x04info\x18\x01 \x01(\v2\x15.user.KYCDocumentInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x9a\x01\n" +
+	" UploadKYCDocumentMessageResponse\x12\x1e\n" +
+	"\n" +
+	"documentId\x18\x01 \x01(\tR\n" +
+	"documentId\x12\"\n" +
+	"\fsellerStatus\x18\x02 \x01(\tR\fsellerStatus\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\"\xff\x01\n" +
+	"\vKYCDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\fdocumentType\x18\x02 \x01(\tR\fdocumentType\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\x12 \n" +
+	"\vcontentType\x18\x04 \x01(\tR\vcontentType\x12\x1c\n" +
+	"\tsizeBytes\x18\x05 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12&\n" +
+	"\x0euploadedAtUnix\x18\a \x01(\x03R\x0euploadedAtUnix\x12 \n" +
+	"\vdownloadUrl\x18\b \x01(\tR\vdownloadUrl\"\x9e\x01\n" +
+	"\rKYCReviewItem\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12(\n" +
+	"\x0fsubmittedAtUnix\x18\x03 \x01(\x03R\x0fsubmittedAtUnix\x12/\n" +
+	"\tdocuments\x18\x04 \x03(\v2\x11.user.KYCDocumentR\tdocuments\"8\n" +
+	" ListKYCReviewQueueMessageRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"N\n" +
+	"!ListKYCReviewQueueMessageResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.user.KYCReviewItemR\x05items\"N\n" +
+	"\x18ApproveKYCMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\breviewer\x18\x02 \x01(\tR\breviewer\"O\n" +
+	"\x19ApproveKYCMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"e\n" +
+	"\x17RejectKYCMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\breviewer\x18\x02 \x01(\tR\breviewer\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"N\n" +
+	"\x18RejectKYCMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"[\n" +
+	"'StartIdentityVerificationMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\"\x8a\x01\n" +
+	"(StartIdentityVerificationMessageResponse\x12\x1c\n" +
+	"\tsessionId\x18\x01 \x01(\tR\tsessionId\x12(\n" +
+	"\x0fverificationUrl\x18\x02 \x01(\tR\x0fverificationUrl\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"?\n" +
+	"%GetIdentityVerificationMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xc4\x01\n" +
+	"&GetIdentityVerificationMessageResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12$\n" +
+	"\rstartedAtUnix\x18\x03 \x01(\x03R\rstartedAtUnix\x12(\n" +
+	"\x0fcompletedAtUnix\x18\x04 \x01(\x03R\x0fcompletedAtUnix\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\";\n" +
+	"!VerifyPayoutAccountMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"V\n" +
+	"\"VerifyPayoutAccountMessageResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"=\n" +
+	"#GetPayoutVerificationMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xc4\x01\n" +
+	"$GetPayoutVerificationMessageResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12,\n" +
+	"\x11maskedAccountName\x18\x03 \x01(\tR\x11maskedAccountName\x12(\n" +
+	"\x0fcompletedAtUnix\x18\x04 \x01(\x03R\x0fcompletedAtUnix\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xa1\x02\n" +
+	"\x11WalletTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vamountMinor\x18\x03 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12,\n" +
+	"\x11balanceAfterMinor\x18\x05 \x01(\x03R\x11balanceAfterMinor\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1c\n" +
+	"\treference\x18\a \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12$\n" +
+	"\rcreatedAtUnix\x18\t \x01(\x03R\rcreatedAtUnix\"\xf2\x01\n" +
+	"\x1aCreditWalletMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vamountMinor\x18\x02 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12&\n" +
+	"\x0eidempotencyKey\x18\a \x01(\tR\x0eidempotencyKey\"\x98\x01\n" +
+	"\x1bCreditWalletMessageResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.user.WalletTransactionR\vtransaction\x12\"\n" +
+	"\fbalanceMinor\x18\x02 \x01(\x03R\fbalanceMinor\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\"\xf1\x01\n" +
+	"\x19DebitWalletMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vamountMinor\x18\x02 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12&\n" +
+	"\x0eidempotencyKey\x18\a \x01(\tR\x0eidempotencyKey\"\x97\x01\n" +
+	"\x1aDebitWalletMessageResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.user.WalletTransactionR\vtransaction\x12\"\n" +
+	"\fbalanceMinor\x18\x02 \x01(\x03R\fbalanceMinor\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\"G\n" +
+	"\x17GetWalletMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x97\x01\n" +
+	"\x18GetWalletMessageResponse\x12\"\n" +
+	"\fbalanceMinor\x18\x01 \x01(\x03R\fbalanceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12;\n" +
+	"\ftransactions\x18\x03 \x03(\v2\x17.user.WalletTransactionR\ftransactions\"\x94\x02\n" +
+	"\bGiftCard\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"maskedCode\x18\x02 \x01(\tR\n" +
+	"maskedCode\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\"\n" +
+	"\fbalanceMinor\x18\x04 \x01(\x03R\fbalanceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12$\n" +
+	"\rexpiresAtUnix\x18\x06 \x01(\x03R\rexpiresAtUnix\x12&\n" +
+	"\x0eattachedAtUnix\x18\a \x01(\x03R\x0eattachedAtUnix\x122\n" +
+	"\x14balanceCheckedAtUnix\x18\b \x01(\x03R\x14balanceCheckedAtUnix\"J\n" +
+	"\x1cAttachGiftCardMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"K\n" +
+	"\x1dAttachGiftCardMessageResponse\x12*\n" +
+	"\bgiftCard\x18\x01 \x01(\v2\x0e.user.GiftCardR\bgiftCard\"5\n" +
+	"\x1bListGiftCardsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"L\n" +
+	"\x1cListGiftCardsMessageResponse\x12,\n" +
+	"\tgiftCards\x18\x01 \x03(\v2\x0e.user.GiftCardR\tgiftCards\"Z\n" +
+	" GetGiftCardBalanceMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\n" +
+	"giftCardId\x18\x02 \x01(\tR\n" +
+	"giftCardId\"O\n" +
+	"!GetGiftCardBalanceMessageResponse\x12*\n" +
+	"\bgiftCard\x18\x01 \x01(\v2\x0e.user.GiftCardR\bgiftCard\"\xce\x02\n" +
+	"\x06Coupon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1e\n" +
+	"\n" +
+	"percentOff\x18\x04 \x01(\x05R\n" +
+	"percentOff\x12&\n" +
+	"\x0eamountOffMinor\x18\x05 \x01(\x03R\x0eamountOffMinor\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12$\n" +
+	"\rminOrderMinor\x18\a \x01(\x03R\rminOrderMinor\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12$\n" +
+	"\rexpiresAtUnix\x18\t \x01(\x03R\rexpiresAtUnix\x12\x18\n" +
+	"\aorderId\x18\n" +
+	" \x01(\tR\aorderId\x12&\n" +
+	"\x0eredeemedAtUnix\x18\v \x01(\x03R\x0eredeemedAtUnix\"\xf3\x01\n" +
+	"\x19GrantCouponMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1e\n" +
+	"\n" +
+	"percentOff\x18\x03 \x01(\x05R\n" +
+	"percentOff\x12&\n" +
+	"\x0eamountOffMinor\x18\x04 \x01(\x03R\x0eamountOffMinor\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12$\n" +
+	"\rminOrderMinor\x18\x06 \x01(\x03R\rminOrderMinor\x12\x1c\n" +
+	"\tvalidDays\x18\a \x01(\x05R\tvalidDays\"B\n" +
+	"\x1aGrantCouponMessageResponse\x12$\n" +
+	"\x06coupon\x18\x01 \x01(\v2\f.user.CouponR\x06coupon\"3\n" +
+	"\x19ListCouponsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"D\n" +
+	"\x1aListCouponsMessageResponse\x12&\n" +
+	"\acoupons\x18\x01 \x03(\v2\f.user.CouponR\acoupons\"c\n" +
+	"\x1bReserveCouponMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\aorderId\x18\x03 \x01(\tR\aorderId\"D\n" +
+	"\x1cReserveCouponMessageResponse\x12$\n" +
+	"\x06coupon\x18\x01 \x01(\v2\f.user.CouponR\x06coupon\"b\n" +
+	"\x1aRedeemCouponMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\aorderId\x18\x03 \x01(\tR\aorderId\"C\n" +
+	"\x1bRedeemCouponMessageResponse\x12$\n" +
+	"\x06coupon\x18\x01 \x01(\v2\f.user.CouponR\x06coupon\"c\n" +
+	"\x1bReleaseCouponMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\aorderId\x18\x03 \x01(\tR\aorderId\"D\n" +
+	"\x1cReleaseCouponMessageResponse\x12$\n" +
+	"\x06coupon\x18\x01 \x01(\v2\f.user.CouponR\x06coupon\"O\n" +
+	"\x19SetTimezoneMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"P\n" +
+	"\x1aSetTimezoneMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"\x98\x01\n" +
+	"\x1cSubmitFeedbackMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x18\n" +
+	"\aorderId\x18\x05 \x01(\tR\aorderId\"S\n" +
+	"\x1dSubmitFeedbackMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"N\n" +
+	" GetFeedbackSummaryMessageRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"\x81\x02\n" +
+	"!GetFeedbackSummaryMessageResponse\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1c\n" +
+	"\tresponses\x18\x02 \x01(\x03R\tresponses\x12\x1c\n" +
+	"\tpromoters\x18\x03 \x01(\x03R\tpromoters\x12\x1a\n" +
+	"\bpassives\x18\x04 \x01(\x03R\bpassives\x12\x1e\n" +
+	"\n" +
+	"detractors\x18\x05 \x01(\x03R\n" +
+	"detractors\x12\x10\n" +
+	"\x03nps\x18\x06 \x01(\x01R\x03nps\x12\"\n" +
+	"\faverageScore\x18\a \x01(\x01R\faverageScore\x12\x1a\n" +
+	"\bcomments\x18\b \x01(\x03R\bcomments\"\xe5\x01\n" +
+	"\rSupportTicket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06system\x18\x02 \x01(\tR\x06system\x12\x1e\n" +
+	"\n" +
+	"externalId\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\"\n" +
+	"\flinkedAtUnix\x18\a \x01(\x03R\flinkedAtUnix\x12$\n" +
+	"\rupdatedAtUnix\x18\b \x01(\x03R\rupdatedAtUnix\"\xc7\x02\n" +
+	"\x0fSupportCustomer\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x03 \x01(\tR\buserName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12 \n" +
+	"\vphoneNumber\x18\x05 \x01(\tR\vphoneNumber\x12\x12\n" +
+	"\x04tier\x18\x06 \x01(\tR\x04tier\x12$\n" +
+	"\remailVerified\x18\a \x01(\bR\remailVerified\x12\"\n" +
+	"\fsellerStatus\x18\b \x01(\tR\fsellerStatus\x12$\n" +
+	"\rcreatedAtUnix\x18\t \x01(\x03R\rcreatedAtUnix\x12(\n" +
+	"\x0flastLoginAtUnix\x18\n" +
+	" \x01(\x03R\x0flastLoginAtUnix\"\xae\x01\n" +
+	"\x18LinkTicketMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06system\x18\x02 \x01(\tR\x06system\x12\x1e\n" +
+	"\n" +
+	"externalId\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\"H\n" +
+	"\x19LinkTicketMessageResponse\x12+\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x13.user.SupportTicketR\x06ticket\"K\n" +
+	"\x19ListTicketsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"~\n" +
+	"\x1aListTicketsMessageResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.user.SupportCustomerR\bcustomer\x12-\n" +
+	"\atickets\x18\x02 \x03(\v2\x13.user.SupportTicketR\atickets\"N\n" +
+	"\x1cUpdatePresenceMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"9\n" +
+	"\x1dUpdatePresenceMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"z\n" +
+	"\fUserPresence\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\bR\x06online\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\"\n" +
+	"\flastSeenUnix\x18\x04 \x01(\x03R\flastSeenUnix\"5\n" +
+	"\x19GetPresenceMessageRequest\x12\x18\n" +
+	"\auserIds\x18\x01 \x03(\tR\auserIds\"N\n" +
+	"\x1aGetPresenceMessageResponse\x120\n" +
+	"\tpresences\x18\x01 \x03(\v2\x12.user.UserPresenceR\tpresences\"H\n" +
+	"\x1aSuggestUsersMessageRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x84\x01\n" +
+	"\x0eUserSuggestion\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x03 \x01(\tR\buserName\x12\"\n" +
+	"\femailAddress\x18\x04 \x01(\tR\femailAddress\"U\n" +
+	"\x1bSuggestUsersMessageResponse\x126\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x14.user.UserSuggestionR\vsuggestions\"q\n" +
+	"%ListDuplicateCandidatesMessageRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1a\n" +
+	"\bminScore\x18\x02 \x01(\x01R\bminScore\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xcb\x01\n" +
+	"\rDuplicateUser\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x03 \x01(\tR\buserName\x12\"\n" +
+	"\femailAddress\x18\x04 \x01(\tR\femailAddress\x12 \n" +
+	"\vphoneNumber\x18\x05 \x01(\tR\vphoneNumber\x12$\n" +
+	"\rcreatedAtUnix\x18\x06 \x01(\x03R\rcreatedAtUnix\"\xea\x01\n" +
+	"\x12DuplicateCandidate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x05userA\x18\x02 \x01(\v2\x13.user.DuplicateUserR\x05userA\x12)\n" +
+	"\x05userB\x18\x03 \x01(\v2\x13.user.DuplicateUserR\x05userB\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\x05 \x03(\tR\areasons\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12&\n" +
+	"\x0edetectedAtUnix\x18\a \x01(\x03R\x0edetectedAtUnix\"b\n" +
+	"&ListDuplicateCandidatesMessageResponse\x128\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x18.user.DuplicateCandidateR\n" +
+	"candidates\"q\n" +
+	"'ResolveDuplicateCandidateMessageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\x12\x16\n" +
+	"\x06dryRun\x18\x03 \x01(\bR\x06dryRun\"\x84\x01\n" +
+	"(ResolveDuplicateCandidateMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12$\n" +
+	"\x04diff\x18\x03 \x01(\v2\x10.user.DryRunDiffR\x04diff\"\xf3\x03\n" +
+	"\tOperation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x129\n" +
+	"\bprogress\x18\x05 \x03(\v2\x1d.user.Operation.ProgressEntryR\bprogress\x123\n" +
+	"\x06result\x18\x06 \x03(\v2\x1b.user.Operation.ResultEntryR\x06result\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12 \n" +
+	"\vrequestedBy\x18\b \x01(\tR\vrequestedBy\x12$\n" +
+	"\rcreatedAtUnix\x18\t \x01(\x03R\rcreatedAtUnix\x12$\n" +
+	"\rstartedAtUnix\x18\n" +
+	" \x01(\x03R\rstartedAtUnix\x12&\n" +
+	"\x0efinishedAtUnix\x18\v \x01(\x03R\x0efinishedAtUnix\x1a;\n" +
+	"\rProgressEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a9\n" +
+	"\vResultEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x1aGetOperationMessageRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x1bGetOperationMessageResponse\x12-\n" +
+	"\toperation\x18\x01 \x01(\v2\x0f.user.OperationR\toperation\"\x84\x01\n" +
+	"\x1cListOperationsMessageRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x04 \x01(\tR\tpageToken\"v\n" +
+	"\x1dListOperationsMessageResponse\x12/\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\x0f.user.OperationR\n" +
+	"operations\x12$\n" +
+	"\rnextPageToken\x18\x02 \x01(\tR\rnextPageToken\"3\n" +
+	"\x1dCancelOperationMessageRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"T\n" +
+	"\x1eCancelOperationMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"q\n" +
+	"#StartComplianceExportMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfromUnix\x18\x02 \x01(\x03R\bfromUnix\x12\x16\n" +
+	"\x06toUnix\x18\x03 \x01(\x03R\x06toUnix\"U\n" +
+	"$StartComplianceExportMessageResponse\x12-\n" +
+	"\toperation\x18\x01 \x01(\v2\x0f.user.OperationR\toperation\"h\n" +
+	"\x1eStartUserErasureMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06dryRun\x18\x03 \x01(\bR\x06dryRun\"v\n" +
+	"\x1fStartUserErasureMessageResponse\x12-\n" +
+	"\toperation\x18\x01 \x01(\v2\x0f.user.OperationR\toperation\x12$\n" +
+	"\x04diff\x18\x02 \x01(\v2\x10.user.DryRunDiffR\x04diff\"\x8f\x01\n" +
+	"\x1dStartUserImportMessageRequest\x12\x1c\n" +
+	"\tsourceKey\x18\x01 \x01(\tR\tsourceKey\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12 \n" +
+	"\vmappingJson\x18\x03 \x01(\tR\vmappingJson\x12\x16\n" +
+	"\x06dryRun\x18\x04 \x01(\bR\x06dryRun\"O\n" +
+	"\x1eStartUserImportMessageResponse\x12-\n" +
+	"\toperation\x18\x01 \x01(\v2\x0f.user.OperationR\toperation\"\xc0\x02\n" +
+	"\x0eBulkUserFilter\x12\x18\n" +
+	"\auserIds\x18\x01 \x03(\tR\auserIds\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05tiers\x18\x03 \x03(\tR\x05tiers\x12\x1c\n" +
+	"\tcountries\x18\x04 \x03(\tR\tcountries\x12\x18\n" +
+	"\alocales\x18\x05 \x03(\tR\alocales\x12&\n" +
+	"\x0esellerStatuses\x18\x06 \x03(\tR\x0esellerStatuses\x12*\n" +
+	"\x10createdAfterUnix\x18\a \x01(\x03R\x10createdAfterUnix\x12,\n" +
+	"\x11createdBeforeUnix\x18\b \x01(\x03R\x11createdBeforeUnix\x120\n" +
+	"\x13lastLoginBeforeUnix\x18\t \x01(\x03R\x13lastLoginBeforeUnix\"u\n" +
+	"\rBulkUserPatch\x12\x18\n" +
+	"\aaddTags\x18\x01 \x03(\tR\aaddTags\x12\x1e\n" +
+	"\n" +
+	"removeTags\x18\x02 \x03(\tR\n" +
+	"removeTags\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\tR\x04tier\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\"\xc8\x01\n" +
+	"\x1dBulkUpdateUsersMessageRequest\x12,\n" +
+	"\x06filter\x18\x01 \x01(\v2\x14.user.BulkUserFilterR\x06filter\x12)\n" +
+	"\x05patch\x18\x02 \x01(\v2\x13.user.BulkUserPatchR\x05patch\x12\x1e\n" +
+	"\n" +
+	"updateMask\x18\x03 \x03(\tR\n" +
+	"updateMask\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06dryRun\x18\x05 \x01(\bR\x06dryRun\"u\n" +
+	"\x1eBulkUpdateUsersMessageResponse\x12-\n" +
+	"\toperation\x18\x01 \x01(\v2\x0f.user.OperationR\toperation\x12$\n" +
+	"\x04diff\x18\x02 \x01(\v2\x10.user.DryRunDiffR\x04diff\"\x1d\n" +
+	"\x1bGetServerInfoMessageRequest\"\xc4\x01\n" +
+	"\x1cGetServerInfoMessageResponse\x12\x16\n" +
+	"\x06gitSha\x18\x01 \x01(\tR\x06gitSha\x12$\n" +
+	"\rbuildTimeUnix\x18\x02 \x01(\x03R\rbuildTimeUnix\x12\"\n" +
+	"\fprotoVersion\x18\x03 \x01(\tR\fprotoVersion\x12\x1c\n" +
+	"\tgoVersion\x18\x04 \x01(\tR\tgoVersion\x12$\n" +
+	"\rstartedAtUnix\x18\x05 \x01(\x03R\rstartedAtUnix\"4\n" +
+	"\x1aGetSLOStatusMessageRequest\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\"\xef\x01\n" +
+	"\tSLOWindow\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12\"\n" +
+	"\favailability\x18\x03 \x01(\x01R\favailability\x12,\n" +
+	"\x11latencyCompliance\x18\x04 \x01(\x01R\x11latencyCompliance\x122\n" +
+	"\x14availabilityBurnRate\x18\x05 \x01(\x01R\x14availabilityBurnRate\x12(\n" +
+	"\x0flatencyBurnRate\x18\x06 \x01(\x01R\x0flatencyBurnRate\"\x84\x01\n" +
+	"\x0fMethodSLOStatus\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12.\n" +
+	"\x12latencyThresholdMs\x18\x02 \x01(\x03R\x12latencyThresholdMs\x12)\n" +
+	"\awindows\x18\x03 \x03(\v2\x0f.user.SLOWindowR\awindows\"\xce\x01\n" +
+	"\x1bGetSLOStatusMessageResponse\x124\n" +
+	"\x15availabilityObjective\x18\x01 \x01(\x01R\x15availabilityObjective\x12*\n" +
+	"\x10latencyObjective\x18\x02 \x01(\x01R\x10latencyObjective\x12/\n" +
+	"\amethods\x18\x03 \x03(\v2\x15.user.MethodSLOStatusR\amethods\x12\x1c\n" +
+	"\tsinceUnix\x18\x04 \x01(\x03R\tsinceUnix\"r\n" +
+	"\x16SubAccountRestrictions\x12$\n" +
+	"\rsharedPayment\x18\x01 \x01(\bR\rsharedPayment\x122\n" +
+	"\x14restrictedCategories\x18\x02 \x03(\tR\x14restrictedCategories\"\xca\x02\n" +
+	"\n" +
+	"SubAccount\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\fparentUserId\x18\x02 \x01(\tR\fparentUserId\x12\x1a\n" +
+	"\bfullName\x18\x03 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x04 \x01(\tR\buserName\x12\"\n" +
+	"\frelationship\x18\x05 \x01(\tR\frelationship\x12@\n" +
+	"\frestrictions\x18\x06 \x01(\v2\x1c.user.SubAccountRestrictionsR\frestrictions\x12$\n" +
+	"\rcreatedAtUnix\x18\a \x01(\x03R\rcreatedAtUnix\x12<\n" +
+	"\x19restrictionsUpdatedAtUnix\x18\b \x01(\x03R\x19restrictionsUpdatedAtUnix\"\xc4\x02\n" +
+	"\x1eCreateSubAccountMessageRequest\x12\"\n" +
+	"\fparentUserId\x18\x01 \x01(\tR\fparentUserId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x03 \x01(\tR\buserName\x12\"\n" +
+	"\femailAddress\x18\x04 \x01(\tR\femailAddress\x12 \n" +
+	"\vphoneNumber\x18\x05 \x01(\tR\vphoneNumber\x12\x1a\n" +
+	"\bpassword\x18\x06 \x01(\tR\bpassword\x12\"\n" +
+	"\frelationship\x18\a \x01(\tR\frelationship\x12@\n" +
+	"\frestrictions\x18\b \x01(\v2\x1c.user.SubAccountRestrictionsR\frestrictions\"\x87\x01\n" +
+	"\x1fCreateSubAccountMessageResponse\x120\n" +
+	"\n" +
+	"subAccount\x18\x01 \x01(\v2\x10.user.SubAccountR\n" +
+	"subAccount\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"C\n" +
+	"\x1dListSubAccountsMessageRequest\x12\"\n" +
+	"\fparentUserId\x18\x01 \x01(\tR\fparentUserId\"T\n" +
+	"\x1eListSubAccountsMessageResponse\x122\n" +
+	"\vsubAccounts\x18\x01 \x03(\v2\x10.user.SubAccountR\vsubAccounts\"\xb3\x01\n" +
+	"'SetSubAccountRestrictionsMessageRequest\x12\"\n" +
+	"\fparentUserId\x18\x01 \x01(\tR\fparentUserId\x12\"\n" +
+	"\fsubAccountId\x18\x02 \x01(\tR\fsubAccountId\x12@\n" +
+	"\frestrictions\x18\x03 \x01(\v2\x1c.user.SubAccountRestrictionsR\frestrictions\"\x90\x01\n" +
+	"(SetSubAccountRestrictionsMessageResponse\x120\n" +
+	"\n" +
+	"subAccount\x18\x01 \x01(\v2\x10.user.SubAccountR\n" +
+	"subAccount\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"\xa6\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\fbillingEmail\x18\x03 \x01(\tR\fbillingEmail\x12(\n" +
+	"\x0fcreatedByUserId\x18\x04 \x01(\tR\x0fcreatedByUserId\x12$\n" +
+	"\rcreatedAtUnix\x18\x05 \x01(\x03R\rcreatedAtUnix\"\xfb\x01\n" +
+	"\tOrgMember\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x03 \x01(\tR\buserName\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12(\n" +
+	"\x0finvitedByUserId\x18\x06 \x01(\tR\x0finvitedByUserId\x12$\n" +
+	"\rinvitedAtUnix\x18\a \x01(\x03R\rinvitedAtUnix\x12\"\n" +
+	"\fjoinedAtUnix\x18\b \x01(\x03R\fjoinedAtUnix\"s\n" +
+	"\rOrgMembership\x126\n" +
+	"\forganization\x18\x01 \x01(\v2\x12.user.OrganizationR\forganization\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"r\n" +
+	" CreateOrganizationMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\fbillingEmail\x18\x03 \x01(\tR\fbillingEmail\"\x8f\x01\n" +
+	"!CreateOrganizationMessageResponse\x126\n" +
+	"\forganization\x18\x01 \x01(\v2\x12.user.OrganizationR\forganization\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"\x85\x01\n" +
+	"\x1dInviteOrgMemberMessageRequest\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\femailAddress\x18\x03 \x01(\tR\femailAddress\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"}\n" +
+	"\x1eInviteOrgMemberMessageResponse\x12'\n" +
+	"\x06member\x18\x01 \x01(\v2\x0f.user.OrgMemberR\x06member\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"M\n" +
+	"\x1dAcceptOrgInviteMessageRequest\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\"}\n" +
+	"\x1eAcceptOrgInviteMessageResponse\x12'\n" +
+	"\x06member\x18\x01 \x01(\v2\x0f.user.OrgMemberR\x06member\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"\x86\x01\n" +
+	"\x1eSetOrgMemberRoleMessageRequest\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\fmemberUserId\x18\x03 \x01(\tR\fmemberUserId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"~\n" +
+	"\x1fSetOrgMemberRoleMessageResponse\x12'\n" +
+	"\x06member\x18\x01 \x01(\v2\x0f.user.OrgMemberR\x06member\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"q\n" +
+	"\x1dRemoveOrgMemberMessageRequest\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\fmemberUserId\x18\x03 \x01(\tR\fmemberUserId\"T\n" +
+	"\x1eRemoveOrgMemberMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"L\n" +
+	"\x1cListOrgMembersMessageRequest\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x1dListOrgMembersMessageResponse\x12)\n" +
+	"\amembers\x18\x01 \x03(\v2\x0f.user.OrgMemberR\amembers\"=\n" +
+	"#ListUserOrganizationsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"]\n" +
+	"$ListUserOrganizationsMessageResponse\x125\n" +
+	"\vmemberships\x18\x01 \x03(\v2\x13.user.OrgMembershipR\vmemberships\"\xcc\x02\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05orgId\x18\x03 \x01(\tR\x05orgId\x12\x18\n" +
+	"\aorgName\x18\x04 \x01(\tR\aorgName\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12$\n" +
+	"\rinviterUserId\x18\x06 \x01(\tR\rinviterUserId\x12 \n" +
+	"\vinviterName\x18\a \x01(\tR\vinviterName\x12\"\n" +
+	"\femailAddress\x18\b \x01(\tR\femailAddress\x12\x1a\n" +
+	"\bfullName\x18\t \x01(\tR\bfullName\x12$\n" +
+	"\rexpiresAtUnix\x18\n" +
+	" \x01(\x03R\rexpiresAtUnix\x12\x18\n" +
+	"\amaxUses\x18\v \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\f \x01(\x05R\x04uses\"\xec\x01\n" +
+	"\x1aCreateInviteMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05orgId\x18\x03 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\"\n" +
+	"\femailAddress\x18\x05 \x01(\tR\femailAddress\x12\x1a\n" +
+	"\bfullName\x18\x06 \x01(\tR\bfullName\x12\x18\n" +
+	"\amaxUses\x18\a \x01(\x05R\amaxUses\x12\x1e\n" +
+	"\n" +
+	"ttlSeconds\x18\b \x01(\x03R\n" +
+	"ttlSeconds\"\x9f\x01\n" +
+	"\x1bCreateInviteMessageResponse\x12$\n" +
+	"\x06invite\x18\x01 \x01(\v2\f.user.InviteR\x06invite\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\"/\n" +
+	"\x17GetInviteMessageRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"@\n" +
+	"\x18GetInviteMessageResponse\x12$\n" +
+	"\x06invite\x18\x01 \x01(\v2\f.user.InviteR\x06invite\"J\n" +
+	"\x1aAcceptInviteMessageRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\"w\n" +
+	"\x1bAcceptInviteMessageResponse\x12$\n" +
+	"\x06invite\x18\x01 \x01(\v2\f.user.InviteR\x06invite\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"\xa1\x02\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x128\n" +
+	"\afilters\x18\x04 \x03(\v2\x1e.user.SavedSearch.FiltersEntryR\afilters\x12\x16\n" +
+	"\x06notify\x18\x05 \x01(\bR\x06notify\x12$\n" +
+	"\rcreatedAtUnix\x18\x06 \x01(\x03R\rcreatedAtUnix\x12$\n" +
+	"\rupdatedAtUnix\x18\a \x01(\x03R\rupdatedAtUnix\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x02\n" +
+	"\x18SaveSearchMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bsearchId\x18\x02 \x01(\tR\bsearchId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12E\n" +
+	"\afilters\x18\x05 \x03(\v2+.user.SaveSearchMessageRequest.FiltersEntryR\afilters\x12\x16\n" +
+	"\x06notify\x18\x06 \x01(\bR\x06notify\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x01\n" +
+	"\x19SaveSearchMessageResponse\x123\n" +
+	"\vsavedSearch\x18\x01 \x01(\v2\x11.user.SavedSearchR\vsavedSearch\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"9\n" +
+	"\x1fListSavedSearchesMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"[\n" +
+	" ListSavedSearchesMessageResponse\x127\n" +
+	"\rsavedSearches\x18\x01 \x03(\v2\x11.user.SavedSearchR\rsavedSearches\"U\n" +
+	"\x1fDeleteSavedSearchMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bsearchId\x18\x02 \x01(\tR\bsearchId\"V\n" +
+	" DeleteSavedSearchMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"\xbe\x01\n" +
+	"\fProductAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tproductId\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12*\n" +
+	"\x10targetPriceMinor\x18\x04 \x01(\x03R\x10targetPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12$\n" +
+	"\rcreatedAtUnix\x18\x06 \x01(\x03R\rcreatedAtUnix\"\xb7\x01\n" +
+	"#SubscribeProductAlertMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tproductId\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12*\n" +
+	"\x10targetPriceMinor\x18\x04 \x01(\x03R\x10targetPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\x84\x01\n" +
+	"$SubscribeProductAlertMessageResponse\x12(\n" +
+	"\x05alert\x18\x01 \x01(\v2\x12.user.ProductAlertR\x05alert\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"9\n" +
+	"\x1fListProductAlertsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"N\n" +
+	" ListProductAlertsMessageResponse\x12*\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x12.user.ProductAlertR\x06alerts\"T\n" +
+	" DeleteProductAlertMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aalertId\x18\x02 \x01(\tR\aalertId\"W\n" +
+	"!DeleteProductAlertMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"W\n" +
+	"\x1fRecordProductViewMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tproductId\x18\x02 \x01(\tR\tproductId\"<\n" +
+	" RecordProductViewMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\rViewedProduct\x12\x1c\n" +
+	"\tproductId\x18\x01 \x01(\tR\tproductId\x12\"\n" +
+	"\fviewedAtUnix\x18\x02 \x01(\x03R\fviewedAtUnix\"O\n" +
+	"\x1fGetRecentlyViewedMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"S\n" +
+	" GetRecentlyViewedMessageResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.user.ViewedProductR\bproducts\"[\n" +
+	"\x1fUpdateDisplayNameMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vdisplayName\x18\x02 \x01(\tR\vdisplayName\"\x82\x01\n" +
+	" UpdateDisplayNameMessageResponse\x12*\n" +
+	"\x10moderationStatus\x18\x01 \x01(\tR\x10moderationStatus\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"F\n" +
+	"\n" +
+	"AvatarInfo\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vcontentType\x18\x02 \x01(\tR\vcontentType\"g\n" +
+	"\x1aUploadAvatarMessageRequest\x12&\n" +
+	"\x04info\x18\x01 \x01(\v2\x10.user.AvatarInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"}\n" +
+	"\x1bUploadAvatarMessageResponse\x12*\n" +
+	"\x10moderationStatus\x18\x01 \x01(\tR\x10moderationStatus\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"~\n" +
+	"\x0eModerationItem\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12(\n" +
+	"\x0fsubmittedAtUnix\x18\x04 \x01(\x03R\x0fsubmittedAtUnix\"O\n" +
+	"!ListModerationQueueMessageRequest\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"P\n" +
+	"\"ListModerationQueueMessageResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.user.ModerationItemR\x05items\"\x9c\x01\n" +
+	"\x1eReviewModerationMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x1a\n" +
+	"\breviewer\x18\x04 \x01(\tR\breviewer\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"U\n" +
+	"\x1fReviewModerationMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"T\n" +
+	"\x1eGetPublicProfileMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bviewerId\x18\x02 \x01(\tR\bviewerId\"\xad\x01\n" +
+	"\x1fGetPublicProfileMessageResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\buserName\x18\x02 \x01(\tR\buserName\x12 \n" +
+	"\vdisplayName\x18\x03 \x01(\tR\vdisplayName\x12\x1c\n" +
+	"\tavatarUrl\x18\x04 \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06hidden\x18\x05 \x01(\bR\x06hidden\"\x9b\x01\n" +
+	"\rPublicProfile\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\buserName\x18\x02 \x01(\tR\buserName\x12 \n" +
+	"\vdisplayName\x18\x03 \x01(\tR\vdisplayName\x12\x1c\n" +
+	"\tavatarUrl\x18\x04 \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06hidden\x18\x05 \x01(\bR\x06hidden\"W\n" +
+	"\x1fGetPublicProfilesMessageRequest\x12\x18\n" +
+	"\auserIds\x18\x01 \x03(\tR\auserIds\x12\x1a\n" +
+	"\bviewerId\x18\x02 \x01(\tR\bviewerId\"S\n" +
+	" GetPublicProfilesMessageResponse\x12/\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x13.user.PublicProfileR\bprofiles\"\x82\x01\n" +
+	"\x1aSetShadowBanMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06banned\x18\x02 \x01(\bR\x06banned\x12\x1c\n" +
+	"\tmoderator\x18\x03 \x01(\tR\tmoderator\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"Q\n" +
+	"\x1bSetShadowBanMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"6\n" +
+	"\x1cGetUserProfileMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xaf\x04\n" +
+	"\x1dGetUserProfileMessageResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x03 \x01(\tR\buserName\x12\"\n" +
+	"\femailAddress\x18\x04 \x01(\tR\femailAddress\x12 \n" +
+	"\vphoneNumber\x18\x05 \x01(\tR\vphoneNumber\x12$\n" +
+	"\remailVerified\x18\x06 \x01(\bR\remailVerified\x12 \n" +
+	"\vemailStatus\x18\a \x01(\tR\vemailStatus\x12,\n" +
+	"\x11emailStatusReason\x18\b \x01(\tR\x11emailStatusReason\x12$\n" +
+	"\rcreatedAtUnix\x18\t \x01(\x03R\rcreatedAtUnix\x12$\n" +
+	"\rphoneVerified\x18\n" +
+	" \x01(\bR\rphoneVerified\x12&\n" +
+	"\x0ephoneReachable\x18\v \x01(\bR\x0ephoneReachable\x12,\n" +
+	"\x11completenessScore\x18\f \x01(\x05R\x11completenessScore\x12$\n" +
+	"\rmissingFields\x18\r \x03(\tR\rmissingFields\x12\x1c\n" +
+	"\tavatarUrl\x18\x0e \x01(\tR\tavatarUrl\x12\x1c\n" +
+	"\tphoneType\x18\x0f \x01(\tR\tphoneType\"=\n" +
+	"#SendPhoneVerificationMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\x9a\x01\n" +
+	"$SendPhoneVerificationMessageResponse\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\"G\n" +
+	"\x19VerifyPhoneMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"P\n" +
+	"\x1aVerifyPhoneMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"L\n" +
+	"\x10DigestPreference\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1c\n" +
+	"\tfrequency\x18\x02 \x01(\tR\tfrequency\"v\n" +
+	"\"SetDigestPreferencesMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x128\n" +
+	"\vpreferences\x18\x02 \x03(\v2\x16.user.DigestPreferenceR\vpreferences\"Y\n" +
+	"#SetDigestPreferencesMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"<\n" +
+	"\"GetDigestPreferencesMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xc9\x01\n" +
+	"#GetDigestPreferencesMessageResponse\x128\n" +
+	"\vpreferences\x18\x01 \x03(\v2\x16.user.DigestPreferenceR\vpreferences\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12$\n" +
+	"\rnextDailyUnix\x18\x03 \x01(\x03R\rnextDailyUnix\x12&\n" +
+	"\x0enextWeeklyUnix\x18\x04 \x01(\x03R\x0enextWeeklyUnix\"\x9b\x01\n" +
+	"\tDueDigest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tfrequency\x18\x02 \x01(\tR\tfrequency\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\tR\n" +
+	"categories\x12\x1c\n" +
+	"\tdueAtUnix\x18\x04 \x01(\x03R\tdueAtUnix\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"3\n" +
+	"\x1bGetDueDigestsMessageRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"I\n" +
+	"\x1cGetDueDigestsMessageResponse\x12)\n" +
+	"\adigests\x18\x01 \x03(\v2\x0f.user.DueDigestR\adigests\"x\n" +
+	"\x14ExperimentAssignment\x12\x1e\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\tR\n" +
+	"experiment\x12\x18\n" +
+	"\avariant\x18\x02 \x01(\tR\avariant\x12&\n" +
+	"\x0eassignedAtUnix\x18\x03 \x01(\x03R\x0eassignedAtUnix\"X\n" +
+	"\x1cGetAssignmentsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vexperiments\x18\x02 \x03(\tR\vexperiments\"\xdd\x01\n" +
+	"\x1dGetAssignmentsMessageResponse\x12<\n" +
+	"\vassignments\x18\x01 \x03(\v2\x1a.user.ExperimentAssignmentR\vassignments\x12D\n" +
+	"\x05flags\x18\x02 \x03(\v2..user.GetAssignmentsMessageResponse.FlagsEntryR\x05flags\x1a8\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"9\n" +
+	"\x1fGetSecurityStatusMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"g\n" +
+	"\rSecurityIssue\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12&\n" +
+	"\x0erecommendation\x18\x02 \x01(\tR\x0erecommendation\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\"\xef\x01\n" +
+	" GetSecurityStatusMessageResponse\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x12+\n" +
+	"\x06issues\x18\x02 \x03(\v2\x13.user.SecurityIssueR\x06issues\x12*\n" +
+	"\x10twoFactorEnabled\x18\x03 \x01(\bR\x10twoFactorEnabled\x124\n" +
+	"\x15passwordChangedAtUnix\x18\x04 \x01(\x03R\x15passwordChangedAtUnix\x12&\n" +
+	"\x0elastSignInRisk\x18\x05 \x01(\tR\x0elastSignInRisk\"p\n" +
+	"\"ExportSecurityEventsMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfromUnix\x18\x02 \x01(\x03R\bfromUnix\x12\x16\n" +
+	"\x06toUnix\x18\x03 \x01(\x03R\x06toUnix\"/\n" +
+	"\x19ExportSecurityEventsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"f\n" +
+	" SetRecoveryContactMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\"W\n" +
+	"!SetRecoveryContactMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"k\n" +
+	"#VerifyRecoveryContactMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"Z\n" +
+	"$VerifyRecoveryContactMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"^\n" +
+	"\"StartAccountRecoveryMessageRequest\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\"y\n" +
+	"#StartAccountRecoveryMessageResponse\x12\x1e\n" +
+	"\n" +
+	"recoveryId\x18\x01 \x01(\tR\n" +
+	"recoveryId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"Z\n" +
+	"$ConfirmAccountRecoveryMessageRequest\x12\x1e\n" +
+	"\n" +
+	"recoveryId\x18\x01 \x01(\tR\n" +
+	"recoveryId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xab\x01\n" +
+	"%ConfirmAccountRecoveryMessageResponse\x12$\n" +
+	"\rrecoveryToken\x18\x01 \x01(\tR\rrecoveryToken\x12(\n" +
+	"\x0favailableAtUnix\x18\x02 \x01(\x03R\x0favailableAtUnix\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\"o\n" +
+	"%CompleteAccountRecoveryMessageRequest\x12$\n" +
+	"\rrecoveryToken\x18\x01 \x01(\tR\rrecoveryToken\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\"\\\n" +
+	"&CompleteAccountRecoveryMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"=\n" +
+	"#CancelAccountRecoveryMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"Z\n" +
+	"$CancelAccountRecoveryMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"\x8a\x01\n" +
+	"\x0eSellerAwayMode\x12\"\n" +
+	"\fstartsAtUnix\x18\x01 \x01(\x03R\fstartsAtUnix\x12\x1e\n" +
+	"\n" +
+	"endsAtUnix\x18\x02 \x01(\x03R\n" +
+	"endsAtUnix\x12\x1c\n" +
+	"\tautoReply\x18\x03 \x01(\tR\tautoReply\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\"\x95\x01\n" +
+	"\x19SetAwayModeMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\fstartsAtUnix\x18\x02 \x01(\x03R\fstartsAtUnix\x12\x1e\n" +
+	"\n" +
+	"endsAtUnix\x18\x03 \x01(\x03R\n" +
+	"endsAtUnix\x12\x1c\n" +
+	"\tautoReply\x18\x04 \x01(\tR\tautoReply\"\x82\x01\n" +
+	"\x1aSetAwayModeMessageResponse\x120\n" +
+	"\bawayMode\x18\x01 \x01(\v2\x14.user.SellerAwayModeR\bawayMode\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"5\n" +
+	"\x1bClearAwayModeMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x1cClearAwayModeMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"3\n" +
+	"\x19GetAwayModeMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"N\n" +
+	"\x1aGetAwayModeMessageResponse\x120\n" +
+	"\bawayMode\x18\x01 \x01(\v2\x14.user.SellerAwayModeR\bawayMode\"\x8f\x01\n" +
+	"\x1bSetTaxProfileMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06kraPin\x18\x02 \x01(\tR\x06kraPin\x12\x1c\n" +
+	"\tvatStatus\x18\x03 \x01(\tR\tvatStatus\x12\"\n" +
+	"\fbusinessName\x18\x04 \x01(\tR\fbusinessName\"R\n" +
+	"\x1cSetTaxProfileMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"5\n" +
+	"\x1bGetTaxProfileMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xba\x01\n" +
+	"\x1cGetTaxProfileMessageResponse\x12\x16\n" +
+	"\x06kraPin\x18\x01 \x01(\tR\x06kraPin\x12\x1c\n" +
+	"\tvatStatus\x18\x02 \x01(\tR\tvatStatus\x12\"\n" +
+	"\fbusinessName\x18\x03 \x01(\tR\fbusinessName\x12$\n" +
+	"\rupdatedAtUnix\x18\x04 \x01(\x03R\rupdatedAtUnix\x12\x1a\n" +
+	"\bredacted\x18\x05 \x01(\bR\bredacted\"\xe3\x01\n" +
+	"\x13AttributeDefinition\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\tR\n" +
+	"visibility\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12\x10\n" +
+	"\x03min\x18\x05 \x01(\x01R\x03min\x12\x16\n" +
+	"\x06hasMin\x18\x06 \x01(\bR\x06hasMin\x12\x10\n" +
+	"\x03max\x18\a \x01(\x01R\x03max\x12\x16\n" +
+	"\x06hasMax\x18\b \x01(\bR\x06hasMax\x12\x18\n" +
+	"\aoptions\x18\t \x03(\tR\aoptions\"Z\n" +
+	"\x1dDefineAttributeMessageRequest\x129\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\v2\x19.user.AttributeDefinitionR\n" +
+	"definition\"\x8f\x01\n" +
+	"\x1eDefineAttributeMessageResponse\x129\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\v2\x19.user.AttributeDefinitionR\n" +
+	"definition\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"(\n" +
+	"&ListAttributeDefinitionsMessageRequest\"f\n" +
+	"'ListAttributeDefinitionsMessageResponse\x12;\n" +
+	"\vdefinitions\x18\x01 \x03(\v2\x19.user.AttributeDefinitionR\vdefinitions\"\xdf\x01\n" +
+	"\x1bSetAttributesMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12Q\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v21.user.SetAttributesMessageRequest.AttributesEntryR\n" +
+	"attributes\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
+	"\x1cSetAttributesMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"Q\n" +
+	"\x1bGetAttributesMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bviewerId\x18\x02 \x01(\tR\bviewerId\"\xb1\x01\n" +
+	"\x1cGetAttributesMessageResponse\x12R\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v22.user.GetAttributesMessageResponse.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"O\n" +
+	"\vFieldChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\x91\x01\n" +
+	"\fDryRunChange\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12\x1e\n" +
+	"\n" +
+	"documentId\x18\x02 \x01(\tR\n" +
+	"documentId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12)\n" +
+	"\x06fields\x18\x04 \x03(\v2\x11.user.FieldChangeR\x06fields\"\xc9\x01\n" +
+	"\n" +
+	"DryRunDiff\x12,\n" +
+	"\achanges\x18\x01 \x03(\v2\x12.user.DryRunChangeR\achanges\x124\n" +
+	"\x06counts\x18\x02 \x03(\v2\x1c.user.DryRunDiff.CountsEntryR\x06counts\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x89\x01\n" +
+	"\x1fGetProfileHistoryMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x04 \x01(\tR\tpageToken\"\xd3\x01\n" +
+	"\rProfileChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\boldValue\x18\x02 \x01(\tR\boldValue\x12\x1a\n" +
+	"\bnewValue\x18\x03 \x01(\tR\bnewValue\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12 \n" +
+	"\vactorUserId\x18\x05 \x01(\tR\vactorUserId\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12$\n" +
+	"\rchangedAtUnix\x18\a \x01(\x03R\rchangedAtUnix\"w\n" +
+	" GetProfileHistoryMessageResponse\x12-\n" +
+	"\achanges\x18\x01 \x03(\v2\x13.user.ProfileChangeR\achanges\x12$\n" +
+	"\rnextPageToken\x18\x02 \x01(\tR\rnextPageToken\"Z\n" +
+	"\x1cGetDownloadURLMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"W\n" +
+	"\x1dGetDownloadURLMessageResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\"\x8e\x01\n" +
+	"\x1eRegisterUSSDUserMessageRequest\x12 \n" +
+	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x10\n" +
+	"\x03pin\x18\x03 \x01(\tR\x03pin\x12\x1c\n" +
+	"\tsessionId\x18\x04 \x01(\tR\tsessionId\"\x89\x01\n" +
+	"\x1fRegisterUSSDUserMessageResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\buserName\x18\x02 \x01(\tR\buserName\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\"o\n" +
+	"\x1bLoginUSSDUserMessageRequest\x12 \n" +
+	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\x12\x10\n" +
+	"\x03pin\x18\x02 \x01(\tR\x03pin\x12\x1c\n" +
+	"\tsessionId\x18\x03 \x01(\tR\tsessionId\"\xa2\x01\n" +
+	"\x1cLoginUSSDUserMessageResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
+	"\buserName\x18\x03 \x01(\tR\buserName\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\"\x95\x01\n" +
+	"\x1bChangeUSSDPINMessageRequest\x12 \n" +
+	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\x12\x1e\n" +
+	"\n" +
+	"currentPin\x18\x02 \x01(\tR\n" +
+	"currentPin\x12\x16\n" +
+	"\x06newPin\x18\x03 \x01(\tR\x06newPin\x12\x1c\n" +
+	"\tsessionId\x18\x04 \x01(\tR\tsessionId\"R\n" +
+	"\x1cChangeUSSDPINMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"b\n" +
+	"\x1aSetDevicePINMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bdeviceId\x18\x02 \x01(\tR\bdeviceId\x12\x10\n" +
+	"\x03pin\x18\x03 \x01(\tR\x03pin\"Q\n" +
+	"\x1bSetDevicePINMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"S\n" +
+	"\x1dRemoveDevicePINMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bdeviceId\x18\x02 \x01(\tR\bdeviceId\"T\n" +
+	"\x1eRemoveDevicePINMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"u\n" +
+	"#ReAuthenticateWithPINMessageRequest\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\x1a\n" +
+	"\bdeviceId\x18\x02 \x01(\tR\bdeviceId\x12\x10\n" +
+	"\x03pin\x18\x03 \x01(\tR\x03pin\"\x92\x01\n" +
+	"$ReAuthenticateWithPINMessageResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\x12\"\n" +
+	"\fauthTimeUnix\x18\x03 \x01(\x03R\fauthTimeUnix\"\x8f\x01\n" +
+	"\x1fRegisterDeviceKeyMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bdeviceId\x18\x02 \x01(\tR\bdeviceId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x1c\n" +
+	"\tpublicKey\x18\x04 \x01(\fR\tpublicKey\"V\n" +
+	" RegisterDeviceKeyMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"S\n" +
+	"\x1dRemoveDeviceKeyMessageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bdeviceId\x18\x02 \x01(\tR\bdeviceId\"T\n" +
+	"\x1eRemoveDeviceKeyMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"e\n" +
+	"%IssueBiometricChallengeMessageRequest\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\x1a\n" +
+	"\bdeviceId\x18\x02 \x01(\tR\bdeviceId\"l\n" +
+	"&IssueBiometricChallengeMessageResponse\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\"\xa5\x01\n" +
+	")ReAuthenticateWithBiometricMessageRequest\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\x1a\n" +
+	"\bdeviceId\x18\x02 \x01(\tR\bdeviceId\x12\x1c\n" +
+	"\tchallenge\x18\x03 \x01(\tR\tchallenge\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\"\x98\x01\n" +
+	"*ReAuthenticateWithBiometricMessageResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\x12\"\n" +
+	"\fauthTimeUnix\x18\x03 \x01(\x03R\fauthTimeUnix\"@\n" +
+	"\x1aRefreshTokenMessageRequest\x12\"\n" +
+	"\frefreshToken\x18\x01 \x01(\tR\frefreshToken\"\xd5\x01\n" +
+	"\x1bRefreshTokenMessageResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x122\n" +
+	"\x14refreshExpiresAtUnix\x18\x04 \x01(\x03R\x14refreshExpiresAtUnix\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\"\xb9\x01\n" +
+	"\x1bExchangeTokenMessageRequest\x12\"\n" +
+	"\fsubjectToken\x18\x01 \x01(\tR\fsubjectToken\x12*\n" +
+	"\x10subjectTokenType\x18\x02 \x01(\tR\x10subjectTokenType\x12\x1a\n" +
+	"\baudience\x18\x03 \x01(\tR\baudience\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x16\n" +
+	"\x06tenant\x18\x05 \x01(\tR\x06tenant\"\xa8\x01\n" +
+	"\x1cExchangeTokenMessageResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12(\n" +
+	"\x0fissuedTokenType\x18\x02 \x01(\tR\x0fissuedTokenType\x12$\n" +
+	"\rexpiresAtUnix\x18\x03 \x01(\x03R\rexpiresAtUnix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\"7\n" +
+	"\x1bGetQuotaUsageMessageRequest\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\"x\n" +
+	"\x10QuotaPeriodUsage\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x03R\x04used\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\"\n" +
+	"\fresetsAtUnix\x18\x04 \x01(\x03R\fresetsAtUnix\"j\n" +
+	"\x1cGetQuotaUsageMessageResponse\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x120\n" +
+	"\aperiods\x18\x02 \x03(\v2\x16.user.QuotaPeriodUsageR\aperiods2\xb1b\n" +
+	"\vUserService\x12D\n" +
+	"\tLoginUser\x12\x19.user.LoginMessageRequest\x1a\x1a.user.LoginMessageResponse\"\x00\x12M\n" +
+	"\fRegisterUser\x12\x1c.user.RegisterMessageRequest\x1a\x1d.user.RegisterMessageResponse\"\x00\x12d\n" +
+	"\x11GetBillingProfile\x12%.user.GetBillingProfileMessageRequest\x1a&.user.GetBillingProfileMessageResponse\"\x00\x12m\n" +
+	"\x14UpdateBillingProfile\x12(.user.UpdateBillingProfileMessageRequest\x1a).user.UpdateBillingProfileMessageResponse\"\x00\x12^\n" +
+	"\x0fGetUserSegments\x12#.user.GetUserSegmentsMessageRequest\x1a$.user.GetUserSegmentsMessageResponse\"\x00\x12U\n" +
+	"\fGetUserStats\x12 .user.GetUserStatsMessageRequest\x1a!.user.GetUserStatsMessageResponse\"\x00\x12W\n" +
+	"\x10WatchUserMetrics\x12$.user.WatchUserMetricsMessageRequest\x1a\x19.user.UserMetricsSnapshot\"\x000\x01\x12a\n" +
+	"\x10ListOutboxEvents\x12$.user.ListOutboxEventsMessageRequest\x1a%.user.ListOutboxEventsMessageResponse\"\x00\x12p\n" +
+	"\x15RepublishOutboxEvents\x12).user.RepublishOutboxEventsMessageRequest\x1a*.user.RepublishOutboxEventsMessageResponse\"\x00\x12^\n" +
+	"\x0fListDeadLetters\x12#.user.ListDeadLettersMessageRequest\x1a$.user.ListDeadLettersMessageResponse\"\x00\x12d\n" +
+	"\x11RequeueDeadLetter\x12%.user.RequeueDeadLetterMessageRequest\x1a&.user.RequeueDeadLetterMessageResponse\"\x00\x12\x7f\n" +
+	"\x1aSetNotificationPreferences\x12..user.SetNotificationPreferencesMessageRequest\x1a/.user.SetNotificationPreferencesMessageResponse\"\x00\x12d\n" +
+	"\x11RegisterPushToken\x12%.user.RegisterPushTokenMessageRequest\x1a&.user.RegisterPushTokenMessageResponse\"\x00\x12R\n" +
+	"\vVerifyEmail\x12\x1f.user.VerifyEmailMessageRequest\x1a .user.VerifyEmailMessageResponse\"\x00\x12s\n" +
+	"\x16RequestAccountDeletion\x12*.user.RequestAccountDeletionMessageRequest\x1a+.user.RequestAccountDeletionMessageResponse\"\x00\x12p\n" +
+	"\x15CancelAccountDeletion\x12).user.CancelAccountDeletionMessageRequest\x1a*.user.CancelAccountDeletionMessageResponse\"\x00\x12m\n" +
+	"\x14GenerateAccessReport\x12(.user.GenerateAccessReportMessageRequest\x1a).user.GenerateAccessReportMessageResponse\"\x00\x12O\n" +
+	"\n" +
+	"SetConsent\x12\x1e.user.SetConsentMessageRequest\x1a\x1f.user.SetConsentMessageResponse\"\x00\x12v\n" +
+	"\x17ExportComplianceRecords\x12+.user.ExportComplianceRecordsMessageRequest\x1a,.user.ExportComplianceRecordsMessageResponse\"\x00\x12[\n" +
+	"\x0eIssueUserToken\x12\".user.IssueUserTokenMessageRequest\x1a#.user.IssueUserTokenMessageResponse\"\x00\x12X\n" +
+	"\rValidateToken\x12!.user.ValidateTokenMessageRequest\x1a\".user.ValidateTokenMessageResponse\"\x00\x12d\n" +
+	"\x11IssueServiceToken\x12%.user.IssueServiceTokenMessageRequest\x1a&.user.IssueServiceTokenMessageResponse\"\x00\x12[\n" +
+	"\x0eReAuthenticate\x12\".user.ReAuthenticateMessageRequest\x1a#.user.ReAuthenticateMessageResponse\"\x00\x12f\n" +
+	"\x11UploadKYCDocument\x12%.user.UploadKYCDocumentMessageRequest\x1a&.user.UploadKYCDocumentMessageResponse\"\x00(\x01\x12g\n" +
+	"\x12ListKYCReviewQueue\x12&.user.ListKYCReviewQueueMessageRequest\x1a'.user.ListKYCReviewQueueMessageResponse\"\x00\x12O\n" +
+	"\n" +
+	"ApproveKYC\x12\x1e.user.ApproveKYCMessageRequest\x1a\x1f.user.ApproveKYCMessageResponse\"\x00\x12L\n" +
+	"\tRejectKYC\x12\x1d.user.RejectKYCMessageRequest\x1a\x1e.user.RejectKYCMessageResponse\"\x00\x12|\n" +
+	"\x19StartIdentityVerification\x12-.user.StartIdentityVerificationMessageRequest\x1a..user.StartIdentityVerificationMessageResponse\"\x00\x12v\n" +
+	"\x17GetIdentityVerification\x12+.user.GetIdentityVerificationMessageRequest\x1a,.user.GetIdentityVerificationMessageResponse\"\x00\x12j\n" +
+	"\x13VerifyPayoutAccount\x12'.user.VerifyPayoutAccountMessageRequest\x1a(.user.VerifyPayoutAccountMessageResponse\"\x00\x12p\n" +
+	"\x15GetPayoutVerification\x12).user.GetPayoutVerificationMessageRequest\x1a*.user.GetPayoutVerificationMessageResponse\"\x00\x12U\n" +
+	"\fCreditWallet\x12 .user.CreditWalletMessageRequest\x1a!.user.CreditWalletMessageResponse\"\x00\x12R\n" +
+	"\vDebitWallet\x12\x1f.user.DebitWalletMessageRequest\x1a .user.DebitWalletMessageResponse\"\x00\x12L\n" +
+	"\tGetWallet\x12\x1d.user.GetWalletMessageRequest\x1a\x1e.user.GetWalletMessageResponse\"\x00\x12[\n" +
+	"\x0eAttachGiftCard\x12\".user.AttachGiftCardMessageRequest\x1a#.user.AttachGiftCardMessageResponse\"\x00\x12X\n" +
+	"\rListGiftCards\x12!.user.ListGiftCardsMessageRequest\x1a\".user.ListGiftCardsMessageResponse\"\x00\x12g\n" +
+	"\x12GetGiftCardBalance\x12&.user.GetGiftCardBalanceMessageRequest\x1a'.user.GetGiftCardBalanceMessageResponse\"\x00\x12R\n" +
+	"\vGrantCoupon\x12\x1f.user.GrantCouponMessageRequest\x1a .user.GrantCouponMessageResponse\"\x00\x12R\n" +
+	"\vListCoupons\x12\x1f.user.ListCouponsMessageRequest\x1a .user.ListCouponsMessageResponse\"\x00\x12X\n" +
+	"\rReserveCoupon\x12!.user.ReserveCouponMessageRequest\x1a\".user.ReserveCouponMessageResponse\"\x00\x12U\n" +
+	"\fRedeemCoupon\x12 .user.RedeemCouponMessageRequest\x1a!.user.RedeemCouponMessageResponse\"\x00\x12X\n" +
+	"\rReleaseCoupon\x12!.user.ReleaseCouponMessageRequest\x1a\".user.ReleaseCouponMessageResponse\"\x00\x12R\n" +
+	"\vSetTimezone\x12\x1f.user.SetTimezoneMessageRequest\x1a .user.SetTimezoneMessageResponse\"\x00\x12[\n" +
+	"\x0eSubmitFeedback\x12\".user.SubmitFeedbackMessageRequest\x1a#.user.SubmitFeedbackMessageResponse\"\x00\x12g\n" +
+	"\x12GetFeedbackSummary\x12&.user.GetFeedbackSummaryMessageRequest\x1a'.user.GetFeedbackSummaryMessageResponse\"\x00\x12O\n" +
+	"\n" +
+	"LinkTicket\x12\x1e.user.LinkTicketMessageRequest\x1a\x1f.user.LinkTicketMessageResponse\"\x00\x12R\n" +
+	"\vListTickets\x12\x1f.user.ListTicketsMessageRequest\x1a .user.ListTicketsMessageResponse\"\x00\x12[\n" +
+	"\x0eUpdatePresence\x12\".user.UpdatePresenceMessageRequest\x1a#.user.UpdatePresenceMessageResponse\"\x00\x12R\n" +
+	"\vGetPresence\x12\x1f.user.GetPresenceMessageRequest\x1a .user.GetPresenceMessageResponse\"\x00\x12U\n" +
+	"\fSuggestUsers\x12 .user.SuggestUsersMessageRequest\x1a!.user.SuggestUsersMessageResponse\"\x00\x12v\n" +
+	"\x17ListDuplicateCandidates\x12+.user.ListDuplicateCandidatesMessageRequest\x1a,.user.ListDuplicateCandidatesMessageResponse\"\x00\x12|\n" +
+	"\x19ResolveDuplicateCandidate\x12-.user.ResolveDuplicateCandidateMessageRequest\x1a..user.ResolveDuplicateCandidateMessageResponse\"\x00\x12^\n" +
+	"\x0fBulkUpdateUsers\x12#.user.BulkUpdateUsersMessageRequest\x1a$.user.BulkUpdateUsersMessageResponse\"\x00\x12U\n" +
+	"\fGetOperation\x12 .user.GetOperationMessageRequest\x1a!.user.GetOperationMessageResponse\"\x00\x12[\n" +
+	"\x0eListOperations\x12\".user.ListOperationsMessageRequest\x1a#.user.ListOperationsMessageResponse\"\x00\x12^\n" +
+	"\x0fCancelOperation\x12#.user.CancelOperationMessageRequest\x1a$.user.CancelOperationMessageResponse\"\x00\x12p\n" +
+	"\x15StartComplianceExport\x12).user.StartComplianceExportMessageRequest\x1a*.user.StartComplianceExportMessageResponse\"\x00\x12a\n" +
+	"\x10StartUserErasure\x12$.user.StartUserErasureMessageRequest\x1a%.user.StartUserErasureMessageResponse\"\x00\x12^\n" +
+	"\x0fStartUserImport\x12#.user.StartUserImportMessageRequest\x1a$.user.StartUserImportMessageResponse\"\x00\x12X\n" +
+	"\rGetServerInfo\x12!.user.GetServerInfoMessageRequest\x1a\".user.GetServerInfoMessageResponse\"\x00\x12U\n" +
+	"\fGetSLOStatus\x12 .user.GetSLOStatusMessageRequest\x1a!.user.GetSLOStatusMessageResponse\"\x00\x12a\n" +
+	"\x10CreateSubAccount\x12$.user.CreateSubAccountMessageRequest\x1a%.user.CreateSubAccountMessageResponse\"\x00\x12^\n" +
+	"\x0fListSubAccounts\x12#.user.ListSubAccountsMessageRequest\x1a$.user.ListSubAccountsMessageResponse\"\x00\x12|\n" +
+	"\x19SetSubAccountRestrictions\x12-.user.SetSubAccountRestrictionsMessageRequest\x1a..user.SetSubAccountRestrictionsMessageResponse\"\x00\x12g\n" +
+	"\x12CreateOrganization\x12&.user.CreateOrganizationMessageRequest\x1a'.user.CreateOrganizationMessageResponse\"\x00\x12^\n" +
+	"\x0fInviteOrgMember\x12#.user.InviteOrgMemberMessageRequest\x1a$.user.InviteOrgMemberMessageResponse\"\x00\x12^\n" +
+	"\x0fAcceptOrgInvite\x12#.user.AcceptOrgInviteMessageRequest\x1a$.user.AcceptOrgInviteMessageResponse\"\x00\x12a\n" +
+	"\x10SetOrgMemberRole\x12$.user.SetOrgMemberRoleMessageRequest\x1a%.user.SetOrgMemberRoleMessageResponse\"\x00\x12^\n" +
+	"\x0fRemoveOrgMember\x12#.user.RemoveOrgMemberMessageRequest\x1a$.user.RemoveOrgMemberMessageResponse\"\x00\x12[\n" +
+	"\x0eListOrgMembers\x12\".user.ListOrgMembersMessageRequest\x1a#.user.ListOrgMembersMessageResponse\"\x00\x12p\n" +
+	"\x15ListUserOrganizations\x12).user.ListUserOrganizationsMessageRequest\x1a*.user.ListUserOrganizationsMessageResponse\"\x00\x12U\n" +
+	"\fCreateInvite\x12 .user.CreateInviteMessageRequest\x1a!.user.CreateInviteMessageResponse\"\x00\x12L\n" +
+	"\tGetInvite\x12\x1d.user.GetInviteMessageRequest\x1a\x1e.user.GetInviteMessageResponse\"\x00\x12U\n" +
+	"\fAcceptInvite\x12 .user.AcceptInviteMessageRequest\x1a!.user.AcceptInviteMessageResponse\"\x00\x12O\n" +
+	"\n" +
+	"SaveSearch\x12\x1e.user.SaveSearchMessageRequest\x1a\x1f.user.SaveSearchMessageResponse\"\x00\x12d\n" +
+	"\x11ListSavedSearches\x12%.user.ListSavedSearchesMessageRequest\x1a&.user.ListSavedSearchesMessageResponse\"\x00\x12d\n" +
+	"\x11DeleteSavedSearch\x12%.user.DeleteSavedSearchMessageRequest\x1a&.user.DeleteSavedSearchMessageResponse\"\x00\x12p\n" +
+	"\x15SubscribeProductAlert\x12).user.SubscribeProductAlertMessageRequest\x1a*.user.SubscribeProductAlertMessageResponse\"\x00\x12d\n" +
+	"\x11ListProductAlerts\x12%.user.ListProductAlertsMessageRequest\x1a&.user.ListProductAlertsMessageResponse\"\x00\x12g\n" +
+	"\x12DeleteProductAlert\x12&.user.DeleteProductAlertMessageRequest\x1a'.user.DeleteProductAlertMessageResponse\"\x00\x12d\n" +
+	"\x11RecordProductView\x12%.user.RecordProductViewMessageRequest\x1a&.user.RecordProductViewMessageResponse\"\x00\x12d\n" +
+	"\x11GetRecentlyViewed\x12%.user.GetRecentlyViewedMessageRequest\x1a&.user.GetRecentlyViewedMessageResponse\"\x00\x12d\n" +
+	"\x11UpdateDisplayName\x12%.user.UpdateDisplayNameMessageRequest\x1a&.user.UpdateDisplayNameMessageResponse\"\x00\x12W\n" +
+	"\fUploadAvatar\x12 .user.UploadAvatarMessageRequest\x1a!.user.UploadAvatarMessageResponse\"\x00(\x01\x12j\n" +
+	"\x13ListModerationQueue\x12'.user.ListModerationQueueMessageRequest\x1a(.user.ListModerationQueueMessageResponse\"\x00\x12a\n" +
+	"\x10ReviewModeration\x12$.user.ReviewModerationMessageRequest\x1a%.user.ReviewModerationMessageResponse\"\x00\x12a\n" +
+	"\x10GetPublicProfile\x12$.user.GetPublicProfileMessageRequest\x1a%.user.GetPublicProfileMessageResponse\"\x00\x12d\n" +
+	"\x11GetPublicProfiles\x12%.user.GetPublicProfilesMessageRequest\x1a&.user.GetPublicProfilesMessageResponse\"\x00\x12U\n" +
+	"\fSetShadowBan\x12 .user.SetShadowBanMessageRequest\x1a!.user.SetShadowBanMessageResponse\"\x00\x12[\n" +
+	"\x0eGetUserProfile\x12\".user.GetUserProfileMessageRequest\x1a#.user.GetUserProfileMessageResponse\"\x00\x12p\n" +
+	"\x15SendPhoneVerification\x12).user.SendPhoneVerificationMessageRequest\x1a*.user.SendPhoneVerificationMessageResponse\"\x00\x12R\n" +
+	"\vVerifyPhone\x12\x1f.user.VerifyPhoneMessageRequest\x1a .user.VerifyPhoneMessageResponse\"\x00\x12m\n" +
+	"\x14SetDigestPreferences\x12(.user.SetDigestPreferencesMessageRequest\x1a).user.SetDigestPreferencesMessageResponse\"\x00\x12m\n" +
+	"\x14GetDigestPreferences\x12(.user.GetDigestPreferencesMessageRequest\x1a).user.GetDigestPreferencesMessageResponse\"\x00\x12X\n" +
+	"\rGetDueDigests\x12!.user.GetDueDigestsMessageRequest\x1a\".user.GetDueDigestsMessageResponse\"\x00\x12[\n" +
+	"\x0eGetAssignments\x12\".user.GetAssignmentsMessageRequest\x1a#.user.GetAssignmentsMessageResponse\"\x00\x12d\n" +
+	"\x11GetSecurityStatus\x12%.user.GetSecurityStatusMessageRequest\x1a&.user.GetSecurityStatusMessageResponse\"\x00\x12e\n" +
+	"\x14ExportSecurityEvents\x12(.user.ExportSecurityEventsMessageRequest\x1a\x1f.user.ExportSecurityEventsChunk\"\x000\x01\x12g\n" +
+	"\x12SetRecoveryContact\x12&.user.SetRecoveryContactMessageRequest\x1a'.user.SetRecoveryContactMessageResponse\"\x00\x12p\n" +
+	"\x15VerifyRecoveryContact\x12).user.VerifyRecoveryContactMessageRequest\x1a*.user.VerifyRecoveryContactMessageResponse\"\x00\x12m\n" +
+	"\x14StartAccountRecovery\x12(.user.StartAccountRecoveryMessageRequest\x1a).user.StartAccountRecoveryMessageResponse\"\x00\x12s\n" +
+	"\x16ConfirmAccountRecovery\x12*.user.ConfirmAccountRecoveryMessageRequest\x1a+.user.ConfirmAccountRecoveryMessageResponse\"\x00\x12v\n" +
+	"\x17CompleteAccountRecovery\x12+.user.CompleteAccountRecoveryMessageRequest\x1a,.user.CompleteAccountRecoveryMessageResponse\"\x00\x12p\n" +
+	"\x15CancelAccountRecovery\x12).user.CancelAccountRecoveryMessageRequest\x1a*.user.CancelAccountRecoveryMessageResponse\"\x00\x12R\n" +
+	"\vSetAwayMode\x12\x1f.user.SetAwayModeMessageRequest\x1a .user.SetAwayModeMessageResponse\"\x00\x12X\n" +
+	"\rClearAwayMode\x12!.user.ClearAwayModeMessageRequest\x1a\".user.ClearAwayModeMessageResponse\"\x00\x12R\n" +
+	"\vGetAwayMode\x12\x1f.user.GetAwayModeMessageRequest\x1a .user.GetAwayModeMessageResponse\"\x00\x12X\n" +
+	"\rSetTaxProfile\x12!.user.SetTaxProfileMessageRequest\x1a\".user.SetTaxProfileMessageResponse\"\x00\x12X\n" +
+	"\rGetTaxProfile\x12!.user.GetTaxProfileMessageRequest\x1a\".user.GetTaxProfileMessageResponse\"\x00\x12^\n" +
+	"\x0fDefineAttribute\x12#.user.DefineAttributeMessageRequest\x1a$.user.DefineAttributeMessageResponse\"\x00\x12y\n" +
+	"\x18ListAttributeDefinitions\x12,.user.ListAttributeDefinitionsMessageRequest\x1a-.user.ListAttributeDefinitionsMessageResponse\"\x00\x12X\n" +
+	"\rSetAttributes\x12!.user.SetAttributesMessageRequest\x1a\".user.SetAttributesMessageResponse\"\x00\x12X\n" +
+	"\rGetAttributes\x12!.user.GetAttributesMessageRequest\x1a\".user.GetAttributesMessageResponse\"\x00\x12d\n" +
+	"\x11GetProfileHistory\x12%.user.GetProfileHistoryMessageRequest\x1a&.user.GetProfileHistoryMessageResponse\"\x00\x12[\n" +
+	"\x0eGetDownloadURL\x12\".user.GetDownloadURLMessageRequest\x1a#.user.GetDownloadURLMessageResponse\"\x00\x12a\n" +
+	"\x10RegisterUSSDUser\x12$.user.RegisterUSSDUserMessageRequest\x1a%.user.RegisterUSSDUserMessageResponse\"\x00\x12X\n" +
+	"\rLoginUSSDUser\x12!.user.LoginUSSDUserMessageRequest\x1a\".user.LoginUSSDUserMessageResponse\"\x00\x12X\n" +
+	"\rChangeUSSDPIN\x12!.user.ChangeUSSDPINMessageRequest\x1a\".user.ChangeUSSDPINMessageResponse\"\x00\x12U\n" +
+	"\fSetDevicePIN\x12 .user.SetDevicePINMessageRequest\x1a!.user.SetDevicePINMessageResponse\"\x00\x12^\n" +
+	"\x0fRemoveDevicePIN\x12#.user.RemoveDevicePINMessageRequest\x1a$.user.RemoveDevicePINMessageResponse\"\x00\x12p\n" +
+	"\x15ReAuthenticateWithPIN\x12).user.ReAuthenticateWithPINMessageRequest\x1a*.user.ReAuthenticateWithPINMessageResponse\"\x00\x12d\n" +
+	"\x11RegisterDeviceKey\x12%.user.RegisterDeviceKeyMessageRequest\x1a&.user.RegisterDeviceKeyMessageResponse\"\x00\x12^\n" +
+	"\x0fRemoveDeviceKey\x12#.user.RemoveDeviceKeyMessageRequest\x1a$.user.RemoveDeviceKeyMessageResponse\"\x00\x12v\n" +
+	"\x17IssueBiometricChallenge\x12+.user.IssueBiometricChallengeMessageRequest\x1a,.user.IssueBiometricChallengeMessageResponse\"\x00\x12\x82\x01\n" +
+	"\x1bReAuthenticateWithBiometric\x12/.user.ReAuthenticateWithBiometricMessageRequest\x1a0.user.ReAuthenticateWithBiometricMessageResponse\"\x00\x12U\n" +
+	"\fRefreshToken\x12 .user.RefreshTokenMessageRequest\x1a!.user.RefreshTokenMessageResponse\"\x00\x12X\n" +
+	"\rExchangeToken\x12!.user.ExchangeTokenMessageRequest\x1a\".user.ExchangeTokenMessageResponse\"\x00\x12X\n" +
+	"\rGetQuotaUsage\x12!.user.GetQuotaUsageMessageRequest\x1a\".user.GetQuotaUsageMessageResponse\"\x00B\n" +
+	"Z\bgen/userb\x06proto3"
+
+var (
+	file_user_proto_rawDescOnce sync.Once
+	file_user_proto_rawDescData []byte
+)
+
+func file_user_proto_rawDescGZIP() []byte {
+	file_user_proto_rawDescOnce.Do(func() {
+		file_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)))
+	})
+	return file_user_proto_rawDescData
+}
+
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 313)
+var file_user_proto_goTypes = []any{
+	(*RegisterMessageRequest)(nil),                     // 0: user.RegisterMessageRequest
+	(*RegisterMessageResponse)(nil),                    // 1: user.RegisterMessageResponse
+	(*LoginMessageRequest)(nil),                        // 2: user.LoginMessageRequest
+	(*LoginMessageResponse)(nil),                       // 3: user.LoginMessageResponse
+	(*GeoPoint)(nil),                                   // 4: user.GeoPoint
+	(*BillingAddress)(nil),                             // 5: user.BillingAddress
+	(*TaxIdentifier)(nil),                              // 6: user.TaxIdentifier
+	(*GetBillingProfileMessageRequest)(nil),            // 7: user.GetBillingProfileMessageRequest
+	(*GetBillingProfileMessageResponse)(nil),           // 8: user.GetBillingProfileMessageResponse
+	(*UpdateBillingProfileMessageRequest)(nil),         // 9: user.UpdateBillingProfileMessageRequest
+	(*UpdateBillingProfileMessageResponse)(nil),        // 10: user.UpdateBillingProfileMessageResponse
+	(*Demographics)(nil),                               // 11: user.Demographics
+	(*GetUserSegmentsMessageRequest)(nil),              // 12: user.GetUserSegmentsMessageRequest
+	(*GetUserSegmentsMessageResponse)(nil),             // 13: user.GetUserSegmentsMessageResponse
+	(*PeriodCount)(nil),                                // 14: user.PeriodCount
+	(*GetUserStatsMessageRequest)(nil),                 // 15: user.GetUserStatsMessageRequest
+	(*GetUserStatsMessageResponse)(nil),                // 16: user.GetUserStatsMessageResponse
+	(*WatchUserMetricsMessageRequest)(nil),             // 17: user.WatchUserMetricsMessageRequest
+	(*UserMetricsSnapshot)(nil),                        // 18: user.UserMetricsSnapshot
+	(*OutboxEvent)(nil),                                // 19: user.OutboxEvent
+	(*OutboxEventFilter)(nil),                          // 20: user.OutboxEventFilter
+	(*ListOutboxEventsMessageRequest)(nil),             // 21: user.ListOutboxEventsMessageRequest
+	(*ListOutboxEventsMessageResponse)(nil),            // 22: user.ListOutboxEventsMessageResponse
+	(*RepublishOutboxEventsMessageRequest)(nil),        // 23: user.RepublishOutboxEventsMessageRequest
+	(*RepublishOutboxEventsMessageResponse)(nil),       // 24: user.RepublishOutboxEventsMessageResponse
+	(*DeadLetter)(nil),                                 // 25: user.DeadLetter
+	(*ListDeadLettersMessageRequest)(nil),              // 26: user.ListDeadLettersMessageRequest
+	(*ListDeadLettersMessageResponse)(nil),             // 27: user.ListDeadLettersMessageResponse
+	(*RequeueDeadLetterMessageRequest)(nil),            // 28: user.RequeueDeadLetterMessageRequest
+	(*RequeueDeadLetterMessageResponse)(nil),           // 29: user.RequeueDeadLetterMessageResponse
+	(*NotificationPreference)(nil),                     // 30: user.NotificationPreference
+	(*SetNotificationPreferencesMessageRequest)(nil),   // 31: user.SetNotificationPreferencesMessageRequest
+	(*SetNotificationPreferencesMessageResponse)(nil),  // 32: user.SetNotificationPreferencesMessageResponse
+	(*RegisterPushTokenMessageRequest)(nil),            // 33: user.RegisterPushTokenMessageRequest
+	(*RegisterPushTokenMessageResponse)(nil),           // 34: user.RegisterPushTokenMessageResponse
+	(*VerifyEmailMessageRequest)(nil),                  // 35: user.VerifyEmailMessageRequest
+	(*VerifyEmailMessageResponse)(nil),                 // 36: user.VerifyEmailMessageResponse
+	(*RequestAccountDeletionMessageRequest)(nil),       // 37: user.RequestAccountDeletionMessageRequest
+	(*RequestAccountDeletionMessageResponse)(nil),      // 38: user.RequestAccountDeletionMessageResponse
+	(*CancelAccountDeletionMessageRequest)(nil),        // 39: user.CancelAccountDeletionMessageRequest
+	(*CancelAccountDeletionMessageResponse)(nil),       // 40: user.CancelAccountDeletionMessageResponse
+	(*GenerateAccessReportMessageRequest)(nil),         // 41: user.GenerateAccessReportMessageRequest
+	(*GenerateAccessReportMessageResponse)(nil),        // 42: user.GenerateAccessReportMessageResponse
+	(*SetConsentMessageRequest)(nil),                   // 43: user.SetConsentMessageRequest
+	(*SetConsentMessageResponse)(nil),                  // 44: user.SetConsentMessageResponse
+	(*ExportComplianceRecordsMessageRequest)(nil),      // 45: user.ExportComplianceRecordsMessageRequest
+	(*ExportComplianceRecordsMessageResponse)(nil),     // 46: user.ExportComplianceRecordsMessageResponse
+	(*IssueUserTokenMessageRequest)(nil),               // 47: user.IssueUserTokenMessageRequest
+	(*IssueUserTokenMessageResponse)(nil),              // 48: user.IssueUserTokenMessageResponse
+	(*ValidateTokenMessageRequest)(nil),                // 49: user.ValidateTokenMessageRequest
+	(*ValidateTokenMessageResponse)(nil),               // 50: user.ValidateTokenMessageResponse
+	(*IssueServiceTokenMessageRequest)(nil),            // 51: user.IssueServiceTokenMessageRequest
+	(*IssueServiceTokenMessageResponse)(nil),           // 52: user.IssueServiceTokenMessageResponse
+	(*ReAuthenticateMessageRequest)(nil),               // 53: user.ReAuthenticateMessageRequest
+	(*ReAuthenticateMessageResponse)(nil),              // 54: user.ReAuthenticateMessageResponse
+	(*KYCDocumentInfo)(nil),                            // 55: user.KYCDocumentInfo
+	(*UploadKYCDocumentMessageRequest)(nil),            // 56: user.UploadKYCDocumentMessageRequest
+	(*UploadKYCDocumentMessageResponse)(nil),           // 57: user.UploadKYCDocumentMessageResponse
+	(*KYCDocument)(nil),                                // 58: user.KYCDocument
+	(*KYCReviewItem)(nil),                              // 59: user.KYCReviewItem
+	(*ListKYCReviewQueueMessageRequest)(nil),           // 60: user.ListKYCReviewQueueMessageRequest
+	(*ListKYCReviewQueueMessageResponse)(nil),          // 61: user.ListKYCReviewQueueMessageResponse
+	(*ApproveKYCMessageRequest)(nil),                   // 62: user.ApproveKYCMessageRequest
+	(*ApproveKYCMessageResponse)(nil),                  // 63: user.ApproveKYCMessageResponse
+	(*RejectKYCMessageRequest)(nil),                    // 64: user.RejectKYCMessageRequest
+	(*RejectKYCMessageResponse)(nil),                   // 65: user.RejectKYCMessageResponse
+	(*StartIdentityVerificationMessageRequest)(nil),    // 66: user.StartIdentityVerificationMessageRequest
+	(*StartIdentityVerificationMessageResponse)(nil),   // 67: user.StartIdentityVerificationMessageResponse
+	(*GetIdentityVerificationMessageRequest)(nil),      // 68: user.GetIdentityVerificationMessageRequest
+	(*GetIdentityVerificationMessageResponse)(nil),     // 69: user.GetIdentityVerificationMessageResponse
+	(*VerifyPayoutAccountMessageRequest)(nil),          // 70: user.VerifyPayoutAccountMessageRequest
+	(*VerifyPayoutAccountMessageResponse)(nil),         // 71: user.VerifyPayoutAccountMessageResponse
+	(*GetPayoutVerificationMessageRequest)(nil),        // 72: user.GetPayoutVerificationMessageRequest
+	(*GetPayoutVerificationMessageResponse)(nil),       // 73: user.GetPayoutVerificationMessageResponse
+	(*WalletTransaction)(nil),                          // 74: user.WalletTransaction
+	(*CreditWalletMessageRequest)(nil),                 // 75: user.CreditWalletMessageRequest
+	(*CreditWalletMessageResponse)(nil),                // 76: user.CreditWalletMessageResponse
+	(*DebitWalletMessageRequest)(nil),                  // 77: user.DebitWalletMessageRequest
+	(*DebitWalletMessageResponse)(nil),                 // 78: user.DebitWalletMessageResponse
+	(*GetWalletMessageRequest)(nil),                    // 79: user.GetWalletMessageRequest
+	(*GetWalletMessageResponse)(nil),                   // 80: user.GetWalletMessageResponse
+	(*GiftCard)(nil),                                   // 81: user.GiftCard
+	(*AttachGiftCardMessageRequest)(nil),               // 82: user.AttachGiftCardMessageRequest
+	(*AttachGiftCardMessageResponse)(nil),              // 83: user.AttachGiftCardMessageResponse
+	(*ListGiftCardsMessageRequest)(nil),                // 84: user.ListGiftCardsMessageRequest
+	(*ListGiftCardsMessageResponse)(nil),               // 85: user.ListGiftCardsMessageResponse
+	(*GetGiftCardBalanceMessageRequest)(nil),           // 86: user.GetGiftCardBalanceMessageRequest
+	(*GetGiftCardBalanceMessageResponse)(nil),          // 87: user.GetGiftCardBalanceMessageResponse
+	(*Coupon)(nil),                                     // 88: user.Coupon
+	(*GrantCouponMessageRequest)(nil),                  // 89: user.GrantCouponMessageRequest
+	(*GrantCouponMessageResponse)(nil),                 // 90: user.GrantCouponMessageResponse
+	(*ListCouponsMessageRequest)(nil),                  // 91: user.ListCouponsMessageRequest
+	(*ListCouponsMessageResponse)(nil),                 // 92: user.ListCouponsMessageResponse
+	(*ReserveCouponMessageRequest)(nil),                // 93: user.ReserveCouponMessageRequest
+	(*ReserveCouponMessageResponse)(nil),               // 94: user.ReserveCouponMessageResponse
+	(*RedeemCouponMessageRequest)(nil),                 // 95: user.RedeemCouponMessageRequest
+	(*RedeemCouponMessageResponse)(nil),                // 96: user.RedeemCouponMessageResponse
+	(*ReleaseCouponMessageRequest)(nil),                // 97: user.ReleaseCouponMessageRequest
+	(*ReleaseCouponMessageResponse)(nil),               // 98: user.ReleaseCouponMessageResponse
+	(*SetTimezoneMessageRequest)(nil),                  // 99: user.SetTimezoneMessageRequest
+	(*SetTimezoneMessageResponse)(nil),                 // 100: user.SetTimezoneMessageResponse
+	(*SubmitFeedbackMessageRequest)(nil),               // 101: user.SubmitFeedbackMessageRequest
+	(*SubmitFeedbackMessageResponse)(nil),              // 102: user.SubmitFeedbackMessageResponse
+	(*GetFeedbackSummaryMessageRequest)(nil),           // 103: user.GetFeedbackSummaryMessageRequest
+	(*GetFeedbackSummaryMessageResponse)(nil),          // 104: user.GetFeedbackSummaryMessageResponse
+	(*SupportTicket)(nil),                              // 105: user.SupportTicket
+	(*SupportCustomer)(nil),                            // 106: user.SupportCustomer
+	(*LinkTicketMessageRequest)(nil),                   // 107: user.LinkTicketMessageRequest
+	(*LinkTicketMessageResponse)(nil),                  // 108: user.LinkTicketMessageResponse
+	(*ListTicketsMessageRequest)(nil),                  // 109: user.ListTicketsMessageRequest
+	(*ListTicketsMessageResponse)(nil),                 // 110: user.ListTicketsMessageResponse
+	(*UpdatePresenceMessageRequest)(nil),               // 111: user.UpdatePresenceMessageRequest
+	(*UpdatePresenceMessageResponse)(nil),              // 112: user.UpdatePresenceMessageResponse
+	(*UserPresence)(nil),                               // 113: user.UserPresence
+	(*GetPresenceMessageRequest)(nil),                  // 114: user.GetPresenceMessageRequest
+	(*GetPresenceMessageResponse)(nil),                 // 115: user.GetPresenceMessageResponse
+	(*SuggestUsersMessageRequest)(nil),                 // 116: user.SuggestUsersMessageRequest
+	(*UserSuggestion)(nil),                             // 117: user.UserSuggestion
+	(*SuggestUsersMessageResponse)(nil),                // 118: user.SuggestUsersMessageResponse
+	(*ListDuplicateCandidatesMessageRequest)(nil),      // 119: user.ListDuplicateCandidatesMessageRequest
+	(*DuplicateUser)(nil),                              // 120: user.DuplicateUser
+	(*DuplicateCandidate)(nil),                         // 121: user.DuplicateCandidate
+	(*ListDuplicateCandidatesMessageResponse)(nil),     // 122: user.ListDuplicateCandidatesMessageResponse
+	(*ResolveDuplicateCandidateMessageRequest)(nil),    // 123: user.ResolveDuplicateCandidateMessageRequest
+	(*ResolveDuplicateCandidateMessageResponse)(nil),   // 124: user.ResolveDuplicateCandidateMessageResponse
+	(*Operation)(nil),                                  // 125: user.Operation
+	(*GetOperationMessageRequest)(nil),                 // 126: user.GetOperationMessageRequest
+	(*GetOperationMessageResponse)(nil),                // 127: user.GetOperationMessageResponse
+	(*ListOperationsMessageRequest)(nil),               // 128: user.ListOperationsMessageRequest
+	(*ListOperationsMessageResponse)(nil),              // 129: user.ListOperationsMessageResponse
+	(*CancelOperationMessageRequest)(nil),              // 130: user.CancelOperationMessageRequest
+	(*CancelOperationMessageResponse)(nil),             // 131: user.CancelOperationMessageResponse
+	(*StartComplianceExportMessageRequest)(nil),        // 132: user.StartComplianceExportMessageRequest
+	(*StartComplianceExportMessageResponse)(nil),       // 133: user.StartComplianceExportMessageResponse
+	(*StartUserErasureMessageRequest)(nil),             // 134: user.StartUserErasureMessageRequest
+	(*StartUserErasureMessageResponse)(nil),            // 135: user.StartUserErasureMessageResponse
+	(*StartUserImportMessageRequest)(nil),              // 136: user.StartUserImportMessageRequest
+	(*StartUserImportMessageResponse)(nil),             // 137: user.StartUserImportMessageResponse
+	(*BulkUserFilter)(nil),                             // 138: user.BulkUserFilter
+	(*BulkUserPatch)(nil),                              // 139: user.BulkUserPatch
+	(*BulkUpdateUsersMessageRequest)(nil),              // 140: user.BulkUpdateUsersMessageRequest
+	(*BulkUpdateUsersMessageResponse)(nil),             // 141: user.BulkUpdateUsersMessageResponse
+	(*GetServerInfoMessageRequest)(nil),                // 142: user.GetServerInfoMessageRequest
+	(*GetServerInfoMessageResponse)(nil),               // 143: user.GetServerInfoMessageResponse
+	(*GetSLOStatusMessageRequest)(nil),                 // 144: user.GetSLOStatusMessageRequest
+	(*SLOWindow)(nil),                                  // 145: user.SLOWindow
+	(*MethodSLOStatus)(nil),                            // 146: user.MethodSLOStatus
+	(*GetSLOStatusMessageResponse)(nil),                // 147: user.GetSLOStatusMessageResponse
+	(*SubAccountRestrictions)(nil),                     // 148: user.SubAccountRestrictions
+	(*SubAccount)(nil),                                 // 149: user.SubAccount
+	(*CreateSubAccountMessageRequest)(nil),             // 150: user.CreateSubAccountMessageRequest
+	(*CreateSubAccountMessageResponse)(nil),            // 151: user.CreateSubAccountMessageResponse
+	(*ListSubAccountsMessageRequest)(nil),              // 152: user.ListSubAccountsMessageRequest
+	(*ListSubAccountsMessageResponse)(nil),             // 153: user.ListSubAccountsMessageResponse
+	(*SetSubAccountRestrictionsMessageRequest)(nil),    // 154: user.SetSubAccountRestrictionsMessageRequest
+	(*SetSubAccountRestrictionsMessageResponse)(nil),   // 155: user.SetSubAccountRestrictionsMessageResponse
+	(*Organization)(nil),                               // 156: user.Organization
+	(*OrgMember)(nil),                                  // 157: user.OrgMember
+	(*OrgMembership)(nil),                              // 158: user.OrgMembership
+	(*CreateOrganizationMessageRequest)(nil),           // 159: user.CreateOrganizationMessageRequest
+	(*CreateOrganizationMessageResponse)(nil),          // 160: user.CreateOrganizationMessageResponse
+	(*InviteOrgMemberMessageRequest)(nil),              // 161: user.InviteOrgMemberMessageRequest
+	(*InviteOrgMemberMessageResponse)(nil),             // 162: user.InviteOrgMemberMessageResponse
+	(*AcceptOrgInviteMessageRequest)(nil),              // 163: user.AcceptOrgInviteMessageRequest
+	(*AcceptOrgInviteMessageResponse)(nil),             // 164: user.AcceptOrgInviteMessageResponse
+	(*SetOrgMemberRoleMessageRequest)(nil),             // 165: user.SetOrgMemberRoleMessageRequest
+	(*SetOrgMemberRoleMessageResponse)(nil),            // 166: user.SetOrgMemberRoleMessageResponse
+	(*RemoveOrgMemberMessageRequest)(nil),              // 167: user.RemoveOrgMemberMessageRequest
+	(*RemoveOrgMemberMessageResponse)(nil),             // 168: user.RemoveOrgMemberMessageResponse
+	(*ListOrgMembersMessageRequest)(nil),               // 169: user.ListOrgMembersMessageRequest
+	(*ListOrgMembersMessageResponse)(nil),              // 170: user.ListOrgMembersMessageResponse
+	(*ListUserOrganizationsMessageRequest)(nil),        // 171: user.ListUserOrganizationsMessageRequest
+	(*ListUserOrganizationsMessageResponse)(nil),       // 172: user.ListUserOrganizationsMessageResponse
+	(*Invite)(nil),                                     // 173: user.Invite
+	(*CreateInviteMessageRequest)(nil),                 // 174: user.CreateInviteMessageRequest
+	(*CreateInviteMessageResponse)(nil),                // 175: user.CreateInviteMessageResponse
+	(*GetInviteMessageRequest)(nil),                    // 176: user.GetInviteMessageRequest
+	(*GetInviteMessageResponse)(nil),                   // 177: user.GetInviteMessageResponse
+	(*AcceptInviteMessageRequest)(nil),                 // 178: user.AcceptInviteMessageRequest
+	(*AcceptInviteMessageResponse)(nil),                // 179: user.AcceptInviteMessageResponse
+	(*SavedSearch)(nil),                                // 180: user.SavedSearch
+	(*SaveSearchMessageRequest)(nil),                   // 181: user.SaveSearchMessageRequest
+	(*SaveSearchMessageResponse)(nil),                  // 182: user.SaveSearchMessageResponse
+	(*ListSavedSearchesMessageRequest)(nil),            // 183: user.ListSavedSearchesMessageRequest
+	(*ListSavedSearchesMessageResponse)(nil),           // 184: user.ListSavedSearchesMessageResponse
+	(*DeleteSavedSearchMessageRequest)(nil),            // 185: user.DeleteSavedSearchMessageRequest
+	(*DeleteSavedSearchMessageResponse)(nil),           // 186: user.DeleteSavedSearchMessageResponse
+	(*ProductAlert)(nil),                               // 187: user.ProductAlert
+	(*SubscribeProductAlertMessageRequest)(nil),        // 188: user.SubscribeProductAlertMessageRequest
+	(*SubscribeProductAlertMessageResponse)(nil),       // 189: user.SubscribeProductAlertMessageResponse
+	(*ListProductAlertsMessageRequest)(nil),            // 190: user.ListProductAlertsMessageRequest
+	(*ListProductAlertsMessageResponse)(nil),           // 191: user.ListProductAlertsMessageResponse
+	(*DeleteProductAlertMessageRequest)(nil),           // 192: user.DeleteProductAlertMessageRequest
+	(*DeleteProductAlertMessageResponse)(nil),          // 193: user.DeleteProductAlertMessageResponse
+	(*RecordProductViewMessageRequest)(nil),            // 194: user.RecordProductViewMessageRequest
+	(*RecordProductViewMessageResponse)(nil),           // 195: user.RecordProductViewMessageResponse
+	(*ViewedProduct)(nil),                              // 196: user.ViewedProduct
+	(*GetRecentlyViewedMessageRequest)(nil),            // 197: user.GetRecentlyViewedMessageRequest
+	(*GetRecentlyViewedMessageResponse)(nil),           // 198: user.GetRecentlyViewedMessageResponse
+	(*UpdateDisplayNameMessageRequest)(nil),            // 199: user.UpdateDisplayNameMessageRequest
+	(*UpdateDisplayNameMessageResponse)(nil),           // 200: user.UpdateDisplayNameMessageResponse
+	(*AvatarInfo)(nil),                                 // 201: user.AvatarInfo
+	(*UploadAvatarMessageRequest)(nil),                 // 202: user.UploadAvatarMessageRequest
+	(*UploadAvatarMessageResponse)(nil),                // 203: user.UploadAvatarMessageResponse
+	(*ModerationItem)(nil),                             // 204: user.ModerationItem
+	(*ListModerationQueueMessageRequest)(nil),          // 205: user.ListModerationQueueMessageRequest
+	(*ListModerationQueueMessageResponse)(nil),         // 206: user.ListModerationQueueMessageResponse
+	(*ReviewModerationMessageRequest)(nil),             // 207: user.ReviewModerationMessageRequest
+	(*ReviewModerationMessageResponse)(nil),            // 208: user.ReviewModerationMessageResponse
+	(*GetPublicProfileMessageRequest)(nil),             // 209: user.GetPublicProfileMessageRequest
+	(*GetPublicProfileMessageResponse)(nil),            // 210: user.GetPublicProfileMessageResponse
+	(*PublicProfile)(nil),                              // 211: user.PublicProfile
+	(*GetPublicProfilesMessageRequest)(nil),            // 212: user.GetPublicProfilesMessageRequest
+	(*GetPublicProfilesMessageResponse)(nil),           // 213: user.GetPublicProfilesMessageResponse
+	(*SetShadowBanMessageRequest)(nil),                 // 214: user.SetShadowBanMessageRequest
+	(*SetShadowBanMessageResponse)(nil),                // 215: user.SetShadowBanMessageResponse
+	(*GetUserProfileMessageRequest)(nil),               // 216: user.GetUserProfileMessageRequest
+	(*GetUserProfileMessageResponse)(nil),              // 217: user.GetUserProfileMessageResponse
+	(*SendPhoneVerificationMessageRequest)(nil),        // 218: user.SendPhoneVerificationMessageRequest
+	(*SendPhoneVerificationMessageResponse)(nil),       // 219: user.SendPhoneVerificationMessageResponse
+	(*VerifyPhoneMessageRequest)(nil),                  // 220: user.VerifyPhoneMessageRequest
+	(*VerifyPhoneMessageResponse)(nil),                 // 221: user.VerifyPhoneMessageResponse
+	(*DigestPreference)(nil),                           // 222: user.DigestPreference
+	(*SetDigestPreferencesMessageRequest)(nil),         // 223: user.SetDigestPreferencesMessageRequest
+	(*SetDigestPreferencesMessageResponse)(nil),        // 224: user.SetDigestPreferencesMessageResponse
+	(*GetDigestPreferencesMessageRequest)(nil),         // 225: user.GetDigestPreferencesMessageRequest
+	(*GetDigestPreferencesMessageResponse)(nil),        // 226: user.GetDigestPreferencesMessageResponse
+	(*DueDigest)(nil),                                  // 227: user.DueDigest
+	(*GetDueDigestsMessageRequest)(nil),                // 228: user.GetDueDigestsMessageRequest
+	(*GetDueDigestsMessageResponse)(nil),               // 229: user.GetDueDigestsMessageResponse
+	(*ExperimentAssignment)(nil),                       // 230: user.ExperimentAssignment
+	(*GetAssignmentsMessageRequest)(nil),               // 231: user.GetAssignmentsMessageRequest
+	(*GetAssignmentsMessageResponse)(nil),              // 232: user.GetAssignmentsMessageResponse
+	(*GetSecurityStatusMessageRequest)(nil),            // 233: user.GetSecurityStatusMessageRequest
+	(*SecurityIssue)(nil),                              // 234: user.SecurityIssue
+	(*GetSecurityStatusMessageResponse)(nil),           // 235: user.GetSecurityStatusMessageResponse
+	(*ExportSecurityEventsMessageRequest)(nil),         // 236: user.ExportSecurityEventsMessageRequest
+	(*ExportSecurityEventsChunk)(nil),                  // 237: user.ExportSecurityEventsChunk
+	(*SetRecoveryContactMessageRequest)(nil),           // 238: user.SetRecoveryContactMessageRequest
+	(*SetRecoveryContactMessageResponse)(nil),          // 239: user.SetRecoveryContactMessageResponse
+	(*VerifyRecoveryContactMessageRequest)(nil),        // 240: user.VerifyRecoveryContactMessageRequest
+	(*VerifyRecoveryContactMessageResponse)(nil),       // 241: user.VerifyRecoveryContactMessageResponse
+	(*StartAccountRecoveryMessageRequest)(nil),         // 242: user.StartAccountRecoveryMessageRequest
+	(*StartAccountRecoveryMessageResponse)(nil),        // 243: user.StartAccountRecoveryMessageResponse
+	(*ConfirmAccountRecoveryMessageRequest)(nil),       // 244: user.ConfirmAccountRecoveryMessageRequest
+	(*ConfirmAccountRecoveryMessageResponse)(nil),      // 245: user.ConfirmAccountRecoveryMessageResponse
+	(*CompleteAccountRecoveryMessageRequest)(nil),      // 246: user.CompleteAccountRecoveryMessageRequest
+	(*CompleteAccountRecoveryMessageResponse)(nil),     // 247: user.CompleteAccountRecoveryMessageResponse
+	(*CancelAccountRecoveryMessageRequest)(nil),        // 248: user.CancelAccountRecoveryMessageRequest
+	(*CancelAccountRecoveryMessageResponse)(nil),       // 249: user.CancelAccountRecoveryMessageResponse
+	(*SellerAwayMode)(nil),                             // 250: user.SellerAwayMode
+	(*SetAwayModeMessageRequest)(nil),                  // 251: user.SetAwayModeMessageRequest
+	(*SetAwayModeMessageResponse)(nil),                 // 252: user.SetAwayModeMessageResponse
+	(*ClearAwayModeMessageRequest)(nil),                // 253: user.ClearAwayModeMessageRequest
+	(*ClearAwayModeMessageResponse)(nil),               // 254: user.ClearAwayModeMessageResponse
+	(*GetAwayModeMessageRequest)(nil),                  // 255: user.GetAwayModeMessageRequest
+	(*GetAwayModeMessageResponse)(nil),                 // 256: user.GetAwayModeMessageResponse
+	(*SetTaxProfileMessageRequest)(nil),                // 257: user.SetTaxProfileMessageRequest
+	(*SetTaxProfileMessageResponse)(nil),               // 258: user.SetTaxProfileMessageResponse
+	(*GetTaxProfileMessageRequest)(nil),                // 259: user.GetTaxProfileMessageRequest
+	(*GetTaxProfileMessageResponse)(nil),               // 260: user.GetTaxProfileMessageResponse
+	(*AttributeDefinition)(nil),                        // 261: user.AttributeDefinition
+	(*DefineAttributeMessageRequest)(nil),              // 262: user.DefineAttributeMessageRequest
+	(*DefineAttributeMessageResponse)(nil),             // 263: user.DefineAttributeMessageResponse
+	(*ListAttributeDefinitionsMessageRequest)(nil),     // 264: user.ListAttributeDefinitionsMessageRequest
+	(*ListAttributeDefinitionsMessageResponse)(nil),    // 265: user.ListAttributeDefinitionsMessageResponse
+	(*SetAttributesMessageRequest)(nil),                // 266: user.SetAttributesMessageRequest
+	(*SetAttributesMessageResponse)(nil),               // 267: user.SetAttributesMessageResponse
+	(*GetAttributesMessageRequest)(nil),                // 268: user.GetAttributesMessageRequest
+	(*GetAttributesMessageResponse)(nil),               // 269: user.GetAttributesMessageResponse
+	(*FieldChange)(nil),                                // 270: user.FieldChange
+	(*DryRunChange)(nil),                               // 271: user.DryRunChange
+	(*DryRunDiff)(nil),                                 // 272: user.DryRunDiff
+	(*GetProfileHistoryMessageRequest)(nil),            // 273: user.GetProfileHistoryMessageRequest
+	(*ProfileChange)(nil),                              // 274: user.ProfileChange
+	(*GetProfileHistoryMessageResponse)(nil),           // 275: user.GetProfileHistoryMessageResponse
+	(*GetDownloadURLMessageRequest)(nil),               // 276: user.GetDownloadURLMessageRequest
+	(*GetDownloadURLMessageResponse)(nil),              // 277: user.GetDownloadURLMessageResponse
+	(*RegisterUSSDUserMessageRequest)(nil),             // 278: user.RegisterUSSDUserMessageRequest
+	(*RegisterUSSDUserMessageResponse)(nil),            // 279: user.RegisterUSSDUserMessageResponse
+	(*LoginUSSDUserMessageRequest)(nil),                // 280: user.LoginUSSDUserMessageRequest
+	(*LoginUSSDUserMessageResponse)(nil),               // 281: user.LoginUSSDUserMessageResponse
+	(*ChangeUSSDPINMessageRequest)(nil),                // 282: user.ChangeUSSDPINMessageRequest
+	(*ChangeUSSDPINMessageResponse)(nil),               // 283: user.ChangeUSSDPINMessageResponse
+	(*SetDevicePINMessageRequest)(nil),                 // 284: user.SetDevicePINMessageRequest
+	(*SetDevicePINMessageResponse)(nil),                // 285: user.SetDevicePINMessageResponse
+	(*RemoveDevicePINMessageRequest)(nil),              // 286: user.RemoveDevicePINMessageRequest
+	(*RemoveDevicePINMessageResponse)(nil),             // 287: user.RemoveDevicePINMessageResponse
+	(*ReAuthenticateWithPINMessageRequest)(nil),        // 288: user.ReAuthenticateWithPINMessageRequest
+	(*ReAuthenticateWithPINMessageResponse)(nil),       // 289: user.ReAuthenticateWithPINMessageResponse
+	(*RegisterDeviceKeyMessageRequest)(nil),            // 290: user.RegisterDeviceKeyMessageRequest
+	(*RegisterDeviceKeyMessageResponse)(nil),           // 291: user.RegisterDeviceKeyMessageResponse
+	(*RemoveDeviceKeyMessageRequest)(nil),              // 292: user.RemoveDeviceKeyMessageRequest
+	(*RemoveDeviceKeyMessageResponse)(nil),             // 293: user.RemoveDeviceKeyMessageResponse
+	(*IssueBiometricChallengeMessageRequest)(nil),      // 294: user.IssueBiometricChallengeMessageRequest
+	(*IssueBiometricChallengeMessageResponse)(nil),     // 295: user.IssueBiometricChallengeMessageResponse
+	(*ReAuthenticateWithBiometricMessageRequest)(nil),  // 296: user.ReAuthenticateWithBiometricMessageRequest
+	(*ReAuthenticateWithBiometricMessageResponse)(nil), // 297: user.ReAuthenticateWithBiometricMessageResponse
+	(*RefreshTokenMessageRequest)(nil),                 // 298: user.RefreshTokenMessageRequest
+	(*RefreshTokenMessageResponse)(nil),                // 299: user.RefreshTokenMessageResponse
+	(*ExchangeTokenMessageRequest)(nil),                // 300: user.ExchangeTokenMessageRequest
+	(*ExchangeTokenMessageResponse)(nil),               // 301: user.ExchangeTokenMessageResponse
+	(*GetQuotaUsageMessageRequest)(nil),                // 302: user.GetQuotaUsageMessageRequest
+	(*QuotaPeriodUsage)(nil),                           // 303: user.QuotaPeriodUsage
+	(*GetQuotaUsageMessageResponse)(nil),               // 304: user.GetQuotaUsageMessageResponse
+	nil,                                                // 305: user.Operation.ProgressEntry
+	nil,                                                // 306: user.Operation.ResultEntry
+	nil,                                                // 307: user.SavedSearch.FiltersEntry
+	nil,                                                // 308: user.SaveSearchMessageRequest.FiltersEntry
+	nil,                                                // 309: user.GetAssignmentsMessageResponse.FlagsEntry
+	nil,                                                // 310: user.SetAttributesMessageRequest.AttributesEntry
+	nil,                                                // 311: user.GetAttributesMessageResponse.AttributesEntry
+	nil,                                                // 312: user.DryRunDiff.CountsEntry
+}
+var file_user_proto_depIdxs = []int32{
+	4,   // 0: user.BillingAddress.location:type_name -> user.GeoPoint
+	5,   // 1: user.GetBillingProfileMessageResponse.billingAddress:type_name -> user.BillingAddress
+	6,   // 2: user.GetBillingProfileMessageResponse.taxIdentifiers:type_name -> user.TaxIdentifier
+	5,   // 3: user.UpdateBillingProfileMessageRequest.billingAddress:type_name -> user.BillingAddress
+	6,   // 4: user.UpdateBillingProfileMessageRequest.taxIdentifiers:type_name -> user.TaxIdentifier
+	11,  // 5: user.GetUserSegmentsMessageResponse.demographics:type_name -> user.Demographics
+	14,  // 6: user.GetUserStatsMessageResponse.dailyRegistrations:type_name -> user.PeriodCount
+	14,  // 7: user.GetUserStatsMessageResponse.weeklyRegistrations:type_name -> user.PeriodCount
+	14,  // 8: user.GetUserStatsMessageResponse.dailyDeletions:type_name -> user.PeriodCount
+	20,  // 9: user.ListOutboxEventsMessageRequest.filter:type_name -> user.OutboxEventFilter
+	19,  // 10: user.ListOutboxEventsMessageResponse.events:type_name -> user.OutboxEvent
+	20,  // 11: user.RepublishOutboxEventsMessageRequest.filter:type_name -> user.OutboxEventFilter
+	19,  // 12: user.DeadLetter.event:type_name -> user.OutboxEvent
+	25,  // 13: user.ListDeadLettersMessageResponse.deadLetters:type_name -> user.DeadLetter
+	30,  // 14: user.SetNotificationPreferencesMessageRequest.preferences:type_name -> user.NotificationPreference
+	55,  // 15: user.UploadKYCDocumentMessageRequest.info:type_name -> user.KYCDocumentInfo
+	58,  // 16: user.KYCReviewItem.documents:type_name -> user.KYCDocument
+	59,  // 17: user.ListKYCReviewQueueMessageResponse.items:type_name -> user.KYCReviewItem
+	74,  // 18: user.CreditWalletMessageResponse.transaction:type_name -> user.WalletTransaction
+	74,  // 19: user.DebitWalletMessageResponse.transaction:type_name -> user.WalletTransaction
+	74,  // 20: user.GetWalletMessageResponse.transactions:type_name -> user.WalletTransaction
+	81,  // 21: user.AttachGiftCardMessageResponse.giftCard:type_name -> user.GiftCard
+	81,  // 22: user.ListGiftCardsMessageResponse.giftCards:type_name -> user.GiftCard
+	81,  // 23: user.GetGiftCardBalanceMessageResponse.giftCard:type_name -> user.GiftCard
+	88,  // 24: user.GrantCouponMessageResponse.coupon:type_name -> user.Coupon
+	88,  // 25: user.ListCouponsMessageResponse.coupons:type_name -> user.Coupon
+	88,  // 26: user.ReserveCouponMessageResponse.coupon:type_name -> user.Coupon
+	88,  // 27: user.RedeemCouponMessageResponse.coupon:type_name -> user.Coupon
+	88,  // 28: user.ReleaseCouponMessageResponse.coupon:type_name -> user.Coupon
+	105, // 29: user.LinkTicketMessageResponse.ticket:type_name -> user.SupportTicket
+	106, // 30: user.ListTicketsMessageResponse.customer:type_name -> user.SupportCustomer
+	105, // 31: user.ListTicketsMessageResponse.tickets:type_name -> user.SupportTicket
+	113, // 32: user.GetPresenceMessageResponse.presences:type_name -> user.UserPresence
+	117, // 33: user.SuggestUsersMessageResponse.suggestions:type_name -> user.UserSuggestion
+	120, // 34: user.DuplicateCandidate.userA:type_name -> user.DuplicateUser
+	120, // 35: user.DuplicateCandidate.userB:type_name -> user.DuplicateUser
+	121, // 36: user.ListDuplicateCandidatesMessageResponse.candidates:type_name -> user.DuplicateCandidate
+	272, // 37: user.ResolveDuplicateCandidateMessageResponse.diff:type_name -> user.DryRunDiff
+	305, // 38: user.Operation.progress:type_name -> user.Operation.ProgressEntry
+	306, // 39: user.Operation.result:type_name -> user.Operation.ResultEntry
+	125, // 40: user.GetOperationMessageResponse.operation:type_name -> user.Operation
+	125, // 41: user.ListOperationsMessageResponse.operations:type_name -> user.Operation
+	125, // 42: user.StartComplianceExportMessageResponse.operation:type_name -> user.Operation
+	125, // 43: user.StartUserErasureMessageResponse.operation:type_name -> user.Operation
+	272, // 44: user.StartUserErasureMessageResponse.diff:type_name -> user.DryRunDiff
+	125, // 45: user.StartUserImportMessageResponse.operation:type_name -> user.Operation
+	138, // 46: user.BulkUpdateUsersMessageRequest.filter:type_name -> user.BulkUserFilter
+	139, // 47: user.BulkUpdateUsersMessageRequest.patch:type_name -> user.BulkUserPatch
+	125, // 48: user.BulkUpdateUsersMessageResponse.operation:type_name -> user.Operation
+	272, // 49: user.BulkUpdateUsersMessageResponse.diff:type_name -> user.DryRunDiff
+	145, // 50: user.MethodSLOStatus.windows:type_name -> user.SLOWindow
+	146, // 51: user.GetSLOStatusMessageResponse.methods:type_name -> user.MethodSLOStatus
+	148, // 52: user.SubAccount.restrictions:type_name -> user.SubAccountRestrictions
+	148, // 53: user.CreateSubAccountMessageRequest.restrictions:type_name -> user.SubAccountRestrictions
+	149, // 54: user.CreateSubAccountMessageResponse.subAccount:type_name -> user.SubAccount
+	149, // 55: user.ListSubAccountsMessageResponse.subAccounts:type_name -> user.SubAccount
+	148, // 56: user.SetSubAccountRestrictionsMessageRequest.restrictions:type_name -> user.SubAccountRestrictions
+	149, // 57: user.SetSubAccountRestrictionsMessageResponse.subAccount:type_name -> user.SubAccount
+	156, // 58: user.OrgMembership.organization:type_name -> user.Organization
+	156, // 59: user.CreateOrganizationMessageResponse.organization:type_name -> user.Organization
+	157, // 60: user.InviteOrgMemberMessageResponse.member:type_name -> user.OrgMember
+	157, // 61: user.AcceptOrgInviteMessageResponse.member:type_name -> user.OrgMember
+	157, // 62: user.SetOrgMemberRoleMessageResponse.member:type_name -> user.OrgMember
+	157, // 63: user.ListOrgMembersMessageResponse.members:type_name -> user.OrgMember
+	158, // 64: user.ListUserOrganizationsMessageResponse.memberships:type_name -> user.OrgMembership
+	173, // 65: user.CreateInviteMessageResponse.invite:type_name -> user.Invite
+	173, // 66: user.GetInviteMessageResponse.invite:type_name -> user.Invite
+	173, // 67: user.AcceptInviteMessageResponse.invite:type_name -> user.Invite
+	307, // 68: user.SavedSearch.filters:type_name -> user.SavedSearch.FiltersEntry
+	308, // 69: user.SaveSearchMessageRequest.filters:type_name -> user.SaveSearchMessageRequest.FiltersEntry
+	180, // 70: user.SaveSearchMessageResponse.savedSearch:type_name -> user.SavedSearch
+	180, // 71: user.ListSavedSearchesMessageResponse.savedSearches:type_name -> user.SavedSearch
+	187, // 72: user.SubscribeProductAlertMessageResponse.alert:type_name -> user.ProductAlert
+	187, // 73: user.ListProductAlertsMessageResponse.alerts:type_name -> user.ProductAlert
+	196, // 74: user.GetRecentlyViewedMessageResponse.products:type_name -> user.ViewedProduct
+	201, // 75: user.UploadAvatarMessageRequest.info:type_name -> user.AvatarInfo
+	204, // 76: user.ListModerationQueueMessageResponse.items:type_name -> user.ModerationItem
+	211, // 77: user.GetPublicProfilesMessageResponse.profiles:type_name -> user.PublicProfile
+	222, // 78: user.SetDigestPreferencesMessageRequest.preferences:type_name -> user.DigestPreference
+	222, // 79: user.GetDigestPreferencesMessageResponse.preferences:type_name -> user.DigestPreference
+	227, // 80: user.GetDueDigestsMessageResponse.digests:type_name -> user.DueDigest
+	230, // 81: user.GetAssignmentsMessageResponse.assignments:type_name -> user.ExperimentAssignment
+	309, // 82: user.GetAssignmentsMessageResponse.flags:type_name -> user.GetAssignmentsMessageResponse.FlagsEntry
+	234, // 83: user.GetSecurityStatusMessageResponse.issues:type_name -> user.SecurityIssue
+	250, // 84: user.SetAwayModeMessageResponse.awayMode:type_name -> user.SellerAwayMode
+	250, // 85: user.GetAwayModeMessageResponse.awayMode:type_name -> user.SellerAwayMode
+	261, // 86: user.DefineAttributeMessageRequest.definition:type_name -> user.AttributeDefinition
+	261, // 87: user.DefineAttributeMessageResponse.definition:type_name -> user.AttributeDefinition
+	261, // 88: user.ListAttributeDefinitionsMessageResponse.definitions:type_name -> user.AttributeDefinition
+	310, // 89: user.SetAttributesMessageRequest.attributes:type_name -> user.SetAttributesMessageRequest.AttributesEntry
+	311, // 90: user.GetAttributesMessageResponse.attributes:type_name -> user.GetAttributesMessageResponse.AttributesEntry
+	270, // 91: user.DryRunChange.fields:type_name -> user.FieldChange
+	271, // 92: user.DryRunDiff.changes:type_name -> user.DryRunChange
+	312, // 93: user.DryRunDiff.counts:type_name -> user.DryRunDiff.CountsEntry
+	274, // 94: user.GetProfileHistoryMessageResponse.changes:type_name -> user.ProfileChange
+	303, // 95: user.GetQuotaUsageMessageResponse.periods:type_name -> user.QuotaPeriodUsage
+	2,   // 96: user.UserService.LoginUser:input_type -> user.LoginMessageRequest
+	0,   // 97: user.UserService.RegisterUser:input_type -> user.RegisterMessageRequest
+	7,   // 98: user.UserService.GetBillingProfile:input_type -> user.GetBillingProfileMessageRequest
+	9,   // 99: user.UserService.UpdateBillingProfile:input_type -> user.UpdateBillingProfileMessageRequest
+	12,  // 100: user.UserService.GetUserSegments:input_type -> user.GetUserSegmentsMessageRequest
+	15,  // 101: user.UserService.GetUserStats:input_type -> user.GetUserStatsMessageRequest
+	17,  // 102: user.UserService.WatchUserMetrics:input_type -> user.WatchUserMetricsMessageRequest
+	21,  // 103: user.UserService.ListOutboxEvents:input_type -> user.ListOutboxEventsMessageRequest
+	23,  // 104: user.UserService.RepublishOutboxEvents:input_type -> user.RepublishOutboxEventsMessageRequest
+	26,  // 105: user.UserService.ListDeadLetters:input_type -> user.ListDeadLettersMessageRequest
+	28,  // 106: user.UserService.RequeueDeadLetter:input_type -> user.RequeueDeadLetterMessageRequest
+	31,  // 107: user.UserService.SetNotificationPreferences:input_type -> user.SetNotificationPreferencesMessageRequest
+	33,  // 108: user.UserService.RegisterPushToken:input_type -> user.RegisterPushTokenMessageRequest
+	35,  // 109: user.UserService.VerifyEmail:input_type -> user.VerifyEmailMessageRequest
+	37,  // 110: user.UserService.RequestAccountDeletion:input_type -> user.RequestAccountDeletionMessageRequest
+	39,  // 111: user.UserService.CancelAccountDeletion:input_type -> user.CancelAccountDeletionMessageRequest
+	41,  // 112: user.UserService.GenerateAccessReport:input_type -> user.GenerateAccessReportMessageRequest
+	43,  // 113: user.UserService.SetConsent:input_type -> user.SetConsentMessageRequest
+	45,  // 114: user.UserService.ExportComplianceRecords:input_type -> user.ExportComplianceRecordsMessageRequest
+	47,  // 115: user.UserService.IssueUserToken:input_type -> user.IssueUserTokenMessageRequest
+	49,  // 116: user.UserService.ValidateToken:input_type -> user.ValidateTokenMessageRequest
+	51,  // 117: user.UserService.IssueServiceToken:input_type -> user.IssueServiceTokenMessageRequest
+	53,  // 118: user.UserService.ReAuthenticate:input_type -> user.ReAuthenticateMessageRequest
+	56,  // 119: user.UserService.UploadKYCDocument:input_type -> user.UploadKYCDocumentMessageRequest
+	60,  // 120: user.UserService.ListKYCReviewQueue:input_type -> user.ListKYCReviewQueueMessageRequest
+	62,  // 121: user.UserService.ApproveKYC:input_type -> user.ApproveKYCMessageRequest
+	64,  // 122: user.UserService.RejectKYC:input_type -> user.RejectKYCMessageRequest
+	66,  // 123: user.UserService.StartIdentityVerification:input_type -> user.StartIdentityVerificationMessageRequest
+	68,  // 124: user.UserService.GetIdentityVerification:input_type -> user.GetIdentityVerificationMessageRequest
+	70,  // 125: user.UserService.VerifyPayoutAccount:input_type -> user.VerifyPayoutAccountMessageRequest
+	72,  // 126: user.UserService.GetPayoutVerification:input_type -> user.GetPayoutVerificationMessageRequest
+	75,  // 127: user.UserService.CreditWallet:input_type -> user.CreditWalletMessageRequest
+	77,  // 128: user.UserService.DebitWallet:input_type -> user.DebitWalletMessageRequest
+	79,  // 129: user.UserService.GetWallet:input_type -> user.GetWalletMessageRequest
+	82,  // 130: user.UserService.AttachGiftCard:input_type -> user.AttachGiftCardMessageRequest
+	84,  // 131: user.UserService.ListGiftCards:input_type -> user.ListGiftCardsMessageRequest
+	86,  // 132: user.UserService.GetGiftCardBalance:input_type -> user.GetGiftCardBalanceMessageRequest
+	89,  // 133: user.UserService.GrantCoupon:input_type -> user.GrantCouponMessageRequest
+	91,  // 134: user.UserService.ListCoupons:input_type -> user.ListCouponsMessageRequest
+	93,  // 135: user.UserService.ReserveCoupon:input_type -> user.ReserveCouponMessageRequest
+	95,  // 136: user.UserService.RedeemCoupon:input_type -> user.RedeemCouponMessageRequest
+	97,  // 137: user.UserService.ReleaseCoupon:input_type -> user.ReleaseCouponMessageRequest
+	99,  // 138: user.UserService.SetTimezone:input_type -> user.SetTimezoneMessageRequest
+	101, // 139: user.UserService.SubmitFeedback:input_type -> user.SubmitFeedbackMessageRequest
+	103, // 140: user.UserService.GetFeedbackSummary:input_type -> user.GetFeedbackSummaryMessageRequest
+	107, // 141: user.UserService.LinkTicket:input_type -> user.LinkTicketMessageRequest
+	109, // 142: user.UserService.ListTickets:input_type -> user.ListTicketsMessageRequest
+	111, // 143: user.UserService.UpdatePresence:input_type -> user.UpdatePresenceMessageRequest
+	114, // 144: user.UserService.GetPresence:input_type -> user.GetPresenceMessageRequest
+	116, // 145: user.UserService.SuggestUsers:input_type -> user.SuggestUsersMessageRequest
+	119, // 146: user.UserService.ListDuplicateCandidates:input_type -> user.ListDuplicateCandidatesMessageRequest
+	123, // 147: user.UserService.ResolveDuplicateCandidate:input_type -> user.ResolveDuplicateCandidateMessageRequest
+	140, // 148: user.UserService.BulkUpdateUsers:input_type -> user.BulkUpdateUsersMessageRequest
+	126, // 149: user.UserService.GetOperation:input_type -> user.GetOperationMessageRequest
+	128, // 150: user.UserService.ListOperations:input_type -> user.ListOperationsMessageRequest
+	130, // 151: user.UserService.CancelOperation:input_type -> user.CancelOperationMessageRequest
+	132, // 152: user.UserService.StartComplianceExport:input_type -> user.StartComplianceExportMessageRequest
+	134, // 153: user.UserService.StartUserErasure:input_type -> user.StartUserErasureMessageRequest
+	136, // 154: user.UserService.StartUserImport:input_type -> user.StartUserImportMessageRequest
+	142, // 155: user.UserService.GetServerInfo:input_type -> user.GetServerInfoMessageRequest
+	144, // 156: user.UserService.GetSLOStatus:input_type -> user.GetSLOStatusMessageRequest
+	150, // 157: user.UserService.CreateSubAccount:input_type -> user.CreateSubAccountMessageRequest
+	152, // 158: user.UserService.ListSubAccounts:input_type -> user.ListSubAccountsMessageRequest
+	154, // 159: user.UserService.SetSubAccountRestrictions:input_type -> user.SetSubAccountRestrictionsMessageRequest
+	159, // 160: user.UserService.CreateOrganization:input_type -> user.CreateOrganizationMessageRequest
+	161, // 161: user.UserService.InviteOrgMember:input_type -> user.InviteOrgMemberMessageRequest
+	163, // 162: user.UserService.AcceptOrgInvite:input_type -> user.AcceptOrgInviteMessageRequest
+	165, // 163: user.UserService.SetOrgMemberRole:input_type -> user.SetOrgMemberRoleMessageRequest
+	167, // 164: user.UserService.RemoveOrgMember:input_type -> user.RemoveOrgMemberMessageRequest
+	169, // 165: user.UserService.ListOrgMembers:input_type -> user.ListOrgMembersMessageRequest
+	171, // 166: user.UserService.ListUserOrganizations:input_type -> user.ListUserOrganizationsMessageRequest
+	174, // 167: user.UserService.CreateInvite:input_type -> user.CreateInviteMessageRequest
+	176, // 168: user.UserService.GetInvite:input_type -> user.GetInviteMessageRequest
+	178, // 169: user.UserService.AcceptInvite:input_type -> user.AcceptInviteMessageRequest
+	181, // 170: user.UserService.SaveSearch:input_type -> user.SaveSearchMessageRequest
+	183, // 171: user.UserService.ListSavedSearches:input_type -> user.ListSavedSearchesMessageRequest
+	185, // 172: user.UserService.DeleteSavedSearch:input_type -> user.DeleteSavedSearchMessageRequest
+	188, // 173: user.UserService.SubscribeProductAlert:input_type -> user.SubscribeProductAlertMessageRequest
+	190, // 174: user.UserService.ListProductAlerts:input_type -> user.ListProductAlertsMessageRequest
+	192, // 175: user.UserService.DeleteProductAlert:input_type -> user.DeleteProductAlertMessageRequest
+	194, // 176: user.UserService.RecordProductView:input_type -> user.RecordProductViewMessageRequest
+	197, // 177: user.UserService.GetRecentlyViewed:input_type -> user.GetRecentlyViewedMessageRequest
+	199, // 178: user.UserService.UpdateDisplayName:input_type -> user.UpdateDisplayNameMessageRequest
+	202, // 179: user.UserService.UploadAvatar:input_type -> user.UploadAvatarMessageRequest
+	205, // 180: user.UserService.ListModerationQueue:input_type -> user.ListModerationQueueMessageRequest
+	207, // 181: user.UserService.ReviewModeration:input_type -> user.ReviewModerationMessageRequest
+	209, // 182: user.UserService.GetPublicProfile:input_type -> user.GetPublicProfileMessageRequest
+	212, // 183: user.UserService.GetPublicProfiles:input_type -> user.GetPublicProfilesMessageRequest
+	214, // 184: user.UserService.SetShadowBan:input_type -> user.SetShadowBanMessageRequest
+	216, // 185: user.UserService.GetUserProfile:input_type -> user.GetUserProfileMessageRequest
+	218, // 186: user.UserService.SendPhoneVerification:input_type -> user.SendPhoneVerificationMessageRequest
+	220, // 187: user.UserService.VerifyPhone:input_type -> user.VerifyPhoneMessageRequest
+	223, // 188: user.UserService.SetDigestPreferences:input_type -> user.SetDigestPreferencesMessageRequest
+	225, // 189: user.UserService.GetDigestPreferences:input_type -> user.GetDigestPreferencesMessageRequest
+	228, // 190: user.UserService.GetDueDigests:input_type -> user.GetDueDigestsMessageRequest
+	231, // 191: user.UserService.GetAssignments:input_type -> user.GetAssignmentsMessageRequest
+	233, // 192: user.UserService.GetSecurityStatus:input_type -> user.GetSecurityStatusMessageRequest
+	236, // 193: user.UserService.ExportSecurityEvents:input_type -> user.ExportSecurityEventsMessageRequest
+	238, // 194: user.UserService.SetRecoveryContact:input_type -> user.SetRecoveryContactMessageRequest
+	240, // 195: user.UserService.VerifyRecoveryContact:input_type -> user.VerifyRecoveryContactMessageRequest
+	242, // 196: user.UserService.StartAccountRecovery:input_type -> user.StartAccountRecoveryMessageRequest
+	244, // 197: user.UserService.ConfirmAccountRecovery:input_type -> user.ConfirmAccountRecoveryMessageRequest
+	246, // 198: user.UserService.CompleteAccountRecovery:input_type -> user.CompleteAccountRecoveryMessageRequest
+	248, // 199: user.UserService.CancelAccountRecovery:input_type -> user.CancelAccountRecoveryMessageRequest
+	251, // 200: user.UserService.SetAwayMode:input_type -> user.SetAwayModeMessageRequest
+	253, // 201: user.UserService.ClearAwayMode:input_type -> user.ClearAwayModeMessageRequest
+	255, // 202: user.UserService.GetAwayMode:input_type -> user.GetAwayModeMessageRequest
+	257, // 203: user.UserService.SetTaxProfile:input_type -> user.SetTaxProfileMessageRequest
+	259, // 204: user.UserService.GetTaxProfile:input_type -> user.GetTaxProfileMessageRequest
+	262, // 205: user.UserService.DefineAttribute:input_type -> user.DefineAttributeMessageRequest
+	264, // 206: user.UserService.ListAttributeDefinitions:input_type -> user.ListAttributeDefinitionsMessageRequest
+	266, // 207: user.UserService.SetAttributes:input_type -> user.SetAttributesMessageRequest
+	268, // 208: user.UserService.GetAttributes:input_type -> user.GetAttributesMessageRequest
+	273, // 209: user.UserService.GetProfileHistory:input_type -> user.GetProfileHistoryMessageRequest
+	276, // 210: user.UserService.GetDownloadURL:input_type -> user.GetDownloadURLMessageRequest
+	278, // 211: user.UserService.RegisterUSSDUser:input_type -> user.RegisterUSSDUserMessageRequest
+	280, // 212: user.UserService.LoginUSSDUser:input_type -> user.LoginUSSDUserMessageRequest
+	282, // 213: user.UserService.ChangeUSSDPIN:input_type -> user.ChangeUSSDPINMessageRequest
+	284, // 214: user.UserService.SetDevicePIN:input_type -> user.SetDevicePINMessageRequest
+	286, // 215: user.UserService.RemoveDevicePIN:input_type -> user.RemoveDevicePINMessageRequest
+	288, // 216: user.UserService.ReAuthenticateWithPIN:input_type -> user.ReAuthenticateWithPINMessageRequest
+	290, // 217: user.UserService.RegisterDeviceKey:input_type -> user.RegisterDeviceKeyMessageRequest
+	292, // 218: user.UserService.RemoveDeviceKey:input_type -> user.RemoveDeviceKeyMessageRequest
+	294, // 219: user.UserService.IssueBiometricChallenge:input_type -> user.IssueBiometricChallengeMessageRequest
+	296, // 220: user.UserService.ReAuthenticateWithBiometric:input_type -> user.ReAuthenticateWithBiometricMessageRequest
+	298, // 221: user.UserService.RefreshToken:input_type -> user.RefreshTokenMessageRequest
+	300, // 222: user.UserService.ExchangeToken:input_type -> user.ExchangeTokenMessageRequest
+	302, // 223: user.UserService.GetQuotaUsage:input_type -> user.GetQuotaUsageMessageRequest
+	3,   // 224: user.UserService.LoginUser:output_type -> user.LoginMessageResponse
+	1,   // 225: user.UserService.RegisterUser:output_type -> user.RegisterMessageResponse
+	8,   // 226: user.UserService.GetBillingProfile:output_type -> user.GetBillingProfileMessageResponse
+	10,  // 227: user.UserService.UpdateBillingProfile:output_type -> user.UpdateBillingProfileMessageResponse
+	13,  // 228: user.UserService.GetUserSegments:output_type -> user.GetUserSegmentsMessageResponse
+	16,  // 229: user.UserService.GetUserStats:output_type -> user.GetUserStatsMessageResponse
+	18,  // 230: user.UserService.WatchUserMetrics:output_type -> user.UserMetricsSnapshot
+	22,  // 231: user.UserService.ListOutboxEvents:output_type -> user.ListOutboxEventsMessageResponse
+	24,  // 232: user.UserService.RepublishOutboxEvents:output_type -> user.RepublishOutboxEventsMessageResponse
+	27,  // 233: user.UserService.ListDeadLetters:output_type -> user.ListDeadLettersMessageResponse
+	29,  // 234: user.UserService.RequeueDeadLetter:output_type -> user.RequeueDeadLetterMessageResponse
+	32,  // 235: user.UserService.SetNotificationPreferences:output_type -> user.SetNotificationPreferencesMessageResponse
+	34,  // 236: user.UserService.RegisterPushToken:output_type -> user.RegisterPushTokenMessageResponse
+	36,  // 237: user.UserService.VerifyEmail:output_type -> user.VerifyEmailMessageResponse
+	38,  // 238: user.UserService.RequestAccountDeletion:output_type -> user.RequestAccountDeletionMessageResponse
+	40,  // 239: user.UserService.CancelAccountDeletion:output_type -> user.CancelAccountDeletionMessageResponse
+	42,  // 240: user.UserService.GenerateAccessReport:output_type -> user.GenerateAccessReportMessageResponse
+	44,  // 241: user.UserService.SetConsent:output_type -> user.SetConsentMessageResponse
+	46,  // 242: user.UserService.ExportComplianceRecords:output_type -> user.ExportComplianceRecordsMessageResponse
+	48,  // 243: user.UserService.IssueUserToken:output_type -> user.IssueUserTokenMessageResponse
+	50,  // 244: user.UserService.ValidateToken:output_type -> user.ValidateTokenMessageResponse
+	52,  // 245: user.UserService.IssueServiceToken:output_type -> user.IssueServiceTokenMessageResponse
+	54,  // 246: user.UserService.ReAuthenticate:output_type -> user.ReAuthenticateMessageResponse
+	57,  // 247: user.UserService.UploadKYCDocument:output_type -> user.UploadKYCDocumentMessageResponse
+	61,  // 248: user.UserService.ListKYCReviewQueue:output_type -> user.ListKYCReviewQueueMessageResponse
+	63,  // 249: user.UserService.ApproveKYC:output_type -> user.ApproveKYCMessageResponse
+	65,  // 250: user.UserService.RejectKYC:output_type -> user.RejectKYCMessageResponse
+	67,  // 251: user.UserService.StartIdentityVerification:output_type -> user.StartIdentityVerificationMessageResponse
+	69,  // 252: user.UserService.GetIdentityVerification:output_type -> user.GetIdentityVerificationMessageResponse
+	71,  // 253: user.UserService.VerifyPayoutAccount:output_type -> user.VerifyPayoutAccountMessageResponse
+	73,  // 254: user.UserService.GetPayoutVerification:output_type -> user.GetPayoutVerificationMessageResponse
+	76,  // 255: user.UserService.CreditWallet:output_type -> user.CreditWalletMessageResponse
+	78,  // 256: user.UserService.DebitWallet:output_type -> user.DebitWalletMessageResponse
+	80,  // 257: user.UserService.GetWallet:output_type -> user.GetWalletMessageResponse
+	83,  // 258: user.UserService.AttachGiftCard:output_type -> user.AttachGiftCardMessageResponse
+	85,  // 259: user.UserService.ListGiftCards:output_type -> user.ListGiftCardsMessageResponse
+	87,  // 260: user.UserService.GetGiftCardBalance:output_type -> user.GetGiftCardBalanceMessageResponse
+	90,  // 261: user.UserService.GrantCoupon:output_type -> user.GrantCouponMessageResponse
+	92,  // 262: user.UserService.ListCoupons:output_type -> user.ListCouponsMessageResponse
+	94,  // 263: user.UserService.ReserveCoupon:output_type -> user.ReserveCouponMessageResponse
+	96,  // 264: user.UserService.RedeemCoupon:output_type -> user.RedeemCouponMessageResponse
+	98,  // 265: user.UserService.ReleaseCoupon:output_type -> user.ReleaseCouponMessageResponse
+	100, // 266: user.UserService.SetTimezone:output_type -> user.SetTimezoneMessageResponse
+	102, // 267: user.UserService.SubmitFeedback:output_type -> user.SubmitFeedbackMessageResponse
+	104, // 268: user.UserService.GetFeedbackSummary:output_type -> user.GetFeedbackSummaryMessageResponse
+	108, // 269: user.UserService.LinkTicket:output_type -> user.LinkTicketMessageResponse
+	110, // 270: user.UserService.ListTickets:output_type -> user.ListTicketsMessageResponse
+	112, // 271: user.UserService.UpdatePresence:output_type -> user.UpdatePresenceMessageResponse
+	115, // 272: user.UserService.GetPresence:output_type -> user.GetPresenceMessageResponse
+	118, // 273: user.UserService.SuggestUsers:output_type -> user.SuggestUsersMessageResponse
+	122, // 274: user.UserService.ListDuplicateCandidates:output_type -> user.ListDuplicateCandidatesMessageResponse
+	124, // 275: user.UserService.ResolveDuplicateCandidate:output_type -> user.ResolveDuplicateCandidateMessageResponse
+	141, // 276: user.UserService.BulkUpdateUsers:output_type -> user.BulkUpdateUsersMessageResponse
+	127, // 277: user.UserService.GetOperation:output_type -> user.GetOperationMessageResponse
+	129, // 278: user.UserService.ListOperations:output_type -> user.ListOperationsMessageResponse
+	131, // 279: user.UserService.CancelOperation:output_type -> user.CancelOperationMessageResponse
+	133, // 280: user.UserService.StartComplianceExport:output_type -> user.StartComplianceExportMessageResponse
+	135, // 281: user.UserService.StartUserErasure:output_type -> user.StartUserErasureMessageResponse
+	137, // 282: user.UserService.StartUserImport:output_type -> user.StartUserImportMessageResponse
+	143, // 283: user.UserService.GetServerInfo:output_type -> user.GetServerInfoMessageResponse
+	147, // 284: user.UserService.GetSLOStatus:output_type -> user.GetSLOStatusMessageResponse
+	151, // 285: user.UserService.CreateSubAccount:output_type -> user.CreateSubAccountMessageResponse
+	153, // 286: user.UserService.ListSubAccounts:output_type -> user.ListSubAccountsMessageResponse
+	155, // 287: user.UserService.SetSubAccountRestrictions:output_type -> user.SetSubAccountRestrictionsMessageResponse
+	160, // 288: user.UserService.CreateOrganization:output_type -> user.CreateOrganizationMessageResponse
+	162, // 289: user.UserService.InviteOrgMember:output_type -> user.InviteOrgMemberMessageResponse
+	164, // 290: user.UserService.AcceptOrgInvite:output_type -> user.AcceptOrgInviteMessageResponse
+	166, // 291: user.UserService.SetOrgMemberRole:output_type -> user.SetOrgMemberRoleMessageResponse
+	168, // 292: user.UserService.RemoveOrgMember:output_type -> user.RemoveOrgMemberMessageResponse
+	170, // 293: user.UserService.ListOrgMembers:output_type -> user.ListOrgMembersMessageResponse
+	172, // 294: user.UserService.ListUserOrganizations:output_type -> user.ListUserOrganizationsMessageResponse
+	175, // 295: user.UserService.CreateInvite:output_type -> user.CreateInviteMessageResponse
+	177, // 296: user.UserService.GetInvite:output_type -> user.GetInviteMessageResponse
+	179, // 297: user.UserService.AcceptInvite:output_type -> user.AcceptInviteMessageResponse
+	182, // 298: user.UserService.SaveSearch:output_type -> user.SaveSearchMessageResponse
+	184, // 299: user.UserService.ListSavedSearches:output_type -> user.ListSavedSearchesMessageResponse
+	186, // 300: user.UserService.DeleteSavedSearch:output_type -> user.DeleteSavedSearchMessageResponse
+	189, // 301: user.UserService.SubscribeProductAlert:output_type -> user.SubscribeProductAlertMessageResponse
+	191, // 302: user.UserService.ListProductAlerts:output_type -> user.ListProductAlertsMessageResponse
+	193, // 303: user.UserService.DeleteProductAlert:output_type -> user.DeleteProductAlertMessageResponse
+	195, // 304: user.UserService.RecordProductView:output_type -> user.RecordProductViewMessageResponse
+	198, // 305: user.UserService.GetRecentlyViewed:output_type -> user.GetRecentlyViewedMessageResponse
+	200, // 306: user.UserService.UpdateDisplayName:output_type -> user.UpdateDisplayNameMessageResponse
+	203, // 307: user.UserService.UploadAvatar:output_type -> user.UploadAvatarMessageResponse
+	206, // 308: user.UserService.ListModerationQueue:output_type -> user.ListModerationQueueMessageResponse
+	208, // 309: user.UserService.ReviewModeration:output_type -> user.ReviewModerationMessageResponse
+	210, // 310: user.UserService.GetPublicProfile:output_type -> user.GetPublicProfileMessageResponse
+	213, // 311: user.UserService.GetPublicProfiles:output_type -> user.GetPublicProfilesMessageResponse
+	215, // 312: user.UserService.SetShadowBan:output_type -> user.SetShadowBanMessageResponse
+	217, // 313: user.UserService.GetUserProfile:output_type -> user.GetUserProfileMessageResponse
+	219, // 314: user.UserService.SendPhoneVerification:output_type -> user.SendPhoneVerificationMessageResponse
+	221, // 315: user.UserService.VerifyPhone:output_type -> user.VerifyPhoneMessageResponse
+	224, // 316: user.UserService.SetDigestPreferences:output_type -> user.SetDigestPreferencesMessageResponse
+	226, // 317: user.UserService.GetDigestPreferences:output_type -> user.GetDigestPreferencesMessageResponse
+	229, // 318: user.UserService.GetDueDigests:output_type -> user.GetDueDigestsMessageResponse
+	232, // 319: user.UserService.GetAssignments:output_type -> user.GetAssignmentsMessageResponse
+	235, // 320: user.UserService.GetSecurityStatus:output_type -> user.GetSecurityStatusMessageResponse
+	237, // 321: user.UserService.ExportSecurityEvents:output_type -> user.ExportSecurityEventsChunk
+	239, // 322: user.UserService.SetRecoveryContact:output_type -> user.SetRecoveryContactMessageResponse
+	241, // 323: user.UserService.VerifyRecoveryContact:output_type -> user.VerifyRecoveryContactMessageResponse
+	243, // 324: user.UserService.StartAccountRecovery:output_type -> user.StartAccountRecoveryMessageResponse
+	245, // 325: user.UserService.ConfirmAccountRecovery:output_type -> user.ConfirmAccountRecoveryMessageResponse
+	247, // 326: user.UserService.CompleteAccountRecovery:output_type -> user.CompleteAccountRecoveryMessageResponse
+	249, // 327: user.UserService.CancelAccountRecovery:output_type -> user.CancelAccountRecoveryMessageResponse
+	252, // 328: user.UserService.SetAwayMode:output_type -> user.SetAwayModeMessageResponse
+	254, // 329: user.UserService.ClearAwayMode:output_type -> user.ClearAwayModeMessageResponse
+	256, // 330: user.UserService.GetAwayMode:output_type -> user.GetAwayModeMessageResponse
+	258, // 331: user.UserService.SetTaxProfile:output_type -> user.SetTaxProfileMessageResponse
+	260, // 332: user.UserService.GetTaxProfile:output_type -> user.GetTaxProfileMessageResponse
+	263, // 333: user.UserService.DefineAttribute:output_type -> user.DefineAttributeMessageResponse
+	265, // 334: user.UserService.ListAttributeDefinitions:output_type -> user.ListAttributeDefinitionsMessageResponse
+	267, // 335: user.UserService.SetAttributes:output_type -> user.SetAttributesMessageResponse
+	269, // 336: user.UserService.GetAttributes:output_type -> user.GetAttributesMessageResponse
+	275, // 337: user.UserService.GetProfileHistory:output_type -> user.GetProfileHistoryMessageResponse
+	277, // 338: user.UserService.GetDownloadURL:output_type -> user.GetDownloadURLMessageResponse
+	279, // 339: user.UserService.RegisterUSSDUser:output_type -> user.RegisterUSSDUserMessageResponse
+	281, // 340: user.UserService.LoginUSSDUser:output_type -> user.LoginUSSDUserMessageResponse
+	283, // 341: user.UserService.ChangeUSSDPIN:output_type -> user.ChangeUSSDPINMessageResponse
+	285, // 342: user.UserService.SetDevicePIN:output_type -> user.SetDevicePINMessageResponse
+	287, // 343: user.UserService.RemoveDevicePIN:output_type -> user.RemoveDevicePINMessageResponse
+	289, // 344: user.UserService.ReAuthenticateWithPIN:output_type -> user.ReAuthenticateWithPINMessageResponse
+	291, // 345: user.UserService.RegisterDeviceKey:output_type -> user.RegisterDeviceKeyMessageResponse
+	293, // 346: user.UserService.RemoveDeviceKey:output_type -> user.RemoveDeviceKeyMessageResponse
+	295, // 347: user.UserService.IssueBiometricChallenge:output_type -> user.IssueBiometricChallengeMessageResponse
+	297, // 348: user.UserService.ReAuthenticateWithBiometric:output_type -> user.ReAuthenticateWithBiometricMessageResponse
+	299, // 349: user.UserService.RefreshToken:output_type -> user.RefreshTokenMessageResponse
+	301, // 350: user.UserService.ExchangeToken:output_type -> user.ExchangeTokenMessageResponse
+	304, // 351: user.UserService.GetQuotaUsage:output_type -> user.GetQuotaUsageMessageResponse
+	224, // [224:352] is the sub-list for method output_type
+	96,  // [96:224] is the sub-list for method input_type
+	96,  // [96:96] is the sub-list for extension type_name
+	96,  // [96:96] is the sub-list for extension extendee
+	0,   // [0:96] is the sub-list for field type_name
+}
+
+func init() { file_user_proto_init() }
+func file_user_proto_init() {
+	if File_user_proto != nil {
+		return
+	}
+	file_user_proto_msgTypes[56].OneofWrappers = []any{
+		(*UploadKYCDocumentMessageRequest_Info)(nil),
+		(*UploadKYCDocumentMessageRequest_Chunk)(nil),
+	}
+	file_user_proto_msgTypes[202].OneofWrappers = []any{
+		(*UploadAvatarMessageRequest_Info)(nil),
+		(*UploadAvatarMessageRequest_Chunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -330,7 +20848,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   313,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
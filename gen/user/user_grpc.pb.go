@@ -19,8 +19,134 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_LoginUser_FullMethodName    = "/user.UserService/LoginUser"
-	UserService_RegisterUser_FullMethodName = "/user.UserService/RegisterUser"
+	UserService_LoginUser_FullMethodName                   = "/user.UserService/LoginUser"
+	UserService_RegisterUser_FullMethodName                = "/user.UserService/RegisterUser"
+	UserService_GetBillingProfile_FullMethodName           = "/user.UserService/GetBillingProfile"
+	UserService_UpdateBillingProfile_FullMethodName        = "/user.UserService/UpdateBillingProfile"
+	UserService_GetUserSegments_FullMethodName             = "/user.UserService/GetUserSegments"
+	UserService_GetUserStats_FullMethodName                = "/user.UserService/GetUserStats"
+	UserService_WatchUserMetrics_FullMethodName            = "/user.UserService/WatchUserMetrics"
+	UserService_ListOutboxEvents_FullMethodName            = "/user.UserService/ListOutboxEvents"
+	UserService_RepublishOutboxEvents_FullMethodName       = "/user.UserService/RepublishOutboxEvents"
+	UserService_ListDeadLetters_FullMethodName             = "/user.UserService/ListDeadLetters"
+	UserService_RequeueDeadLetter_FullMethodName           = "/user.UserService/RequeueDeadLetter"
+	UserService_SetNotificationPreferences_FullMethodName  = "/user.UserService/SetNotificationPreferences"
+	UserService_RegisterPushToken_FullMethodName           = "/user.UserService/RegisterPushToken"
+	UserService_VerifyEmail_FullMethodName                 = "/user.UserService/VerifyEmail"
+	UserService_RequestAccountDeletion_FullMethodName      = "/user.UserService/RequestAccountDeletion"
+	UserService_CancelAccountDeletion_FullMethodName       = "/user.UserService/CancelAccountDeletion"
+	UserService_GenerateAccessReport_FullMethodName        = "/user.UserService/GenerateAccessReport"
+	UserService_SetConsent_FullMethodName                  = "/user.UserService/SetConsent"
+	UserService_ExportComplianceRecords_FullMethodName     = "/user.UserService/ExportComplianceRecords"
+	UserService_IssueUserToken_FullMethodName              = "/user.UserService/IssueUserToken"
+	UserService_ValidateToken_FullMethodName               = "/user.UserService/ValidateToken"
+	UserService_IssueServiceToken_FullMethodName           = "/user.UserService/IssueServiceToken"
+	UserService_ReAuthenticate_FullMethodName              = "/user.UserService/ReAuthenticate"
+	UserService_UploadKYCDocument_FullMethodName           = "/user.UserService/UploadKYCDocument"
+	UserService_ListKYCReviewQueue_FullMethodName          = "/user.UserService/ListKYCReviewQueue"
+	UserService_ApproveKYC_FullMethodName                  = "/user.UserService/ApproveKYC"
+	UserService_RejectKYC_FullMethodName                   = "/user.UserService/RejectKYC"
+	UserService_StartIdentityVerification_FullMethodName   = "/user.UserService/StartIdentityVerification"
+	UserService_GetIdentityVerification_FullMethodName     = "/user.UserService/GetIdentityVerification"
+	UserService_VerifyPayoutAccount_FullMethodName         = "/user.UserService/VerifyPayoutAccount"
+	UserService_GetPayoutVerification_FullMethodName       = "/user.UserService/GetPayoutVerification"
+	UserService_CreditWallet_FullMethodName                = "/user.UserService/CreditWallet"
+	UserService_DebitWallet_FullMethodName                 = "/user.UserService/DebitWallet"
+	UserService_GetWallet_FullMethodName                   = "/user.UserService/GetWallet"
+	UserService_AttachGiftCard_FullMethodName              = "/user.UserService/AttachGiftCard"
+	UserService_ListGiftCards_FullMethodName               = "/user.UserService/ListGiftCards"
+	UserService_GetGiftCardBalance_FullMethodName          = "/user.UserService/GetGiftCardBalance"
+	UserService_GrantCoupon_FullMethodName                 = "/user.UserService/GrantCoupon"
+	UserService_ListCoupons_FullMethodName                 = "/user.UserService/ListCoupons"
+	UserService_ReserveCoupon_FullMethodName               = "/user.UserService/ReserveCoupon"
+	UserService_RedeemCoupon_FullMethodName                = "/user.UserService/RedeemCoupon"
+	UserService_ReleaseCoupon_FullMethodName               = "/user.UserService/ReleaseCoupon"
+	UserService_SetTimezone_FullMethodName                 = "/user.UserService/SetTimezone"
+	UserService_SubmitFeedback_FullMethodName              = "/user.UserService/SubmitFeedback"
+	UserService_GetFeedbackSummary_FullMethodName          = "/user.UserService/GetFeedbackSummary"
+	UserService_LinkTicket_FullMethodName                  = "/user.UserService/LinkTicket"
+	UserService_ListTickets_FullMethodName                 = "/user.UserService/ListTickets"
+	UserService_UpdatePresence_FullMethodName              = "/user.UserService/UpdatePresence"
+	UserService_GetPresence_FullMethodName                 = "/user.UserService/GetPresence"
+	UserService_SuggestUsers_FullMethodName                = "/user.UserService/SuggestUsers"
+	UserService_ListDuplicateCandidates_FullMethodName     = "/user.UserService/ListDuplicateCandidates"
+	UserService_ResolveDuplicateCandidate_FullMethodName   = "/user.UserService/ResolveDuplicateCandidate"
+	UserService_BulkUpdateUsers_FullMethodName             = "/user.UserService/BulkUpdateUsers"
+	UserService_GetOperation_FullMethodName                = "/user.UserService/GetOperation"
+	UserService_ListOperations_FullMethodName              = "/user.UserService/ListOperations"
+	UserService_CancelOperation_FullMethodName             = "/user.UserService/CancelOperation"
+	UserService_StartComplianceExport_FullMethodName       = "/user.UserService/StartComplianceExport"
+	UserService_StartUserErasure_FullMethodName            = "/user.UserService/StartUserErasure"
+	UserService_StartUserImport_FullMethodName             = "/user.UserService/StartUserImport"
+	UserService_GetServerInfo_FullMethodName               = "/user.UserService/GetServerInfo"
+	UserService_GetSLOStatus_FullMethodName                = "/user.UserService/GetSLOStatus"
+	UserService_CreateSubAccount_FullMethodName            = "/user.UserService/CreateSubAccount"
+	UserService_ListSubAccounts_FullMethodName             = "/user.UserService/ListSubAccounts"
+	UserService_SetSubAccountRestrictions_FullMethodName   = "/user.UserService/SetSubAccountRestrictions"
+	UserService_CreateOrganization_FullMethodName          = "/user.UserService/CreateOrganization"
+	UserService_InviteOrgMember_FullMethodName             = "/user.UserService/InviteOrgMember"
+	UserService_AcceptOrgInvite_FullMethodName             = "/user.UserService/AcceptOrgInvite"
+	UserService_SetOrgMemberRole_FullMethodName            = "/user.UserService/SetOrgMemberRole"
+	UserService_RemoveOrgMember_FullMethodName             = "/user.UserService/RemoveOrgMember"
+	UserService_ListOrgMembers_FullMethodName              = "/user.UserService/ListOrgMembers"
+	UserService_ListUserOrganizations_FullMethodName       = "/user.UserService/ListUserOrganizations"
+	UserService_CreateInvite_FullMethodName                = "/user.UserService/CreateInvite"
+	UserService_GetInvite_FullMethodName                   = "/user.UserService/GetInvite"
+	UserService_AcceptInvite_FullMethodName                = "/user.UserService/AcceptInvite"
+	UserService_SaveSearch_FullMethodName                  = "/user.UserService/SaveSearch"
+	UserService_ListSavedSearches_FullMethodName           = "/user.UserService/ListSavedSearches"
+	UserService_DeleteSavedSearch_FullMethodName           = "/user.UserService/DeleteSavedSearch"
+	UserService_SubscribeProductAlert_FullMethodName       = "/user.UserService/SubscribeProductAlert"
+	UserService_ListProductAlerts_FullMethodName           = "/user.UserService/ListProductAlerts"
+	UserService_DeleteProductAlert_FullMethodName          = "/user.UserService/DeleteProductAlert"
+	UserService_RecordProductView_FullMethodName           = "/user.UserService/RecordProductView"
+	UserService_GetRecentlyViewed_FullMethodName           = "/user.UserService/GetRecentlyViewed"
+	UserService_UpdateDisplayName_FullMethodName           = "/user.UserService/UpdateDisplayName"
+	UserService_UploadAvatar_FullMethodName                = "/user.UserService/UploadAvatar"
+	UserService_ListModerationQueue_FullMethodName         = "/user.UserService/ListModerationQueue"
+	UserService_ReviewModeration_FullMethodName            = "/user.UserService/ReviewModeration"
+	UserService_GetPublicProfile_FullMethodName            = "/user.UserService/GetPublicProfile"
+	UserService_GetPublicProfiles_FullMethodName           = "/user.UserService/GetPublicProfiles"
+	UserService_SetShadowBan_FullMethodName                = "/user.UserService/SetShadowBan"
+	UserService_GetUserProfile_FullMethodName              = "/user.UserService/GetUserProfile"
+	UserService_SendPhoneVerification_FullMethodName       = "/user.UserService/SendPhoneVerification"
+	UserService_VerifyPhone_FullMethodName                 = "/user.UserService/VerifyPhone"
+	UserService_SetDigestPreferences_FullMethodName        = "/user.UserService/SetDigestPreferences"
+	UserService_GetDigestPreferences_FullMethodName        = "/user.UserService/GetDigestPreferences"
+	UserService_GetDueDigests_FullMethodName               = "/user.UserService/GetDueDigests"
+	UserService_GetAssignments_FullMethodName              = "/user.UserService/GetAssignments"
+	UserService_GetSecurityStatus_FullMethodName           = "/user.UserService/GetSecurityStatus"
+	UserService_ExportSecurityEvents_FullMethodName        = "/user.UserService/ExportSecurityEvents"
+	UserService_SetRecoveryContact_FullMethodName          = "/user.UserService/SetRecoveryContact"
+	UserService_VerifyRecoveryContact_FullMethodName       = "/user.UserService/VerifyRecoveryContact"
+	UserService_StartAccountRecovery_FullMethodName        = "/user.UserService/StartAccountRecovery"
+	UserService_ConfirmAccountRecovery_FullMethodName      = "/user.UserService/ConfirmAccountRecovery"
+	UserService_CompleteAccountRecovery_FullMethodName     = "/user.UserService/CompleteAccountRecovery"
+	UserService_CancelAccountRecovery_FullMethodName       = "/user.UserService/CancelAccountRecovery"
+	UserService_SetAwayMode_FullMethodName                 = "/user.UserService/SetAwayMode"
+	UserService_ClearAwayMode_FullMethodName               = "/user.UserService/ClearAwayMode"
+	UserService_GetAwayMode_FullMethodName                 = "/user.UserService/GetAwayMode"
+	UserService_SetTaxProfile_FullMethodName               = "/user.UserService/SetTaxProfile"
+	UserService_GetTaxProfile_FullMethodName               = "/user.UserService/GetTaxProfile"
+	UserService_DefineAttribute_FullMethodName             = "/user.UserService/DefineAttribute"
+	UserService_ListAttributeDefinitions_FullMethodName    = "/user.UserService/ListAttributeDefinitions"
+	UserService_SetAttributes_FullMethodName               = "/user.UserService/SetAttributes"
+	UserService_GetAttributes_FullMethodName               = "/user.UserService/GetAttributes"
+	UserService_GetProfileHistory_FullMethodName           = "/user.UserService/GetProfileHistory"
+	UserService_GetDownloadURL_FullMethodName              = "/user.UserService/GetDownloadURL"
+	UserService_RegisterUSSDUser_FullMethodName            = "/user.UserService/RegisterUSSDUser"
+	UserService_LoginUSSDUser_FullMethodName               = "/user.UserService/LoginUSSDUser"
+	UserService_ChangeUSSDPIN_FullMethodName               = "/user.UserService/ChangeUSSDPIN"
+	UserService_SetDevicePIN_FullMethodName                = "/user.UserService/SetDevicePIN"
+	UserService_RemoveDevicePIN_FullMethodName             = "/user.UserService/RemoveDevicePIN"
+	UserService_ReAuthenticateWithPIN_FullMethodName       = "/user.UserService/ReAuthenticateWithPIN"
+	UserService_RegisterDeviceKey_FullMethodName           = "/user.UserService/RegisterDeviceKey"
+	UserService_RemoveDeviceKey_FullMethodName             = "/user.UserService/RemoveDeviceKey"
+	UserService_IssueBiometricChallenge_FullMethodName     = "/user.UserService/IssueBiometricChallenge"
+	UserService_ReAuthenticateWithBiometric_FullMethodName = "/user.UserService/ReAuthenticateWithBiometric"
+	UserService_RefreshToken_FullMethodName                = "/user.UserService/RefreshToken"
+	UserService_ExchangeToken_FullMethodName               = "/user.UserService/ExchangeToken"
+	UserService_GetQuotaUsage_FullMethodName               = "/user.UserService/GetQuotaUsage"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -29,6 +155,132 @@ const (
 type UserServiceClient interface {
 	LoginUser(ctx context.Context, in *LoginMessageRequest, opts ...grpc.CallOption) (*LoginMessageResponse, error)
 	RegisterUser(ctx context.Context, in *RegisterMessageRequest, opts ...grpc.CallOption) (*RegisterMessageResponse, error)
+	GetBillingProfile(ctx context.Context, in *GetBillingProfileMessageRequest, opts ...grpc.CallOption) (*GetBillingProfileMessageResponse, error)
+	UpdateBillingProfile(ctx context.Context, in *UpdateBillingProfileMessageRequest, opts ...grpc.CallOption) (*UpdateBillingProfileMessageResponse, error)
+	GetUserSegments(ctx context.Context, in *GetUserSegmentsMessageRequest, opts ...grpc.CallOption) (*GetUserSegmentsMessageResponse, error)
+	GetUserStats(ctx context.Context, in *GetUserStatsMessageRequest, opts ...grpc.CallOption) (*GetUserStatsMessageResponse, error)
+	WatchUserMetrics(ctx context.Context, in *WatchUserMetricsMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserMetricsSnapshot], error)
+	ListOutboxEvents(ctx context.Context, in *ListOutboxEventsMessageRequest, opts ...grpc.CallOption) (*ListOutboxEventsMessageResponse, error)
+	RepublishOutboxEvents(ctx context.Context, in *RepublishOutboxEventsMessageRequest, opts ...grpc.CallOption) (*RepublishOutboxEventsMessageResponse, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersMessageRequest, opts ...grpc.CallOption) (*ListDeadLettersMessageResponse, error)
+	RequeueDeadLetter(ctx context.Context, in *RequeueDeadLetterMessageRequest, opts ...grpc.CallOption) (*RequeueDeadLetterMessageResponse, error)
+	SetNotificationPreferences(ctx context.Context, in *SetNotificationPreferencesMessageRequest, opts ...grpc.CallOption) (*SetNotificationPreferencesMessageResponse, error)
+	RegisterPushToken(ctx context.Context, in *RegisterPushTokenMessageRequest, opts ...grpc.CallOption) (*RegisterPushTokenMessageResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailMessageRequest, opts ...grpc.CallOption) (*VerifyEmailMessageResponse, error)
+	RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionMessageRequest, opts ...grpc.CallOption) (*RequestAccountDeletionMessageResponse, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionMessageRequest, opts ...grpc.CallOption) (*CancelAccountDeletionMessageResponse, error)
+	GenerateAccessReport(ctx context.Context, in *GenerateAccessReportMessageRequest, opts ...grpc.CallOption) (*GenerateAccessReportMessageResponse, error)
+	SetConsent(ctx context.Context, in *SetConsentMessageRequest, opts ...grpc.CallOption) (*SetConsentMessageResponse, error)
+	ExportComplianceRecords(ctx context.Context, in *ExportComplianceRecordsMessageRequest, opts ...grpc.CallOption) (*ExportComplianceRecordsMessageResponse, error)
+	IssueUserToken(ctx context.Context, in *IssueUserTokenMessageRequest, opts ...grpc.CallOption) (*IssueUserTokenMessageResponse, error)
+	ValidateToken(ctx context.Context, in *ValidateTokenMessageRequest, opts ...grpc.CallOption) (*ValidateTokenMessageResponse, error)
+	IssueServiceToken(ctx context.Context, in *IssueServiceTokenMessageRequest, opts ...grpc.CallOption) (*IssueServiceTokenMessageResponse, error)
+	ReAuthenticate(ctx context.Context, in *ReAuthenticateMessageRequest, opts ...grpc.CallOption) (*ReAuthenticateMessageResponse, error)
+	UploadKYCDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse], error)
+	ListKYCReviewQueue(ctx context.Context, in *ListKYCReviewQueueMessageRequest, opts ...grpc.CallOption) (*ListKYCReviewQueueMessageResponse, error)
+	ApproveKYC(ctx context.Context, in *ApproveKYCMessageRequest, opts ...grpc.CallOption) (*ApproveKYCMessageResponse, error)
+	RejectKYC(ctx context.Context, in *RejectKYCMessageRequest, opts ...grpc.CallOption) (*RejectKYCMessageResponse, error)
+	StartIdentityVerification(ctx context.Context, in *StartIdentityVerificationMessageRequest, opts ...grpc.CallOption) (*StartIdentityVerificationMessageResponse, error)
+	GetIdentityVerification(ctx context.Context, in *GetIdentityVerificationMessageRequest, opts ...grpc.CallOption) (*GetIdentityVerificationMessageResponse, error)
+	VerifyPayoutAccount(ctx context.Context, in *VerifyPayoutAccountMessageRequest, opts ...grpc.CallOption) (*VerifyPayoutAccountMessageResponse, error)
+	GetPayoutVerification(ctx context.Context, in *GetPayoutVerificationMessageRequest, opts ...grpc.CallOption) (*GetPayoutVerificationMessageResponse, error)
+	CreditWallet(ctx context.Context, in *CreditWalletMessageRequest, opts ...grpc.CallOption) (*CreditWalletMessageResponse, error)
+	DebitWallet(ctx context.Context, in *DebitWalletMessageRequest, opts ...grpc.CallOption) (*DebitWalletMessageResponse, error)
+	GetWallet(ctx context.Context, in *GetWalletMessageRequest, opts ...grpc.CallOption) (*GetWalletMessageResponse, error)
+	AttachGiftCard(ctx context.Context, in *AttachGiftCardMessageRequest, opts ...grpc.CallOption) (*AttachGiftCardMessageResponse, error)
+	ListGiftCards(ctx context.Context, in *ListGiftCardsMessageRequest, opts ...grpc.CallOption) (*ListGiftCardsMessageResponse, error)
+	GetGiftCardBalance(ctx context.Context, in *GetGiftCardBalanceMessageRequest, opts ...grpc.CallOption) (*GetGiftCardBalanceMessageResponse, error)
+	GrantCoupon(ctx context.Context, in *GrantCouponMessageRequest, opts ...grpc.CallOption) (*GrantCouponMessageResponse, error)
+	ListCoupons(ctx context.Context, in *ListCouponsMessageRequest, opts ...grpc.CallOption) (*ListCouponsMessageResponse, error)
+	ReserveCoupon(ctx context.Context, in *ReserveCouponMessageRequest, opts ...grpc.CallOption) (*ReserveCouponMessageResponse, error)
+	RedeemCoupon(ctx context.Context, in *RedeemCouponMessageRequest, opts ...grpc.CallOption) (*RedeemCouponMessageResponse, error)
+	ReleaseCoupon(ctx context.Context, in *ReleaseCouponMessageRequest, opts ...grpc.CallOption) (*ReleaseCouponMessageResponse, error)
+	SetTimezone(ctx context.Context, in *SetTimezoneMessageRequest, opts ...grpc.CallOption) (*SetTimezoneMessageResponse, error)
+	SubmitFeedback(ctx context.Context, in *SubmitFeedbackMessageRequest, opts ...grpc.CallOption) (*SubmitFeedbackMessageResponse, error)
+	GetFeedbackSummary(ctx context.Context, in *GetFeedbackSummaryMessageRequest, opts ...grpc.CallOption) (*GetFeedbackSummaryMessageResponse, error)
+	LinkTicket(ctx context.Context, in *LinkTicketMessageRequest, opts ...grpc.CallOption) (*LinkTicketMessageResponse, error)
+	ListTickets(ctx context.Context, in *ListTicketsMessageRequest, opts ...grpc.CallOption) (*ListTicketsMessageResponse, error)
+	UpdatePresence(ctx context.Context, in *UpdatePresenceMessageRequest, opts ...grpc.CallOption) (*UpdatePresenceMessageResponse, error)
+	GetPresence(ctx context.Context, in *GetPresenceMessageRequest, opts ...grpc.CallOption) (*GetPresenceMessageResponse, error)
+	SuggestUsers(ctx context.Context, in *SuggestUsersMessageRequest, opts ...grpc.CallOption) (*SuggestUsersMessageResponse, error)
+	ListDuplicateCandidates(ctx context.Context, in *ListDuplicateCandidatesMessageRequest, opts ...grpc.CallOption) (*ListDuplicateCandidatesMessageResponse, error)
+	ResolveDuplicateCandidate(ctx context.Context, in *ResolveDuplicateCandidateMessageRequest, opts ...grpc.CallOption) (*ResolveDuplicateCandidateMessageResponse, error)
+	BulkUpdateUsers(ctx context.Context, in *BulkUpdateUsersMessageRequest, opts ...grpc.CallOption) (*BulkUpdateUsersMessageResponse, error)
+	GetOperation(ctx context.Context, in *GetOperationMessageRequest, opts ...grpc.CallOption) (*GetOperationMessageResponse, error)
+	ListOperations(ctx context.Context, in *ListOperationsMessageRequest, opts ...grpc.CallOption) (*ListOperationsMessageResponse, error)
+	CancelOperation(ctx context.Context, in *CancelOperationMessageRequest, opts ...grpc.CallOption) (*CancelOperationMessageResponse, error)
+	StartComplianceExport(ctx context.Context, in *StartComplianceExportMessageRequest, opts ...grpc.CallOption) (*StartComplianceExportMessageResponse, error)
+	StartUserErasure(ctx context.Context, in *StartUserErasureMessageRequest, opts ...grpc.CallOption) (*StartUserErasureMessageResponse, error)
+	StartUserImport(ctx context.Context, in *StartUserImportMessageRequest, opts ...grpc.CallOption) (*StartUserImportMessageResponse, error)
+	GetServerInfo(ctx context.Context, in *GetServerInfoMessageRequest, opts ...grpc.CallOption) (*GetServerInfoMessageResponse, error)
+	GetSLOStatus(ctx context.Context, in *GetSLOStatusMessageRequest, opts ...grpc.CallOption) (*GetSLOStatusMessageResponse, error)
+	CreateSubAccount(ctx context.Context, in *CreateSubAccountMessageRequest, opts ...grpc.CallOption) (*CreateSubAccountMessageResponse, error)
+	ListSubAccounts(ctx context.Context, in *ListSubAccountsMessageRequest, opts ...grpc.CallOption) (*ListSubAccountsMessageResponse, error)
+	SetSubAccountRestrictions(ctx context.Context, in *SetSubAccountRestrictionsMessageRequest, opts ...grpc.CallOption) (*SetSubAccountRestrictionsMessageResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationMessageRequest, opts ...grpc.CallOption) (*CreateOrganizationMessageResponse, error)
+	InviteOrgMember(ctx context.Context, in *InviteOrgMemberMessageRequest, opts ...grpc.CallOption) (*InviteOrgMemberMessageResponse, error)
+	AcceptOrgInvite(ctx context.Context, in *AcceptOrgInviteMessageRequest, opts ...grpc.CallOption) (*AcceptOrgInviteMessageResponse, error)
+	SetOrgMemberRole(ctx context.Context, in *SetOrgMemberRoleMessageRequest, opts ...grpc.CallOption) (*SetOrgMemberRoleMessageResponse, error)
+	RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberMessageRequest, opts ...grpc.CallOption) (*RemoveOrgMemberMessageResponse, error)
+	ListOrgMembers(ctx context.Context, in *ListOrgMembersMessageRequest, opts ...grpc.CallOption) (*ListOrgMembersMessageResponse, error)
+	ListUserOrganizations(ctx context.Context, in *ListUserOrganizationsMessageRequest, opts ...grpc.CallOption) (*ListUserOrganizationsMessageResponse, error)
+	CreateInvite(ctx context.Context, in *CreateInviteMessageRequest, opts ...grpc.CallOption) (*CreateInviteMessageResponse, error)
+	GetInvite(ctx context.Context, in *GetInviteMessageRequest, opts ...grpc.CallOption) (*GetInviteMessageResponse, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteMessageRequest, opts ...grpc.CallOption) (*AcceptInviteMessageResponse, error)
+	SaveSearch(ctx context.Context, in *SaveSearchMessageRequest, opts ...grpc.CallOption) (*SaveSearchMessageResponse, error)
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesMessageRequest, opts ...grpc.CallOption) (*ListSavedSearchesMessageResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchMessageRequest, opts ...grpc.CallOption) (*DeleteSavedSearchMessageResponse, error)
+	SubscribeProductAlert(ctx context.Context, in *SubscribeProductAlertMessageRequest, opts ...grpc.CallOption) (*SubscribeProductAlertMessageResponse, error)
+	ListProductAlerts(ctx context.Context, in *ListProductAlertsMessageRequest, opts ...grpc.CallOption) (*ListProductAlertsMessageResponse, error)
+	DeleteProductAlert(ctx context.Context, in *DeleteProductAlertMessageRequest, opts ...grpc.CallOption) (*DeleteProductAlertMessageResponse, error)
+	RecordProductView(ctx context.Context, in *RecordProductViewMessageRequest, opts ...grpc.CallOption) (*RecordProductViewMessageResponse, error)
+	GetRecentlyViewed(ctx context.Context, in *GetRecentlyViewedMessageRequest, opts ...grpc.CallOption) (*GetRecentlyViewedMessageResponse, error)
+	UpdateDisplayName(ctx context.Context, in *UpdateDisplayNameMessageRequest, opts ...grpc.CallOption) (*UpdateDisplayNameMessageResponse, error)
+	UploadAvatar(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAvatarMessageRequest, UploadAvatarMessageResponse], error)
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueMessageRequest, opts ...grpc.CallOption) (*ListModerationQueueMessageResponse, error)
+	ReviewModeration(ctx context.Context, in *ReviewModerationMessageRequest, opts ...grpc.CallOption) (*ReviewModerationMessageResponse, error)
+	GetPublicProfile(ctx context.Context, in *GetPublicProfileMessageRequest, opts ...grpc.CallOption) (*GetPublicProfileMessageResponse, error)
+	GetPublicProfiles(ctx context.Context, in *GetPublicProfilesMessageRequest, opts ...grpc.CallOption) (*GetPublicProfilesMessageResponse, error)
+	SetShadowBan(ctx context.Context, in *SetShadowBanMessageRequest, opts ...grpc.CallOption) (*SetShadowBanMessageResponse, error)
+	GetUserProfile(ctx context.Context, in *GetUserProfileMessageRequest, opts ...grpc.CallOption) (*GetUserProfileMessageResponse, error)
+	SendPhoneVerification(ctx context.Context, in *SendPhoneVerificationMessageRequest, opts ...grpc.CallOption) (*SendPhoneVerificationMessageResponse, error)
+	VerifyPhone(ctx context.Context, in *VerifyPhoneMessageRequest, opts ...grpc.CallOption) (*VerifyPhoneMessageResponse, error)
+	SetDigestPreferences(ctx context.Context, in *SetDigestPreferencesMessageRequest, opts ...grpc.CallOption) (*SetDigestPreferencesMessageResponse, error)
+	GetDigestPreferences(ctx context.Context, in *GetDigestPreferencesMessageRequest, opts ...grpc.CallOption) (*GetDigestPreferencesMessageResponse, error)
+	GetDueDigests(ctx context.Context, in *GetDueDigestsMessageRequest, opts ...grpc.CallOption) (*GetDueDigestsMessageResponse, error)
+	GetAssignments(ctx context.Context, in *GetAssignmentsMessageRequest, opts ...grpc.CallOption) (*GetAssignmentsMessageResponse, error)
+	GetSecurityStatus(ctx context.Context, in *GetSecurityStatusMessageRequest, opts ...grpc.CallOption) (*GetSecurityStatusMessageResponse, error)
+	ExportSecurityEvents(ctx context.Context, in *ExportSecurityEventsMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSecurityEventsChunk], error)
+	SetRecoveryContact(ctx context.Context, in *SetRecoveryContactMessageRequest, opts ...grpc.CallOption) (*SetRecoveryContactMessageResponse, error)
+	VerifyRecoveryContact(ctx context.Context, in *VerifyRecoveryContactMessageRequest, opts ...grpc.CallOption) (*VerifyRecoveryContactMessageResponse, error)
+	StartAccountRecovery(ctx context.Context, in *StartAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*StartAccountRecoveryMessageResponse, error)
+	ConfirmAccountRecovery(ctx context.Context, in *ConfirmAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*ConfirmAccountRecoveryMessageResponse, error)
+	CompleteAccountRecovery(ctx context.Context, in *CompleteAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*CompleteAccountRecoveryMessageResponse, error)
+	CancelAccountRecovery(ctx context.Context, in *CancelAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*CancelAccountRecoveryMessageResponse, error)
+	SetAwayMode(ctx context.Context, in *SetAwayModeMessageRequest, opts ...grpc.CallOption) (*SetAwayModeMessageResponse, error)
+	ClearAwayMode(ctx context.Context, in *ClearAwayModeMessageRequest, opts ...grpc.CallOption) (*ClearAwayModeMessageResponse, error)
+	GetAwayMode(ctx context.Context, in *GetAwayModeMessageRequest, opts ...grpc.CallOption) (*GetAwayModeMessageResponse, error)
+	SetTaxProfile(ctx context.Context, in *SetTaxProfileMessageRequest, opts ...grpc.CallOption) (*SetTaxProfileMessageResponse, error)
+	GetTaxProfile(ctx context.Context, in *GetTaxProfileMessageRequest, opts ...grpc.CallOption) (*GetTaxProfileMessageResponse, error)
+	DefineAttribute(ctx context.Context, in *DefineAttributeMessageRequest, opts ...grpc.CallOption) (*DefineAttributeMessageResponse, error)
+	ListAttributeDefinitions(ctx context.Context, in *ListAttributeDefinitionsMessageRequest, opts ...grpc.CallOption) (*ListAttributeDefinitionsMessageResponse, error)
+	SetAttributes(ctx context.Context, in *SetAttributesMessageRequest, opts ...grpc.CallOption) (*SetAttributesMessageResponse, error)
+	GetAttributes(ctx context.Context, in *GetAttributesMessageRequest, opts ...grpc.CallOption) (*GetAttributesMessageResponse, error)
+	GetProfileHistory(ctx context.Context, in *GetProfileHistoryMessageRequest, opts ...grpc.CallOption) (*GetProfileHistoryMessageResponse, error)
+	GetDownloadURL(ctx context.Context, in *GetDownloadURLMessageRequest, opts ...grpc.CallOption) (*GetDownloadURLMessageResponse, error)
+	RegisterUSSDUser(ctx context.Context, in *RegisterUSSDUserMessageRequest, opts ...grpc.CallOption) (*RegisterUSSDUserMessageResponse, error)
+	LoginUSSDUser(ctx context.Context, in *LoginUSSDUserMessageRequest, opts ...grpc.CallOption) (*LoginUSSDUserMessageResponse, error)
+	ChangeUSSDPIN(ctx context.Context, in *ChangeUSSDPINMessageRequest, opts ...grpc.CallOption) (*ChangeUSSDPINMessageResponse, error)
+	SetDevicePIN(ctx context.Context, in *SetDevicePINMessageRequest, opts ...grpc.CallOption) (*SetDevicePINMessageResponse, error)
+	RemoveDevicePIN(ctx context.Context, in *RemoveDevicePINMessageRequest, opts ...grpc.CallOption) (*RemoveDevicePINMessageResponse, error)
+	ReAuthenticateWithPIN(ctx context.Context, in *ReAuthenticateWithPINMessageRequest, opts ...grpc.CallOption) (*ReAuthenticateWithPINMessageResponse, error)
+	RegisterDeviceKey(ctx context.Context, in *RegisterDeviceKeyMessageRequest, opts ...grpc.CallOption) (*RegisterDeviceKeyMessageResponse, error)
+	RemoveDeviceKey(ctx context.Context, in *RemoveDeviceKeyMessageRequest, opts ...grpc.CallOption) (*RemoveDeviceKeyMessageResponse, error)
+	IssueBiometricChallenge(ctx context.Context, in *IssueBiometricChallengeMessageRequest, opts ...grpc.CallOption) (*IssueBiometricChallengeMessageResponse, error)
+	ReAuthenticateWithBiometric(ctx context.Context, in *ReAuthenticateWithBiometricMessageRequest, opts ...grpc.CallOption) (*ReAuthenticateWithBiometricMessageResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenMessageRequest, opts ...grpc.CallOption) (*RefreshTokenMessageResponse, error)
+	ExchangeToken(ctx context.Context, in *ExchangeTokenMessageRequest, opts ...grpc.CallOption) (*ExchangeTokenMessageResponse, error)
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageMessageRequest, opts ...grpc.CallOption) (*GetQuotaUsageMessageResponse, error)
 }
 
 type userServiceClient struct {
@@ -59,81 +311,4101 @@ func (c *userServiceClient) RegisterUser(ctx context.Context, in *RegisterMessag
 	return out, nil
 }
 
+func (c *userServiceClient) GetBillingProfile(ctx context.Context, in *GetBillingProfileMessageRequest, opts ...grpc.CallOption) (*GetBillingProfileMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBillingProfileMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetBillingProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateBillingProfile(ctx context.Context, in *UpdateBillingProfileMessageRequest, opts ...grpc.CallOption) (*UpdateBillingProfileMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBillingProfileMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateBillingProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserSegments(ctx context.Context, in *GetUserSegmentsMessageRequest, opts ...grpc.CallOption) (*GetUserSegmentsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserSegmentsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserSegments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserStats(ctx context.Context, in *GetUserStatsMessageRequest, opts ...grpc.CallOption) (*GetUserStatsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserStatsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) WatchUserMetrics(ctx context.Context, in *WatchUserMetricsMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserMetricsSnapshot], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_WatchUserMetrics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchUserMetricsMessageRequest, UserMetricsSnapshot]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_WatchUserMetricsClient = grpc.ServerStreamingClient[UserMetricsSnapshot]
+
+func (c *userServiceClient) ListOutboxEvents(ctx context.Context, in *ListOutboxEventsMessageRequest, opts ...grpc.CallOption) (*ListOutboxEventsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutboxEventsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListOutboxEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RepublishOutboxEvents(ctx context.Context, in *RepublishOutboxEventsMessageRequest, opts ...grpc.CallOption) (*RepublishOutboxEventsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepublishOutboxEventsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RepublishOutboxEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersMessageRequest, opts ...grpc.CallOption) (*ListDeadLettersMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequeueDeadLetter(ctx context.Context, in *RequeueDeadLetterMessageRequest, opts ...grpc.CallOption) (*RequeueDeadLetterMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueDeadLetterMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RequeueDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetNotificationPreferences(ctx context.Context, in *SetNotificationPreferencesMessageRequest, opts ...grpc.CallOption) (*SetNotificationPreferencesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNotificationPreferencesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RegisterPushToken(ctx context.Context, in *RegisterPushTokenMessageRequest, opts ...grpc.CallOption) (*RegisterPushTokenMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPushTokenMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RegisterPushToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailMessageRequest, opts ...grpc.CallOption) (*VerifyEmailMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionMessageRequest, opts ...grpc.CallOption) (*RequestAccountDeletionMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestAccountDeletionMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionMessageRequest, opts ...grpc.CallOption) (*CancelAccountDeletionMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccountDeletionMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CancelAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GenerateAccessReport(ctx context.Context, in *GenerateAccessReportMessageRequest, opts ...grpc.CallOption) (*GenerateAccessReportMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateAccessReportMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GenerateAccessReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetConsent(ctx context.Context, in *SetConsentMessageRequest, opts ...grpc.CallOption) (*SetConsentMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConsentMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportComplianceRecords(ctx context.Context, in *ExportComplianceRecordsMessageRequest, opts ...grpc.CallOption) (*ExportComplianceRecordsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportComplianceRecordsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportComplianceRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IssueUserToken(ctx context.Context, in *IssueUserTokenMessageRequest, opts ...grpc.CallOption) (*IssueUserTokenMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueUserTokenMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_IssueUserToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ValidateToken(ctx context.Context, in *ValidateTokenMessageRequest, opts ...grpc.CallOption) (*ValidateTokenMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateTokenMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ValidateToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IssueServiceToken(ctx context.Context, in *IssueServiceTokenMessageRequest, opts ...grpc.CallOption) (*IssueServiceTokenMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueServiceTokenMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_IssueServiceToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReAuthenticate(ctx context.Context, in *ReAuthenticateMessageRequest, opts ...grpc.CallOption) (*ReAuthenticateMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReAuthenticateMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ReAuthenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadKYCDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[1], UserService_UploadKYCDocument_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_UploadKYCDocumentClient = grpc.ClientStreamingClient[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse]
+
+func (c *userServiceClient) ListKYCReviewQueue(ctx context.Context, in *ListKYCReviewQueueMessageRequest, opts ...grpc.CallOption) (*ListKYCReviewQueueMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKYCReviewQueueMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListKYCReviewQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ApproveKYC(ctx context.Context, in *ApproveKYCMessageRequest, opts ...grpc.CallOption) (*ApproveKYCMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveKYCMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ApproveKYC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RejectKYC(ctx context.Context, in *RejectKYCMessageRequest, opts ...grpc.CallOption) (*RejectKYCMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectKYCMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RejectKYC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartIdentityVerification(ctx context.Context, in *StartIdentityVerificationMessageRequest, opts ...grpc.CallOption) (*StartIdentityVerificationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartIdentityVerificationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_StartIdentityVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetIdentityVerification(ctx context.Context, in *GetIdentityVerificationMessageRequest, opts ...grpc.CallOption) (*GetIdentityVerificationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIdentityVerificationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetIdentityVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyPayoutAccount(ctx context.Context, in *VerifyPayoutAccountMessageRequest, opts ...grpc.CallOption) (*VerifyPayoutAccountMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPayoutAccountMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyPayoutAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPayoutVerification(ctx context.Context, in *GetPayoutVerificationMessageRequest, opts ...grpc.CallOption) (*GetPayoutVerificationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayoutVerificationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPayoutVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreditWallet(ctx context.Context, in *CreditWalletMessageRequest, opts ...grpc.CallOption) (*CreditWalletMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditWalletMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CreditWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DebitWallet(ctx context.Context, in *DebitWalletMessageRequest, opts ...grpc.CallOption) (*DebitWalletMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebitWalletMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_DebitWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetWallet(ctx context.Context, in *GetWalletMessageRequest, opts ...grpc.CallOption) (*GetWalletMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AttachGiftCard(ctx context.Context, in *AttachGiftCardMessageRequest, opts ...grpc.CallOption) (*AttachGiftCardMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachGiftCardMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_AttachGiftCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListGiftCards(ctx context.Context, in *ListGiftCardsMessageRequest, opts ...grpc.CallOption) (*ListGiftCardsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGiftCardsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListGiftCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGiftCardBalance(ctx context.Context, in *GetGiftCardBalanceMessageRequest, opts ...grpc.CallOption) (*GetGiftCardBalanceMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGiftCardBalanceMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetGiftCardBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GrantCoupon(ctx context.Context, in *GrantCouponMessageRequest, opts ...grpc.CallOption) (*GrantCouponMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantCouponMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GrantCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListCoupons(ctx context.Context, in *ListCouponsMessageRequest, opts ...grpc.CallOption) (*ListCouponsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReserveCoupon(ctx context.Context, in *ReserveCouponMessageRequest, opts ...grpc.CallOption) (*ReserveCouponMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveCouponMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ReserveCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RedeemCoupon(ctx context.Context, in *RedeemCouponMessageRequest, opts ...grpc.CallOption) (*RedeemCouponMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCouponMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RedeemCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReleaseCoupon(ctx context.Context, in *ReleaseCouponMessageRequest, opts ...grpc.CallOption) (*ReleaseCouponMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseCouponMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ReleaseCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetTimezone(ctx context.Context, in *SetTimezoneMessageRequest, opts ...grpc.CallOption) (*SetTimezoneMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTimezoneMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetTimezone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SubmitFeedback(ctx context.Context, in *SubmitFeedbackMessageRequest, opts ...grpc.CallOption) (*SubmitFeedbackMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitFeedbackMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SubmitFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFeedbackSummary(ctx context.Context, in *GetFeedbackSummaryMessageRequest, opts ...grpc.CallOption) (*GetFeedbackSummaryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedbackSummaryMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetFeedbackSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) LinkTicket(ctx context.Context, in *LinkTicketMessageRequest, opts ...grpc.CallOption) (*LinkTicketMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkTicketMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_LinkTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListTickets(ctx context.Context, in *ListTicketsMessageRequest, opts ...grpc.CallOption) (*ListTicketsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePresence(ctx context.Context, in *UpdatePresenceMessageRequest, opts ...grpc.CallOption) (*UpdatePresenceMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePresenceMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdatePresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPresence(ctx context.Context, in *GetPresenceMessageRequest, opts ...grpc.CallOption) (*GetPresenceMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPresenceMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuggestUsers(ctx context.Context, in *SuggestUsersMessageRequest, opts ...grpc.CallOption) (*SuggestUsersMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestUsersMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SuggestUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListDuplicateCandidates(ctx context.Context, in *ListDuplicateCandidatesMessageRequest, opts ...grpc.CallOption) (*ListDuplicateCandidatesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateCandidatesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListDuplicateCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResolveDuplicateCandidate(ctx context.Context, in *ResolveDuplicateCandidateMessageRequest, opts ...grpc.CallOption) (*ResolveDuplicateCandidateMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveDuplicateCandidateMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ResolveDuplicateCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BulkUpdateUsers(ctx context.Context, in *BulkUpdateUsersMessageRequest, opts ...grpc.CallOption) (*BulkUpdateUsersMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateUsersMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_BulkUpdateUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetOperation(ctx context.Context, in *GetOperationMessageRequest, opts ...grpc.CallOption) (*GetOperationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOperationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListOperations(ctx context.Context, in *ListOperationsMessageRequest, opts ...grpc.CallOption) (*ListOperationsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOperationsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CancelOperation(ctx context.Context, in *CancelOperationMessageRequest, opts ...grpc.CallOption) (*CancelOperationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOperationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CancelOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartComplianceExport(ctx context.Context, in *StartComplianceExportMessageRequest, opts ...grpc.CallOption) (*StartComplianceExportMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartComplianceExportMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_StartComplianceExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartUserErasure(ctx context.Context, in *StartUserErasureMessageRequest, opts ...grpc.CallOption) (*StartUserErasureMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartUserErasureMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_StartUserErasure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartUserImport(ctx context.Context, in *StartUserImportMessageRequest, opts ...grpc.CallOption) (*StartUserImportMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartUserImportMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_StartUserImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetServerInfo(ctx context.Context, in *GetServerInfoMessageRequest, opts ...grpc.CallOption) (*GetServerInfoMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerInfoMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetServerInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSLOStatus(ctx context.Context, in *GetSLOStatusMessageRequest, opts ...grpc.CallOption) (*GetSLOStatusMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSLOStatusMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetSLOStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateSubAccount(ctx context.Context, in *CreateSubAccountMessageRequest, opts ...grpc.CallOption) (*CreateSubAccountMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSubAccountMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateSubAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSubAccounts(ctx context.Context, in *ListSubAccountsMessageRequest, opts ...grpc.CallOption) (*ListSubAccountsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubAccountsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSubAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetSubAccountRestrictions(ctx context.Context, in *SetSubAccountRestrictionsMessageRequest, opts ...grpc.CallOption) (*SetSubAccountRestrictionsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSubAccountRestrictionsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetSubAccountRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationMessageRequest, opts ...grpc.CallOption) (*CreateOrganizationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) InviteOrgMember(ctx context.Context, in *InviteOrgMemberMessageRequest, opts ...grpc.CallOption) (*InviteOrgMemberMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteOrgMemberMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_InviteOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptOrgInvite(ctx context.Context, in *AcceptOrgInviteMessageRequest, opts ...grpc.CallOption) (*AcceptOrgInviteMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptOrgInviteMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_AcceptOrgInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetOrgMemberRole(ctx context.Context, in *SetOrgMemberRoleMessageRequest, opts ...grpc.CallOption) (*SetOrgMemberRoleMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOrgMemberRoleMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetOrgMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberMessageRequest, opts ...grpc.CallOption) (*RemoveOrgMemberMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrgMemberMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListOrgMembers(ctx context.Context, in *ListOrgMembersMessageRequest, opts ...grpc.CallOption) (*ListOrgMembersMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrgMembersMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListOrgMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserOrganizations(ctx context.Context, in *ListUserOrganizationsMessageRequest, opts ...grpc.CallOption) (*ListUserOrganizationsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserOrganizationsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateInvite(ctx context.Context, in *CreateInviteMessageRequest, opts ...grpc.CallOption) (*CreateInviteMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetInvite(ctx context.Context, in *GetInviteMessageRequest, opts ...grpc.CallOption) (*GetInviteMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInviteMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptInvite(ctx context.Context, in *AcceptInviteMessageRequest, opts ...grpc.CallOption) (*AcceptInviteMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptInviteMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_AcceptInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SaveSearch(ctx context.Context, in *SaveSearchMessageRequest, opts ...grpc.CallOption) (*SaveSearchMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSearchMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SaveSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesMessageRequest, opts ...grpc.CallOption) (*ListSavedSearchesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchMessageRequest, opts ...grpc.CallOption) (*DeleteSavedSearchMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SubscribeProductAlert(ctx context.Context, in *SubscribeProductAlertMessageRequest, opts ...grpc.CallOption) (*SubscribeProductAlertMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeProductAlertMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SubscribeProductAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListProductAlerts(ctx context.Context, in *ListProductAlertsMessageRequest, opts ...grpc.CallOption) (*ListProductAlertsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductAlertsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListProductAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteProductAlert(ctx context.Context, in *DeleteProductAlertMessageRequest, opts ...grpc.CallOption) (*DeleteProductAlertMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductAlertMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteProductAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RecordProductView(ctx context.Context, in *RecordProductViewMessageRequest, opts ...grpc.CallOption) (*RecordProductViewMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordProductViewMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RecordProductView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetRecentlyViewed(ctx context.Context, in *GetRecentlyViewedMessageRequest, opts ...grpc.CallOption) (*GetRecentlyViewedMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentlyViewedMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetRecentlyViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateDisplayName(ctx context.Context, in *UpdateDisplayNameMessageRequest, opts ...grpc.CallOption) (*UpdateDisplayNameMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDisplayNameMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateDisplayName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadAvatar(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAvatarMessageRequest, UploadAvatarMessageResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[2], UserService_UploadAvatar_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAvatarMessageRequest, UploadAvatarMessageResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_UploadAvatarClient = grpc.ClientStreamingClient[UploadAvatarMessageRequest, UploadAvatarMessageResponse]
+
+func (c *userServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueMessageRequest, opts ...grpc.CallOption) (*ListModerationQueueMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReviewModeration(ctx context.Context, in *ReviewModerationMessageRequest, opts ...grpc.CallOption) (*ReviewModerationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewModerationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ReviewModeration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPublicProfile(ctx context.Context, in *GetPublicProfileMessageRequest, opts ...grpc.CallOption) (*GetPublicProfileMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicProfileMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPublicProfiles(ctx context.Context, in *GetPublicProfilesMessageRequest, opts ...grpc.CallOption) (*GetPublicProfilesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicProfilesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPublicProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetShadowBan(ctx context.Context, in *SetShadowBanMessageRequest, opts ...grpc.CallOption) (*SetShadowBanMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetShadowBanMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetShadowBan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserProfile(ctx context.Context, in *GetUserProfileMessageRequest, opts ...grpc.CallOption) (*GetUserProfileMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserProfileMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendPhoneVerification(ctx context.Context, in *SendPhoneVerificationMessageRequest, opts ...grpc.CallOption) (*SendPhoneVerificationMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendPhoneVerificationMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SendPhoneVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyPhone(ctx context.Context, in *VerifyPhoneMessageRequest, opts ...grpc.CallOption) (*VerifyPhoneMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPhoneMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetDigestPreferences(ctx context.Context, in *SetDigestPreferencesMessageRequest, opts ...grpc.CallOption) (*SetDigestPreferencesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDigestPreferencesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetDigestPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDigestPreferences(ctx context.Context, in *GetDigestPreferencesMessageRequest, opts ...grpc.CallOption) (*GetDigestPreferencesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDigestPreferencesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDigestPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDueDigests(ctx context.Context, in *GetDueDigestsMessageRequest, opts ...grpc.CallOption) (*GetDueDigestsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDueDigestsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDueDigests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAssignments(ctx context.Context, in *GetAssignmentsMessageRequest, opts ...grpc.CallOption) (*GetAssignmentsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssignmentsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSecurityStatus(ctx context.Context, in *GetSecurityStatusMessageRequest, opts ...grpc.CallOption) (*GetSecurityStatusMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecurityStatusMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetSecurityStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportSecurityEvents(ctx context.Context, in *ExportSecurityEventsMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSecurityEventsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[3], UserService_ExportSecurityEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSecurityEventsMessageRequest, ExportSecurityEventsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportSecurityEventsClient = grpc.ServerStreamingClient[ExportSecurityEventsChunk]
+
+func (c *userServiceClient) SetRecoveryContact(ctx context.Context, in *SetRecoveryContactMessageRequest, opts ...grpc.CallOption) (*SetRecoveryContactMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRecoveryContactMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetRecoveryContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyRecoveryContact(ctx context.Context, in *VerifyRecoveryContactMessageRequest, opts ...grpc.CallOption) (*VerifyRecoveryContactMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyRecoveryContactMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyRecoveryContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartAccountRecovery(ctx context.Context, in *StartAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*StartAccountRecoveryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartAccountRecoveryMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_StartAccountRecovery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmAccountRecovery(ctx context.Context, in *ConfirmAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*ConfirmAccountRecoveryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmAccountRecoveryMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmAccountRecovery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CompleteAccountRecovery(ctx context.Context, in *CompleteAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*CompleteAccountRecoveryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteAccountRecoveryMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CompleteAccountRecovery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CancelAccountRecovery(ctx context.Context, in *CancelAccountRecoveryMessageRequest, opts ...grpc.CallOption) (*CancelAccountRecoveryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccountRecoveryMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CancelAccountRecovery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetAwayMode(ctx context.Context, in *SetAwayModeMessageRequest, opts ...grpc.CallOption) (*SetAwayModeMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAwayModeMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetAwayMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClearAwayMode(ctx context.Context, in *ClearAwayModeMessageRequest, opts ...grpc.CallOption) (*ClearAwayModeMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearAwayModeMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ClearAwayMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAwayMode(ctx context.Context, in *GetAwayModeMessageRequest, opts ...grpc.CallOption) (*GetAwayModeMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAwayModeMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAwayMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetTaxProfile(ctx context.Context, in *SetTaxProfileMessageRequest, opts ...grpc.CallOption) (*SetTaxProfileMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaxProfileMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetTaxProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTaxProfile(ctx context.Context, in *GetTaxProfileMessageRequest, opts ...grpc.CallOption) (*GetTaxProfileMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaxProfileMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTaxProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DefineAttribute(ctx context.Context, in *DefineAttributeMessageRequest, opts ...grpc.CallOption) (*DefineAttributeMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefineAttributeMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_DefineAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAttributeDefinitions(ctx context.Context, in *ListAttributeDefinitionsMessageRequest, opts ...grpc.CallOption) (*ListAttributeDefinitionsMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttributeDefinitionsMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAttributeDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetAttributes(ctx context.Context, in *SetAttributesMessageRequest, opts ...grpc.CallOption) (*SetAttributesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAttributesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAttributes(ctx context.Context, in *GetAttributesMessageRequest, opts ...grpc.CallOption) (*GetAttributesMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttributesMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProfileHistory(ctx context.Context, in *GetProfileHistoryMessageRequest, opts ...grpc.CallOption) (*GetProfileHistoryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileHistoryMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetProfileHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDownloadURL(ctx context.Context, in *GetDownloadURLMessageRequest, opts ...grpc.CallOption) (*GetDownloadURLMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDownloadURLMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDownloadURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RegisterUSSDUser(ctx context.Context, in *RegisterUSSDUserMessageRequest, opts ...grpc.CallOption) (*RegisterUSSDUserMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterUSSDUserMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RegisterUSSDUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) LoginUSSDUser(ctx context.Context, in *LoginUSSDUserMessageRequest, opts ...grpc.CallOption) (*LoginUSSDUserMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginUSSDUserMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_LoginUSSDUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ChangeUSSDPIN(ctx context.Context, in *ChangeUSSDPINMessageRequest, opts ...grpc.CallOption) (*ChangeUSSDPINMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUSSDPINMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangeUSSDPIN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetDevicePIN(ctx context.Context, in *SetDevicePINMessageRequest, opts ...grpc.CallOption) (*SetDevicePINMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDevicePINMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_SetDevicePIN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveDevicePIN(ctx context.Context, in *RemoveDevicePINMessageRequest, opts ...grpc.CallOption) (*RemoveDevicePINMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveDevicePINMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveDevicePIN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReAuthenticateWithPIN(ctx context.Context, in *ReAuthenticateWithPINMessageRequest, opts ...grpc.CallOption) (*ReAuthenticateWithPINMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReAuthenticateWithPINMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ReAuthenticateWithPIN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RegisterDeviceKey(ctx context.Context, in *RegisterDeviceKeyMessageRequest, opts ...grpc.CallOption) (*RegisterDeviceKeyMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterDeviceKeyMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RegisterDeviceKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveDeviceKey(ctx context.Context, in *RemoveDeviceKeyMessageRequest, opts ...grpc.CallOption) (*RemoveDeviceKeyMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveDeviceKeyMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveDeviceKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IssueBiometricChallenge(ctx context.Context, in *IssueBiometricChallengeMessageRequest, opts ...grpc.CallOption) (*IssueBiometricChallengeMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueBiometricChallengeMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_IssueBiometricChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReAuthenticateWithBiometric(ctx context.Context, in *ReAuthenticateWithBiometricMessageRequest, opts ...grpc.CallOption) (*ReAuthenticateWithBiometricMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReAuthenticateWithBiometricMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ReAuthenticateWithBiometric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenMessageRequest, opts ...grpc.CallOption) (*RefreshTokenMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExchangeToken(ctx context.Context, in *ExchangeTokenMessageRequest, opts ...grpc.CallOption) (*ExchangeTokenMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeTokenMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_ExchangeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageMessageRequest, opts ...grpc.CallOption) (*GetQuotaUsageMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuotaUsageMessageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetQuotaUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	LoginUser(context.Context, *LoginMessageRequest) (*LoginMessageResponse, error)
 	RegisterUser(context.Context, *RegisterMessageRequest) (*RegisterMessageResponse, error)
+	GetBillingProfile(context.Context, *GetBillingProfileMessageRequest) (*GetBillingProfileMessageResponse, error)
+	UpdateBillingProfile(context.Context, *UpdateBillingProfileMessageRequest) (*UpdateBillingProfileMessageResponse, error)
+	GetUserSegments(context.Context, *GetUserSegmentsMessageRequest) (*GetUserSegmentsMessageResponse, error)
+	GetUserStats(context.Context, *GetUserStatsMessageRequest) (*GetUserStatsMessageResponse, error)
+	WatchUserMetrics(*WatchUserMetricsMessageRequest, grpc.ServerStreamingServer[UserMetricsSnapshot]) error
+	ListOutboxEvents(context.Context, *ListOutboxEventsMessageRequest) (*ListOutboxEventsMessageResponse, error)
+	RepublishOutboxEvents(context.Context, *RepublishOutboxEventsMessageRequest) (*RepublishOutboxEventsMessageResponse, error)
+	ListDeadLetters(context.Context, *ListDeadLettersMessageRequest) (*ListDeadLettersMessageResponse, error)
+	RequeueDeadLetter(context.Context, *RequeueDeadLetterMessageRequest) (*RequeueDeadLetterMessageResponse, error)
+	SetNotificationPreferences(context.Context, *SetNotificationPreferencesMessageRequest) (*SetNotificationPreferencesMessageResponse, error)
+	RegisterPushToken(context.Context, *RegisterPushTokenMessageRequest) (*RegisterPushTokenMessageResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailMessageRequest) (*VerifyEmailMessageResponse, error)
+	RequestAccountDeletion(context.Context, *RequestAccountDeletionMessageRequest) (*RequestAccountDeletionMessageResponse, error)
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionMessageRequest) (*CancelAccountDeletionMessageResponse, error)
+	GenerateAccessReport(context.Context, *GenerateAccessReportMessageRequest) (*GenerateAccessReportMessageResponse, error)
+	SetConsent(context.Context, *SetConsentMessageRequest) (*SetConsentMessageResponse, error)
+	ExportComplianceRecords(context.Context, *ExportComplianceRecordsMessageRequest) (*ExportComplianceRecordsMessageResponse, error)
+	IssueUserToken(context.Context, *IssueUserTokenMessageRequest) (*IssueUserTokenMessageResponse, error)
+	ValidateToken(context.Context, *ValidateTokenMessageRequest) (*ValidateTokenMessageResponse, error)
+	IssueServiceToken(context.Context, *IssueServiceTokenMessageRequest) (*IssueServiceTokenMessageResponse, error)
+	ReAuthenticate(context.Context, *ReAuthenticateMessageRequest) (*ReAuthenticateMessageResponse, error)
+	UploadKYCDocument(grpc.ClientStreamingServer[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse]) error
+	ListKYCReviewQueue(context.Context, *ListKYCReviewQueueMessageRequest) (*ListKYCReviewQueueMessageResponse, error)
+	ApproveKYC(context.Context, *ApproveKYCMessageRequest) (*ApproveKYCMessageResponse, error)
+	RejectKYC(context.Context, *RejectKYCMessageRequest) (*RejectKYCMessageResponse, error)
+	StartIdentityVerification(context.Context, *StartIdentityVerificationMessageRequest) (*StartIdentityVerificationMessageResponse, error)
+	GetIdentityVerification(context.Context, *GetIdentityVerificationMessageRequest) (*GetIdentityVerificationMessageResponse, error)
+	VerifyPayoutAccount(context.Context, *VerifyPayoutAccountMessageRequest) (*VerifyPayoutAccountMessageResponse, error)
+	GetPayoutVerification(context.Context, *GetPayoutVerificationMessageRequest) (*GetPayoutVerificationMessageResponse, error)
+	CreditWallet(context.Context, *CreditWalletMessageRequest) (*CreditWalletMessageResponse, error)
+	DebitWallet(context.Context, *DebitWalletMessageRequest) (*DebitWalletMessageResponse, error)
+	GetWallet(context.Context, *GetWalletMessageRequest) (*GetWalletMessageResponse, error)
+	AttachGiftCard(context.Context, *AttachGiftCardMessageRequest) (*AttachGiftCardMessageResponse, error)
+	ListGiftCards(context.Context, *ListGiftCardsMessageRequest) (*ListGiftCardsMessageResponse, error)
+	GetGiftCardBalance(context.Context, *GetGiftCardBalanceMessageRequest) (*GetGiftCardBalanceMessageResponse, error)
+	GrantCoupon(context.Context, *GrantCouponMessageRequest) (*GrantCouponMessageResponse, error)
+	ListCoupons(context.Context, *ListCouponsMessageRequest) (*ListCouponsMessageResponse, error)
+	ReserveCoupon(context.Context, *ReserveCouponMessageRequest) (*ReserveCouponMessageResponse, error)
+	RedeemCoupon(context.Context, *RedeemCouponMessageRequest) (*RedeemCouponMessageResponse, error)
+	ReleaseCoupon(context.Context, *ReleaseCouponMessageRequest) (*ReleaseCouponMessageResponse, error)
+	SetTimezone(context.Context, *SetTimezoneMessageRequest) (*SetTimezoneMessageResponse, error)
+	SubmitFeedback(context.Context, *SubmitFeedbackMessageRequest) (*SubmitFeedbackMessageResponse, error)
+	GetFeedbackSummary(context.Context, *GetFeedbackSummaryMessageRequest) (*GetFeedbackSummaryMessageResponse, error)
+	LinkTicket(context.Context, *LinkTicketMessageRequest) (*LinkTicketMessageResponse, error)
+	ListTickets(context.Context, *ListTicketsMessageRequest) (*ListTicketsMessageResponse, error)
+	UpdatePresence(context.Context, *UpdatePresenceMessageRequest) (*UpdatePresenceMessageResponse, error)
+	GetPresence(context.Context, *GetPresenceMessageRequest) (*GetPresenceMessageResponse, error)
+	SuggestUsers(context.Context, *SuggestUsersMessageRequest) (*SuggestUsersMessageResponse, error)
+	ListDuplicateCandidates(context.Context, *ListDuplicateCandidatesMessageRequest) (*ListDuplicateCandidatesMessageResponse, error)
+	ResolveDuplicateCandidate(context.Context, *ResolveDuplicateCandidateMessageRequest) (*ResolveDuplicateCandidateMessageResponse, error)
+	BulkUpdateUsers(context.Context, *BulkUpdateUsersMessageRequest) (*BulkUpdateUsersMessageResponse, error)
+	GetOperation(context.Context, *GetOperationMessageRequest) (*GetOperationMessageResponse, error)
+	ListOperations(context.Context, *ListOperationsMessageRequest) (*ListOperationsMessageResponse, error)
+	CancelOperation(context.Context, *CancelOperationMessageRequest) (*CancelOperationMessageResponse, error)
+	StartComplianceExport(context.Context, *StartComplianceExportMessageRequest) (*StartComplianceExportMessageResponse, error)
+	StartUserErasure(context.Context, *StartUserErasureMessageRequest) (*StartUserErasureMessageResponse, error)
+	StartUserImport(context.Context, *StartUserImportMessageRequest) (*StartUserImportMessageResponse, error)
+	GetServerInfo(context.Context, *GetServerInfoMessageRequest) (*GetServerInfoMessageResponse, error)
+	GetSLOStatus(context.Context, *GetSLOStatusMessageRequest) (*GetSLOStatusMessageResponse, error)
+	CreateSubAccount(context.Context, *CreateSubAccountMessageRequest) (*CreateSubAccountMessageResponse, error)
+	ListSubAccounts(context.Context, *ListSubAccountsMessageRequest) (*ListSubAccountsMessageResponse, error)
+	SetSubAccountRestrictions(context.Context, *SetSubAccountRestrictionsMessageRequest) (*SetSubAccountRestrictionsMessageResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationMessageRequest) (*CreateOrganizationMessageResponse, error)
+	InviteOrgMember(context.Context, *InviteOrgMemberMessageRequest) (*InviteOrgMemberMessageResponse, error)
+	AcceptOrgInvite(context.Context, *AcceptOrgInviteMessageRequest) (*AcceptOrgInviteMessageResponse, error)
+	SetOrgMemberRole(context.Context, *SetOrgMemberRoleMessageRequest) (*SetOrgMemberRoleMessageResponse, error)
+	RemoveOrgMember(context.Context, *RemoveOrgMemberMessageRequest) (*RemoveOrgMemberMessageResponse, error)
+	ListOrgMembers(context.Context, *ListOrgMembersMessageRequest) (*ListOrgMembersMessageResponse, error)
+	ListUserOrganizations(context.Context, *ListUserOrganizationsMessageRequest) (*ListUserOrganizationsMessageResponse, error)
+	CreateInvite(context.Context, *CreateInviteMessageRequest) (*CreateInviteMessageResponse, error)
+	GetInvite(context.Context, *GetInviteMessageRequest) (*GetInviteMessageResponse, error)
+	AcceptInvite(context.Context, *AcceptInviteMessageRequest) (*AcceptInviteMessageResponse, error)
+	SaveSearch(context.Context, *SaveSearchMessageRequest) (*SaveSearchMessageResponse, error)
+	ListSavedSearches(context.Context, *ListSavedSearchesMessageRequest) (*ListSavedSearchesMessageResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchMessageRequest) (*DeleteSavedSearchMessageResponse, error)
+	SubscribeProductAlert(context.Context, *SubscribeProductAlertMessageRequest) (*SubscribeProductAlertMessageResponse, error)
+	ListProductAlerts(context.Context, *ListProductAlertsMessageRequest) (*ListProductAlertsMessageResponse, error)
+	DeleteProductAlert(context.Context, *DeleteProductAlertMessageRequest) (*DeleteProductAlertMessageResponse, error)
+	RecordProductView(context.Context, *RecordProductViewMessageRequest) (*RecordProductViewMessageResponse, error)
+	GetRecentlyViewed(context.Context, *GetRecentlyViewedMessageRequest) (*GetRecentlyViewedMessageResponse, error)
+	UpdateDisplayName(context.Context, *UpdateDisplayNameMessageRequest) (*UpdateDisplayNameMessageResponse, error)
+	UploadAvatar(grpc.ClientStreamingServer[UploadAvatarMessageRequest, UploadAvatarMessageResponse]) error
+	ListModerationQueue(context.Context, *ListModerationQueueMessageRequest) (*ListModerationQueueMessageResponse, error)
+	ReviewModeration(context.Context, *ReviewModerationMessageRequest) (*ReviewModerationMessageResponse, error)
+	GetPublicProfile(context.Context, *GetPublicProfileMessageRequest) (*GetPublicProfileMessageResponse, error)
+	GetPublicProfiles(context.Context, *GetPublicProfilesMessageRequest) (*GetPublicProfilesMessageResponse, error)
+	SetShadowBan(context.Context, *SetShadowBanMessageRequest) (*SetShadowBanMessageResponse, error)
+	GetUserProfile(context.Context, *GetUserProfileMessageRequest) (*GetUserProfileMessageResponse, error)
+	SendPhoneVerification(context.Context, *SendPhoneVerificationMessageRequest) (*SendPhoneVerificationMessageResponse, error)
+	VerifyPhone(context.Context, *VerifyPhoneMessageRequest) (*VerifyPhoneMessageResponse, error)
+	SetDigestPreferences(context.Context, *SetDigestPreferencesMessageRequest) (*SetDigestPreferencesMessageResponse, error)
+	GetDigestPreferences(context.Context, *GetDigestPreferencesMessageRequest) (*GetDigestPreferencesMessageResponse, error)
+	GetDueDigests(context.Context, *GetDueDigestsMessageRequest) (*GetDueDigestsMessageResponse, error)
+	GetAssignments(context.Context, *GetAssignmentsMessageRequest) (*GetAssignmentsMessageResponse, error)
+	GetSecurityStatus(context.Context, *GetSecurityStatusMessageRequest) (*GetSecurityStatusMessageResponse, error)
+	ExportSecurityEvents(*ExportSecurityEventsMessageRequest, grpc.ServerStreamingServer[ExportSecurityEventsChunk]) error
+	SetRecoveryContact(context.Context, *SetRecoveryContactMessageRequest) (*SetRecoveryContactMessageResponse, error)
+	VerifyRecoveryContact(context.Context, *VerifyRecoveryContactMessageRequest) (*VerifyRecoveryContactMessageResponse, error)
+	StartAccountRecovery(context.Context, *StartAccountRecoveryMessageRequest) (*StartAccountRecoveryMessageResponse, error)
+	ConfirmAccountRecovery(context.Context, *ConfirmAccountRecoveryMessageRequest) (*ConfirmAccountRecoveryMessageResponse, error)
+	CompleteAccountRecovery(context.Context, *CompleteAccountRecoveryMessageRequest) (*CompleteAccountRecoveryMessageResponse, error)
+	CancelAccountRecovery(context.Context, *CancelAccountRecoveryMessageRequest) (*CancelAccountRecoveryMessageResponse, error)
+	SetAwayMode(context.Context, *SetAwayModeMessageRequest) (*SetAwayModeMessageResponse, error)
+	ClearAwayMode(context.Context, *ClearAwayModeMessageRequest) (*ClearAwayModeMessageResponse, error)
+	GetAwayMode(context.Context, *GetAwayModeMessageRequest) (*GetAwayModeMessageResponse, error)
+	SetTaxProfile(context.Context, *SetTaxProfileMessageRequest) (*SetTaxProfileMessageResponse, error)
+	GetTaxProfile(context.Context, *GetTaxProfileMessageRequest) (*GetTaxProfileMessageResponse, error)
+	DefineAttribute(context.Context, *DefineAttributeMessageRequest) (*DefineAttributeMessageResponse, error)
+	ListAttributeDefinitions(context.Context, *ListAttributeDefinitionsMessageRequest) (*ListAttributeDefinitionsMessageResponse, error)
+	SetAttributes(context.Context, *SetAttributesMessageRequest) (*SetAttributesMessageResponse, error)
+	GetAttributes(context.Context, *GetAttributesMessageRequest) (*GetAttributesMessageResponse, error)
+	GetProfileHistory(context.Context, *GetProfileHistoryMessageRequest) (*GetProfileHistoryMessageResponse, error)
+	GetDownloadURL(context.Context, *GetDownloadURLMessageRequest) (*GetDownloadURLMessageResponse, error)
+	RegisterUSSDUser(context.Context, *RegisterUSSDUserMessageRequest) (*RegisterUSSDUserMessageResponse, error)
+	LoginUSSDUser(context.Context, *LoginUSSDUserMessageRequest) (*LoginUSSDUserMessageResponse, error)
+	ChangeUSSDPIN(context.Context, *ChangeUSSDPINMessageRequest) (*ChangeUSSDPINMessageResponse, error)
+	SetDevicePIN(context.Context, *SetDevicePINMessageRequest) (*SetDevicePINMessageResponse, error)
+	RemoveDevicePIN(context.Context, *RemoveDevicePINMessageRequest) (*RemoveDevicePINMessageResponse, error)
+	ReAuthenticateWithPIN(context.Context, *ReAuthenticateWithPINMessageRequest) (*ReAuthenticateWithPINMessageResponse, error)
+	RegisterDeviceKey(context.Context, *RegisterDeviceKeyMessageRequest) (*RegisterDeviceKeyMessageResponse, error)
+	RemoveDeviceKey(context.Context, *RemoveDeviceKeyMessageRequest) (*RemoveDeviceKeyMessageResponse, error)
+	IssueBiometricChallenge(context.Context, *IssueBiometricChallengeMessageRequest) (*IssueBiometricChallengeMessageResponse, error)
+	ReAuthenticateWithBiometric(context.Context, *ReAuthenticateWithBiometricMessageRequest) (*ReAuthenticateWithBiometricMessageResponse, error)
+	RefreshToken(context.Context, *RefreshTokenMessageRequest) (*RefreshTokenMessageResponse, error)
+	ExchangeToken(context.Context, *ExchangeTokenMessageRequest) (*ExchangeTokenMessageResponse, error)
+	GetQuotaUsage(context.Context, *GetQuotaUsageMessageRequest) (*GetQuotaUsageMessageResponse, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) LoginUser(context.Context, *LoginMessageRequest) (*LoginMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedUserServiceServer) RegisterUser(context.Context, *RegisterMessageRequest) (*RegisterMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetBillingProfile(context.Context, *GetBillingProfileMessageRequest) (*GetBillingProfileMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBillingProfile not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateBillingProfile(context.Context, *UpdateBillingProfileMessageRequest) (*UpdateBillingProfileMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBillingProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserSegments(context.Context, *GetUserSegmentsMessageRequest) (*GetUserSegmentsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSegments not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserStats(context.Context, *GetUserStatsMessageRequest) (*GetUserStatsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
+}
+func (UnimplementedUserServiceServer) WatchUserMetrics(*WatchUserMetricsMessageRequest, grpc.ServerStreamingServer[UserMetricsSnapshot]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUserMetrics not implemented")
+}
+func (UnimplementedUserServiceServer) ListOutboxEvents(context.Context, *ListOutboxEventsMessageRequest) (*ListOutboxEventsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutboxEvents not implemented")
+}
+func (UnimplementedUserServiceServer) RepublishOutboxEvents(context.Context, *RepublishOutboxEventsMessageRequest) (*RepublishOutboxEventsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepublishOutboxEvents not implemented")
+}
+func (UnimplementedUserServiceServer) ListDeadLetters(context.Context, *ListDeadLettersMessageRequest) (*ListDeadLettersMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedUserServiceServer) RequeueDeadLetter(context.Context, *RequeueDeadLetterMessageRequest) (*RequeueDeadLetterMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueDeadLetter not implemented")
+}
+func (UnimplementedUserServiceServer) SetNotificationPreferences(context.Context, *SetNotificationPreferencesMessageRequest) (*SetNotificationPreferencesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) RegisterPushToken(context.Context, *RegisterPushTokenMessageRequest) (*RegisterPushTokenMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPushToken not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyEmail(context.Context, *VerifyEmailMessageRequest) (*VerifyEmailMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedUserServiceServer) RequestAccountDeletion(context.Context, *RequestAccountDeletionMessageRequest) (*RequestAccountDeletionMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountDeletion not implemented")
+}
+func (UnimplementedUserServiceServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionMessageRequest) (*CancelAccountDeletionMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedUserServiceServer) GenerateAccessReport(context.Context, *GenerateAccessReportMessageRequest) (*GenerateAccessReportMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateAccessReport not implemented")
+}
+func (UnimplementedUserServiceServer) SetConsent(context.Context, *SetConsentMessageRequest) (*SetConsentMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsent not implemented")
+}
+func (UnimplementedUserServiceServer) ExportComplianceRecords(context.Context, *ExportComplianceRecordsMessageRequest) (*ExportComplianceRecordsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportComplianceRecords not implemented")
+}
+func (UnimplementedUserServiceServer) IssueUserToken(context.Context, *IssueUserTokenMessageRequest) (*IssueUserTokenMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueUserToken not implemented")
+}
+func (UnimplementedUserServiceServer) ValidateToken(context.Context, *ValidateTokenMessageRequest) (*ValidateTokenMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
+}
+func (UnimplementedUserServiceServer) IssueServiceToken(context.Context, *IssueServiceTokenMessageRequest) (*IssueServiceTokenMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueServiceToken not implemented")
+}
+func (UnimplementedUserServiceServer) ReAuthenticate(context.Context, *ReAuthenticateMessageRequest) (*ReAuthenticateMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReAuthenticate not implemented")
+}
+func (UnimplementedUserServiceServer) UploadKYCDocument(grpc.ClientStreamingServer[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadKYCDocument not implemented")
+}
+func (UnimplementedUserServiceServer) ListKYCReviewQueue(context.Context, *ListKYCReviewQueueMessageRequest) (*ListKYCReviewQueueMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKYCReviewQueue not implemented")
+}
+func (UnimplementedUserServiceServer) ApproveKYC(context.Context, *ApproveKYCMessageRequest) (*ApproveKYCMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveKYC not implemented")
+}
+func (UnimplementedUserServiceServer) RejectKYC(context.Context, *RejectKYCMessageRequest) (*RejectKYCMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectKYC not implemented")
+}
+func (UnimplementedUserServiceServer) StartIdentityVerification(context.Context, *StartIdentityVerificationMessageRequest) (*StartIdentityVerificationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartIdentityVerification not implemented")
+}
+func (UnimplementedUserServiceServer) GetIdentityVerification(context.Context, *GetIdentityVerificationMessageRequest) (*GetIdentityVerificationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityVerification not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyPayoutAccount(context.Context, *VerifyPayoutAccountMessageRequest) (*VerifyPayoutAccountMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPayoutAccount not implemented")
+}
+func (UnimplementedUserServiceServer) GetPayoutVerification(context.Context, *GetPayoutVerificationMessageRequest) (*GetPayoutVerificationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoutVerification not implemented")
+}
+func (UnimplementedUserServiceServer) CreditWallet(context.Context, *CreditWalletMessageRequest) (*CreditWalletMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditWallet not implemented")
+}
+func (UnimplementedUserServiceServer) DebitWallet(context.Context, *DebitWalletMessageRequest) (*DebitWalletMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebitWallet not implemented")
+}
+func (UnimplementedUserServiceServer) GetWallet(context.Context, *GetWalletMessageRequest) (*GetWalletMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedUserServiceServer) AttachGiftCard(context.Context, *AttachGiftCardMessageRequest) (*AttachGiftCardMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachGiftCard not implemented")
+}
+func (UnimplementedUserServiceServer) ListGiftCards(context.Context, *ListGiftCardsMessageRequest) (*ListGiftCardsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGiftCards not implemented")
+}
+func (UnimplementedUserServiceServer) GetGiftCardBalance(context.Context, *GetGiftCardBalanceMessageRequest) (*GetGiftCardBalanceMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGiftCardBalance not implemented")
+}
+func (UnimplementedUserServiceServer) GrantCoupon(context.Context, *GrantCouponMessageRequest) (*GrantCouponMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCoupon not implemented")
+}
+func (UnimplementedUserServiceServer) ListCoupons(context.Context, *ListCouponsMessageRequest) (*ListCouponsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedUserServiceServer) ReserveCoupon(context.Context, *ReserveCouponMessageRequest) (*ReserveCouponMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveCoupon not implemented")
+}
+func (UnimplementedUserServiceServer) RedeemCoupon(context.Context, *RedeemCouponMessageRequest) (*RedeemCouponMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCoupon not implemented")
+}
+func (UnimplementedUserServiceServer) ReleaseCoupon(context.Context, *ReleaseCouponMessageRequest) (*ReleaseCouponMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCoupon not implemented")
+}
+func (UnimplementedUserServiceServer) SetTimezone(context.Context, *SetTimezoneMessageRequest) (*SetTimezoneMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimezone not implemented")
+}
+func (UnimplementedUserServiceServer) SubmitFeedback(context.Context, *SubmitFeedbackMessageRequest) (*SubmitFeedbackMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedback not implemented")
+}
+func (UnimplementedUserServiceServer) GetFeedbackSummary(context.Context, *GetFeedbackSummaryMessageRequest) (*GetFeedbackSummaryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackSummary not implemented")
+}
+func (UnimplementedUserServiceServer) LinkTicket(context.Context, *LinkTicketMessageRequest) (*LinkTicketMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkTicket not implemented")
+}
+func (UnimplementedUserServiceServer) ListTickets(context.Context, *ListTicketsMessageRequest) (*ListTicketsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePresence(context.Context, *UpdatePresenceMessageRequest) (*UpdatePresenceMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresence not implemented")
+}
+func (UnimplementedUserServiceServer) GetPresence(context.Context, *GetPresenceMessageRequest) (*GetPresenceMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedUserServiceServer) SuggestUsers(context.Context, *SuggestUsersMessageRequest) (*SuggestUsersMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestUsers not implemented")
+}
+func (UnimplementedUserServiceServer) ListDuplicateCandidates(context.Context, *ListDuplicateCandidatesMessageRequest) (*ListDuplicateCandidatesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateCandidates not implemented")
+}
+func (UnimplementedUserServiceServer) ResolveDuplicateCandidate(context.Context, *ResolveDuplicateCandidateMessageRequest) (*ResolveDuplicateCandidateMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDuplicateCandidate not implemented")
+}
+func (UnimplementedUserServiceServer) BulkUpdateUsers(context.Context, *BulkUpdateUsersMessageRequest) (*BulkUpdateUsersMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateUsers not implemented")
+}
+func (UnimplementedUserServiceServer) GetOperation(context.Context, *GetOperationMessageRequest) (*GetOperationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedUserServiceServer) ListOperations(context.Context, *ListOperationsMessageRequest) (*ListOperationsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedUserServiceServer) CancelOperation(context.Context, *CancelOperationMessageRequest) (*CancelOperationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedUserServiceServer) StartComplianceExport(context.Context, *StartComplianceExportMessageRequest) (*StartComplianceExportMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartComplianceExport not implemented")
+}
+func (UnimplementedUserServiceServer) StartUserErasure(context.Context, *StartUserErasureMessageRequest) (*StartUserErasureMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUserErasure not implemented")
+}
+func (UnimplementedUserServiceServer) StartUserImport(context.Context, *StartUserImportMessageRequest) (*StartUserImportMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUserImport not implemented")
+}
+func (UnimplementedUserServiceServer) GetServerInfo(context.Context, *GetServerInfoMessageRequest) (*GetServerInfoMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
+func (UnimplementedUserServiceServer) GetSLOStatus(context.Context, *GetSLOStatusMessageRequest) (*GetSLOStatusMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLOStatus not implemented")
+}
+func (UnimplementedUserServiceServer) CreateSubAccount(context.Context, *CreateSubAccountMessageRequest) (*CreateSubAccountMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubAccount not implemented")
+}
+func (UnimplementedUserServiceServer) ListSubAccounts(context.Context, *ListSubAccountsMessageRequest) (*ListSubAccountsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubAccounts not implemented")
+}
+func (UnimplementedUserServiceServer) SetSubAccountRestrictions(context.Context, *SetSubAccountRestrictionsMessageRequest) (*SetSubAccountRestrictionsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubAccountRestrictions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateOrganization(context.Context, *CreateOrganizationMessageRequest) (*CreateOrganizationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedUserServiceServer) InviteOrgMember(context.Context, *InviteOrgMemberMessageRequest) (*InviteOrgMemberMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteOrgMember not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptOrgInvite(context.Context, *AcceptOrgInviteMessageRequest) (*AcceptOrgInviteMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrgInvite not implemented")
+}
+func (UnimplementedUserServiceServer) SetOrgMemberRole(context.Context, *SetOrgMemberRoleMessageRequest) (*SetOrgMemberRoleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrgMemberRole not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveOrgMember(context.Context, *RemoveOrgMemberMessageRequest) (*RemoveOrgMemberMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
+func (UnimplementedUserServiceServer) ListOrgMembers(context.Context, *ListOrgMembersMessageRequest) (*ListOrgMembersMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgMembers not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserOrganizations(context.Context, *ListUserOrganizationsMessageRequest) (*ListUserOrganizationsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserOrganizations not implemented")
+}
+func (UnimplementedUserServiceServer) CreateInvite(context.Context, *CreateInviteMessageRequest) (*CreateInviteMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedUserServiceServer) GetInvite(context.Context, *GetInviteMessageRequest) (*GetInviteMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvite not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptInvite(context.Context, *AcceptInviteMessageRequest) (*AcceptInviteMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedUserServiceServer) SaveSearch(context.Context, *SaveSearchMessageRequest) (*SaveSearchMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSearch not implemented")
+}
+func (UnimplementedUserServiceServer) ListSavedSearches(context.Context, *ListSavedSearchesMessageRequest) (*ListSavedSearchesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchMessageRequest) (*DeleteSavedSearchMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedUserServiceServer) SubscribeProductAlert(context.Context, *SubscribeProductAlertMessageRequest) (*SubscribeProductAlertMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeProductAlert not implemented")
+}
+func (UnimplementedUserServiceServer) ListProductAlerts(context.Context, *ListProductAlertsMessageRequest) (*ListProductAlertsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductAlerts not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteProductAlert(context.Context, *DeleteProductAlertMessageRequest) (*DeleteProductAlertMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductAlert not implemented")
+}
+func (UnimplementedUserServiceServer) RecordProductView(context.Context, *RecordProductViewMessageRequest) (*RecordProductViewMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordProductView not implemented")
+}
+func (UnimplementedUserServiceServer) GetRecentlyViewed(context.Context, *GetRecentlyViewedMessageRequest) (*GetRecentlyViewedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentlyViewed not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateDisplayName(context.Context, *UpdateDisplayNameMessageRequest) (*UpdateDisplayNameMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDisplayName not implemented")
+}
+func (UnimplementedUserServiceServer) UploadAvatar(grpc.ClientStreamingServer[UploadAvatarMessageRequest, UploadAvatarMessageResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAvatar not implemented")
+}
+func (UnimplementedUserServiceServer) ListModerationQueue(context.Context, *ListModerationQueueMessageRequest) (*ListModerationQueueMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedUserServiceServer) ReviewModeration(context.Context, *ReviewModerationMessageRequest) (*ReviewModerationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewModeration not implemented")
+}
+func (UnimplementedUserServiceServer) GetPublicProfile(context.Context, *GetPublicProfileMessageRequest) (*GetPublicProfileMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetPublicProfiles(context.Context, *GetPublicProfilesMessageRequest) (*GetPublicProfilesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfiles not implemented")
+}
+func (UnimplementedUserServiceServer) SetShadowBan(context.Context, *SetShadowBanMessageRequest) (*SetShadowBanMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShadowBan not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserProfile(context.Context, *GetUserProfileMessageRequest) (*GetUserProfileMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (UnimplementedUserServiceServer) SendPhoneVerification(context.Context, *SendPhoneVerificationMessageRequest) (*SendPhoneVerificationMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPhoneVerification not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyPhone(context.Context, *VerifyPhoneMessageRequest) (*VerifyPhoneMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPhone not implemented")
+}
+func (UnimplementedUserServiceServer) SetDigestPreferences(context.Context, *SetDigestPreferencesMessageRequest) (*SetDigestPreferencesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDigestPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) GetDigestPreferences(context.Context, *GetDigestPreferencesMessageRequest) (*GetDigestPreferencesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDigestPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) GetDueDigests(context.Context, *GetDueDigestsMessageRequest) (*GetDueDigestsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDueDigests not implemented")
+}
+func (UnimplementedUserServiceServer) GetAssignments(context.Context, *GetAssignmentsMessageRequest) (*GetAssignmentsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignments not implemented")
+}
+func (UnimplementedUserServiceServer) GetSecurityStatus(context.Context, *GetSecurityStatusMessageRequest) (*GetSecurityStatusMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecurityStatus not implemented")
+}
+func (UnimplementedUserServiceServer) ExportSecurityEvents(*ExportSecurityEventsMessageRequest, grpc.ServerStreamingServer[ExportSecurityEventsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSecurityEvents not implemented")
+}
+func (UnimplementedUserServiceServer) SetRecoveryContact(context.Context, *SetRecoveryContactMessageRequest) (*SetRecoveryContactMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecoveryContact not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyRecoveryContact(context.Context, *VerifyRecoveryContactMessageRequest) (*VerifyRecoveryContactMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRecoveryContact not implemented")
+}
+func (UnimplementedUserServiceServer) StartAccountRecovery(context.Context, *StartAccountRecoveryMessageRequest) (*StartAccountRecoveryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAccountRecovery not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmAccountRecovery(context.Context, *ConfirmAccountRecoveryMessageRequest) (*ConfirmAccountRecoveryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmAccountRecovery not implemented")
+}
+func (UnimplementedUserServiceServer) CompleteAccountRecovery(context.Context, *CompleteAccountRecoveryMessageRequest) (*CompleteAccountRecoveryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAccountRecovery not implemented")
+}
+func (UnimplementedUserServiceServer) CancelAccountRecovery(context.Context, *CancelAccountRecoveryMessageRequest) (*CancelAccountRecoveryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountRecovery not implemented")
+}
+func (UnimplementedUserServiceServer) SetAwayMode(context.Context, *SetAwayModeMessageRequest) (*SetAwayModeMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAwayMode not implemented")
+}
+func (UnimplementedUserServiceServer) ClearAwayMode(context.Context, *ClearAwayModeMessageRequest) (*ClearAwayModeMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAwayMode not implemented")
+}
+func (UnimplementedUserServiceServer) GetAwayMode(context.Context, *GetAwayModeMessageRequest) (*GetAwayModeMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAwayMode not implemented")
+}
+func (UnimplementedUserServiceServer) SetTaxProfile(context.Context, *SetTaxProfileMessageRequest) (*SetTaxProfileMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaxProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetTaxProfile(context.Context, *GetTaxProfileMessageRequest) (*GetTaxProfileMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxProfile not implemented")
+}
+func (UnimplementedUserServiceServer) DefineAttribute(context.Context, *DefineAttributeMessageRequest) (*DefineAttributeMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineAttribute not implemented")
+}
+func (UnimplementedUserServiceServer) ListAttributeDefinitions(context.Context, *ListAttributeDefinitionsMessageRequest) (*ListAttributeDefinitionsMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttributeDefinitions not implemented")
+}
+func (UnimplementedUserServiceServer) SetAttributes(context.Context, *SetAttributesMessageRequest) (*SetAttributesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributes not implemented")
+}
+func (UnimplementedUserServiceServer) GetAttributes(context.Context, *GetAttributesMessageRequest) (*GetAttributesMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributes not implemented")
+}
+func (UnimplementedUserServiceServer) GetProfileHistory(context.Context, *GetProfileHistoryMessageRequest) (*GetProfileHistoryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileHistory not implemented")
+}
+func (UnimplementedUserServiceServer) GetDownloadURL(context.Context, *GetDownloadURLMessageRequest) (*GetDownloadURLMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadURL not implemented")
+}
+func (UnimplementedUserServiceServer) RegisterUSSDUser(context.Context, *RegisterUSSDUserMessageRequest) (*RegisterUSSDUserMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUSSDUser not implemented")
+}
+func (UnimplementedUserServiceServer) LoginUSSDUser(context.Context, *LoginUSSDUserMessageRequest) (*LoginUSSDUserMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUSSDUser not implemented")
+}
+func (UnimplementedUserServiceServer) ChangeUSSDPIN(context.Context, *ChangeUSSDPINMessageRequest) (*ChangeUSSDPINMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUSSDPIN not implemented")
+}
+func (UnimplementedUserServiceServer) SetDevicePIN(context.Context, *SetDevicePINMessageRequest) (*SetDevicePINMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDevicePIN not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveDevicePIN(context.Context, *RemoveDevicePINMessageRequest) (*RemoveDevicePINMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDevicePIN not implemented")
+}
+func (UnimplementedUserServiceServer) ReAuthenticateWithPIN(context.Context, *ReAuthenticateWithPINMessageRequest) (*ReAuthenticateWithPINMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReAuthenticateWithPIN not implemented")
+}
+func (UnimplementedUserServiceServer) RegisterDeviceKey(context.Context, *RegisterDeviceKeyMessageRequest) (*RegisterDeviceKeyMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDeviceKey not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveDeviceKey(context.Context, *RemoveDeviceKeyMessageRequest) (*RemoveDeviceKeyMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeviceKey not implemented")
+}
+func (UnimplementedUserServiceServer) IssueBiometricChallenge(context.Context, *IssueBiometricChallengeMessageRequest) (*IssueBiometricChallengeMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueBiometricChallenge not implemented")
+}
+func (UnimplementedUserServiceServer) ReAuthenticateWithBiometric(context.Context, *ReAuthenticateWithBiometricMessageRequest) (*ReAuthenticateWithBiometricMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReAuthenticateWithBiometric not implemented")
+}
+func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenMessageRequest) (*RefreshTokenMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) ExchangeToken(context.Context, *ExchangeTokenMessageRequest) (*ExchangeTokenMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeToken not implemented")
+}
+func (UnimplementedUserServiceServer) GetQuotaUsage(context.Context, *GetQuotaUsageMessageRequest) (*GetQuotaUsageMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
 	mustEmbedUnimplementedUserServiceServer()
 }
 
-// UnimplementedUserServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUserServiceServer struct{}
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LoginUser(ctx, req.(*LoginMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterUser(ctx, req.(*RegisterMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetBillingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBillingProfileMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBillingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBillingProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBillingProfile(ctx, req.(*GetBillingProfileMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateBillingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBillingProfileMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateBillingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateBillingProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateBillingProfile(ctx, req.(*UpdateBillingProfileMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSegmentsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserSegments(ctx, req.(*GetUserSegmentsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserStats(ctx, req.(*GetUserStatsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_WatchUserMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUserMetricsMessageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).WatchUserMetrics(m, &grpc.GenericServerStream[WatchUserMetricsMessageRequest, UserMetricsSnapshot]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_WatchUserMetricsServer = grpc.ServerStreamingServer[UserMetricsSnapshot]
+
+func _UserService_ListOutboxEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxEventsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOutboxEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListOutboxEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOutboxEvents(ctx, req.(*ListOutboxEventsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RepublishOutboxEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepublishOutboxEventsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RepublishOutboxEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RepublishOutboxEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RepublishOutboxEvents(ctx, req.(*RepublishOutboxEventsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequeueDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueDeadLetterMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequeueDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequeueDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequeueDeadLetter(ctx, req.(*RequeueDeadLetterMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationPreferencesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetNotificationPreferences(ctx, req.(*SetNotificationPreferencesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegisterPushToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPushTokenMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterPushToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterPushToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterPushToken(ctx, req.(*RegisterPushTokenMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyEmail(ctx, req.(*VerifyEmailMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountDeletionMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestAccountDeletion(ctx, req.(*RequestAccountDeletionMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GenerateAccessReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateAccessReportMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GenerateAccessReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GenerateAccessReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GenerateAccessReport(ctx, req.(*GenerateAccessReportMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConsentMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetConsent(ctx, req.(*SetConsentMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportComplianceRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportComplianceRecordsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportComplianceRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportComplianceRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportComplianceRecords(ctx, req.(*ExportComplianceRecordsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IssueUserToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueUserTokenMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IssueUserToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IssueUserToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IssueUserToken(ctx, req.(*IssueUserTokenMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTokenMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ValidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ValidateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ValidateToken(ctx, req.(*ValidateTokenMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IssueServiceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueServiceTokenMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IssueServiceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IssueServiceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IssueServiceToken(ctx, req.(*IssueServiceTokenMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReAuthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReAuthenticateMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReAuthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReAuthenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReAuthenticate(ctx, req.(*ReAuthenticateMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UploadKYCDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserServiceServer).UploadKYCDocument(&grpc.GenericServerStream[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_UploadKYCDocumentServer = grpc.ClientStreamingServer[UploadKYCDocumentMessageRequest, UploadKYCDocumentMessageResponse]
+
+func _UserService_ListKYCReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKYCReviewQueueMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListKYCReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListKYCReviewQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListKYCReviewQueue(ctx, req.(*ListKYCReviewQueueMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ApproveKYC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveKYCMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ApproveKYC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ApproveKYC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ApproveKYC(ctx, req.(*ApproveKYCMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RejectKYC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectKYCMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RejectKYC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RejectKYC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RejectKYC(ctx, req.(*RejectKYCMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartIdentityVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartIdentityVerificationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartIdentityVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartIdentityVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartIdentityVerification(ctx, req.(*StartIdentityVerificationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetIdentityVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityVerificationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetIdentityVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetIdentityVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetIdentityVerification(ctx, req.(*GetIdentityVerificationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyPayoutAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPayoutAccountMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyPayoutAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyPayoutAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyPayoutAccount(ctx, req.(*VerifyPayoutAccountMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPayoutVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutVerificationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPayoutVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPayoutVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPayoutVerification(ctx, req.(*GetPayoutVerificationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreditWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditWalletMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreditWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreditWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreditWallet(ctx, req.(*CreditWalletMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DebitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebitWalletMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DebitWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DebitWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DebitWallet(ctx, req.(*DebitWalletMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetWallet(ctx, req.(*GetWalletMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AttachGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachGiftCardMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AttachGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AttachGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AttachGiftCard(ctx, req.(*AttachGiftCardMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListGiftCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGiftCardsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListGiftCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListGiftCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListGiftCards(ctx, req.(*ListGiftCardsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGiftCardBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGiftCardBalanceMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGiftCardBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGiftCardBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGiftCardBalance(ctx, req.(*GetGiftCardBalanceMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GrantCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantCouponMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GrantCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GrantCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GrantCoupon(ctx, req.(*GrantCouponMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListCoupons(ctx, req.(*ListCouponsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReserveCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveCouponMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReserveCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReserveCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReserveCoupon(ctx, req.(*ReserveCouponMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCouponMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RedeemCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RedeemCoupon(ctx, req.(*RedeemCouponMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReleaseCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCouponMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReleaseCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReleaseCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReleaseCoupon(ctx, req.(*ReleaseCouponMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetTimezone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTimezoneMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetTimezone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetTimezone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetTimezone(ctx, req.(*SetTimezoneMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFeedbackMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SubmitFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SubmitFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SubmitFeedback(ctx, req.(*SubmitFeedbackMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetFeedbackSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedbackSummaryMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFeedbackSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFeedbackSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFeedbackSummary(ctx, req.(*GetFeedbackSummaryMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_LinkTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkTicketMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LinkTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LinkTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LinkTicket(ctx, req.(*LinkTicketMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTickets(ctx, req.(*ListTicketsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePresence(ctx, req.(*UpdatePresenceMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPresence(ctx, req.(*GetPresenceMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuggestUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestUsersMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuggestUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuggestUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuggestUsers(ctx, req.(*SuggestUsersMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListDuplicateCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateCandidatesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListDuplicateCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListDuplicateCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListDuplicateCandidates(ctx, req.(*ListDuplicateCandidatesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResolveDuplicateCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDuplicateCandidateMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveDuplicateCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveDuplicateCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveDuplicateCandidate(ctx, req.(*ResolveDuplicateCandidateMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BulkUpdateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateUsersMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BulkUpdateUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BulkUpdateUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BulkUpdateUsers(ctx, req.(*BulkUpdateUsersMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetOperation(ctx, req.(*GetOperationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOperations(ctx, req.(*ListOperationsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CancelOperation(ctx, req.(*CancelOperationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartComplianceExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartComplianceExportMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartComplianceExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartComplianceExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartComplianceExport(ctx, req.(*StartComplianceExportMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartUserErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUserErasureMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartUserErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartUserErasure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartUserErasure(ctx, req.(*StartUserErasureMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartUserImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUserImportMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartUserImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartUserImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartUserImport(ctx, req.(*StartUserImportMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerInfoMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetServerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetServerInfo(ctx, req.(*GetServerInfoMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSLOStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSLOStatusMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSLOStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetSLOStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSLOStatus(ctx, req.(*GetSLOStatusMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateSubAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubAccountMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateSubAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateSubAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateSubAccount(ctx, req.(*CreateSubAccountMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSubAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubAccountsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSubAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSubAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSubAccounts(ctx, req.(*ListSubAccountsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetSubAccountRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubAccountRestrictionsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetSubAccountRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetSubAccountRestrictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetSubAccountRestrictions(ctx, req.(*SetSubAccountRestrictionsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_InviteOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteOrgMemberMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).InviteOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_InviteOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).InviteOrgMember(ctx, req.(*InviteOrgMemberMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptOrgInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrgInviteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptOrgInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptOrgInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptOrgInvite(ctx, req.(*AcceptOrgInviteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetOrgMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrgMemberRoleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetOrgMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetOrgMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetOrgMemberRole(ctx, req.(*SetOrgMemberRoleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrgMemberMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveOrgMember(ctx, req.(*RemoveOrgMemberMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListOrgMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrgMembersMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOrgMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListOrgMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOrgMembers(ctx, req.(*ListOrgMembersMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserOrganizationsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserOrganizations(ctx, req.(*ListUserOrganizationsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateInvite(ctx, req.(*CreateInviteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetInvite(ctx, req.(*GetInviteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptInvite(ctx, req.(*AcceptInviteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SaveSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSearchMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SaveSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SaveSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SaveSearch(ctx, req.(*SaveSearchMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSavedSearches(ctx, req.(*ListSavedSearchesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SubscribeProductAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeProductAlertMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SubscribeProductAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SubscribeProductAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SubscribeProductAlert(ctx, req.(*SubscribeProductAlertMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListProductAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductAlertsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListProductAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListProductAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListProductAlerts(ctx, req.(*ListProductAlertsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteProductAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductAlertMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteProductAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteProductAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteProductAlert(ctx, req.(*DeleteProductAlertMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RecordProductView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordProductViewMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RecordProductView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RecordProductView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RecordProductView(ctx, req.(*RecordProductViewMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetRecentlyViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentlyViewedMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRecentlyViewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetRecentlyViewed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRecentlyViewed(ctx, req.(*GetRecentlyViewedMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateDisplayName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDisplayNameMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateDisplayName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateDisplayName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateDisplayName(ctx, req.(*UpdateDisplayNameMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UploadAvatar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserServiceServer).UploadAvatar(&grpc.GenericServerStream[UploadAvatarMessageRequest, UploadAvatarMessageResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_UploadAvatarServer = grpc.ClientStreamingServer[UploadAvatarMessageRequest, UploadAvatarMessageResponse]
+
+func _UserService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReviewModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewModerationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReviewModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReviewModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReviewModeration(ctx, req.(*ReviewModerationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfileMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, req.(*GetPublicProfileMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPublicProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfilesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPublicProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPublicProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPublicProfiles(ctx, req.(*GetPublicProfilesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetShadowBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShadowBanMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetShadowBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetShadowBan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetShadowBan(ctx, req.(*SetShadowBanMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProfileMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserProfile(ctx, req.(*GetUserProfileMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendPhoneVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPhoneVerificationMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendPhoneVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendPhoneVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendPhoneVerification(ctx, req.(*SendPhoneVerificationMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPhoneMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyPhone(ctx, req.(*VerifyPhoneMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetDigestPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDigestPreferencesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetDigestPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetDigestPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetDigestPreferences(ctx, req.(*SetDigestPreferencesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDigestPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDigestPreferencesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDigestPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDigestPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDigestPreferences(ctx, req.(*GetDigestPreferencesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDueDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDueDigestsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDueDigests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDueDigests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDueDigests(ctx, req.(*GetDueDigestsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAssignments(ctx, req.(*GetAssignmentsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSecurityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecurityStatusMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSecurityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetSecurityStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSecurityStatus(ctx, req.(*GetSecurityStatusMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportSecurityEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSecurityEventsMessageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).ExportSecurityEvents(m, &grpc.GenericServerStream[ExportSecurityEventsMessageRequest, ExportSecurityEventsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportSecurityEventsServer = grpc.ServerStreamingServer[ExportSecurityEventsChunk]
+
+func _UserService_SetRecoveryContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecoveryContactMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetRecoveryContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetRecoveryContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetRecoveryContact(ctx, req.(*SetRecoveryContactMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyRecoveryContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRecoveryContactMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyRecoveryContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyRecoveryContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyRecoveryContact(ctx, req.(*VerifyRecoveryContactMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartAccountRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartAccountRecoveryMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartAccountRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartAccountRecovery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartAccountRecovery(ctx, req.(*StartAccountRecoveryMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmAccountRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmAccountRecoveryMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmAccountRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmAccountRecovery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmAccountRecovery(ctx, req.(*ConfirmAccountRecoveryMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CompleteAccountRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAccountRecoveryMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CompleteAccountRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CompleteAccountRecovery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CompleteAccountRecovery(ctx, req.(*CompleteAccountRecoveryMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CancelAccountRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountRecoveryMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CancelAccountRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CancelAccountRecovery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CancelAccountRecovery(ctx, req.(*CancelAccountRecoveryMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetAwayMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAwayModeMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetAwayMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetAwayMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetAwayMode(ctx, req.(*SetAwayModeMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClearAwayMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearAwayModeMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClearAwayMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ClearAwayMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClearAwayMode(ctx, req.(*ClearAwayModeMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAwayMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAwayModeMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAwayMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAwayMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAwayMode(ctx, req.(*GetAwayModeMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetTaxProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaxProfileMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetTaxProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetTaxProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetTaxProfile(ctx, req.(*SetTaxProfileMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTaxProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxProfileMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTaxProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTaxProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTaxProfile(ctx, req.(*GetTaxProfileMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DefineAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineAttributeMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DefineAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DefineAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DefineAttribute(ctx, req.(*DefineAttributeMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedUserServiceServer) LoginUser(context.Context, *LoginMessageRequest) (*LoginMessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+func _UserService_ListAttributeDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttributeDefinitionsMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAttributeDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAttributeDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAttributeDefinitions(ctx, req.(*ListAttributeDefinitionsMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) RegisterUser(context.Context, *RegisterMessageRequest) (*RegisterMessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
+
+func _UserService_SetAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetAttributes(ctx, req.(*SetAttributesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_GetAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributesMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAttributes(ctx, req.(*GetAttributesMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	// If the following call pancis, it indicates UnimplementedUserServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UserService_GetProfileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileHistoryMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UserService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProfileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProfileHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProfileHistory(ctx, req.(*GetProfileHistoryMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginMessageRequest)
+func _UserService_GetDownloadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadURLMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).LoginUser(ctx, in)
+		return srv.(UserServiceServer).GetDownloadURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_LoginUser_FullMethodName,
+		FullMethod: UserService_GetDownloadURL_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).LoginUser(ctx, req.(*LoginMessageRequest))
+		return srv.(UserServiceServer).GetDownloadURL(ctx, req.(*GetDownloadURLMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterMessageRequest)
+func _UserService_RegisterUSSDUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUSSDUserMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterUSSDUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterUSSDUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterUSSDUser(ctx, req.(*RegisterUSSDUserMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_LoginUSSDUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUSSDUserMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LoginUSSDUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LoginUSSDUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LoginUSSDUser(ctx, req.(*LoginUSSDUserMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangeUSSDPIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUSSDPINMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeUSSDPIN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeUSSDPIN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeUSSDPIN(ctx, req.(*ChangeUSSDPINMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetDevicePIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDevicePINMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetDevicePIN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetDevicePIN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetDevicePIN(ctx, req.(*SetDevicePINMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveDevicePIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDevicePINMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveDevicePIN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveDevicePIN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveDevicePIN(ctx, req.(*RemoveDevicePINMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReAuthenticateWithPIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReAuthenticateWithPINMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReAuthenticateWithPIN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReAuthenticateWithPIN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReAuthenticateWithPIN(ctx, req.(*ReAuthenticateWithPINMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegisterDeviceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDeviceKeyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterDeviceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterDeviceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterDeviceKey(ctx, req.(*RegisterDeviceKeyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveDeviceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeviceKeyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveDeviceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveDeviceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveDeviceKey(ctx, req.(*RemoveDeviceKeyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IssueBiometricChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueBiometricChallengeMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IssueBiometricChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IssueBiometricChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IssueBiometricChallenge(ctx, req.(*IssueBiometricChallengeMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReAuthenticateWithBiometric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReAuthenticateWithBiometricMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReAuthenticateWithBiometric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReAuthenticateWithBiometric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReAuthenticateWithBiometric(ctx, req.(*ReAuthenticateWithBiometricMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExchangeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeTokenMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExchangeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExchangeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExchangeToken(ctx, req.(*ExchangeTokenMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RegisterUser(ctx, in)
+		return srv.(UserServiceServer).GetQuotaUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RegisterUser_FullMethodName,
+		FullMethod: UserService_GetQuotaUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RegisterUser(ctx, req.(*RegisterMessageRequest))
+		return srv.(UserServiceServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -153,7 +4425,516 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterUser",
 			Handler:    _UserService_RegisterUser_Handler,
 		},
+		{
+			MethodName: "GetBillingProfile",
+			Handler:    _UserService_GetBillingProfile_Handler,
+		},
+		{
+			MethodName: "UpdateBillingProfile",
+			Handler:    _UserService_UpdateBillingProfile_Handler,
+		},
+		{
+			MethodName: "GetUserSegments",
+			Handler:    _UserService_GetUserSegments_Handler,
+		},
+		{
+			MethodName: "GetUserStats",
+			Handler:    _UserService_GetUserStats_Handler,
+		},
+		{
+			MethodName: "ListOutboxEvents",
+			Handler:    _UserService_ListOutboxEvents_Handler,
+		},
+		{
+			MethodName: "RepublishOutboxEvents",
+			Handler:    _UserService_RepublishOutboxEvents_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _UserService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "RequeueDeadLetter",
+			Handler:    _UserService_RequeueDeadLetter_Handler,
+		},
+		{
+			MethodName: "SetNotificationPreferences",
+			Handler:    _UserService_SetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "RegisterPushToken",
+			Handler:    _UserService_RegisterPushToken_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _UserService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestAccountDeletion",
+			Handler:    _UserService_RequestAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _UserService_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "GenerateAccessReport",
+			Handler:    _UserService_GenerateAccessReport_Handler,
+		},
+		{
+			MethodName: "SetConsent",
+			Handler:    _UserService_SetConsent_Handler,
+		},
+		{
+			MethodName: "ExportComplianceRecords",
+			Handler:    _UserService_ExportComplianceRecords_Handler,
+		},
+		{
+			MethodName: "IssueUserToken",
+			Handler:    _UserService_IssueUserToken_Handler,
+		},
+		{
+			MethodName: "ValidateToken",
+			Handler:    _UserService_ValidateToken_Handler,
+		},
+		{
+			MethodName: "IssueServiceToken",
+			Handler:    _UserService_IssueServiceToken_Handler,
+		},
+		{
+			MethodName: "ReAuthenticate",
+			Handler:    _UserService_ReAuthenticate_Handler,
+		},
+		{
+			MethodName: "ListKYCReviewQueue",
+			Handler:    _UserService_ListKYCReviewQueue_Handler,
+		},
+		{
+			MethodName: "ApproveKYC",
+			Handler:    _UserService_ApproveKYC_Handler,
+		},
+		{
+			MethodName: "RejectKYC",
+			Handler:    _UserService_RejectKYC_Handler,
+		},
+		{
+			MethodName: "StartIdentityVerification",
+			Handler:    _UserService_StartIdentityVerification_Handler,
+		},
+		{
+			MethodName: "GetIdentityVerification",
+			Handler:    _UserService_GetIdentityVerification_Handler,
+		},
+		{
+			MethodName: "VerifyPayoutAccount",
+			Handler:    _UserService_VerifyPayoutAccount_Handler,
+		},
+		{
+			MethodName: "GetPayoutVerification",
+			Handler:    _UserService_GetPayoutVerification_Handler,
+		},
+		{
+			MethodName: "CreditWallet",
+			Handler:    _UserService_CreditWallet_Handler,
+		},
+		{
+			MethodName: "DebitWallet",
+			Handler:    _UserService_DebitWallet_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _UserService_GetWallet_Handler,
+		},
+		{
+			MethodName: "AttachGiftCard",
+			Handler:    _UserService_AttachGiftCard_Handler,
+		},
+		{
+			MethodName: "ListGiftCards",
+			Handler:    _UserService_ListGiftCards_Handler,
+		},
+		{
+			MethodName: "GetGiftCardBalance",
+			Handler:    _UserService_GetGiftCardBalance_Handler,
+		},
+		{
+			MethodName: "GrantCoupon",
+			Handler:    _UserService_GrantCoupon_Handler,
+		},
+		{
+			MethodName: "ListCoupons",
+			Handler:    _UserService_ListCoupons_Handler,
+		},
+		{
+			MethodName: "ReserveCoupon",
+			Handler:    _UserService_ReserveCoupon_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _UserService_RedeemCoupon_Handler,
+		},
+		{
+			MethodName: "ReleaseCoupon",
+			Handler:    _UserService_ReleaseCoupon_Handler,
+		},
+		{
+			MethodName: "SetTimezone",
+			Handler:    _UserService_SetTimezone_Handler,
+		},
+		{
+			MethodName: "SubmitFeedback",
+			Handler:    _UserService_SubmitFeedback_Handler,
+		},
+		{
+			MethodName: "GetFeedbackSummary",
+			Handler:    _UserService_GetFeedbackSummary_Handler,
+		},
+		{
+			MethodName: "LinkTicket",
+			Handler:    _UserService_LinkTicket_Handler,
+		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _UserService_ListTickets_Handler,
+		},
+		{
+			MethodName: "UpdatePresence",
+			Handler:    _UserService_UpdatePresence_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _UserService_GetPresence_Handler,
+		},
+		{
+			MethodName: "SuggestUsers",
+			Handler:    _UserService_SuggestUsers_Handler,
+		},
+		{
+			MethodName: "ListDuplicateCandidates",
+			Handler:    _UserService_ListDuplicateCandidates_Handler,
+		},
+		{
+			MethodName: "ResolveDuplicateCandidate",
+			Handler:    _UserService_ResolveDuplicateCandidate_Handler,
+		},
+		{
+			MethodName: "BulkUpdateUsers",
+			Handler:    _UserService_BulkUpdateUsers_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _UserService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _UserService_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _UserService_CancelOperation_Handler,
+		},
+		{
+			MethodName: "StartComplianceExport",
+			Handler:    _UserService_StartComplianceExport_Handler,
+		},
+		{
+			MethodName: "StartUserErasure",
+			Handler:    _UserService_StartUserErasure_Handler,
+		},
+		{
+			MethodName: "StartUserImport",
+			Handler:    _UserService_StartUserImport_Handler,
+		},
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _UserService_GetServerInfo_Handler,
+		},
+		{
+			MethodName: "GetSLOStatus",
+			Handler:    _UserService_GetSLOStatus_Handler,
+		},
+		{
+			MethodName: "CreateSubAccount",
+			Handler:    _UserService_CreateSubAccount_Handler,
+		},
+		{
+			MethodName: "ListSubAccounts",
+			Handler:    _UserService_ListSubAccounts_Handler,
+		},
+		{
+			MethodName: "SetSubAccountRestrictions",
+			Handler:    _UserService_SetSubAccountRestrictions_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _UserService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "InviteOrgMember",
+			Handler:    _UserService_InviteOrgMember_Handler,
+		},
+		{
+			MethodName: "AcceptOrgInvite",
+			Handler:    _UserService_AcceptOrgInvite_Handler,
+		},
+		{
+			MethodName: "SetOrgMemberRole",
+			Handler:    _UserService_SetOrgMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveOrgMember",
+			Handler:    _UserService_RemoveOrgMember_Handler,
+		},
+		{
+			MethodName: "ListOrgMembers",
+			Handler:    _UserService_ListOrgMembers_Handler,
+		},
+		{
+			MethodName: "ListUserOrganizations",
+			Handler:    _UserService_ListUserOrganizations_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _UserService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "GetInvite",
+			Handler:    _UserService_GetInvite_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _UserService_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "SaveSearch",
+			Handler:    _UserService_SaveSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _UserService_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _UserService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "SubscribeProductAlert",
+			Handler:    _UserService_SubscribeProductAlert_Handler,
+		},
+		{
+			MethodName: "ListProductAlerts",
+			Handler:    _UserService_ListProductAlerts_Handler,
+		},
+		{
+			MethodName: "DeleteProductAlert",
+			Handler:    _UserService_DeleteProductAlert_Handler,
+		},
+		{
+			MethodName: "RecordProductView",
+			Handler:    _UserService_RecordProductView_Handler,
+		},
+		{
+			MethodName: "GetRecentlyViewed",
+			Handler:    _UserService_GetRecentlyViewed_Handler,
+		},
+		{
+			MethodName: "UpdateDisplayName",
+			Handler:    _UserService_UpdateDisplayName_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _UserService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ReviewModeration",
+			Handler:    _UserService_ReviewModeration_Handler,
+		},
+		{
+			MethodName: "GetPublicProfile",
+			Handler:    _UserService_GetPublicProfile_Handler,
+		},
+		{
+			MethodName: "GetPublicProfiles",
+			Handler:    _UserService_GetPublicProfiles_Handler,
+		},
+		{
+			MethodName: "SetShadowBan",
+			Handler:    _UserService_SetShadowBan_Handler,
+		},
+		{
+			MethodName: "GetUserProfile",
+			Handler:    _UserService_GetUserProfile_Handler,
+		},
+		{
+			MethodName: "SendPhoneVerification",
+			Handler:    _UserService_SendPhoneVerification_Handler,
+		},
+		{
+			MethodName: "VerifyPhone",
+			Handler:    _UserService_VerifyPhone_Handler,
+		},
+		{
+			MethodName: "SetDigestPreferences",
+			Handler:    _UserService_SetDigestPreferences_Handler,
+		},
+		{
+			MethodName: "GetDigestPreferences",
+			Handler:    _UserService_GetDigestPreferences_Handler,
+		},
+		{
+			MethodName: "GetDueDigests",
+			Handler:    _UserService_GetDueDigests_Handler,
+		},
+		{
+			MethodName: "GetAssignments",
+			Handler:    _UserService_GetAssignments_Handler,
+		},
+		{
+			MethodName: "GetSecurityStatus",
+			Handler:    _UserService_GetSecurityStatus_Handler,
+		},
+		{
+			MethodName: "SetRecoveryContact",
+			Handler:    _UserService_SetRecoveryContact_Handler,
+		},
+		{
+			MethodName: "VerifyRecoveryContact",
+			Handler:    _UserService_VerifyRecoveryContact_Handler,
+		},
+		{
+			MethodName: "StartAccountRecovery",
+			Handler:    _UserService_StartAccountRecovery_Handler,
+		},
+		{
+			MethodName: "ConfirmAccountRecovery",
+			Handler:    _UserService_ConfirmAccountRecovery_Handler,
+		},
+		{
+			MethodName: "CompleteAccountRecovery",
+			Handler:    _UserService_CompleteAccountRecovery_Handler,
+		},
+		{
+			MethodName: "CancelAccountRecovery",
+			Handler:    _UserService_CancelAccountRecovery_Handler,
+		},
+		{
+			MethodName: "SetAwayMode",
+			Handler:    _UserService_SetAwayMode_Handler,
+		},
+		{
+			MethodName: "ClearAwayMode",
+			Handler:    _UserService_ClearAwayMode_Handler,
+		},
+		{
+			MethodName: "GetAwayMode",
+			Handler:    _UserService_GetAwayMode_Handler,
+		},
+		{
+			MethodName: "SetTaxProfile",
+			Handler:    _UserService_SetTaxProfile_Handler,
+		},
+		{
+			MethodName: "GetTaxProfile",
+			Handler:    _UserService_GetTaxProfile_Handler,
+		},
+		{
+			MethodName: "DefineAttribute",
+			Handler:    _UserService_DefineAttribute_Handler,
+		},
+		{
+			MethodName: "ListAttributeDefinitions",
+			Handler:    _UserService_ListAttributeDefinitions_Handler,
+		},
+		{
+			MethodName: "SetAttributes",
+			Handler:    _UserService_SetAttributes_Handler,
+		},
+		{
+			MethodName: "GetAttributes",
+			Handler:    _UserService_GetAttributes_Handler,
+		},
+		{
+			MethodName: "GetProfileHistory",
+			Handler:    _UserService_GetProfileHistory_Handler,
+		},
+		{
+			MethodName: "GetDownloadURL",
+			Handler:    _UserService_GetDownloadURL_Handler,
+		},
+		{
+			MethodName: "RegisterUSSDUser",
+			Handler:    _UserService_RegisterUSSDUser_Handler,
+		},
+		{
+			MethodName: "LoginUSSDUser",
+			Handler:    _UserService_LoginUSSDUser_Handler,
+		},
+		{
+			MethodName: "ChangeUSSDPIN",
+			Handler:    _UserService_ChangeUSSDPIN_Handler,
+		},
+		{
+			MethodName: "SetDevicePIN",
+			Handler:    _UserService_SetDevicePIN_Handler,
+		},
+		{
+			MethodName: "RemoveDevicePIN",
+			Handler:    _UserService_RemoveDevicePIN_Handler,
+		},
+		{
+			MethodName: "ReAuthenticateWithPIN",
+			Handler:    _UserService_ReAuthenticateWithPIN_Handler,
+		},
+		{
+			MethodName: "RegisterDeviceKey",
+			Handler:    _UserService_RegisterDeviceKey_Handler,
+		},
+		{
+			MethodName: "RemoveDeviceKey",
+			Handler:    _UserService_RemoveDeviceKey_Handler,
+		},
+		{
+			MethodName: "IssueBiometricChallenge",
+			Handler:    _UserService_IssueBiometricChallenge_Handler,
+		},
+		{
+			MethodName: "ReAuthenticateWithBiometric",
+			Handler:    _UserService_ReAuthenticateWithBiometric_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _UserService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "ExchangeToken",
+			Handler:    _UserService_ExchangeToken_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _UserService_GetQuotaUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchUserMetrics",
+			Handler:       _UserService_WatchUserMetrics_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadKYCDocument",
+			Handler:       _UserService_UploadKYCDocument_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadAvatar",
+			Handler:       _UserService_UploadAvatar_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportSecurityEvents",
+			Handler:       _UserService_ExportSecurityEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
 }
@@ -0,0 +1,418 @@
+// Package config loads the settings the server needs before it can start:
+// listen addresses, the MongoDB connection and collection names, TLS files,
+// the internal callers let in, event delivery and webhook tokens. Defaults
+// are overridden by an optional YAML file, then by environment variables,
+// then by command-line flags. Integrations with their own configuration,
+// like the token issuer, the runtime tunables or provider credentials, keep
+// reading their own variables.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the startup configuration of the server. A YAML file looks
+// like:
+//
+//	listen_addr: :50051
+//	http_addr: :8080
+//	metrics_addr: :9090
+//	admin_addr: :9091
+//	shutdown_grace_period: 20s
+//	account_deletion_grace_days: 30
+//	api_keys: orders:secret:profile.read
+//	mtls_clients: spiffe://cluster.local/ns/orders/sa/orders:profile.read
+//	events:
+//	  webhook_url: https://events.internal/users
+//	  format: debezium
+//	  outbox_max_attempts: 10
+//	webhooks:
+//	  email_token: secret
+//	  sms_token: secret
+//	mongo:
+//	  uri: mongodb://mongo:27017
+//	  database: userdb
+//	  connect_timeout: 10s
+//	  server_selection_timeout: 30s
+//	  collection_prefix: staging_
+//	  collections:
+//	    audit_log: audit_log_v2
+//	  tenants:
+//	    acme:
+//	      database: userdb_acme
+//	tls:
+//	  cert_file: /etc/tls/tls.crt
+//	  key_file: /etc/tls/tls.key
+//	  client_ca_file: /etc/tls/ca.crt
+type Config struct {
+	// ListenAddr is the TCP address of the gRPC server
+	ListenAddr string `yaml:"listen_addr"`
+	// HTTPAddr serves file downloads and provider webhooks
+	HTTPAddr    string `yaml:"http_addr"`
+	MetricsAddr string `yaml:"metrics_addr"`
+	AdminAddr   string `yaml:"admin_addr"`
+
+	// ShutdownGracePeriod is how long in-flight calls may run after SIGTERM
+	// before they are cut off. Keep it below the orchestrator's kill timeout.
+	ShutdownGracePeriod time.Duration `yaml:"shutdown_grace_period"`
+
+	// AccountDeletionGraceDays is how long a deleted account can still be
+	// restored before it is erased
+	AccountDeletionGraceDays int `yaml:"account_deletion_grace_days"`
+
+	// APIKeys lets internal services in by key, written as
+	// "service:key:scope1,scope2;service2:key2:scope3"
+	APIKeys string `yaml:"api_keys"`
+	// MTLSClients lets internal services in by client certificate, written
+	// as "common-name:scope1,scope2;common-name2:scope3"
+	MTLSClients string `yaml:"mtls_clients"`
+
+	Events   EventsConfig  `yaml:"events"`
+	Webhooks WebhookConfig `yaml:"webhooks"`
+	Mongo    MongoConfig   `yaml:"mongo"`
+	TLS      TLSConfig     `yaml:"tls"`
+}
+
+// EventsConfig is the delivery of outbox events to downstream consumers
+type EventsConfig struct {
+	// WebhookURL receives every event; without one events are only logged
+	WebhookURL string `yaml:"webhook_url"`
+	// Format is native or debezium
+	Format string `yaml:"format"`
+	// OutboxMaxAttempts failed deliveries move an event to the dead letters
+	OutboxMaxAttempts int `yaml:"outbox_max_attempts"`
+}
+
+// WebhookConfig holds the tokens delivery-receipt webhooks must present.
+// A webhook without a token is not served.
+type WebhookConfig struct {
+	EmailToken string `yaml:"email_token"`
+	SMSToken   string `yaml:"sms_token"`
+}
+
+// MongoConfig is the database connection
+type MongoConfig struct {
+	URI      string `yaml:"uri"`
+	Database string `yaml:"database"`
+	// ConnectTimeout bounds dialing one server
+	ConnectTimeout time.Duration `yaml:"connect_timeout"`
+	// ServerSelectionTimeout bounds how long an operation waits for a
+	// suitable server, so calls fail instead of hanging during an outage
+	ServerSelectionTimeout time.Duration `yaml:"server_selection_timeout"`
+
+	// CollectionPrefix is put in front of every collection name, so
+	// environments can share a database
+	CollectionPrefix string `yaml:"collection_prefix"`
+	// Collections renames single collections, keyed by their default name.
+	// A renamed collection takes no prefix.
+	Collections map[string]string `yaml:"collections"`
+	// Tenants keeps the data of some tenants apart from the rest, keyed by
+	// tenant ID. Tenants not listed use the settings above.
+	Tenants map[string]TenantMongoConfig `yaml:"tenants"`
+}
+
+// TenantMongoConfig overrides where one tenant's data lives. Fields left
+// empty fall back to the MongoConfig ones.
+type TenantMongoConfig struct {
+	Database         string            `yaml:"database"`
+	CollectionPrefix string            `yaml:"collection_prefix"`
+	Collections      map[string]string `yaml:"collections"`
+}
+
+// DatabaseName returns the database holding the data of tenant, "" for
+// requests without one
+func (c MongoConfig) DatabaseName(tenant string) string {
+	if t, ok := c.Tenants[tenant]; ok && t.Database != "" {
+		return t.Database
+	}
+	return c.Database
+}
+
+// CollectionName returns the name of the collection the code calls name
+// for tenant
+func (c MongoConfig) CollectionName(tenant, name string) string {
+	t, ok := c.Tenants[tenant]
+	if renamed := t.Collections[name]; ok && renamed != "" {
+		return renamed
+	}
+	if renamed := c.Collections[name]; renamed != "" {
+		return renamed
+	}
+	if ok && t.CollectionPrefix != "" {
+		return t.CollectionPrefix + name
+	}
+	return c.CollectionPrefix + name
+}
+
+// TenantIDs returns the tenants with storage of their own, sorted
+func (c MongoConfig) TenantIDs() []string {
+	ids := make([]string, 0, len(c.Tenants))
+	for id := range c.Tenants {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// TLSConfig names the server certificate files. Without a certificate the
+// server speaks plaintext.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ClientCAFile verifies the client certificates of internal services
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+// Enabled reports whether the server speaks TLS
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+// Default returns the settings used for anything left unset
+func Default() Config {
+	return Config{
+		ListenAddr:  ":50051",
+		HTTPAddr:    ":8080",
+		MetricsAddr: ":9090",
+		AdminAddr:   ":9091",
+
+		ShutdownGracePeriod:      20 * time.Second,
+		AccountDeletionGraceDays: 30,
+		Events: EventsConfig{
+			Format:            "native",
+			OutboxMaxAttempts: 10,
+		},
+		Mongo: MongoConfig{
+			Database:               "userdb",
+			ConnectTimeout:         10 * time.Second,
+			ServerSelectionTimeout: 30 * time.Second,
+		},
+	}
+}
+
+// setting is one value that can come from the environment and a flag
+type setting struct {
+	env, flag, usage string
+	apply            func(string) error
+}
+
+func stringSetting(env, name, usage string, dst *string) setting {
+	return setting{env, name, usage, func(v string) error {
+		*dst = v
+		return nil
+	}}
+}
+
+func durationSetting(env, name, usage string, dst *time.Duration) setting {
+	return setting{env, name, usage, func(v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("%s must be a duration like 10s", env)
+		}
+		*dst = d
+		return nil
+	}}
+}
+
+func intSetting(env, name, usage string, dst *int) setting {
+	return setting{env, name, usage, func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%s must be a whole number", env)
+		}
+		*dst = n
+		return nil
+	}}
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		stringSetting("GRPC_ADDR", "listen", "gRPC listen address", &c.ListenAddr),
+		stringSetting("HTTP_ADDR", "http-addr", "HTTP listen address for downloads and webhooks", &c.HTTPAddr),
+		stringSetting("METRICS_ADDR", "metrics-addr", "Prometheus metrics listen address", &c.MetricsAddr),
+		stringSetting("ADMIN_ADDR", "admin-addr", "health and diagnostics listen address", &c.AdminAddr),
+		durationSetting("SHUTDOWN_GRACE_PERIOD", "shutdown-grace-period", "how long in-flight calls may finish on shutdown", &c.ShutdownGracePeriod),
+		intSetting("ACCOUNT_DELETION_GRACE_DAYS", "account-deletion-grace-days", "days a deleted account can be restored", &c.AccountDeletionGraceDays),
+		stringSetting("API_KEYS", "api-keys", "API keys of internal services", &c.APIKeys),
+		stringSetting("MTLS_CLIENTS", "mtls-clients", "client certificates of internal services", &c.MTLSClients),
+		stringSetting("EVENTS_WEBHOOK_URL", "events-webhook-url", "URL outbox events are posted to", &c.Events.WebhookURL),
+		stringSetting("EVENTS_FORMAT", "events-format", "encoding of outbox events, native or debezium", &c.Events.Format),
+		intSetting("OUTBOX_MAX_ATTEMPTS", "outbox-max-attempts", "deliveries of an event before it is dead-lettered", &c.Events.OutboxMaxAttempts),
+		stringSetting("EMAIL_WEBHOOK_TOKEN", "email-webhook-token", "token of the email delivery webhooks", &c.Webhooks.EmailToken),
+		stringSetting("SMS_WEBHOOK_TOKEN", "sms-webhook-token", "token of the SMS delivery receipt webhook", &c.Webhooks.SMSToken),
+		stringSetting("MONGODB_URI", "mongo-uri", "MongoDB connection string", &c.Mongo.URI),
+		stringSetting("MONGODB_DATABASE", "mongo-database", "MongoDB database name", &c.Mongo.Database),
+		stringSetting("MONGODB_COLLECTION_PREFIX", "mongo-collection-prefix", "prefix of every MongoDB collection name", &c.Mongo.CollectionPrefix),
+		durationSetting("MONGODB_CONNECT_TIMEOUT", "mongo-connect-timeout", "timeout for dialing a MongoDB server", &c.Mongo.ConnectTimeout),
+		durationSetting("MONGODB_SERVER_SELECTION_TIMEOUT", "mongo-server-selection-timeout", "how long operations wait for a MongoDB server", &c.Mongo.ServerSelectionTimeout),
+		stringSetting("GRPC_TLS_CERT_FILE", "tls-cert", "server certificate file", &c.TLS.CertFile),
+		stringSetting("GRPC_TLS_KEY_FILE", "tls-key", "server private key file", &c.TLS.KeyFile),
+		stringSetting("GRPC_TLS_CLIENT_CA_FILE", "tls-client-ca", "CA file verifying client certificates", &c.TLS.ClientCAFile),
+	}
+}
+
+// LoadDotEnv reads a .env file into the environment when there is one.
+// Variables already set keep their values, so the real environment always
+// wins.
+func LoadDotEnv(path string) error {
+	err := godotenv.Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Load builds the configuration from the command-line arguments, without
+// the program name. The YAML file is named by -config or CONFIG_FILE.
+func Load(args []string) (Config, error) {
+	cfg := Default()
+	settings := cfg.settings()
+
+	fset := flag.NewFlagSet("userservice", flag.ContinueOnError)
+	path := fset.String("config", os.Getenv("CONFIG_FILE"), "YAML configuration file")
+	flags := make(map[string]*string, len(settings))
+	for _, s := range settings {
+		flags[s.flag] = fset.String(s.flag, "", s.usage+" (env "+s.env+")")
+	}
+	if err := fset.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	if *path != "" {
+		data, err := os.ReadFile(*path)
+		if err != nil {
+			return cfg, err
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("config: parse %s: %w", *path, err)
+		}
+	}
+	for _, s := range settings {
+		if v := os.Getenv(s.env); v != "" {
+			if err := s.apply(v); err != nil {
+				return cfg, fmt.Errorf("config: %w", err)
+			}
+		}
+	}
+	var flagErr error
+	fset.Visit(func(f *flag.Flag) {
+		for _, s := range settings {
+			if s.flag == f.Name && flagErr == nil {
+				if err := s.apply(*flags[s.flag]); err != nil {
+					flagErr = fmt.Errorf("config: -%s: %w", s.flag, err)
+				}
+			}
+		}
+	})
+	if flagErr != nil {
+		return cfg, flagErr
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that the configuration can be started with
+func (c Config) Validate() error {
+	addrs := []struct{ name, addr string }{
+		{"listen_addr", c.ListenAddr},
+		{"http_addr", c.HTTPAddr},
+		{"metrics_addr", c.MetricsAddr},
+		{"admin_addr", c.AdminAddr},
+	}
+	for _, a := range addrs {
+		if _, _, err := net.SplitHostPort(a.addr); err != nil {
+			return fmt.Errorf("config: %s %q must be host:port", a.name, a.addr)
+		}
+	}
+
+	if c.ShutdownGracePeriod < 0 {
+		return errors.New("config: shutdown_grace_period must not be negative")
+	}
+	if c.AccountDeletionGraceDays < 0 {
+		return errors.New("config: account_deletion_grace_days must not be negative")
+	}
+	if c.Events.Format != "native" && c.Events.Format != "debezium" {
+		return fmt.Errorf("config: events format %q must be native or debezium", c.Events.Format)
+	}
+	if c.Events.OutboxMaxAttempts < 1 {
+		return errors.New("config: events outbox_max_attempts must be at least 1")
+	}
+
+	if c.Mongo.URI == "" {
+		return errors.New("config: mongo uri is required, set MONGODB_URI or -mongo-uri")
+	}
+	if !strings.HasPrefix(c.Mongo.URI, "mongodb://") && !strings.HasPrefix(c.Mongo.URI, "mongodb+srv://") {
+		return errors.New("config: mongo uri must start with mongodb:// or mongodb+srv://")
+	}
+	if err := validateDatabaseName(c.Mongo.Database); err != nil {
+		return err
+	}
+	if err := validateCollectionNames(c.Mongo.CollectionPrefix, c.Mongo.Collections); err != nil {
+		return err
+	}
+	for _, id := range c.Mongo.TenantIDs() {
+		t := c.Mongo.Tenants[id]
+		if id == "" {
+			return errors.New("config: mongo tenants need an ID")
+		}
+		if t.Database != "" {
+			if err := validateDatabaseName(t.Database); err != nil {
+				return fmt.Errorf("%w (tenant %s)", err, id)
+			}
+		}
+		if err := validateCollectionNames(t.CollectionPrefix, t.Collections); err != nil {
+			return fmt.Errorf("%w (tenant %s)", err, id)
+		}
+	}
+	if c.Mongo.ConnectTimeout <= 0 || c.Mongo.ServerSelectionTimeout <= 0 {
+		return errors.New("config: mongo timeouts must be positive")
+	}
+
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return errors.New("config: tls cert_file and key_file must be set together")
+	}
+	if c.TLS.ClientCAFile != "" && !c.TLS.Enabled() {
+		return errors.New("config: tls client_ca_file needs a server certificate")
+	}
+	return nil
+}
+
+// validateDatabaseName applies the MongoDB naming rules, which the driver
+// only enforces on the first operation
+func validateDatabaseName(name string) error {
+	if name == "" {
+		return errors.New("config: mongo database is required")
+	}
+	if len(name) > 63 {
+		return errors.New("config: mongo database name must be at most 63 characters")
+	}
+	if strings.ContainsAny(name, "/\\. \"$\x00") {
+		return fmt.Errorf("config: mongo database name %q contains a character MongoDB does not allow", name)
+	}
+	return nil
+}
+
+// validateCollectionNames applies the MongoDB collection naming rules to a
+// prefix and renames
+func validateCollectionNames(prefix string, renames map[string]string) error {
+	if strings.ContainsAny(prefix, "$\x00") || strings.HasPrefix(prefix, "system.") {
+		return fmt.Errorf("config: mongo collection prefix %q is not allowed by MongoDB", prefix)
+	}
+	for name, renamed := range renames {
+		if renamed == "" || strings.ContainsAny(renamed, "$\x00") || strings.HasPrefix(renamed, "system.") {
+			return fmt.Errorf("config: mongo collection %s cannot be named %q", name, renamed)
+		}
+	}
+	return nil
+}
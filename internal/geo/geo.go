@@ -0,0 +1,69 @@
+// Package geo validates and geocodes postal addresses through a pluggable
+// provider.
+package geo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ErrNoMatch is returned when the provider cannot place an address
+var ErrNoMatch = errors.New("geo: address not found")
+
+const httpTimeout = 10 * time.Second
+
+// Query is an address as entered by the user
+type Query struct {
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	// Country is an ISO 3166-1 alpha-2 code
+	Country string
+}
+
+// Result is the normalized address and its coordinates
+type Result struct {
+	FormattedAddress string
+	Street           string
+	City             string
+	Region           string
+	PostalCode       string
+	Country          string
+	Latitude         float64
+	Longitude        float64
+	PlaceID          string
+}
+
+// Geocoder resolves addresses to normalized components and coordinates
+type Geocoder interface {
+	Name() string
+	Geocode(ctx context.Context, q Query) (Result, error)
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, header http.Header) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if client == nil {
+		client = &http.Client{Timeout: httpTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("geo: provider returned %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
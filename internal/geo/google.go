@@ -0,0 +1,97 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const googleGeocodeURL = "https://maps.googleapis.com/maps/api/geocode/json"
+
+// Google geocodes with the Google Maps Geocoding API
+type Google struct {
+	APIKey string
+	Client *http.Client
+}
+
+func (g *Google) Name() string { return "google" }
+
+func (g *Google) Geocode(ctx context.Context, q Query) (Result, error) {
+	params := url.Values{
+		"address": {strings.Join(nonEmpty(q.Line1, q.Line2, q.City, q.Region, q.PostalCode), ", ")},
+		"key":     {g.APIKey},
+	}
+	if q.Country != "" {
+		params.Set("components", "country:"+q.Country)
+	}
+
+	body, err := getJSON(ctx, g.Client, googleGeocodeURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var resp struct {
+		Status  string `json:"status"`
+		Results []struct {
+			PlaceID           string `json:"place_id"`
+			FormattedAddress  string `json:"formatted_address"`
+			AddressComponents []struct {
+				LongName  string   `json:"long_name"`
+				ShortName string   `json:"short_name"`
+				Types     []string `json:"types"`
+			} `json:"address_components"`
+			Geometry struct {
+				Location struct {
+					Lat float64 `json:"lat"`
+					Lng float64 `json:"lng"`
+				} `json:"location"`
+			} `json:"geometry"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Result{}, err
+	}
+	switch resp.Status {
+	case "OK":
+	case "ZERO_RESULTS":
+		return Result{}, ErrNoMatch
+	default:
+		return Result{}, fmt.Errorf("geo: google returned %s", resp.Status)
+	}
+
+	top := resp.Results[0]
+	r := Result{
+		FormattedAddress: top.FormattedAddress,
+		Latitude:         top.Geometry.Location.Lat,
+		Longitude:        top.Geometry.Location.Lng,
+		PlaceID:          top.PlaceID,
+	}
+	var number, route string
+	for _, c := range top.AddressComponents {
+		for _, t := range c.Types {
+			switch t {
+			case "street_number":
+				number = c.LongName
+			case "route":
+				route = c.LongName
+			case "locality":
+				r.City = c.LongName
+			case "postal_town":
+				if r.City == "" {
+					r.City = c.LongName
+				}
+			case "administrative_area_level_1":
+				r.Region = c.LongName
+			case "postal_code":
+				r.PostalCode = c.LongName
+			case "country":
+				r.Country = c.ShortName
+			}
+		}
+	}
+	r.Street = strings.Join(nonEmpty(number, route), " ")
+	return r, nil
+}
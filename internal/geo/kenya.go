@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"strings"
+	"unicode"
+)
+
+// County is one of Kenya's 47 counties with its official code
+type County struct {
+	Code string
+	Name string
+}
+
+// KenyaCounties is the county list from the First Schedule of the Constitution
+var KenyaCounties = []County{
+	{"001", "Mombasa"}, {"002", "Kwale"}, {"003", "Kilifi"}, {"004", "Tana River"},
+	{"005", "Lamu"}, {"006", "Taita-Taveta"}, {"007", "Garissa"}, {"008", "Wajir"},
+	{"009", "Mandera"}, {"010", "Marsabit"}, {"011", "Isiolo"}, {"012", "Meru"},
+	{"013", "Tharaka-Nithi"}, {"014", "Embu"}, {"015", "Kitui"}, {"016", "Machakos"},
+	{"017", "Makueni"}, {"018", "Nyandarua"}, {"019", "Nyeri"}, {"020", "Kirinyaga"},
+	{"021", "Murang'a"}, {"022", "Kiambu"}, {"023", "Turkana"}, {"024", "West Pokot"},
+	{"025", "Samburu"}, {"026", "Trans Nzoia"}, {"027", "Uasin Gishu"}, {"028", "Elgeyo-Marakwet"},
+	{"029", "Nandi"}, {"030", "Baringo"}, {"031", "Laikipia"}, {"032", "Nakuru"},
+	{"033", "Narok"}, {"034", "Kajiado"}, {"035", "Kericho"}, {"036", "Bomet"},
+	{"037", "Kakamega"}, {"038", "Vihiga"}, {"039", "Bungoma"}, {"040", "Busia"},
+	{"041", "Siaya"}, {"042", "Kisumu"}, {"043", "Homa Bay"}, {"044", "Migori"},
+	{"045", "Kisii"}, {"046", "Nyamira"}, {"047", "Nairobi"},
+}
+
+// countyAliases maps spellings seen in user input to the official name
+var countyAliases = map[string]string{
+	"nairobicity": "Nairobi",
+	"muranga":     "Murang'a",
+	"taveta":      "Taita-Taveta",
+	"tharaka":     "Tharaka-Nithi",
+	"keiyo":       "Elgeyo-Marakwet",
+}
+
+var countiesByKey = func() map[string]County {
+	m := make(map[string]County, len(KenyaCounties))
+	for _, c := range KenyaCounties {
+		m[countyKey(c.Name)] = c
+	}
+	return m
+}()
+
+// countyKey folds case, spacing and punctuation so "homa bay", "Homa-Bay"
+// and "HOMABAY" compare equal
+func countyKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "county")
+}
+
+// LookupKenyaCounty resolves user input to a county
+func LookupKenyaCounty(name string) (County, bool) {
+	key := countyKey(name)
+	if alias, ok := countyAliases[key]; ok {
+		key = countyKey(alias)
+	}
+	c, ok := countiesByKey[key]
+	return c, ok
+}
@@ -0,0 +1,105 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultNominatimURL is the public OpenStreetMap instance. Its usage policy
+// requires an identifying User-Agent and at most one request per second.
+const DefaultNominatimURL = "https://nominatim.openstreetmap.org"
+
+// Nominatim geocodes with OpenStreetMap data
+type Nominatim struct {
+	BaseURL   string
+	UserAgent string
+	Client    *http.Client
+}
+
+func (n *Nominatim) Name() string { return "nominatim" }
+
+func (n *Nominatim) Geocode(ctx context.Context, q Query) (Result, error) {
+	params := url.Values{
+		"format":         {"jsonv2"},
+		"addressdetails": {"1"},
+		"limit":          {"1"},
+		"q":              {strings.Join(nonEmpty(q.Line1, q.Line2, q.City, q.Region, q.PostalCode), ", ")},
+	}
+	if q.Country != "" {
+		params.Set("countrycodes", strings.ToLower(q.Country))
+	}
+
+	body, err := getJSON(ctx, n.Client, strings.TrimRight(n.BaseURL, "/")+"/search?"+params.Encode(),
+		http.Header{"User-Agent": {n.UserAgent}})
+	if err != nil {
+		return Result{}, err
+	}
+
+	var places []struct {
+		PlaceID     int64  `json:"place_id"`
+		Lat         string `json:"lat"`
+		Lon         string `json:"lon"`
+		DisplayName string `json:"display_name"`
+		Address     struct {
+			HouseNumber string `json:"house_number"`
+			Road        string `json:"road"`
+			City        string `json:"city"`
+			Town        string `json:"town"`
+			Village     string `json:"village"`
+			State       string `json:"state"`
+			County      string `json:"county"`
+			Postcode    string `json:"postcode"`
+			CountryCode string `json:"country_code"`
+		} `json:"address"`
+	}
+	if err := json.Unmarshal(body, &places); err != nil {
+		return Result{}, err
+	}
+	if len(places) == 0 {
+		return Result{}, ErrNoMatch
+	}
+
+	p := places[0]
+	lat, err := strconv.ParseFloat(p.Lat, 64)
+	if err != nil {
+		return Result{}, err
+	}
+	lon, err := strconv.ParseFloat(p.Lon, 64)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		FormattedAddress: p.DisplayName,
+		Street:           strings.Join(nonEmpty(p.Address.HouseNumber, p.Address.Road), " "),
+		City:             firstNonEmpty(p.Address.City, p.Address.Town, p.Address.Village),
+		Region:           firstNonEmpty(p.Address.State, p.Address.County),
+		PostalCode:       p.Address.Postcode,
+		Country:          strings.ToUpper(p.Address.CountryCode),
+		Latitude:         lat,
+		Longitude:        lon,
+		PlaceID:          strconv.FormatInt(p.PlaceID, 10),
+	}, nil
+}
+
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+type Address struct {
+	Kind             string `json:"kind"`
+	RecipientName    string `json:"recipientName"`
+	Line1            string `json:"line1"`
+	Line2            string `json:"line2"`
+	City             string `json:"city"`
+	Region           string `json:"region"`
+	PostalCode       string `json:"postalCode"`
+	Country          string `json:"country"`
+	County           string `json:"county"`
+	SubCounty        string `json:"subCounty"`
+	Landmark         string `json:"landmark"`
+	FormattedAddress string `json:"formattedAddress"`
+	Redacted         bool   `json:"redacted"`
+}
+
+type Consent struct {
+	Purpose string `json:"purpose"`
+	Granted bool   `json:"granted"`
+}
+
+type NotificationPreference struct {
+	Kind     string   `json:"kind"`
+	Channels []string `json:"channels"`
+}
+
+type Preferences struct {
+	Locale        string                    `json:"locale"`
+	Timezone      string                    `json:"timezone"`
+	Notifications []*NotificationPreference `json:"notifications"`
+	Consents      []*Consent                `json:"consents"`
+}
+
+type Query struct {
+}
+
+type Session struct {
+	State      string     `json:"state"`
+	Online     bool       `json:"online"`
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+}
+
+type User struct {
+	ID            string       `json:"id"`
+	FullName      string       `json:"fullName"`
+	UserName      string       `json:"userName"`
+	Email         string       `json:"email"`
+	Phone         string       `json:"phone"`
+	Tier          string       `json:"tier"`
+	EmailVerified bool         `json:"emailVerified"`
+	CreatedAt     time.Time    `json:"createdAt"`
+	LastLoginAt   *time.Time   `json:"lastLoginAt,omitempty"`
+	Preferences   *Preferences `json:"preferences"`
+	Addresses     []*Address   `json:"addresses"`
+	Sessions      []*Session   `json:"sessions"`
+}
@@ -0,0 +1,27 @@
+// Package graph is a GraphQL facade over the user domain for backend-for-
+// frontend services. Resolvers only shape data; every lookup goes through a
+// Backend so the RPC layer keeps enforcing scopes, redaction and auditing.
+package graph
+
+//go:generate go run github.com/99designs/gqlgen generate
+
+import (
+	"context"
+
+	"github.com/bruceoaudo/userService/internal/graph/model"
+)
+
+// MaxBatchUsers caps the ids accepted by the users query
+const MaxBatchUsers = 50
+
+// Backend loads user data on behalf of the resolvers
+type Backend interface {
+	User(ctx context.Context, id string) (*model.User, error)
+	Addresses(ctx context.Context, userID string) ([]*model.Address, error)
+	Sessions(ctx context.Context, userID string) ([]*model.Session, error)
+}
+
+// Resolver is the root resolver
+type Resolver struct {
+	Backend Backend
+}
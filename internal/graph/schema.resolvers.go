@@ -0,0 +1,54 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bruceoaudo/userService/internal/graph/model"
+)
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
+	return r.Backend.User(ctx, id)
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, ids []string) ([]*model.User, error) {
+	if len(ids) > MaxBatchUsers {
+		return nil, fmt.Errorf("at most %d users per query", MaxBatchUsers)
+	}
+	users := make([]*model.User, 0, len(ids))
+	for _, id := range ids {
+		user, err := r.Backend.User(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// Addresses is the resolver for the addresses field.
+func (r *userResolver) Addresses(ctx context.Context, obj *model.User) ([]*model.Address, error) {
+	return r.Backend.Addresses(ctx, obj.ID)
+}
+
+// Sessions is the resolver for the sessions field.
+func (r *userResolver) Sessions(ctx context.Context, obj *model.User) ([]*model.Session, error) {
+	return r.Backend.Sessions(ctx, obj.ID)
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type queryResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
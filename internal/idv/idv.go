@@ -0,0 +1,80 @@
+// Package idv starts identity verification sessions with an external
+// provider and interprets the provider's result callbacks.
+package idv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Status is the outcome of a verification session
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusVerified Status = "verified"
+	StatusFailed   Status = "failed"
+	// StatusReview means the provider needs a manual check before deciding
+	StatusReview Status = "review"
+)
+
+// ErrInvalidSignature is returned for callbacks that were not sent by the provider
+var ErrInvalidSignature = errors.New("idv: invalid callback signature")
+
+// ErrIgnoredCallback is returned for callbacks that carry no final result
+var ErrIgnoredCallback = errors.New("idv: callback carries no result")
+
+const httpTimeout = 15 * time.Second
+
+// Applicant is the person being verified
+type Applicant struct {
+	UserID   string
+	FullName string
+	Email    string
+	Phone    string
+	Country  string
+}
+
+// Session is a started verification the user completes on the provider's page
+type Session struct {
+	ID  string
+	URL string
+}
+
+// Result is the provider's decision for a session
+type Result struct {
+	SessionID string
+	Status    Status
+	Reason    string
+}
+
+// Provider is an identity verification vendor
+type Provider interface {
+	Name() string
+	StartSession(ctx context.Context, a Applicant) (Session, error)
+	// ParseCallback authenticates and decodes a result webhook
+	ParseCallback(header http.Header, body []byte) (Result, error)
+}
+
+func doJSON(client *http.Client, req *http.Request) ([]byte, error) {
+	if client == nil {
+		client = &http.Client{Timeout: httpTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("idv: provider returned %s", resp.Status)
+	}
+	return body, nil
+}
@@ -0,0 +1,116 @@
+package idv
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// DefaultOnfidoURL is the EU region API
+const DefaultOnfidoURL = "https://api.eu.onfido.com/v3.6"
+
+// Onfido runs an Onfido Studio workflow for each session
+type Onfido struct {
+	BaseURL      string
+	APIToken     string
+	WebhookToken string
+	WorkflowID   string
+	Client       *http.Client
+}
+
+func (o *Onfido) Name() string { return "onfido" }
+
+func (o *Onfido) post(ctx context.Context, path string, in, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(o.BaseURL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Token token="+o.APIToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := doJSON(o.Client, req)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resp, out)
+}
+
+func (o *Onfido) StartSession(ctx context.Context, a Applicant) (Session, error) {
+	first, last := a.FullName, ""
+	if i := strings.LastIndex(a.FullName, " "); i > 0 {
+		first, last = a.FullName[:i], a.FullName[i+1:]
+	}
+
+	var applicant struct {
+		ID string `json:"id"`
+	}
+	err := o.post(ctx, "/applicants", map[string]string{
+		"first_name": first,
+		"last_name":  last,
+		"email":      a.Email,
+	}, &applicant)
+	if err != nil {
+		return Session{}, err
+	}
+
+	var run struct {
+		ID   string `json:"id"`
+		Link struct {
+			URL string `json:"url"`
+		} `json:"link"`
+	}
+	err = o.post(ctx, "/workflow_runs", map[string]string{
+		"workflow_id":  o.WorkflowID,
+		"applicant_id": applicant.ID,
+	}, &run)
+	if err != nil {
+		return Session{}, err
+	}
+	return Session{ID: run.ID, URL: run.Link.URL}, nil
+}
+
+func (o *Onfido) ParseCallback(header http.Header, body []byte) (Result, error) {
+	mac := hmac.New(sha256.New, []byte(o.WebhookToken))
+	mac.Write(body)
+	got, err := hex.DecodeString(header.Get("X-SHA2-Signature"))
+	if err != nil || !hmac.Equal(got, mac.Sum(nil)) {
+		return Result{}, ErrInvalidSignature
+	}
+
+	var event struct {
+		Payload struct {
+			ResourceType string `json:"resource_type"`
+			Action       string `json:"action"`
+			Object       struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+			} `json:"object"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return Result{}, err
+	}
+	if event.Payload.Action != "workflow_run.completed" {
+		return Result{}, ErrIgnoredCallback
+	}
+
+	r := Result{SessionID: event.Payload.Object.ID, Reason: event.Payload.Object.Status}
+	switch event.Payload.Object.Status {
+	case "approved":
+		r.Status = StatusVerified
+	case "review":
+		r.Status = StatusReview
+	default:
+		r.Status = StatusFailed
+	}
+	return r, nil
+}
@@ -0,0 +1,161 @@
+package legacyimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Target fields a mapping can fill
+const (
+	FieldLegacyID     = "legacy_id"
+	FieldFullName     = "full_name"
+	FieldUserName     = "user_name"
+	FieldEmail        = "email"
+	FieldPhone        = "phone"
+	FieldPasswordHash = "password_hash"
+	FieldCreatedAt    = "created_at"
+	FieldDateOfBirth  = "date_of_birth"
+	FieldGender       = "gender"
+	FieldLocale       = "locale"
+	FieldTags         = "tags"
+)
+
+var targetFields = map[string]bool{
+	FieldLegacyID:     true,
+	FieldFullName:     true,
+	FieldUserName:     true,
+	FieldEmail:        true,
+	FieldPhone:        true,
+	FieldPasswordHash: true,
+	FieldCreatedAt:    true,
+	FieldDateOfBirth:  true,
+	FieldGender:       true,
+	FieldLocale:       true,
+	FieldTags:         true,
+}
+
+// Rule fills one target field. Values of all Sources are joined with a
+// space, which covers dumps that split first and last names, then the
+// Transforms run in order. Default is used when the result is empty.
+type Rule struct {
+	Sources    []string `json:"sources"`
+	Transforms []string `json:"transforms,omitempty"`
+	Default    string   `json:"default,omitempty"`
+}
+
+// Mapping maps target fields to rules
+type Mapping map[string]Rule
+
+var transforms = map[string]func(string) string{
+	"trim":  strings.TrimSpace,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"title": titleCase,
+	"digits": func(v string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, v)
+	},
+	"alnum": func(v string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsNumber(r) {
+				return r
+			}
+			return -1
+		}, v)
+	},
+	"email_local": func(v string) string {
+		if at := strings.LastIndex(v, "@"); at > 0 {
+			return v[:at]
+		}
+		return v
+	},
+	"strip_mailto": func(v string) string {
+		return strings.TrimPrefix(strings.TrimPrefix(v, "mailto:"), "MAILTO:")
+	},
+}
+
+// DefaultMapping reads the column names used by the previous platform's
+// customer export
+var DefaultMapping = Mapping{
+	FieldLegacyID:     {Sources: []string{"customer_id"}, Transforms: []string{"trim"}},
+	FieldFullName:     {Sources: []string{"first_name", "last_name"}, Transforms: []string{"trim", "title"}},
+	FieldUserName:     {Sources: []string{"username"}, Transforms: []string{"trim"}},
+	FieldEmail:        {Sources: []string{"email"}, Transforms: []string{"trim", "strip_mailto"}},
+	FieldPhone:        {Sources: []string{"phone"}, Transforms: []string{"trim"}},
+	FieldPasswordHash: {Sources: []string{"password_hash"}, Transforms: []string{"trim"}},
+	FieldCreatedAt:    {Sources: []string{"created_at"}, Transforms: []string{"trim"}},
+}
+
+// ParseMapping decodes a JSON mapping and checks its fields and transforms
+func ParseMapping(raw []byte) (Mapping, error) {
+	var m Mapping
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("legacyimport: invalid mapping: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate reports unknown target fields or transforms, and a missing
+// legacy_id rule, which re-runs rely on to skip rows already imported
+func (m Mapping) Validate() error {
+	for field, rule := range m {
+		if !targetFields[field] {
+			return fmt.Errorf("legacyimport: unknown target field %q", field)
+		}
+		if len(rule.Sources) == 0 && rule.Default == "" {
+			return fmt.Errorf("legacyimport: field %q needs sources or a default", field)
+		}
+		for _, t := range rule.Transforms {
+			if transforms[t] == nil {
+				return fmt.Errorf("legacyimport: unknown transform %q for field %q", t, field)
+			}
+		}
+	}
+	if _, ok := m[FieldLegacyID]; !ok {
+		return fmt.Errorf("legacyimport: mapping must set %q", FieldLegacyID)
+	}
+	return nil
+}
+
+// Apply maps a record onto target fields. Empty results are left out.
+func (m Mapping) Apply(rec Record) map[string]string {
+	out := make(map[string]string, len(m))
+	for field, rule := range m {
+		parts := make([]string, 0, len(rule.Sources))
+		for _, src := range rule.Sources {
+			if v := strings.TrimSpace(rec[src]); v != "" {
+				parts = append(parts, v)
+			}
+		}
+		v := strings.Join(parts, " ")
+		for _, t := range rule.Transforms {
+			v = transforms[t](v)
+		}
+		if v == "" {
+			v = rule.Default
+		}
+		if v != "" {
+			out[field] = v
+		}
+	}
+	return out
+}
+
+func titleCase(v string) string {
+	words := strings.Fields(strings.ToLower(v))
+	for i, w := range words {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
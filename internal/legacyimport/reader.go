@@ -0,0 +1,193 @@
+// Package legacyimport reads user dumps exported from the previous shop
+// platform and maps their rows onto user fields with configurable rules.
+package legacyimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Supported dump formats
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// ErrUnknownFormat is returned for dump formats other than CSV and JSON
+var ErrUnknownFormat = errors.New("legacyimport: unknown format")
+
+// Record is one row of a dump keyed by column name. Nested JSON objects are
+// flattened with dotted keys, e.g. "address.city".
+type Record map[string]string
+
+// Read calls fn for every row of the dump with its 1-based row number.
+// JSON dumps may be a single array or one object per line. A malformed row
+// is passed to fn as a nil record with the parse error so the caller can
+// report it and carry on.
+func Read(r io.Reader, format string, fn func(row int, rec Record, err error) error) error {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return readCSV(r, fn)
+	case FormatJSON:
+		return readJSON(r, fn)
+	}
+	return ErrUnknownFormat
+}
+
+func readCSV(r io.Reader, fn func(int, Record, error) error) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("legacyimport: read header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+
+	for row := 1; ; row++ {
+		values, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				if err := fn(row, nil, err); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+		if len(values) != len(header) {
+			if err := fn(row, nil, fmt.Errorf("expected %d columns, got %d", len(header), len(values))); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rec := make(Record, len(header))
+		for i, name := range header {
+			rec[name] = values[i]
+		}
+		if err := fn(row, rec, nil); err != nil {
+			return err
+		}
+	}
+}
+
+func readJSON(r io.Reader, fn func(int, Record, error) error) error {
+	br := bufio.NewReader(r)
+	first, err := firstNonSpace(br)
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	if first == '[' {
+		dec := json.NewDecoder(br)
+		if _, err := dec.Token(); err != nil {
+			return fmt.Errorf("legacyimport: read array: %w", err)
+		}
+		for row := 1; dec.More(); row++ {
+			var obj map[string]interface{}
+			if err := dec.Decode(&obj); err != nil {
+				return fmt.Errorf("legacyimport: row %d: %w", row, err)
+			}
+			if err := fn(row, flatten(obj), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// One object per line
+	scanner := bufio.NewScanner(br)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for row := 1; scanner.Scan(); row++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			row--
+			continue
+		}
+		var obj map[string]interface{}
+		if err := json.Unmarshal(line, &obj); err != nil {
+			if err := fn(row, nil, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := fn(row, flatten(obj), nil); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func firstNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != ' ' && b != '\n' && b != '\r' && b != '\t' {
+			return b, br.UnreadByte()
+		}
+	}
+}
+
+// flatten turns nested JSON into dotted string keys. Arrays of scalars are
+// joined with commas.
+func flatten(obj map[string]interface{}) Record {
+	rec := make(Record)
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			for k, child := range t {
+				key := k
+				if prefix != "" {
+					key = prefix + "." + k
+				}
+				walk(key, child)
+			}
+		case []interface{}:
+			parts := make([]string, 0, len(t))
+			for _, item := range t {
+				parts = append(parts, scalar(item))
+			}
+			rec[prefix] = strings.Join(parts, ",")
+		default:
+			rec[prefix] = scalar(t)
+		}
+	}
+	walk("", obj)
+	return rec
+}
+
+func scalar(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		if t == float64(int64(t)) {
+			return fmt.Sprintf("%d", int64(t))
+		}
+		return fmt.Sprint(t)
+	default:
+		return fmt.Sprint(t)
+	}
+}
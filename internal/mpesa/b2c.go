@@ -0,0 +1,101 @@
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// B2CRequest pays Amount from the business short code to a customer phone
+type B2CRequest struct {
+	OriginatorConversationID string `json:"OriginatorConversationID"`
+	InitiatorName            string `json:"InitiatorName"`
+	SecurityCredential       string `json:"SecurityCredential"`
+	CommandID                string `json:"CommandID"`
+	Amount                   int    `json:"Amount"`
+	PartyA                   string `json:"PartyA"`
+	PartyB                   string `json:"PartyB"`
+	Remarks                  string `json:"Remarks"`
+	QueueTimeOutURL          string `json:"QueueTimeOutURL"`
+	ResultURL                string `json:"ResultURL"`
+	Occasion                 string `json:"Occassion"`
+}
+
+// B2CResponse acknowledges a request; the outcome arrives at ResultURL
+type B2CResponse struct {
+	ConversationID           string `json:"ConversationID"`
+	OriginatorConversationID string `json:"OriginatorConversationID"`
+	ResponseCode             string `json:"ResponseCode"`
+	ResponseDescription      string `json:"ResponseDescription"`
+}
+
+// B2C submits a business-to-customer payment
+func (c *Client) B2C(ctx context.Context, r B2CRequest) (*B2CResponse, error) {
+	var resp B2CResponse
+	if err := c.post(ctx, "/mpesa/b2c/v3/paymentrequest", r, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ResponseCode != "0" {
+		return nil, fmt.Errorf("mpesa: b2c rejected: %s", resp.ResponseDescription)
+	}
+	return &resp, nil
+}
+
+// B2CResult is the asynchronous outcome posted to the result URL
+type B2CResult struct {
+	ResultCode               int
+	ResultDesc               string
+	OriginatorConversationID string
+	ConversationID           string
+	TransactionID            string
+	Parameters               map[string]string
+}
+
+// ReceiverName extracts the registered name from ReceiverPartyPublicName,
+// which Daraja formats as "2547XXXXXXXX - FIRST LAST".
+func (r *B2CResult) ReceiverName() string {
+	v := r.Parameters["ReceiverPartyPublicName"]
+	if i := strings.Index(v, " - "); i >= 0 {
+		return strings.TrimSpace(v[i+3:])
+	}
+	return strings.TrimSpace(v)
+}
+
+// ParseB2CResult decodes a result or queue timeout callback body
+func ParseB2CResult(body []byte) (*B2CResult, error) {
+	var cb struct {
+		Result struct {
+			ResultCode               int    `json:"ResultCode"`
+			ResultDesc               string `json:"ResultDesc"`
+			OriginatorConversationID string `json:"OriginatorConversationID"`
+			ConversationID           string `json:"ConversationID"`
+			TransactionID            string `json:"TransactionID"`
+			ResultParameters         struct {
+				ResultParameter []struct {
+					Key   string      `json:"Key"`
+					Value interface{} `json:"Value"`
+				} `json:"ResultParameter"`
+			} `json:"ResultParameters"`
+		} `json:"Result"`
+	}
+	if err := json.Unmarshal(body, &cb); err != nil {
+		return nil, err
+	}
+	if cb.Result.ConversationID == "" && cb.Result.OriginatorConversationID == "" {
+		return nil, fmt.Errorf("mpesa: callback has no conversation id")
+	}
+
+	r := &B2CResult{
+		ResultCode:               cb.Result.ResultCode,
+		ResultDesc:               cb.Result.ResultDesc,
+		OriginatorConversationID: cb.Result.OriginatorConversationID,
+		ConversationID:           cb.Result.ConversationID,
+		TransactionID:            cb.Result.TransactionID,
+		Parameters:               make(map[string]string),
+	}
+	for _, p := range cb.Result.ResultParameters.ResultParameter {
+		r.Parameters[p.Key] = fmt.Sprint(p.Value)
+	}
+	return r, nil
+}
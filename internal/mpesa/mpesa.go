@@ -0,0 +1,114 @@
+// Package mpesa is a small client for the Safaricom Daraja API.
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	SandboxURL    = "https://sandbox.safaricom.co.ke"
+	ProductionURL = "https://api.safaricom.co.ke"
+
+	httpTimeout = 30 * time.Second
+)
+
+// Client authenticates with OAuth client credentials and caches the token
+type Client struct {
+	BaseURL        string
+	ConsumerKey    string
+	ConsumerSecret string
+	HTTPClient     *http.Client
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return &http.Client{Timeout: httpTimeout}
+}
+
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Now().Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		strings.TrimRight(c.BaseURL, "/")+"/oauth/v1/generate?grant_type=client_credentials", nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(c.ConsumerKey, c.ConsumerSecret)
+
+	body, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   string `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", err
+	}
+	seconds, _ := strconv.Atoi(resp.ExpiresIn)
+	if seconds <= 60 {
+		seconds = 120
+	}
+	c.token = resp.AccessToken
+	c.tokenExpiry = time.Now().Add(time.Duration(seconds-60) * time.Second)
+	return c.token, nil
+}
+
+func (c *Client) post(ctx context.Context, path string, in, out interface{}) error {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return fmt.Errorf("mpesa: authenticate: %w", err)
+	}
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(c.BaseURL, "/")+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("mpesa: %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return body, nil
+}
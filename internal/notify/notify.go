@@ -0,0 +1,263 @@
+// Package notify routes user notifications to email, SMS, WhatsApp and push
+// channels according to user preferences, channel availability and
+// per-channel retry policies.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Kind identifies the purpose of a notification
+type Kind string
+
+const (
+	KindVerifyEmail       Kind = "verify_email"
+	KindNewLogin          Kind = "new_login"
+	KindPasswordChanged   Kind = "password_changed"
+	KindDeletionPending   Kind = "deletion_pending"
+	KindDeletionReminder  Kind = "deletion_reminder"
+	KindDeletionCancelled Kind = "deletion_cancelled"
+	KindOrgInvite         Kind = "org_invite"
+	KindInvite            Kind = "invite"
+	KindOTP               Kind = "otp"
+
+	KindRecoveryContactChanged Kind = "recovery_contact_changed"
+	KindAccountRecoveryStarted Kind = "account_recovery_started"
+)
+
+// Channel is a delivery mechanism
+type Channel string
+
+const (
+	ChannelEmail    Channel = "email"
+	ChannelSMS      Channel = "sms"
+	ChannelPush     Channel = "push"
+	ChannelWhatsApp Channel = "whatsapp"
+)
+
+// ErrNoChannel is returned when no channel could be used for a recipient
+var ErrNoChannel = errors.New("notify: no available channel for recipient")
+
+// Recipient carries the contact details and routing preferences of a user
+type Recipient struct {
+	UserID     string
+	Name       string
+	Email      string
+	Phone      string
+	PushTokens []string
+	// WhatsApp is the number to message on WhatsApp. It is kept apart from
+	// Phone because a number that stopped receiving SMS may still be
+	// reachable there.
+	WhatsApp string
+
+	// Preferences lists the channels the user opted into per kind. Kinds
+	// missing from the map use the dispatcher defaults.
+	Preferences map[Kind][]Channel
+}
+
+// Message is a rendered notification
+type Message struct {
+	Kind    Kind
+	Subject string
+	Body    string
+	Data    map[string]string
+}
+
+// Sender delivers messages over a single channel
+type Sender interface {
+	Channel() Channel
+	// Available reports whether the recipient can be reached on this channel
+	Available(r Recipient) bool
+	Send(ctx context.Context, r Recipient, m Message) error
+}
+
+// RetryPolicy controls how often a failed send is retried on a channel
+type RetryPolicy struct {
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicies are used for channels registered without a policy
+var DefaultRetryPolicies = map[Channel]RetryPolicy{
+	ChannelEmail: {Attempts: 3, InitialBackoff: 2 * time.Second, MaxBackoff: 30 * time.Second},
+	ChannelSMS:   {Attempts: 2, InitialBackoff: 5 * time.Second, MaxBackoff: 30 * time.Second},
+	ChannelPush:  {Attempts: 2, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+
+	ChannelWhatsApp: {Attempts: 2, InitialBackoff: 2 * time.Second, MaxBackoff: 10 * time.Second},
+}
+
+// DefaultFallbacks name the channel tried instead when a channel is not
+// registered, cannot reach the recipient or fails every attempt
+var DefaultFallbacks = map[Channel]Channel{
+	ChannelWhatsApp: ChannelSMS,
+}
+
+// DefaultRoutes are the channels used when a user has no preference for a kind
+var DefaultRoutes = map[Kind][]Channel{
+	KindVerifyEmail:       {ChannelEmail},
+	KindNewLogin:          {ChannelPush, ChannelEmail},
+	KindPasswordChanged:   {ChannelEmail, ChannelSMS},
+	KindDeletionPending:   {ChannelEmail},
+	KindDeletionReminder:  {ChannelEmail},
+	KindDeletionCancelled: {ChannelEmail},
+	KindOrgInvite:         {ChannelEmail, ChannelPush},
+	KindInvite:            {ChannelEmail},
+	KindOTP:               {ChannelSMS},
+
+	KindRecoveryContactChanged: {ChannelEmail, ChannelSMS},
+	KindAccountRecoveryStarted: {ChannelEmail, ChannelSMS, ChannelPush},
+}
+
+// mandatoryKinds are security notifications that fall back to the default
+// routes when none of the preferred channels is reachable.
+var mandatoryKinds = map[Kind]bool{
+	KindVerifyEmail:       true,
+	KindPasswordChanged:   true,
+	KindDeletionPending:   true,
+	KindDeletionReminder:  true,
+	KindDeletionCancelled: true,
+
+	KindRecoveryContactChanged: true,
+	KindAccountRecoveryStarted: true,
+}
+
+type route struct {
+	sender Sender
+	policy RetryPolicy
+}
+
+// Dispatcher sends messages over the registered channels
+type Dispatcher struct {
+	routes    map[Channel]route
+	defaults  map[Kind][]Channel
+	fallbacks map[Channel]Channel
+}
+
+// NewDispatcher returns a dispatcher using DefaultRoutes and
+// DefaultFallbacks
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{routes: make(map[Channel]route), defaults: DefaultRoutes, fallbacks: DefaultFallbacks}
+}
+
+// available reports whether ch is registered and can reach r
+func (d *Dispatcher) available(ch Channel, r Recipient) bool {
+	rt, ok := d.routes[ch]
+	return ok && rt.sender.Available(r)
+}
+
+func hasChannel(channels []Channel, ch Channel) bool {
+	for _, c := range channels {
+		if c == ch {
+			return true
+		}
+	}
+	return false
+}
+
+// Register adds a sender with its retry policy. A zero policy selects the
+// channel default.
+func (d *Dispatcher) Register(s Sender, policy RetryPolicy) {
+	if policy.Attempts == 0 {
+		policy = DefaultRetryPolicies[s.Channel()]
+	}
+	if policy.Attempts < 1 {
+		policy.Attempts = 1
+	}
+	d.routes[s.Channel()] = route{sender: s, policy: policy}
+}
+
+// channelsFor resolves the channels to try for a recipient and kind
+func (d *Dispatcher) channelsFor(r Recipient, kind Kind) []Channel {
+	var selected []Channel
+	preferred, ok := r.Preferences[kind]
+	if !ok {
+		preferred = d.defaults[kind]
+	}
+	for _, ch := range preferred {
+		if !d.available(ch, r) {
+			ch = d.fallbacks[ch]
+			if ch == "" || hasChannel(preferred, ch) || !d.available(ch, r) {
+				continue
+			}
+		}
+		if !hasChannel(selected, ch) {
+			selected = append(selected, ch)
+		}
+	}
+
+	if len(selected) == 0 && mandatoryKinds[kind] {
+		for _, ch := range d.defaults[kind] {
+			if d.available(ch, r) {
+				selected = append(selected, ch)
+			}
+		}
+	}
+	return selected
+}
+
+// Dispatch delivers m to every selected channel, trying a channel's fallback
+// when it fails. It succeeds when at least one channel accepted the message.
+func (d *Dispatcher) Dispatch(ctx context.Context, r Recipient, m Message) error {
+	channels := d.channelsFor(r, m.Kind)
+	if len(channels) == 0 {
+		return ErrNoChannel
+	}
+
+	var errs []error
+	delivered := false
+	for _, ch := range channels {
+		err := d.sendWithRetry(ctx, d.routes[ch], r, m)
+		if err == nil {
+			delivered = true
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", ch, err))
+
+		fb := d.fallbacks[ch]
+		if fb == "" || hasChannel(channels, fb) || !d.available(fb, r) {
+			continue
+		}
+		if err := d.sendWithRetry(ctx, d.routes[fb], r, m); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", fb, err))
+			continue
+		}
+		delivered = true
+	}
+
+	if delivered {
+		for _, err := range errs {
+			log.Printf("Notification %s for user %s partially failed: %v", m.Kind, r.UserID, err)
+		}
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) sendWithRetry(ctx context.Context, rt route, r Recipient, m Message) error {
+	backoff := rt.policy.InitialBackoff
+	var err error
+	for attempt := 1; attempt <= rt.policy.Attempts; attempt++ {
+		if err = rt.sender.Send(ctx, r, m); err == nil {
+			return nil
+		}
+		if attempt == rt.policy.Attempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if rt.policy.MaxBackoff > 0 && backoff > rt.policy.MaxBackoff {
+			backoff = rt.policy.MaxBackoff
+		}
+	}
+	return err
+}
@@ -0,0 +1,210 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const providerTimeout = 10 * time.Second
+
+// LogSender writes messages to the log. It stands in for channels that have
+// no provider configured in development.
+type LogSender struct {
+	Ch Channel
+}
+
+func (s LogSender) Channel() Channel { return s.Ch }
+
+func (s LogSender) Available(r Recipient) bool { return address(s.Ch, r) != "" }
+
+func (s LogSender) Send(_ context.Context, r Recipient, m Message) error {
+	log.Printf("[%s] to=%s kind=%s subject=%q", s.Ch, address(s.Ch, r), m.Kind, m.Subject)
+	return nil
+}
+
+func address(ch Channel, r Recipient) string {
+	switch ch {
+	case ChannelEmail:
+		return r.Email
+	case ChannelSMS:
+		return r.Phone
+	case ChannelPush:
+		return strings.Join(r.PushTokens, ",")
+	case ChannelWhatsApp:
+		return r.WhatsApp
+	}
+	return ""
+}
+
+// SMTPSender delivers email through an SMTP relay
+type SMTPSender struct {
+	Addr     string
+	Username string
+	Password string
+	From     string
+}
+
+func (s *SMTPSender) Channel() Channel { return ChannelEmail }
+
+func (s *SMTPSender) Available(r Recipient) bool { return r.Email != "" }
+
+func (s *SMTPSender) Send(_ context.Context, r Recipient, m Message) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		host := s.Addr
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		s.From, r.Email, m.Subject, m.Body)
+	return smtp.SendMail(s.Addr, auth, s.From, []string{r.Email}, []byte(msg))
+}
+
+// AfricasTalkingSender delivers SMS through the Africa's Talking messaging API
+type AfricasTalkingSender struct {
+	URL      string
+	Username string
+	APIKey   string
+	SenderID string
+	Client   *http.Client
+}
+
+func (s *AfricasTalkingSender) Channel() Channel { return ChannelSMS }
+
+func (s *AfricasTalkingSender) Available(r Recipient) bool { return r.Phone != "" }
+
+func (s *AfricasTalkingSender) Send(ctx context.Context, r Recipient, m Message) error {
+	form := url.Values{
+		"username": {s.Username},
+		"to":       {"+" + strings.TrimPrefix(r.Phone, "+")},
+		"message":  {m.Body},
+	}
+	if s.SenderID != "" {
+		form.Set("from", s.SenderID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("apiKey", s.APIKey)
+
+	return doRequest(s.Client, req)
+}
+
+// WhatsAppSender delivers messages through the WhatsApp Business Cloud API.
+// Messages a user did not reply to in the last day must use a pre-approved
+// template, so kinds listed in Templates are sent as that template with the
+// "code" data as its only body parameter; other kinds go out as text.
+type WhatsAppSender struct {
+	// URL is the messages endpoint of the business phone number, e.g.
+	// https://graph.facebook.com/v19.0/<phone-number-id>/messages
+	URL       string
+	Token     string
+	Templates map[Kind]string
+	Language  string
+	Client    *http.Client
+}
+
+func (s *WhatsAppSender) Channel() Channel { return ChannelWhatsApp }
+
+func (s *WhatsAppSender) Available(r Recipient) bool { return r.WhatsApp != "" }
+
+func (s *WhatsAppSender) Send(ctx context.Context, r Recipient, m Message) error {
+	payload := map[string]interface{}{
+		"messaging_product": "whatsapp",
+		"to":                strings.TrimPrefix(r.WhatsApp, "+"),
+	}
+	if name, ok := s.Templates[m.Kind]; ok {
+		language := s.Language
+		if language == "" {
+			language = "en"
+		}
+		template := map[string]interface{}{
+			"name":     name,
+			"language": map[string]string{"code": language},
+		}
+		if code := m.Data["code"]; code != "" {
+			template["components"] = []map[string]interface{}{{
+				"type":       "body",
+				"parameters": []map[string]string{{"type": "text", "text": code}},
+			}}
+		}
+		payload["type"] = "template"
+		payload["template"] = template
+	} else {
+		payload["type"] = "text"
+		payload["text"] = map[string]string{"body": m.Body}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.Token)
+
+	return doRequest(s.Client, req)
+}
+
+// WebhookPushSender hands push notifications to a push gateway over HTTP
+type WebhookPushSender struct {
+	URL    string
+	Client *http.Client
+}
+
+func (s *WebhookPushSender) Channel() Channel { return ChannelPush }
+
+func (s *WebhookPushSender) Available(r Recipient) bool { return len(r.PushTokens) > 0 }
+
+func (s *WebhookPushSender) Send(ctx context.Context, r Recipient, m Message) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"tokens": r.PushTokens,
+		"title":  m.Subject,
+		"body":   m.Body,
+		"data":   m.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return doRequest(s.Client, req)
+}
+
+func doRequest(client *http.Client, req *http.Request) error {
+	if client == nil {
+		client = &http.Client{Timeout: providerTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("provider returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+type messageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+func mustTemplate(subject, body string) messageTemplate {
+	return messageTemplate{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+var templates = map[Kind]messageTemplate{
+	KindVerifyEmail: mustTemplate(
+		"Verify your AI-Shop email address",
+		"Hi {{.name}}, your AI-Shop verification code is {{.code}}. It expires in {{.expires_in}}.",
+	),
+	KindNewLogin: mustTemplate(
+		"New sign-in to your AI-Shop account",
+		"Hi {{.name}}, we noticed a new sign-in to your account at {{.time}}. If this wasn't you, reset your password now.",
+	),
+	KindPasswordChanged: mustTemplate(
+		"Your AI-Shop password was changed",
+		"Hi {{.name}}, the password for your account was changed at {{.time}}. If you didn't do this, contact support immediately.",
+	),
+	KindDeletionPending: mustTemplate(
+		"Your AI-Shop account is scheduled for deletion",
+		"Hi {{.name}}, your account and personal data will be permanently deleted on {{.date}}. You can cancel this from your account settings until then.",
+	),
+	KindDeletionReminder: mustTemplate(
+		"Your AI-Shop account will be deleted in {{.days}} days",
+		"Hi {{.name}}, this is a reminder that your account will be permanently deleted on {{.date}}. Cancel the deletion from your account settings if you changed your mind.",
+	),
+	KindDeletionCancelled: mustTemplate(
+		"Your AI-Shop account deletion was cancelled",
+		"Hi {{.name}}, the scheduled deletion of your account has been cancelled. Your account stays active.",
+	),
+	KindOrgInvite: mustTemplate(
+		"You're invited to join {{.org}} on AI-Shop",
+		"Hi {{.name}}, {{.inviter}} invited you to buy for {{.org}} as {{.role}}. Accept the invitation from your account settings.",
+	),
+	KindInvite: mustTemplate(
+		"{{.inviter}} invited you to {{.target}}",
+		"Hi {{.name}}, {{.inviter}} invited you to join {{.target}}. Create your account here: {{.link}}",
+	),
+	KindOTP: mustTemplate(
+		"Your AI-Shop verification code",
+		"Your AI-Shop code is {{.code}}. It expires in {{.expires_in}}. Never share it with anyone.",
+	),
+	KindRecoveryContactChanged: mustTemplate(
+		"Your AI-Shop recovery contacts were changed",
+		"Hi {{.name}}, the recovery email or phone on your account was changed at {{.time}}. If you didn't do this, reset your password and contact support immediately.",
+	),
+	KindAccountRecoveryStarted: mustTemplate(
+		"Someone is recovering your AI-Shop account",
+		"Hi {{.name}}, a request to recover your account through your recovery contact was confirmed. Unless you cancel it from your account settings, the password can be reset on {{.date}}.",
+	),
+}
+
+// Render builds the message for kind from its template and data
+func Render(kind Kind, data map[string]string) (Message, error) {
+	tmpl, ok := templates[kind]
+	if !ok {
+		return Message{}, fmt.Errorf("notify: no template for %s", kind)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return Message{}, err
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return Message{}, err
+	}
+	return Message{Kind: kind, Subject: subject.String(), Body: body.String(), Data: data}, nil
+}
@@ -0,0 +1,117 @@
+// Package password hashes and verifies passwords through a registry of
+// self-describing hash schemes. Every stored hash carries a prefix naming its
+// algorithm and parameters, so the preferred scheme can change at any time:
+// existing hashes keep verifying and are upgraded the next time the user
+// proves knowledge of the password.
+package password
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrMismatch is returned when a password does not match its hash
+	ErrMismatch = errors.New("password: mismatch")
+	// ErrUnknownScheme is returned for hashes no registered scheme recognizes
+	ErrUnknownScheme = errors.New("password: unknown hash scheme")
+)
+
+// Scheme is one hashing algorithm with its current parameters
+type Scheme interface {
+	// ID names the scheme, e.g. "argon2id"
+	ID() string
+	// Recognizes reports whether encoded was produced by this scheme
+	Recognizes(encoded string) bool
+	Hash(password string) (string, error)
+	// Verify returns ErrMismatch when the password is wrong
+	Verify(password, encoded string) error
+	// Outdated reports whether encoded uses weaker parameters than the scheme
+	Outdated(encoded string) bool
+}
+
+// Registry verifies hashes from any registered scheme and produces new ones
+// with the preferred scheme.
+type Registry struct {
+	preferred Scheme
+	schemes   []Scheme
+	legacy    bool
+}
+
+// NewRegistry returns a registry hashing with preferred and verifying hashes
+// of preferred and any of the additional schemes.
+func NewRegistry(preferred Scheme, others ...Scheme) *Registry {
+	return &Registry{preferred: preferred, schemes: append([]Scheme{preferred}, others...)}
+}
+
+// hashPrefixes are the formats of every scheme in this package, registered
+// or not
+var hashPrefixes = []string{"$2a$", "$2b$", "$2y$", argon2Prefix, scryptPrefix}
+
+// looksHashed reports whether encoded is in a hash format this package knows
+func looksHashed(encoded string) bool {
+	for _, p := range hashPrefixes {
+		if strings.HasPrefix(encoded, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowLegacyPlaintext makes the registry accept values in no known hash
+// format, including ones starting with "$", as plaintext passwords stored
+// before hashing was introduced. They always verify as needing a rehash.
+func (r *Registry) AllowLegacyPlaintext() *Registry {
+	r.legacy = true
+	return r
+}
+
+// Preferred returns the scheme used for new hashes
+func (r *Registry) Preferred() Scheme {
+	return r.preferred
+}
+
+// MaxLength returns the longest password in bytes the preferred scheme
+// hashes in full, 0 when there is no limit
+func (r *Registry) MaxLength() int {
+	if l, ok := r.preferred.(interface{ MaxLength() int }); ok {
+		return l.MaxLength()
+	}
+	return 0
+}
+
+// Hash hashes password with the preferred scheme
+func (r *Registry) Hash(password string) (string, error) {
+	return r.preferred.Hash(password)
+}
+
+// Identify returns the scheme that produced encoded
+func (r *Registry) Identify(encoded string) (Scheme, error) {
+	for _, s := range r.schemes {
+		if s.Recognizes(encoded) {
+			return s, nil
+		}
+	}
+	return nil, ErrUnknownScheme
+}
+
+// Verify checks password against encoded. needsRehash is set when the
+// password matched but the hash should be replaced by Hash(password).
+func (r *Registry) Verify(password, encoded string) (needsRehash bool, err error) {
+	scheme, err := r.Identify(encoded)
+	if err != nil {
+		if r.legacy && encoded != "" && !looksHashed(encoded) {
+			if subtle.ConstantTimeCompare([]byte(password), []byte(encoded)) == 1 {
+				return true, nil
+			}
+			return false, ErrMismatch
+		}
+		return false, err
+	}
+
+	if err := scheme.Verify(password, encoded); err != nil {
+		return false, err
+	}
+	return scheme.ID() != r.preferred.ID() || scheme.Outdated(encoded), nil
+}
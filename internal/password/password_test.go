@@ -0,0 +1,123 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Cheap parameters keep the tests fast; the weaker variants stand for
+// hashes stored before the parameters were raised
+var (
+	fastBcrypt = Bcrypt{Cost: 5}
+	weakBcrypt = Bcrypt{Cost: 4}
+	fastArgon  = Argon2id{Memory: 1024, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	weakArgon  = Argon2id{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	fastScrypt = Scrypt{LogN: 5, R: 8, P: 1, SaltLength: 16, KeyLength: 32}
+	weakScrypt = Scrypt{LogN: 4, R: 8, P: 1, SaltLength: 16, KeyLength: 32}
+)
+
+func TestSchemes(t *testing.T) {
+	const plain = "correct horse battery"
+
+	tests := []struct {
+		name         string
+		scheme, weak Scheme
+	}{
+		{"bcrypt", fastBcrypt, weakBcrypt},
+		{"argon2id", fastArgon, weakArgon},
+		{"scrypt", fastScrypt, weakScrypt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := tt.scheme.Hash(plain)
+			if err != nil {
+				t.Fatalf("Hash: %v", err)
+			}
+			if !tt.scheme.Recognizes(encoded) {
+				t.Errorf("Recognizes(%q) = false", encoded)
+			}
+			if err := tt.scheme.Verify(plain, encoded); err != nil {
+				t.Errorf("Verify(correct) = %v", err)
+			}
+			if err := tt.scheme.Verify("wrong", encoded); !errors.Is(err, ErrMismatch) {
+				t.Errorf("Verify(wrong) = %v, want %v", err, ErrMismatch)
+			}
+			if tt.scheme.Outdated(encoded) {
+				t.Error("fresh hash reported outdated")
+			}
+			weak, err := tt.weak.Hash(plain)
+			if err != nil {
+				t.Fatalf("Hash: %v", err)
+			}
+			if !tt.scheme.Outdated(weak) {
+				t.Error("hash with weaker parameters not reported outdated")
+			}
+			again, _ := tt.scheme.Hash(plain)
+			if again == encoded {
+				t.Error("two hashes of one password are equal, the salt is not random")
+			}
+		})
+	}
+}
+
+func TestRegistryVerify(t *testing.T) {
+	const plain = "correct horse battery"
+	hash := func(s Scheme) string {
+		encoded, err := s.Hash(plain)
+		if err != nil {
+			t.Fatalf("Hash: %v", err)
+		}
+		return encoded
+	}
+	registry := NewRegistry(fastArgon, fastBcrypt).AllowLegacyPlaintext()
+	strict := NewRegistry(fastArgon, fastBcrypt)
+
+	tests := []struct {
+		name        string
+		registry    *Registry
+		password    string
+		encoded     string
+		needsRehash bool
+		err         error
+	}{
+		{name: "preferred scheme", registry: registry, password: plain, encoded: hash(fastArgon)},
+		{name: "preferred scheme outdated", registry: registry, password: plain, encoded: hash(weakArgon), needsRehash: true},
+		{name: "other scheme", registry: registry, password: plain, encoded: hash(fastBcrypt), needsRehash: true},
+		{name: "wrong password", registry: registry, password: "wrong", encoded: hash(fastBcrypt), err: ErrMismatch},
+		{name: "known format not registered", registry: registry, password: plain, encoded: hash(fastScrypt), err: ErrUnknownScheme},
+		{name: "legacy plaintext", registry: registry, password: plain, encoded: plain, needsRehash: true},
+		{name: "legacy plaintext starting with $", registry: registry, password: "$ecret99", encoded: "$ecret99", needsRehash: true},
+		{name: "wrong legacy plaintext", registry: registry, password: "wrong", encoded: plain, err: ErrMismatch},
+		{name: "plaintext not allowed", registry: strict, password: plain, encoded: plain, err: ErrUnknownScheme},
+		{name: "empty hash", registry: registry, password: "", encoded: "", err: ErrUnknownScheme},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			needsRehash, err := tt.registry.Verify(tt.password, tt.encoded)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.err)
+			}
+			if needsRehash != tt.needsRehash {
+				t.Errorf("Verify() needsRehash = %v, want %v", needsRehash, tt.needsRehash)
+			}
+		})
+	}
+}
+
+func TestRegistryMaxLength(t *testing.T) {
+	if got := NewRegistry(fastBcrypt, fastArgon).MaxLength(); got != 72 {
+		t.Errorf("bcrypt preferred: MaxLength() = %d, want 72", got)
+	}
+	if got := NewRegistry(fastArgon, fastBcrypt).MaxLength(); got != 0 {
+		t.Errorf("argon2id preferred: MaxLength() = %d, want 0", got)
+	}
+	long := strings.Repeat("p", 100)
+	encoded, err := NewRegistry(fastArgon).Hash(long)
+	if err != nil {
+		t.Fatalf("Hash(100 bytes) with argon2id: %v", err)
+	}
+	if _, err := NewRegistry(fastArgon).Verify(long[:72], encoded); !errors.Is(err, ErrMismatch) {
+		t.Errorf("argon2id matched a 100-byte password by its first 72 bytes: %v", err)
+	}
+}
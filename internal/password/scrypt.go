@@ -0,0 +1,91 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const scryptPrefix = "$scrypt$"
+
+// Scrypt produces hashes formatted as
+// $scrypt$ln=<log2 N>,r=<block size>,p=<parallelism>$<salt>$<hash>
+type Scrypt struct {
+	LogN       uint8
+	R          int
+	P          int
+	SaltLength int
+	KeyLength  int
+}
+
+// DefaultScrypt uses N=2^15, r=8, p=1
+var DefaultScrypt = Scrypt{LogN: 15, R: 8, P: 1, SaltLength: 16, KeyLength: 32}
+
+func (s Scrypt) ID() string { return "scrypt" }
+
+func (s Scrypt) Recognizes(encoded string) bool {
+	return strings.HasPrefix(encoded, scryptPrefix)
+}
+
+func (s Scrypt) Hash(password string) (string, error) {
+	salt := make([]byte, s.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := scrypt.Key([]byte(password), salt, 1<<s.LogN, s.R, s.P, s.KeyLength)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%sln=%d,r=%d,p=%d$%s$%s", scryptPrefix, s.LogN, s.R, s.P,
+		b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+func (s Scrypt) Verify(password, encoded string) error {
+	p, salt, key, err := parseScrypt(encoded)
+	if err != nil {
+		return err
+	}
+	got, err := scrypt.Key([]byte(password), salt, 1<<p.LogN, p.R, p.P, len(key))
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(got, key) != 1 {
+		return ErrMismatch
+	}
+	return nil
+}
+
+func (s Scrypt) Outdated(encoded string) bool {
+	p, _, key, err := parseScrypt(encoded)
+	if err != nil {
+		return true
+	}
+	return p.LogN < s.LogN || p.R < s.R || p.P < s.P || len(key) < s.KeyLength
+}
+
+func parseScrypt(encoded string) (Scrypt, []byte, []byte, error) {
+	var p Scrypt
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 5 || parts[1] != "scrypt" {
+		return p, nil, nil, fmt.Errorf("password: malformed scrypt hash")
+	}
+	if _, err := fmt.Sscanf(parts[2], "ln=%d,r=%d,p=%d", &p.LogN, &p.R, &p.P); err != nil {
+		return p, nil, nil, fmt.Errorf("password: malformed scrypt parameters")
+	}
+	if p.LogN == 0 || p.LogN > 30 {
+		return p, nil, nil, fmt.Errorf("password: invalid scrypt cost")
+	}
+
+	salt, err := b64.DecodeString(parts[3])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("password: malformed scrypt salt")
+	}
+	key, err := b64.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("password: malformed scrypt hash")
+	}
+	return p, salt, key, nil
+}
@@ -0,0 +1,144 @@
+// Package pdf renders plain text documents as minimal PDF files. It supports
+// a title, headings and wrapped body text in the standard Helvetica fonts,
+// which is all the compliance reports need.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth    = 595 // A4 in points
+	pageHeight   = 842
+	margin       = 50
+	fontSize     = 10
+	headingSize  = 13
+	titleSize    = 18
+	lineHeight   = 14
+	wrapAtColumn = 95
+)
+
+type line struct {
+	text string
+	font string
+	size int
+}
+
+// Document accumulates lines and lays them out on pages
+type Document struct {
+	lines []line
+}
+
+// Title adds a large bold line
+func (d *Document) Title(text string) {
+	d.lines = append(d.lines, line{text: text, font: "F2", size: titleSize}, line{})
+}
+
+// Heading adds a bold section heading preceded by a blank line
+func (d *Document) Heading(text string) {
+	d.lines = append(d.lines, line{}, line{text: text, font: "F2", size: headingSize})
+}
+
+// Text adds body text, wrapping long lines
+func (d *Document) Text(text string) {
+	for _, para := range strings.Split(text, "\n") {
+		for _, l := range wrap(para, wrapAtColumn) {
+			d.lines = append(d.lines, line{text: l, font: "F1", size: fontSize})
+		}
+	}
+}
+
+// Bytes renders the document
+func (d *Document) Bytes() []byte {
+	perPage := (pageHeight - 2*margin) / lineHeight
+	var pages [][]line
+	for i := 0; i < len(d.lines); i += perPage {
+		end := i + perPage
+		if end > len(d.lines) {
+			end = len(d.lines)
+		}
+		pages = append(pages, d.lines[i:end])
+	}
+	if len(pages) == 0 {
+		pages = [][]line{nil}
+	}
+
+	var buf bytes.Buffer
+	var offsets []int
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		var content bytes.Buffer
+		y := pageHeight - margin
+		for _, l := range page {
+			if l.text != "" {
+				fmt.Fprintf(&content, "BT /%s %d Tf %d %d Td (%s) Tj ET\n", l.font, l.size, margin, y, escape(l.text))
+			}
+			y -= lineHeight
+		}
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
+
+// escape makes text safe inside a PDF string literal. Characters outside
+// printable ASCII are replaced since only the base encoding is embedded.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func wrap(s string, width int) []string {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	current := words[0]
+	for _, w := range words[1:] {
+		if len(current)+1+len(w) > width {
+			lines = append(lines, current)
+			current = w
+			continue
+		}
+		current += " " + w
+	}
+	return append(lines, current)
+}
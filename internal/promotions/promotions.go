@@ -0,0 +1,78 @@
+// Package promotions is a client for the AI-Shop promotions service.
+package promotions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned for codes the promotions service does not know
+var ErrNotFound = errors.New("promotions: not found")
+
+const httpTimeout = 5 * time.Second
+
+// Gift card states reported by the promotions service
+const (
+	GiftCardActive   = "active"
+	GiftCardRedeemed = "redeemed"
+	GiftCardExpired  = "expired"
+	GiftCardDisabled = "disabled"
+)
+
+// GiftCard is the promotions service's view of a card
+type GiftCard struct {
+	Code         string     `json:"code"`
+	Status       string     `json:"status"`
+	BalanceMinor int64      `json:"balance_minor"`
+	Currency     string     `json:"currency"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+}
+
+// Client calls the promotions service over HTTP
+type Client struct {
+	BaseURL string
+	APIKey  string
+	HTTP    *http.Client
+}
+
+// GiftCard looks up a card by its code
+func (c *Client) GiftCard(ctx context.Context, code string) (*GiftCard, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		strings.TrimRight(c.BaseURL, "/")+"/v1/gift-cards/"+url.PathEscape(code), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.APIKey != "" {
+		req.Header.Set("X-API-Key", c.APIKey)
+	}
+
+	client := c.HTTP
+	if client == nil {
+		client = &http.Client{Timeout: httpTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("promotions: %s", resp.Status)
+	}
+
+	var card GiftCard
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&card); err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
@@ -0,0 +1,322 @@
+// Package rpcclient manages outbound gRPC connections to sibling services.
+// Each registered service gets a small pool of connections with a retry
+// policy, optional hedging for idempotent methods, a circuit breaker and
+// W3C trace context propagation.
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrUnknownService is returned for services that were never registered
+var ErrUnknownService = errors.New("rpcclient: unknown service")
+
+// RetryPolicy is applied by gRPC itself through the service config
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	RetryableCodes []codes.Code
+}
+
+// HedgePolicy sends extra copies of a call to an idempotent method when the
+// previous copy has not answered within Delay. The first reply wins.
+type HedgePolicy struct {
+	Delay       time.Duration
+	MaxAttempts int
+	// Methods lists the full method names that are safe to hedge
+	Methods []string
+}
+
+// Options configure one sibling service
+type Options struct {
+	Target      string
+	Credentials credentials.TransportCredentials
+	// PoolSize is the number of connections calls are spread over
+	PoolSize int
+	// Timeout bounds calls whose context has no earlier deadline
+	Timeout time.Duration
+	Retry   RetryPolicy
+	Hedge   HedgePolicy
+	Breaker BreakerPolicy
+	// Observe is called after every call with its outcome
+	Observe func(service, method string, code codes.Code, elapsed time.Duration)
+}
+
+// DefaultOptions returns the settings used for fields left empty
+func DefaultOptions() Options {
+	return Options{
+		PoolSize: 2,
+		Timeout:  5 * time.Second,
+		Retry: RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     time.Second,
+			RetryableCodes: []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+		},
+		Breaker: BreakerPolicy{FailureThreshold: 5, OpenFor: 30 * time.Second},
+	}
+}
+
+func (o Options) withDefaults() Options {
+	d := DefaultOptions()
+	if o.Credentials == nil {
+		o.Credentials = insecure.NewCredentials()
+	}
+	if o.PoolSize <= 0 {
+		o.PoolSize = d.PoolSize
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = d.Timeout
+	}
+	if o.Retry.MaxAttempts == 0 {
+		o.Retry = d.Retry
+	}
+	if o.Breaker.FailureThreshold == 0 {
+		o.Breaker = d.Breaker
+	}
+	return o
+}
+
+// Client is a pooled connection to one sibling service. It implements
+// grpc.ClientConnInterface, so generated clients can be built on it.
+type Client struct {
+	name    string
+	opts    Options
+	conns   []*grpc.ClientConn
+	next    atomic.Uint32
+	breaker *breaker
+	hedged  map[string]bool
+}
+
+var _ grpc.ClientConnInterface = (*Client)(nil)
+
+func newClient(name string, opts Options) (*Client, error) {
+	opts = opts.withDefaults()
+	c := &Client{name: name, opts: opts, breaker: newBreaker(opts.Breaker), hedged: make(map[string]bool)}
+	for _, m := range opts.Hedge.Methods {
+		c.hedged[m] = true
+	}
+
+	serviceConfig, err := opts.Retry.serviceConfig()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < opts.PoolSize; i++ {
+		conn, err := grpc.NewClient(opts.Target,
+			grpc.WithTransportCredentials(opts.Credentials),
+			grpc.WithDefaultServiceConfig(serviceConfig),
+		)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("rpcclient: dial %s: %w", name, err)
+		}
+		c.conns = append(c.conns, conn)
+	}
+	return c, nil
+}
+
+// conn picks the next pooled connection
+func (c *Client) conn() *grpc.ClientConn {
+	return c.conns[int(c.next.Add(1))%len(c.conns)]
+}
+
+// Invoke performs a unary call through the breaker, hedging and tracing
+func (c *Client) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	if !c.breaker.allow() {
+		c.observe(method, codes.Unavailable, 0)
+		return status.Errorf(codes.Unavailable, "rpcclient: circuit open for %s", c.name)
+	}
+
+	ctx = withTraceContext(ctx)
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	var err error
+	if c.hedged[method] && c.opts.Hedge.MaxAttempts > 1 {
+		err = c.invokeHedged(ctx, method, args, reply, opts)
+	} else {
+		err = c.conn().Invoke(ctx, method, args, reply, opts...)
+	}
+
+	code := status.Code(err)
+	c.breaker.record(code)
+	c.observe(method, code, time.Since(start))
+	return err
+}
+
+// invokeHedged starts a new copy of the call every Delay until one answers
+// or MaxAttempts copies are in flight. Errors that are not retryable end the
+// call right away.
+func (c *Client) invokeHedged(ctx context.Context, method string, args, reply interface{}, opts []grpc.CallOption) error {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return c.conn().Invoke(ctx, method, args, reply, opts...)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+	results := make(chan result, c.opts.Hedge.MaxAttempts)
+	launch := func() {
+		out := proto.Clone(msg)
+		proto.Reset(out)
+		go func() {
+			err := c.conn().Invoke(ctx, method, args, out, opts...)
+			results <- result{out, err}
+		}()
+	}
+
+	launch()
+	inFlight, launched := 1, 1
+	timer := time.NewTimer(c.opts.Hedge.Delay)
+	defer timer.Stop()
+
+	var lastErr error
+	for inFlight > 0 {
+		select {
+		case <-timer.C:
+			if launched < c.opts.Hedge.MaxAttempts {
+				launch()
+				inFlight++
+				launched++
+				timer.Reset(c.opts.Hedge.Delay)
+			}
+		case r := <-results:
+			inFlight--
+			if r.err == nil {
+				proto.Reset(msg)
+				proto.Merge(msg, r.reply)
+				return nil
+			}
+			lastErr = r.err
+			if !c.opts.Retry.retryable(status.Code(r.err)) {
+				return r.err
+			}
+			if inFlight == 0 && launched < c.opts.Hedge.MaxAttempts {
+				launch()
+				inFlight++
+				launched++
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	return lastErr
+}
+
+// NewStream opens a stream on a pooled connection. Streams are not hedged
+// but still respect the breaker and carry the trace context.
+func (c *Client) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if !c.breaker.allow() {
+		return nil, status.Errorf(codes.Unavailable, "rpcclient: circuit open for %s", c.name)
+	}
+	stream, err := c.conn().NewStream(withTraceContext(ctx), desc, method, opts...)
+	c.breaker.record(status.Code(err))
+	return stream, err
+}
+
+// BreakerState reports the circuit breaker state of the service
+func (c *Client) BreakerState() State {
+	return c.breaker.current()
+}
+
+// Close closes every pooled connection
+func (c *Client) Close() error {
+	var errs []error
+	for _, conn := range c.conns {
+		errs = append(errs, conn.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Client) observe(method string, code codes.Code, elapsed time.Duration) {
+	if c.opts.Observe != nil {
+		c.opts.Observe(c.name, method, code, elapsed)
+	}
+}
+
+// Manager holds the clients of every sibling service
+type Manager struct {
+	mu      sync.RWMutex
+	clients map[string]*Client
+}
+
+// NewManager returns an empty manager
+func NewManager() *Manager {
+	return &Manager{clients: make(map[string]*Client)}
+}
+
+// Register dials a sibling service. Connections are established lazily on
+// the first call, so registering never blocks on the target.
+func (m *Manager) Register(name string, opts Options) error {
+	if opts.Target == "" {
+		return fmt.Errorf("rpcclient: %s needs a target", name)
+	}
+	client, err := newClient(name, opts)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if old, ok := m.clients[name]; ok {
+		old.Close()
+	}
+	m.clients[name] = client
+	return nil
+}
+
+// Client returns the client of a registered service
+func (m *Manager) Client(name string) (*Client, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	client, ok := m.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownService, name)
+	}
+	return client, nil
+}
+
+// Services lists the registered service names
+func (m *Manager) Services() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	names := make([]string, 0, len(m.clients))
+	for name := range m.clients {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Close closes every client
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var errs []error
+	for name, client := range m.clients {
+		errs = append(errs, client.Close())
+		delete(m.clients, name)
+	}
+	return errors.Join(errs...)
+}
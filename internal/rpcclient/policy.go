@@ -0,0 +1,166 @@
+package rpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// serviceConfig renders the retry policy as a gRPC service config that also
+// balances calls over every resolved backend
+func (p RetryPolicy) serviceConfig() (string, error) {
+	cfg := map[string]interface{}{
+		"loadBalancingConfig": []map[string]interface{}{{"round_robin": map[string]interface{}{}}},
+	}
+	if p.MaxAttempts > 1 {
+		if len(p.RetryableCodes) == 0 {
+			return "", fmt.Errorf("rpcclient: retry policy needs retryable codes")
+		}
+		var names []string
+		for _, code := range p.RetryableCodes {
+			names = append(names, strings.ToUpper(codeName(code)))
+		}
+		cfg["methodConfig"] = []map[string]interface{}{{
+			"name": []map[string]interface{}{{}},
+			"retryPolicy": map[string]interface{}{
+				"maxAttempts":          min(p.MaxAttempts, 5),
+				"initialBackoff":       durationString(p.InitialBackoff),
+				"maxBackoff":           durationString(p.MaxBackoff),
+				"backoffMultiplier":    2,
+				"retryableStatusCodes": names,
+			},
+		}}
+	}
+	out, err := json.Marshal(cfg)
+	return string(out), err
+}
+
+func (p RetryPolicy) retryable(code codes.Code) bool {
+	for _, c := range p.RetryableCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// codeName converts a code to the SCREAMING_SNAKE form of service configs
+func codeName(code codes.Code) string {
+	name := code.String()
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func durationString(d time.Duration) string {
+	return fmt.Sprintf("%.3fs", d.Seconds())
+}
+
+// BreakerPolicy opens the circuit after FailureThreshold consecutive
+// failures and lets one probe call through after OpenFor
+type BreakerPolicy struct {
+	FailureThreshold int
+	OpenFor          time.Duration
+}
+
+// State is the circuit breaker state
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half_open"
+	}
+	return "closed"
+}
+
+type breaker struct {
+	policy BreakerPolicy
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(policy BreakerPolicy) *breaker {
+	return &breaker{policy: policy}
+}
+
+// breakerFailure reports whether a code says the service is unhealthy
+// rather than that the request was refused
+func breakerFailure(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+func (b *breaker) allow() bool {
+	if b.policy.FailureThreshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.policy.OpenFor {
+			return false
+		}
+		b.state = StateHalfOpen
+		b.probing = true
+		return true
+	case StateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+func (b *breaker) record(code codes.Code) {
+	if b.policy.FailureThreshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !breakerFailure(code) {
+		b.state = StateClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.policy.FailureThreshold {
+		b.state = StateOpen
+		b.openedAt = time.Now()
+	}
+}
+
+func (b *breaker) current() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
@@ -0,0 +1,52 @@
+package rpcclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	traceparentHeader = "traceparent"
+	requestIDHeader   = "x-request-id"
+)
+
+// withTraceContext continues the W3C trace of the incoming request, or
+// starts one, and forwards the request id so calls can be correlated across
+// services
+func withTraceContext(ctx context.Context) context.Context {
+	traceID := ""
+	flags := "01"
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(traceparentHeader); len(v) > 0 {
+			if parts := strings.Split(v[0], "-"); len(parts) == 4 && len(parts[1]) == 32 {
+				traceID, flags = parts[1], parts[3]
+			}
+		}
+		if v := md.Get(requestIDHeader); len(v) > 0 {
+			requestID = v[0]
+		}
+	}
+	if out, ok := metadata.FromOutgoingContext(ctx); ok && len(out.Get(traceparentHeader)) > 0 {
+		return ctx
+	}
+	if traceID == "" {
+		traceID = randomHex(16)
+	}
+
+	pairs := []string{traceparentHeader, "00-" + traceID + "-" + randomHex(8) + "-" + flags}
+	if requestID != "" {
+		pairs = append(pairs, requestIDHeader, requestID)
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,36 @@
+// Package scan checks uploaded files for malware through an external
+// engine, either a ClamAV daemon or an ICAP server.
+package scan
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInfected matches every error reporting that a file is infected
+var ErrInfected = errors.New("scan: file is infected")
+
+const defaultTimeout = 30 * time.Second
+
+// InfectedError names the signature the engine matched
+type InfectedError struct {
+	Signature string
+}
+
+func (e *InfectedError) Error() string {
+	return "scan: infected with " + e.Signature
+}
+
+func (e *InfectedError) Is(target error) bool {
+	return target == ErrInfected
+}
+
+// Signature returns the signature of an infected error, or "" for other
+// errors
+func Signature(err error) string {
+	var infected *InfectedError
+	if errors.As(err, &infected) {
+		return infected.Signature
+	}
+	return ""
+}
@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FileStore keeps objects on the local filesystem. Downloads are served by
+// its Handler, which checks the HMAC signature embedded in signed URLs.
+type FileStore struct {
+	Dir        string
+	BaseURL    string // public URL the Handler is mounted at, e.g. https://host/files
+	SigningKey []byte
+}
+
+func (s *FileStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return "", fmt.Errorf("storage: invalid key %q", key)
+	}
+	return filepath.Join(s.Dir, clean), nil
+}
+
+func (s *FileStore) Put(_ context.Context, key string, data []byte, contentType string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p, data, 0o600); err != nil {
+		return err
+	}
+	if contentType != "" {
+		return os.WriteFile(p+".type", []byte(contentType), 0o600)
+	}
+	return nil
+}
+
+func (s *FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	os.Remove(p + ".type")
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *FileStore) SignedURL(_ context.Context, key string, ttl time.Duration) (string, error) {
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	q := url.Values{"expires": {expires}, "sig": {s.sign(key, expires)}}
+	return strings.TrimRight(s.BaseURL, "/") + "/" + strings.TrimLeft(key, "/") + "?" + q.Encode(), nil
+}
+
+func (s *FileStore) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.SigningKey)
+	mac.Write([]byte(strings.TrimLeft(key, "/") + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Handler serves objects for valid, unexpired signed URLs. Mount it with
+// http.StripPrefix so request paths are object keys.
+func (s *FileStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimLeft(r.URL.Path, "/")
+		expires := r.URL.Query().Get("expires")
+		exp, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil || time.Now().Unix() > exp {
+			http.Error(w, "link expired", http.StatusForbidden)
+			return
+		}
+		if !hmac.Equal([]byte(s.sign(key, expires)), []byte(r.URL.Query().Get("sig"))) {
+			http.Error(w, "invalid signature", http.StatusForbidden)
+			return
+		}
+
+		data, err := s.Get(r.Context(), key)
+		if errors.Is(err, ErrNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		if p, err := s.path(key); err == nil {
+			if ct, err := os.ReadFile(p + ".type"); err == nil {
+				w.Header().Set("Content-Type", string(ct))
+			}
+		}
+		w.Header().Set("Cache-Control", "private, no-store")
+		w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(filepath.Base(key)))
+		w.Write(data)
+	})
+}
+
+// Cleanup removes objects under prefix last modified before cutoff
+func (s *FileStore) Cleanup(prefix string, cutoff time.Time) error {
+	root, err := s.path(prefix)
+	if err != nil {
+		return err
+	}
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().Before(cutoff) {
+			return os.Remove(p)
+		}
+		return nil
+	})
+}
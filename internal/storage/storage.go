@@ -0,0 +1,21 @@
+// Package storage stores generated files and uploads in object storage and
+// hands out short-lived signed URLs for downloading them.
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when an object does not exist
+var ErrNotFound = errors.New("storage: object not found")
+
+// Store is an object store addressed by slash-separated keys
+type Store interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL that allows downloading key until ttl elapses
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
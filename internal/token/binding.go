@@ -0,0 +1,114 @@
+package token
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ErrBindingMismatch is returned for a bound token presented from a client
+// other than the one it was issued to
+var ErrBindingMismatch = errors.New("token: used from a different client")
+
+// User agent classes a session can be bound to
+const (
+	AgentAndroid = "android"
+	AgentIOS     = "ios"
+	AgentWeb     = "web"
+	AgentOther   = "other"
+)
+
+// BindingConfig binds user tokens to the client that signed in, so a token
+// copied off a device does not work from another one. Sessions are bound to
+// the user agent class and the major app version, so updating the app
+// within a release line keeps it; a non-zero prefix length also binds them
+// to the network the client signed in from.
+type BindingConfig struct {
+	Enabled    bool `yaml:"enabled"`
+	IPv4Prefix int  `yaml:"ipv4_prefix"`
+	IPv6Prefix int  `yaml:"ipv6_prefix"`
+}
+
+func (b BindingConfig) validate() error {
+	if b.IPv4Prefix < 0 || b.IPv4Prefix > 32 {
+		return fmt.Errorf("token: binding ipv4_prefix must be between 0 and 32")
+	}
+	if b.IPv6Prefix < 0 || b.IPv6Prefix > 128 {
+		return fmt.Errorf("token: binding ipv6_prefix must be between 0 and 128")
+	}
+	return nil
+}
+
+// ClientContext describes the client a request came from
+type ClientContext struct {
+	UserAgent  string
+	AppVersion string
+	IP         string
+}
+
+// AgentClass reduces a user agent to the platform it runs on
+func AgentClass(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	switch {
+	case strings.Contains(ua, "android"):
+		return AgentAndroid
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"), strings.Contains(ua, "ios"), strings.Contains(ua, "cfnetwork"):
+		return AgentIOS
+	case strings.Contains(ua, "mozilla"):
+		return AgentWeb
+	default:
+		return AgentOther
+	}
+}
+
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".")
+	return major
+}
+
+func ipPrefix(ip string, v4, v6 int) string {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return ""
+	}
+	if v4addr := parsed.To4(); v4addr != nil {
+		if v4 == 0 {
+			return ""
+		}
+		return v4addr.Mask(net.CIDRMask(v4, 32)).String()
+	}
+	if v6 == 0 {
+		return ""
+	}
+	return parsed.Mask(net.CIDRMask(v6, 128)).String()
+}
+
+// Binding returns the fingerprint written to the bnd claim of tokens issued
+// to client, or "" when binding is disabled. Only a hash is embedded, so the
+// token does not reveal the client's network.
+func (i *Issuer) Binding(client ClientContext) string {
+	b := i.cfg.Binding
+	if !b.Enabled {
+		return ""
+	}
+	parts := []string{
+		AgentClass(client.UserAgent),
+		majorVersion(client.AppVersion),
+		ipPrefix(client.IP, b.IPv4Prefix, b.IPv6Prefix),
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return base64.RawURLEncoding.EncodeToString(sum[:16])
+}
+
+// CheckBinding rejects a bound token presented with a different binding.
+// Tokens issued before binding was enabled carry none and pass; their
+// session is bound on its next refresh.
+func (c *Claims) CheckBinding(binding string) error {
+	if c.Binding == "" || c.Binding == binding {
+		return nil
+	}
+	return ErrBindingMismatch
+}
@@ -0,0 +1,220 @@
+package token
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Claim names operators can choose to embed in user tokens
+const (
+	ClaimRoles    = "roles"
+	ClaimTier     = "tier"
+	ClaimTenant   = "tenant"
+	ClaimSegments = "segments"
+	ClaimLocale   = "locale"
+)
+
+// DefaultLoginClient receives login tokens unless the configuration names
+// another client
+const DefaultLoginClient = "storefront"
+
+var knownClaims = map[string]bool{
+	ClaimRoles:    true,
+	ClaimTier:     true,
+	ClaimTenant:   true,
+	ClaimSegments: true,
+	ClaimLocale:   true,
+}
+
+// Config controls what goes into issued tokens. It is loaded from a YAML
+// file so downstream authorization is driven from one place:
+//
+//	issuer: https://users.ai-shop.example
+//	audience: ai-shop
+//	access_ttl: 15m
+//	refresh_ttl: 720h
+//	login_client: storefront
+//	binding:
+//	  enabled: true
+//	  ipv4_prefix: 16
+//	claims: [roles, tier, segments]
+//	scopes:
+//	  profile: Read and update the signed-in user's profile
+//	  orders:write: Place orders on behalf of the user
+//	clients:
+//	  storefront:
+//	    scopes: [profile, orders:write]
+//	    default_scopes: [profile]
+//	services:
+//	  order-service:
+//	    audiences: [payment-service]
+//	    scopes: [payments:capture]
+//	    delegated_scopes: [payments:charge]
+//	tenants:
+//	  nairobi-electronics:
+//	    issuer: https://users.ai-shop.example/t/nairobi-electronics
+//	    audience: nairobi-electronics
+//
+// Defining tenants switches on multi-store mode: every token is bound to
+// the issuer and audience of its tenant and is rejected by the others.
+type Config struct {
+	Issuer    string        `yaml:"issuer"`
+	Audience  string        `yaml:"audience"`
+	AccessTTL time.Duration `yaml:"access_ttl"`
+	// RefreshTTL is the lifetime of each refresh token. Every refresh
+	// issues a new one, so an active session never expires.
+	RefreshTTL time.Duration           `yaml:"refresh_ttl"`
+	Claims     []string                `yaml:"claims"`
+	Scopes     map[string]string       `yaml:"scopes"`
+	Clients    map[string]ClientConfig `yaml:"clients"`
+	Tenants    map[string]TenantConfig `yaml:"tenants"`
+
+	// LoginClient is the client tokens returned by a password login are
+	// issued to when the caller names none
+	LoginClient string `yaml:"login_client"`
+
+	// ServiceTTL is the maximum lifetime of machine-to-machine tokens
+	ServiceTTL time.Duration            `yaml:"service_ttl"`
+	Services   map[string]ServiceConfig `yaml:"services"`
+
+	Binding BindingConfig `yaml:"binding"`
+}
+
+// ServiceConfig lists the audiences and scopes an internal service may
+// request service tokens for
+type ServiceConfig struct {
+	Audiences []string `yaml:"audiences"`
+	Scopes    []string `yaml:"scopes"`
+	// DelegatedScopes may be granted when the service exchanges a user's
+	// token to call one of its audiences on the user's behalf
+	DelegatedScopes []string `yaml:"delegated_scopes"`
+}
+
+// TenantConfig holds the token identity of one storefront
+type TenantConfig struct {
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+}
+
+// MultiTenant reports whether tokens are bound to tenants
+func (c Config) MultiTenant() bool {
+	return len(c.Tenants) > 0
+}
+
+// identity returns the issuer and audience used for tenant
+func (c Config) identity(tenant string) (issuer, audience string, err error) {
+	if !c.MultiTenant() {
+		return c.Issuer, c.Audience, nil
+	}
+	t, ok := c.Tenants[tenant]
+	if !ok {
+		return "", "", ErrUnknownTenant
+	}
+	return t.Issuer, t.Audience, nil
+}
+
+// ClientConfig lists the scopes a client service may request
+type ClientConfig struct {
+	Scopes []string `yaml:"scopes"`
+	// DefaultScopes are granted when the client requests none
+	DefaultScopes []string `yaml:"default_scopes"`
+	// Claims overrides the global claim selection for this client
+	Claims []string `yaml:"claims"`
+}
+
+// DefaultConfig is used when no configuration file is given
+func DefaultConfig() Config {
+	return Config{
+		Issuer:     "ai-shop-user-service",
+		Audience:   "ai-shop",
+		AccessTTL:  15 * time.Minute,
+		RefreshTTL: 30 * 24 * time.Hour,
+		Claims:     []string{ClaimRoles},
+		ServiceTTL: 5 * time.Minute,
+		Clients:    map[string]ClientConfig{DefaultLoginClient: {}},
+
+		LoginClient: DefaultLoginClient,
+	}
+}
+
+// LoadConfig reads a YAML config file on top of DefaultConfig
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("token: parse %s: %w", path, err)
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that clients only reference defined scopes and known claims
+func (c Config) Validate() error {
+	if c.AccessTTL <= 0 {
+		return fmt.Errorf("token: access_ttl must be positive")
+	}
+	if c.RefreshTTL <= c.AccessTTL {
+		return fmt.Errorf("token: refresh_ttl must be longer than access_ttl")
+	}
+	if c.ServiceTTL <= 0 {
+		return fmt.Errorf("token: service_ttl must be positive")
+	}
+	if err := c.Binding.validate(); err != nil {
+		return err
+	}
+	for name, svc := range c.Services {
+		if len(svc.Audiences) == 0 {
+			return fmt.Errorf("token: service %s needs at least one audience", name)
+		}
+	}
+	for _, claim := range c.Claims {
+		if !knownClaims[claim] {
+			return fmt.Errorf("token: unknown claim %q", claim)
+		}
+	}
+	issuers := make(map[string]string)
+	for name, t := range c.Tenants {
+		if t.Issuer == "" || t.Audience == "" {
+			return fmt.Errorf("token: tenant %s needs an issuer and audience", name)
+		}
+		if other, ok := issuers[t.Issuer]; ok {
+			return fmt.Errorf("token: tenants %s and %s share issuer %s", other, name, t.Issuer)
+		}
+		issuers[t.Issuer] = name
+	}
+	if _, ok := c.Clients[c.LoginClient]; c.LoginClient != "" && !ok {
+		return fmt.Errorf("token: login_client %s is not a defined client", c.LoginClient)
+	}
+	for name, client := range c.Clients {
+		for _, scope := range append(append([]string{}, client.Scopes...), client.DefaultScopes...) {
+			if _, ok := c.Scopes[scope]; !ok {
+				return fmt.Errorf("token: client %s references undefined scope %q", name, scope)
+			}
+		}
+		for _, scope := range client.DefaultScopes {
+			if !contains(client.Scopes, scope) {
+				return fmt.Errorf("token: client %s default scope %q is not in its scopes", name, scope)
+			}
+		}
+		for _, claim := range client.Claims {
+			if !knownClaims[claim] {
+				return fmt.Errorf("token: client %s uses unknown claim %q", name, claim)
+			}
+		}
+	}
+	return nil
+}
+
+func contains(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrUnknownKey is returned for tokens signed with a key id that is not
+// configured, such as one retired after a rotation
+var ErrUnknownKey = errors.New("token: unknown signing key")
+
+const minKeyLength = 32
+
+// Key is an HMAC signing key. The id is written to the kid header of every
+// token signed with it.
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// ParseKeys reads keys written as "id:secret,id:secret". The first key signs
+// new tokens; the others only verify, so a key can be rotated by adding the
+// new one in front and dropping the old one once its tokens expired.
+func ParseKeys(spec string) ([]Key, error) {
+	var keys []Key
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("token: signing key %q must be written as id:secret", entry)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("token: duplicate signing key id %q", id)
+		}
+		seen[id] = true
+		keys = append(keys, Key{ID: id, Secret: []byte(secret)})
+	}
+	return keys, nil
+}
+
+// keyring signs with its first key and verifies with any of them
+type keyring struct {
+	active Key
+	byID   map[string][]byte
+	all    jwt.VerificationKeySet
+}
+
+func newKeyring(keys []Key) (*keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("token: at least one signing key is required")
+	}
+	r := &keyring{active: keys[0], byID: make(map[string][]byte, len(keys))}
+	for _, k := range keys {
+		if len(k.Secret) < minKeyLength {
+			return nil, fmt.Errorf("token: signing key must be at least %d bytes", minKeyLength)
+		}
+		r.byID[k.ID] = k.Secret
+		r.all.Keys = append(r.all.Keys, k.Secret)
+	}
+	return r, nil
+}
+
+func (r *keyring) sign(claims jwt.Claims) (string, error) {
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if r.active.ID != "" {
+		t.Header["kid"] = r.active.ID
+	}
+	return t.SignedString(r.active.Secret)
+}
+
+// verificationKey picks the key a token names. Tokens signed before key ids
+// were written carry none and are checked against every key.
+func (r *keyring) verificationKey(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		return r.all, nil
+	}
+	secret, ok := r.byID[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return secret, nil
+}
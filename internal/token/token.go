@@ -0,0 +1,423 @@
+// Package token issues and validates the signed JWTs used across AI-Shop
+// services. Which claims and scopes a token carries is driven by Config.
+// A login gets a short-lived access token and a long-lived refresh token
+// that is exchanged for new ones; signing keys rotate through their kid.
+package token
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrUnknownClient is returned when a token is requested for a client
+	// service missing from the configuration
+	ErrUnknownClient = errors.New("token: unknown client")
+	// ErrScopeNotAllowed is returned when a client requests a scope it may not use
+	ErrScopeNotAllowed = errors.New("token: scope not allowed for client")
+	// ErrUnknownTenant is returned in multi-store mode for tenants missing
+	// from the configuration
+	ErrUnknownTenant = errors.New("token: unknown tenant")
+	// ErrTenantMismatch is returned when a token belongs to another tenant
+	ErrTenantMismatch = errors.New("token: issued for another tenant")
+	// ErrUnknownService is returned when a service token is requested by a
+	// service missing from the configuration
+	ErrUnknownService = errors.New("token: unknown service")
+	// ErrAudienceNotAllowed is returned when a service requests a token for
+	// an audience it may not call
+	ErrAudienceNotAllowed = errors.New("token: audience not allowed for service")
+	// ErrWrongTokenUse is returned when a user token is presented where a
+	// service token is expected, or the reverse
+	ErrWrongTokenUse = errors.New("token: wrong token use")
+)
+
+// Values of the token_use claim
+const (
+	UseUser    = "user"
+	UseService = "service"
+	UseRefresh = "refresh"
+)
+
+// Subject describes the user a token is issued for
+type Subject struct {
+	UserID   string
+	Roles    []string
+	Tier     string
+	Tenant   string
+	Segments []string
+	Locale   string
+}
+
+// Claims is the JWT payload of user and service tokens
+type Claims struct {
+	jwt.RegisteredClaims
+	Use      string           `json:"token_use,omitempty"`
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	Client   string           `json:"azp,omitempty"`
+	Scope    string           `json:"scope,omitempty"`
+	Roles    []string         `json:"roles,omitempty"`
+	Tier     string           `json:"tier,omitempty"`
+	Tenant   string           `json:"tenant,omitempty"`
+	Segments []string         `json:"segments,omitempty"`
+	Locale   string           `json:"locale,omitempty"`
+	// SessionID ties the access and refresh tokens of one login together
+	SessionID string `json:"sid,omitempty"`
+	// Binding fingerprints the client the session was issued to
+	Binding string `json:"bnd,omitempty"`
+	// Actor is set on delegated tokens
+	Actor *Actor `json:"act,omitempty"`
+}
+
+// Scopes returns the granted scopes as a list
+func (c *Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// Issuer mints and parses tokens
+type Issuer struct {
+	cfg  Config
+	keys *keyring
+	now  func() time.Time
+}
+
+// NewIssuer returns an issuer signing with HMAC-SHA256 under the first key
+// and accepting tokens signed with any of them
+func NewIssuer(cfg Config, keys []Key) (*Issuer, error) {
+	ring, err := newKeyring(keys)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Issuer{cfg: cfg, keys: ring, now: time.Now}, nil
+}
+
+// Pair is the access and refresh token of one session
+type Pair struct {
+	Access        string
+	AccessClaims  *Claims
+	Refresh       string
+	RefreshClaims *Claims
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Config returns the active configuration
+func (i *Issuer) Config() Config {
+	return i.cfg
+}
+
+// IssueUserToken mints an access token for sub on behalf of client. When
+// requested is empty the client's default scopes are granted.
+func (i *Issuer) IssueUserToken(sub Subject, client string, requested []string) (string, *Claims, error) {
+	cc, ok := i.cfg.Clients[client]
+	if !ok {
+		return "", nil, ErrUnknownClient
+	}
+
+	scopes := requested
+	if len(scopes) == 0 {
+		scopes = cc.DefaultScopes
+	}
+	for _, s := range scopes {
+		if !contains(cc.Scopes, s) {
+			return "", nil, fmt.Errorf("%w: %s", ErrScopeNotAllowed, s)
+		}
+	}
+	return i.issueUser(sub, client, cc, scopes, i.now(), "", "")
+}
+
+// issueUser signs an access token for scopes the client was checked for.
+// authTime is when the user last proved their credentials.
+func (i *Issuer) issueUser(sub Subject, client string, cc ClientConfig, scopes []string, authTime time.Time, session, binding string) (string, *Claims, error) {
+	issuer, audience, err := i.cfg.identity(sub.Tenant)
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := i.now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   sub.UserID,
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(i.cfg.AccessTTL)),
+		},
+		Use:       UseUser,
+		AuthTime:  jwt.NewNumericDate(authTime),
+		Client:    client,
+		Scope:     strings.Join(scopes, " "),
+		SessionID: session,
+		Binding:   binding,
+	}
+
+	selected := i.cfg.Claims
+	if len(cc.Claims) > 0 {
+		selected = cc.Claims
+	}
+	for _, name := range selected {
+		switch name {
+		case ClaimRoles:
+			claims.Roles = sub.Roles
+		case ClaimTier:
+			claims.Tier = sub.Tier
+		case ClaimTenant:
+			claims.Tenant = sub.Tenant
+		case ClaimSegments:
+			claims.Segments = sub.Segments
+		case ClaimLocale:
+			claims.Locale = sub.Locale
+		}
+	}
+	if i.cfg.MultiTenant() {
+		claims.Tenant = sub.Tenant
+	}
+
+	signed, err := i.keys.sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, claims, nil
+}
+
+// IssueSession mints the access and refresh token of a new login. Both carry
+// a fresh session id; the refresh token has its own id so every refresh can
+// be used once. binding comes from Binding and may be empty.
+func (i *Issuer) IssueSession(sub Subject, client string, requested []string, binding string) (*Pair, error) {
+	cc, ok := i.cfg.Clients[client]
+	if !ok {
+		return nil, ErrUnknownClient
+	}
+	scopes := requested
+	if len(scopes) == 0 {
+		scopes = cc.DefaultScopes
+	}
+	for _, s := range scopes {
+		if !contains(cc.Scopes, s) {
+			return nil, fmt.Errorf("%w: %s", ErrScopeNotAllowed, s)
+		}
+	}
+	session, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	return i.issuePair(sub, client, cc, scopes, i.now(), session, binding)
+}
+
+// Refresh exchanges a parsed refresh token for a new pair in the same
+// session. The scopes are checked against the client again, so removing a
+// scope from the configuration takes effect on the next refresh. The
+// auth_time of the login is kept: refreshing is not re-authenticating.
+// binding is the one of the refreshing client; a bound refresh token only
+// works from the same client.
+func (i *Issuer) Refresh(refresh *Claims, sub Subject, binding string) (*Pair, error) {
+	if refresh.Use != UseRefresh {
+		return nil, ErrWrongTokenUse
+	}
+	if err := refresh.CheckBinding(binding); err != nil {
+		return nil, err
+	}
+	cc, ok := i.cfg.Clients[refresh.Client]
+	if !ok {
+		return nil, ErrUnknownClient
+	}
+	scopes := refresh.Scopes()
+	for _, s := range scopes {
+		if !contains(cc.Scopes, s) {
+			return nil, fmt.Errorf("%w: %s", ErrScopeNotAllowed, s)
+		}
+	}
+	authTime := i.now()
+	if refresh.AuthTime != nil {
+		authTime = refresh.AuthTime.Time
+	}
+	return i.issuePair(sub, refresh.Client, cc, scopes, authTime, refresh.SessionID, binding)
+}
+
+func (i *Issuer) issuePair(sub Subject, client string, cc ClientConfig, scopes []string, authTime time.Time, session, binding string) (*Pair, error) {
+	access, accessClaims, err := i.issueUser(sub, client, cc, scopes, authTime, session, binding)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.now()
+	refreshClaims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id,
+			Issuer:    accessClaims.Issuer,
+			Subject:   sub.UserID,
+			Audience:  accessClaims.Audience,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(i.cfg.RefreshTTL)),
+		},
+		Use:       UseRefresh,
+		AuthTime:  jwt.NewNumericDate(authTime),
+		Client:    client,
+		Scope:     strings.Join(scopes, " "),
+		Tenant:    accessClaims.Tenant,
+		SessionID: session,
+		Binding:   binding,
+	}
+	refresh, err := i.keys.sign(refreshClaims)
+	if err != nil {
+		return nil, err
+	}
+	return &Pair{Access: access, AccessClaims: accessClaims, Refresh: refresh, RefreshClaims: refreshClaims}, nil
+}
+
+// Reauthenticate reissues a valid user token with a fresh auth_time and a
+// new lifetime, keeping its subject, client, scopes and embedded claims.
+func (i *Issuer) Reauthenticate(c *Claims) (string, *Claims, error) {
+	if c.Use == UseService || c.Use == UseRefresh {
+		return "", nil, ErrWrongTokenUse
+	}
+	now := i.now()
+	upgraded := *c
+	upgraded.IssuedAt = jwt.NewNumericDate(now)
+	upgraded.NotBefore = jwt.NewNumericDate(now)
+	upgraded.ExpiresAt = jwt.NewNumericDate(now.Add(i.cfg.AccessTTL))
+	upgraded.AuthTime = jwt.NewNumericDate(now)
+
+	signed, err := i.keys.sign(&upgraded)
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, &upgraded, nil
+}
+
+// AuthenticatedWithin reports whether the user proved their credentials no
+// longer than window ago
+func (c *Claims) AuthenticatedWithin(window time.Duration, now time.Time) bool {
+	return c.AuthTime != nil && now.Sub(c.AuthTime.Time) <= window
+}
+
+// Parse validates an access token's signature, expiry, issuer and audience.
+// In multi-store mode the token must have been issued for tenant.
+func (i *Issuer) Parse(raw, tenant string) (*Claims, error) {
+	claims, err := i.parseUser(raw, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Use == UseRefresh {
+		return nil, ErrWrongTokenUse
+	}
+	return claims, nil
+}
+
+// ParseRefreshToken validates a refresh token like Parse. Whether it was
+// already used is up to the caller, which tracks refresh token ids.
+func (i *Issuer) ParseRefreshToken(raw, tenant string) (*Claims, error) {
+	claims, err := i.parseUser(raw, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Use != UseRefresh || claims.ID == "" {
+		return nil, ErrWrongTokenUse
+	}
+	return claims, nil
+}
+
+func (i *Issuer) parseUser(raw, tenant string) (*Claims, error) {
+	issuer, audience, err := i.cfg.identity(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{}
+	_, err = jwt.ParseWithClaims(raw, claims, i.keys.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(audience),
+		jwt.WithTimeFunc(i.now),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Use == UseService || claims.Use == UseDelegated {
+		return nil, ErrWrongTokenUse
+	}
+	if i.cfg.MultiTenant() && claims.Tenant != tenant {
+		return nil, ErrTenantMismatch
+	}
+	return claims, nil
+}
+
+// IssueServiceToken mints a short-lived token for an internal service to
+// call audience. A zero ttl, or one above the configured maximum, selects
+// ServiceTTL.
+func (i *Issuer) IssueServiceToken(service, audience string, requested []string, ttl time.Duration) (string, *Claims, error) {
+	sc, ok := i.cfg.Services[service]
+	if !ok {
+		return "", nil, ErrUnknownService
+	}
+	if !contains(sc.Audiences, audience) {
+		return "", nil, fmt.Errorf("%w: %s", ErrAudienceNotAllowed, audience)
+	}
+	for _, s := range requested {
+		if !contains(sc.Scopes, s) {
+			return "", nil, fmt.Errorf("%w: %s", ErrScopeNotAllowed, s)
+		}
+	}
+	if ttl <= 0 || ttl > i.cfg.ServiceTTL {
+		ttl = i.cfg.ServiceTTL
+	}
+
+	now := i.now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.cfg.Issuer,
+			Subject:   "service:" + service,
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Use:    UseService,
+		Client: service,
+		Scope:  strings.Join(requested, " "),
+	}
+
+	signed, err := i.keys.sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, claims, nil
+}
+
+// ParseServiceToken validates a service token presented to audience
+func (i *Issuer) ParseServiceToken(raw, audience string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(raw, claims, i.keys.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(i.cfg.Issuer),
+		jwt.WithAudience(audience),
+		jwt.WithTimeFunc(i.now),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Use != UseService {
+		return nil, ErrWrongTokenUse
+	}
+	return claims, nil
+}
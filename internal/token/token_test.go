@@ -0,0 +1,270 @@
+package token
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+var testStart = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+func testConfig() Config {
+	cfg := DefaultConfig()
+	cfg.Scopes = map[string]string{
+		"profile":      "Read and update the signed-in user's profile",
+		"orders:write": "Place orders on behalf of the user",
+	}
+	cfg.Clients = map[string]ClientConfig{
+		"storefront": {
+			Scopes:        []string{"profile", "orders:write"},
+			DefaultScopes: []string{"profile"},
+		},
+	}
+	return cfg
+}
+
+func testKey(id string, fill byte) Key {
+	return Key{ID: id, Secret: bytes.Repeat([]byte{fill}, minKeyLength)}
+}
+
+// newTestIssuer returns an issuer whose clock is *now
+func newTestIssuer(t *testing.T, cfg Config, now *time.Time, keys ...Key) *Issuer {
+	t.Helper()
+	if len(keys) == 0 {
+		keys = []Key{testKey("k1", 'a')}
+	}
+	i, err := NewIssuer(cfg, keys)
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	i.now = func() time.Time { return *now }
+	return i
+}
+
+func TestIssueUserToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		client    string
+		requested []string
+		scope     string
+		err       error
+	}{
+		{name: "default scopes", client: "storefront", scope: "profile"},
+		{name: "requested scopes", client: "storefront", requested: []string{"profile", "orders:write"}, scope: "profile orders:write"},
+		{name: "scope not allowed", client: "storefront", requested: []string{"admin"}, err: ErrScopeNotAllowed},
+		{name: "unknown client", client: "kiosk", err: ErrUnknownClient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := testStart
+			i := newTestIssuer(t, testConfig(), &now)
+			signed, claims, err := i.IssueUserToken(Subject{UserID: "u1", Roles: []string{"buyer"}}, tt.client, tt.requested)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("IssueUserToken() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if claims.Scope != tt.scope {
+				t.Errorf("scope = %q, want %q", claims.Scope, tt.scope)
+			}
+			parsed, err := i.Parse(signed, "")
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if parsed.Subject != "u1" || parsed.Use != UseUser || parsed.Client != tt.client {
+				t.Errorf("parsed subject %q, use %q, client %q", parsed.Subject, parsed.Use, parsed.Client)
+			}
+			if len(parsed.Roles) != 1 || parsed.Roles[0] != "buyer" {
+				t.Errorf("roles = %v, want [buyer]", parsed.Roles)
+			}
+			if !parsed.AuthTime.Time.Equal(testStart) {
+				t.Errorf("auth_time = %v, want %v", parsed.AuthTime.Time, testStart)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	other := testConfig()
+	other.Audience = "another-shop"
+
+	tests := []struct {
+		name    string
+		later   time.Duration
+		cfg     *Config
+		refresh bool
+		wantErr bool
+	}{
+		{name: "valid access token"},
+		{name: "expired", later: testConfig().AccessTTL + time.Second, wantErr: true},
+		{name: "other audience", cfg: &other, wantErr: true},
+		{name: "refresh token", refresh: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := testStart
+			i := newTestIssuer(t, testConfig(), &now)
+			pair, err := i.IssueSession(Subject{UserID: "u1"}, "storefront", nil, "")
+			if err != nil {
+				t.Fatalf("IssueSession: %v", err)
+			}
+			raw := pair.Access
+			if tt.refresh {
+				raw = pair.Refresh
+			}
+			parser := i
+			if tt.cfg != nil {
+				parser = newTestIssuer(t, *tt.cfg, &now)
+			}
+			now = now.Add(tt.later)
+			if _, err := parser.Parse(raw, ""); (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseRefreshToken(t *testing.T) {
+	now := testStart
+	i := newTestIssuer(t, testConfig(), &now)
+	pair, err := i.IssueSession(Subject{UserID: "u1"}, "storefront", nil, "")
+	if err != nil {
+		t.Fatalf("IssueSession: %v", err)
+	}
+	if _, err := i.ParseRefreshToken(pair.Access, ""); !errors.Is(err, ErrWrongTokenUse) {
+		t.Errorf("ParseRefreshToken(access) error = %v, want %v", err, ErrWrongTokenUse)
+	}
+	claims, err := i.ParseRefreshToken(pair.Refresh, "")
+	if err != nil {
+		t.Fatalf("ParseRefreshToken: %v", err)
+	}
+	if claims.SessionID != pair.AccessClaims.SessionID || claims.ID == "" {
+		t.Errorf("refresh token session %q id %q, want session %q and an id", claims.SessionID, claims.ID, pair.AccessClaims.SessionID)
+	}
+}
+
+func TestRefreshKeepsAuthTime(t *testing.T) {
+	now := testStart
+	i := newTestIssuer(t, testConfig(), &now)
+	pair, err := i.IssueSession(Subject{UserID: "u1"}, "storefront", nil, "")
+	if err != nil {
+		t.Fatalf("IssueSession: %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	claims, err := i.ParseRefreshToken(pair.Refresh, "")
+	if err != nil {
+		t.Fatalf("ParseRefreshToken: %v", err)
+	}
+	refreshed, err := i.Refresh(claims, Subject{UserID: "u1"}, "")
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if !refreshed.AccessClaims.AuthTime.Time.Equal(testStart) {
+		t.Errorf("refreshed auth_time = %v, want the login time %v", refreshed.AccessClaims.AuthTime.Time, testStart)
+	}
+	if refreshed.AccessClaims.SessionID != pair.AccessClaims.SessionID {
+		t.Errorf("refresh started session %q, want %q", refreshed.AccessClaims.SessionID, pair.AccessClaims.SessionID)
+	}
+	if refreshed.RefreshClaims.ID == claims.ID {
+		t.Error("refresh reused the refresh token id")
+	}
+	if _, err := i.Refresh(pair.AccessClaims, Subject{UserID: "u1"}, ""); !errors.Is(err, ErrWrongTokenUse) {
+		t.Errorf("Refresh(access claims) error = %v, want %v", err, ErrWrongTokenUse)
+	}
+}
+
+func TestReauthenticate(t *testing.T) {
+	now := testStart
+	i := newTestIssuer(t, testConfig(), &now)
+	_, claims, err := i.IssueUserToken(Subject{UserID: "u1"}, "storefront", nil)
+	if err != nil {
+		t.Fatalf("IssueUserToken: %v", err)
+	}
+
+	now = now.Add(10 * time.Minute)
+	if claims.AuthenticatedWithin(5*time.Minute, now) {
+		t.Error("token authenticated 10 minutes ago counts as within 5")
+	}
+	signed, upgraded, err := i.Reauthenticate(claims)
+	if err != nil {
+		t.Fatalf("Reauthenticate: %v", err)
+	}
+	if !upgraded.AuthenticatedWithin(time.Second, now) {
+		t.Errorf("reauthenticated auth_time = %v, want %v", upgraded.AuthTime.Time, now)
+	}
+	parsed, err := i.Parse(signed, "")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if parsed.Subject != claims.Subject || parsed.Scope != claims.Scope {
+		t.Errorf("reauthenticated subject %q scope %q, want %q %q", parsed.Subject, parsed.Scope, claims.Subject, claims.Scope)
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey, newKey := testKey("2025-01", 'a'), testKey("2026-01", 'b')
+	now := testStart
+	before := newTestIssuer(t, testConfig(), &now, oldKey)
+	signed, _, err := before.IssueUserToken(Subject{UserID: "u1"}, "storefront", nil)
+	if err != nil {
+		t.Fatalf("IssueUserToken: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		keys []Key
+		err  error
+	}{
+		{name: "old key still verifying", keys: []Key{newKey, oldKey}},
+		{name: "old key retired", keys: []Key{newKey}, err: ErrUnknownKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := newTestIssuer(t, testConfig(), &now, tt.keys...)
+			if _, err := after.Parse(signed, ""); !errors.Is(err, tt.err) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	rotated := newTestIssuer(t, testConfig(), &now, newKey, oldKey)
+	fresh, _, err := rotated.IssueUserToken(Subject{UserID: "u1"}, "storefront", nil)
+	if err != nil {
+		t.Fatalf("IssueUserToken: %v", err)
+	}
+	if _, err := before.Parse(fresh, ""); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("token signed with the new key parsed by an issuer without it: error = %v, want %v", err, ErrUnknownKey)
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	tests := []struct {
+		spec    string
+		ids     []string
+		wantErr bool
+	}{
+		{spec: "a:secret1,b:secret2", ids: []string{"a", "b"}},
+		{spec: " a:secret1 , ", ids: []string{"a"}},
+		{spec: "secret", wantErr: true},
+		{spec: "a:x,a:y", wantErr: true},
+	}
+	for _, tt := range tests {
+		keys, err := ParseKeys(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseKeys(%q) error = %v, want error %v", tt.spec, err, tt.wantErr)
+			continue
+		}
+		if len(keys) != len(tt.ids) {
+			t.Errorf("ParseKeys(%q) = %d keys, want %d", tt.spec, len(keys), len(tt.ids))
+			continue
+		}
+		for n, k := range keys {
+			if k.ID != tt.ids[n] {
+				t.Errorf("ParseKeys(%q) key %d id = %q, want %q", tt.spec, n, k.ID, tt.ids[n])
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	googleTokenURL = "https://oauth2.googleapis.com/token"
+	bigQueryScope  = "https://www.googleapis.com/auth/bigquery.insertdata"
+	bigQueryAPI    = "https://bigquery.googleapis.com/bigquery/v2"
+)
+
+// ServiceAccount is the subset of a Google service account key file used to
+// obtain access tokens
+type ServiceAccount struct {
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// ParseServiceAccount decodes a service account JSON key
+func ParseServiceAccount(raw []byte) (*ServiceAccount, error) {
+	var sa ServiceAccount
+	if err := json.Unmarshal(raw, &sa); err != nil {
+		return nil, fmt.Errorf("warehouse: invalid service account key: %w", err)
+	}
+	if sa.ClientEmail == "" || sa.PrivateKey == "" {
+		return nil, errors.New("warehouse: service account key needs client_email and private_key")
+	}
+	if sa.TokenURI == "" {
+		sa.TokenURI = googleTokenURL
+	}
+	return &sa, nil
+}
+
+// BigQuery streams rows with tabledata.insertAll. Each row's insertId is
+// its user key and version, which BigQuery uses to drop resent rows.
+type BigQuery struct {
+	Project string
+	Dataset string
+	Table   string
+	Account *ServiceAccount
+	Client  *http.Client
+
+	mu      sync.Mutex
+	key     *rsa.PrivateKey
+	token   string
+	expires time.Time
+}
+
+func (b *BigQuery) Name() string { return "bigquery:" + b.Project + "." + b.Dataset + "." + b.Table }
+
+type insertAllRequest struct {
+	SkipInvalidRows bool             `json:"skipInvalidRows"`
+	Rows            []insertAllEntry `json:"rows"`
+}
+
+type insertAllEntry struct {
+	InsertID string        `json:"insertId"`
+	JSON     UserDimension `json:"json"`
+}
+
+type insertAllResponse struct {
+	InsertErrors []struct {
+		Index  int `json:"index"`
+		Errors []struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"insertErrors"`
+}
+
+func (b *BigQuery) Write(ctx context.Context, rows []UserDimension) error {
+	token, err := b.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload := insertAllRequest{Rows: make([]insertAllEntry, len(rows))}
+	for i, r := range rows {
+		payload.Rows[i] = insertAllEntry{
+			InsertID: fmt.Sprintf("%s-%d", r.UserKey, r.UpdatedAt.UnixNano()),
+			JSON:     r,
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/projects/%s/datasets/%s/tables/%s/insertAll",
+		bigQueryAPI, url.PathEscape(b.Project), url.PathEscape(b.Dataset), url.PathEscape(b.Table))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	respBody, err := doRequest(b.Client, req)
+	if err != nil {
+		return err
+	}
+	var resp insertAllResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return err
+	}
+	if len(resp.InsertErrors) > 0 {
+		e := resp.InsertErrors[0]
+		msg := "unknown error"
+		if len(e.Errors) > 0 {
+			msg = e.Errors[0].Reason + ": " + e.Errors[0].Message
+		}
+		return fmt.Errorf("warehouse: %d rows rejected, first at index %d: %s", len(resp.InsertErrors), e.Index, msg)
+	}
+	return nil
+}
+
+// accessToken exchanges a signed JWT assertion for an OAuth access token,
+// caching it until shortly before it expires
+func (b *BigQuery) accessToken(ctx context.Context) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.token != "" && time.Until(b.expires) > time.Minute {
+		return b.token, nil
+	}
+	if b.key == nil {
+		key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(b.Account.PrivateKey))
+		if err != nil {
+			return "", fmt.Errorf("warehouse: invalid service account private key: %w", err)
+		}
+		b.key = key
+	}
+
+	now := time.Now()
+	assertion := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   b.Account.ClientEmail,
+		"scope": bigQueryScope,
+		"aud":   b.Account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	assertion.Header["kid"] = b.Account.PrivateKeyID
+	signed, err := assertion.SignedString(b.key)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {signed},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.Account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body, err := doRequest(b.Client, req)
+	if err != nil {
+		return "", err
+	}
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return "", err
+	}
+	if tok.AccessToken == "" {
+		return "", errors.New("warehouse: token response has no access token")
+	}
+	b.token = tok.AccessToken
+	b.expires = now.Add(time.Duration(tok.ExpiresIn) * time.Second)
+	return b.token, nil
+}
@@ -0,0 +1,64 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClickHouse inserts rows over the HTTP interface with JSONEachRow. The
+// table is expected to be a ReplacingMergeTree ordered by user_key with
+// updated_at as its version, so re-sent rows collapse.
+type ClickHouse struct {
+	URL      string
+	Database string
+	Table    string
+	User     string
+	Password string
+	Client   *http.Client
+}
+
+func (c *ClickHouse) Name() string { return "clickhouse:" + c.Database + "." + c.Table }
+
+type clickHouseRow struct {
+	UserDimension
+	UpdatedAt  string `json:"updated_at"`
+	ExportedAt string `json:"exported_at"`
+}
+
+func (c *ClickHouse) Write(ctx context.Context, rows []UserDimension) error {
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, r := range rows {
+		if err := enc.Encode(clickHouseRow{
+			UserDimension: r,
+			UpdatedAt:     r.UpdatedAt.UTC().Format(time.DateTime),
+			ExportedAt:    r.ExportedAt.UTC().Format(time.DateTime),
+		}); err != nil {
+			return err
+		}
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return err
+	}
+	q := u.Query()
+	q.Set("query", "INSERT INTO "+c.Database+"."+c.Table+" FORMAT JSONEachRow")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if c.User != "" {
+		req.Header.Set("X-ClickHouse-User", c.User)
+		req.Header.Set("X-ClickHouse-Key", c.Password)
+	}
+	_, err = doRequest(c.Client, req)
+	return err
+}
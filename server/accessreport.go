@@ -0,0 +1,339 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/pdf"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	accessReportURLTTL    = time.Hour
+	accessReportRetention = 24 * time.Hour
+	accessReportSweep     = 15 * time.Minute
+	accessReportMaxEvents = 1000
+)
+
+// AccessReport records a generated report so its object can be removed once
+// the retention window has passed.
+type AccessReport struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Key       string             `bson:"key"`
+	Format    string             `bson:"format"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+}
+
+type reportField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type reportSection struct {
+	Category   string        `json:"category"`
+	Purpose    string        `json:"purpose"`
+	LegalBasis string        `json:"legal_basis"`
+	Fields     []reportField `json:"fields"`
+}
+
+type reportEvent struct {
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	SharedWith string    `json:"shared_with"`
+}
+
+type accessReport struct {
+	UserID      string          `json:"user_id"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Sections    []reportSection `json:"sections"`
+	Events      []reportEvent   `json:"events"`
+	Notes       []string        `json:"notes"`
+}
+
+// GenerateAccessReport assembles every piece of personal data held about a
+// user together with the purpose it is processed for, stores the report in
+// object storage and returns a short-lived signed download URL. The request
+// must carry a user token from a recent sign-in or ReAuthenticate.
+func (s *userService) GenerateAccessReport(ctx context.Context, req *pb.GenerateAccessReportMessageRequest) (*pb.GenerateAccessReportMessageResponse, error) {
+	format := strings.ToLower(strings.TrimSpace(req.GetFormat()))
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "pdf" {
+		return nil, status.Error(codes.InvalidArgument, "format must be json or pdf")
+	}
+
+	// 1. Collect the data
+	if err := s.requireFreshAuth(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.buildAccessReport(ctx, user)
+	if err != nil {
+		log.Printf("Failed to build access report: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate access report")
+	}
+
+	// 2. Render it
+	var data []byte
+	contentType := "application/json"
+	if format == "pdf" {
+		data = renderAccessReportPDF(report)
+		contentType = "application/pdf"
+	} else {
+		data, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Printf("Failed to encode access report: %v", err)
+			return nil, status.Error(codes.Internal, "failed to generate access report")
+		}
+	}
+
+	// 3. Store it temporarily and sign a download link
+	now := time.Now()
+	reportID := primitive.NewObjectID()
+	key := fmt.Sprintf("reports/access/%s/%s.%s", user.ID.Hex(), reportID.Hex(), format)
+	if err := s.store.Put(ctx, key, data, contentType); err != nil {
+		log.Printf("Failed to store access report: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate access report")
+	}
+
+	_, err = s.collection(ctx, "access_reports").InsertOne(ctx, AccessReport{
+		ID:        reportID,
+		UserID:    user.ID,
+		Key:       key,
+		Format:    format,
+		CreatedAt: now,
+		ExpiresAt: now.Add(accessReportRetention),
+	})
+	if err != nil {
+		log.Printf("Failed to record access report: %v", err)
+	}
+
+	url, err := s.store.SignedURL(ctx, key, accessReportURLTTL)
+	if err != nil {
+		log.Printf("Failed to sign access report URL: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate access report")
+	}
+
+	return &pb.GenerateAccessReportMessageResponse{
+		ReportId:      reportID.Hex(),
+		Format:        format,
+		DownloadUrl:   url,
+		ExpiresAtUnix: now.Add(accessReportURLTTL).Unix(),
+	}, nil
+}
+
+func (s *userService) buildAccessReport(ctx context.Context, user *User) (*accessReport, error) {
+	report := &accessReport{
+		UserID:      user.ID.Hex(),
+		GeneratedAt: time.Now().UTC(),
+		Notes: []string{
+			"Your password is stored only as a one-way hash and cannot be disclosed.",
+			"Payment card details are held by our payment provider; we only store an opaque token.",
+		},
+	}
+
+	report.Sections = append(report.Sections, reportSection{
+		Category:   "Identity",
+		Purpose:    "Creating and managing your account",
+		LegalBasis: "Contract",
+		Fields: []reportField{
+			{"Full name", user.FullName},
+			{"Username", user.UserName},
+			{"Date of birth", formatOptionalTime(user.DateOfBirth, "2006-01-02")},
+			{"Gender", user.Gender},
+		},
+	}, reportSection{
+		Category:   "Contact details",
+		Purpose:    "Signing you in, securing your account and sending service messages",
+		LegalBasis: "Contract",
+		Fields: []reportField{
+			{"Email address", user.EmailAddress},
+			{"Email verified at", formatOptionalTime(user.EmailVerifiedAt, time.RFC3339)},
+			{"Phone number", user.PhoneNumber},
+		},
+	})
+
+	if b := user.Billing; b != nil {
+		fields := []reportField{
+			{"Billing address", strings.Join(nonEmpty(b.Address.RecipientName, b.Address.Line1, b.Address.Line2, b.Address.City, b.Address.Region, b.Address.PostalCode, b.Address.Country), ", ")},
+			{"Currency", b.Currency},
+			{"Payment token", maskValue(b.PaymentToken, 4)},
+		}
+		if b.Address.County != "" || b.Address.Landmark != "" {
+			fields = append(fields,
+				reportField{"Delivery area", strings.Join(nonEmpty(b.Address.SubCounty, b.Address.County), ", ")},
+				reportField{"Nearest landmark", b.Address.Landmark},
+				reportField{"Rider instructions", b.Address.RiderInstructions},
+			)
+		}
+		if loc := b.Address.Location; loc != nil {
+			fields = append(fields, reportField{"Address coordinates", fmt.Sprintf("%.6f, %.6f", loc.Latitude(), loc.Longitude())})
+		}
+		for _, t := range b.TaxIDs {
+			fields = append(fields, reportField{"Tax identifier (" + t.Type + ")", t.Value})
+		}
+		report.Sections = append(report.Sections, reportSection{
+			Category:   "Billing",
+			Purpose:    "Processing payments and issuing invoices",
+			LegalBasis: "Contract and legal obligation",
+			Fields:     fields,
+		})
+	}
+
+	prefs := make([]string, 0, len(user.NotificationPrefs))
+	for kind, channels := range user.NotificationPrefs {
+		prefs = append(prefs, kind+": "+strings.Join(channels, "/"))
+	}
+	sort.Strings(prefs)
+	report.Sections = append(report.Sections, reportSection{
+		Category:   "Preferences and personalization",
+		Purpose:    "Personalizing recommendations and choosing how we contact you",
+		LegalBasis: "Legitimate interest",
+		Fields: []reportField{
+			{"Locale", user.Locale},
+			{"Tier", user.Tier},
+			{"Tags", strings.Join(user.Tags, ", ")},
+			{"Notification preferences", strings.Join(prefs, "; ")},
+			{"Registered push devices", fmt.Sprint(len(user.PushTokens))},
+		},
+	}, reportSection{
+		Category:   "Account activity",
+		Purpose:    "Security monitoring and service analytics",
+		LegalBasis: "Legitimate interest",
+		Fields: []reportField{
+			{"Registered at", user.CreatedAt.UTC().Format(time.RFC3339)},
+			{"Last updated at", user.UpdatedAt.UTC().Format(time.RFC3339)},
+			{"Last login at", formatOptionalTime(user.LastLoginAt, time.RFC3339)},
+			{"Deletion scheduled for", formatOptionalTime(user.DeletionScheduledFor, time.RFC3339)},
+		},
+	})
+
+	// Events shared with other AI-Shop services
+	cursor, err := s.collection(ctx, "outbox").Find(ctx,
+		bson.M{"aggregate_id": user.ID.Hex()},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(accessReportMaxEvents),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var events []OutboxEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		report.Events = append(report.Events, reportEvent{
+			Type:       e.Type,
+			OccurredAt: e.CreatedAt.UTC(),
+			SharedWith: "AI-Shop internal services",
+		})
+	}
+
+	return report, nil
+}
+
+func renderAccessReportPDF(r *accessReport) []byte {
+	var doc pdf.Document
+	doc.Title("Your AI-Shop personal data report")
+	doc.Text("Account ID: " + r.UserID)
+	doc.Text("Generated at: " + r.GeneratedAt.Format(time.RFC1123))
+
+	for _, section := range r.Sections {
+		doc.Heading(section.Category)
+		doc.Text("Why we process it: " + section.Purpose + " (" + section.LegalBasis + ")")
+		for _, f := range section.Fields {
+			value := f.Value
+			if value == "" {
+				value = "-"
+			}
+			doc.Text(f.Name + ": " + value)
+		}
+	}
+
+	doc.Heading("Data shared with other services")
+	if len(r.Events) == 0 {
+		doc.Text("None")
+	}
+	for _, e := range r.Events {
+		doc.Text(e.OccurredAt.Format(time.RFC3339) + "  " + e.Type + " -> " + e.SharedWith)
+	}
+
+	doc.Heading("Notes")
+	for _, n := range r.Notes {
+		doc.Text(n)
+	}
+	return doc.Bytes()
+}
+
+// runAccessReportSweeper removes report objects once their retention has passed
+func (s *userService) runAccessReportSweeper(ctx context.Context) {
+	ticker := time.NewTicker(accessReportSweep)
+	defer ticker.Stop()
+
+	for {
+		if !s.config.inMaintenance() {
+			s.sweepAccessReports(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *userService) sweepAccessReports(ctx context.Context) {
+	collection := s.collection(ctx, "access_reports")
+	cursor, err := collection.Find(ctx, bson.M{"expires_at": bson.M{"$lte": time.Now()}})
+	if err != nil {
+		log.Printf("Failed to load expired access reports: %v", err)
+		return
+	}
+
+	var reports []AccessReport
+	if err := cursor.All(ctx, &reports); err != nil {
+		log.Printf("Failed to decode expired access reports: %v", err)
+		return
+	}
+
+	for _, r := range reports {
+		if err := s.store.Delete(ctx, r.Key); err != nil {
+			log.Printf("Failed to delete access report %s: %v", r.Key, err)
+			continue
+		}
+		collection.DeleteOne(ctx, bson.M{"_id": r.ID})
+	}
+}
+
+func formatOptionalTime(t *time.Time, layout string) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(layout)
+}
+
+func nonEmpty(values ...string) []string {
+	out := values[:0]
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+)
+
+const readinessTimeout = 2 * time.Second
+
+// Set at build time with
+// -ldflags "-X main.gitSHA=$(git rev-parse HEAD) -X main.buildTime=$(date +%s)".
+// Binaries built without them fall back to the VCS stamp of the Go toolchain.
+var (
+	gitSHA    string
+	buildTime string
+)
+
+var startedAt = time.Now()
+
+type buildInfo struct {
+	GitSHA        string `json:"git_sha"`
+	BuildTimeUnix int64  `json:"build_time_unix"`
+	ProtoVersion  string `json:"proto_version"`
+	GoVersion     string `json:"go_version"`
+	StartedAtUnix int64  `json:"started_at_unix"`
+}
+
+var currentBuild = loadBuildInfo()
+
+func loadBuildInfo() buildInfo {
+	info := buildInfo{
+		GitSHA:        gitSHA,
+		ProtoVersion:  protoVersion(),
+		GoVersion:     runtime.Version(),
+		StartedAtUnix: startedAt.Unix(),
+	}
+	info.BuildTimeUnix, _ = strconv.ParseInt(buildTime, 10, 64)
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.GitSHA == "" {
+					info.GitSHA = setting.Value
+				}
+			case "vcs.time":
+				if t, err := time.Parse(time.RFC3339, setting.Value); err == nil && info.BuildTimeUnix == 0 {
+					info.BuildTimeUnix = t.Unix()
+				}
+			}
+		}
+	}
+	if info.GitSHA == "" {
+		info.GitSHA = "unknown"
+	}
+	return info
+}
+
+// protoVersion fingerprints the compiled user.proto descriptor so clients
+// can tell whether they were generated from the same API definition
+func protoVersion() string {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(protodesc.ToFileDescriptorProto(pb.File_user_proto))
+	if err != nil {
+		return "unknown"
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:6])
+}
+
+// GetServerInfo reports the build running behind this endpoint
+func (s *userService) GetServerInfo(ctx context.Context, req *pb.GetServerInfoMessageRequest) (*pb.GetServerInfoMessageResponse, error) {
+	return &pb.GetServerInfoMessageResponse{
+		GitSha:        currentBuild.GitSHA,
+		BuildTimeUnix: currentBuild.BuildTimeUnix,
+		ProtoVersion:  currentBuild.ProtoVersion,
+		GoVersion:     currentBuild.GoVersion,
+		StartedAtUnix: currentBuild.StartedAtUnix,
+	}, nil
+}
+
+// ready reports whether the dependencies needed to serve requests respond
+func (s *userService) ready(ctx context.Context) map[string]string {
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	failures := make(map[string]string)
+	if s.draining.Load() {
+		failures["server"] = "shutting down"
+	}
+	if !s.mongoIsReady() {
+		failures["mongodb"] = "connecting"
+	} else if err := s.db.Ping(ctx, nil); err != nil {
+		failures["mongodb"] = err.Error()
+	}
+	if s.redis != nil {
+		if err := s.redis.Ping(ctx).Err(); err != nil {
+			failures["redis"] = err.Error()
+		}
+	}
+	return failures
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Failed to write admin response: %v", err)
+	}
+}
+
+// newAdminHandler serves the endpoints deployment tooling probes: /healthz
+// for liveness, /readyz for dependency readiness, /version for the build and
+// /service-config for the client service config, plus authenticated runtime
+// diagnostics
+func (s *userService) newAdminHandler(clients *clientRegistry) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if failures := s.ready(r.Context()); len(failures) > 0 {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]interface{}{"status": "unavailable", "failures": failures})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, currentBuild)
+	})
+	mux.Handle("/service-config", serviceConfigHandler())
+	mountDiagnostics(mux, clients)
+	return mux
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Subscription events carry the full criteria so the catalog service can
+// keep its own match index without calling back
+const (
+	eventUserSavedSearchSaved       = "user.saved_search_saved"
+	eventUserSavedSearchDeleted     = "user.saved_search_deleted"
+	eventUserProductAlertSubscribed = "user.product_alert_subscribed"
+	eventUserProductAlertDeleted    = "user.product_alert_deleted"
+)
+
+const (
+	maxSavedSearchesPerUser = 50
+	maxProductAlertsPerUser = 200
+	maxSearchFilters        = 20
+	maxSavedSearchName      = 80
+
+	alertBackInStock = "back_in_stock"
+	alertPriceDrop   = "price_drop"
+)
+
+var productAlertKinds = map[string]bool{alertBackInStock: true, alertPriceDrop: true}
+
+type SavedSearch struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Name      string             `bson:"name"`
+	Query     string             `bson:"query"`
+	Filters   map[string]string  `bson:"filters,omitempty"`
+	Notify    bool               `bson:"notify"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// ProductAlert asks to be told when a product is back in stock or drops to
+// a target price. There is at most one alert per user, product and kind.
+type ProductAlert struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	UserID           primitive.ObjectID `bson:"user_id"`
+	ProductID        string             `bson:"product_id"`
+	Kind             string             `bson:"kind"`
+	TargetPriceMinor int64              `bson:"target_price_minor,omitempty"`
+	Currency         string             `bson:"currency,omitempty"`
+	CreatedAt        time.Time          `bson:"created_at"`
+}
+
+func savedSearchToProto(s *SavedSearch) *pb.SavedSearch {
+	return &pb.SavedSearch{
+		Id:            s.ID.Hex(),
+		Name:          s.Name,
+		Query:         s.Query,
+		Filters:       s.Filters,
+		Notify:        s.Notify,
+		CreatedAtUnix: s.CreatedAt.Unix(),
+		UpdatedAtUnix: s.UpdatedAt.Unix(),
+	}
+}
+
+func productAlertToProto(a *ProductAlert) *pb.ProductAlert {
+	return &pb.ProductAlert{
+		Id:               a.ID.Hex(),
+		ProductId:        a.ProductID,
+		Kind:             a.Kind,
+		TargetPriceMinor: a.TargetPriceMinor,
+		Currency:         a.Currency,
+		CreatedAtUnix:    a.CreatedAt.Unix(),
+	}
+}
+
+func parseObjectID(id, what string) (primitive.ObjectID, error) {
+	oid, err := primitive.ObjectIDFromHex(strings.TrimSpace(id))
+	if err != nil {
+		return primitive.NilObjectID, status.Errorf(codes.InvalidArgument, "invalid %s id", what)
+	}
+	return oid, nil
+}
+
+// SaveSearch creates a saved search, or replaces one when searchId is set
+func (s *userService) SaveSearch(ctx context.Context, req *pb.SaveSearchMessageRequest) (*pb.SaveSearchMessageResponse, error) {
+	// 1. Validate input
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.GetName())
+	query := strings.TrimSpace(req.GetQuery())
+	if name == "" || len(name) > maxSavedSearchName {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be 1 to %d characters", maxSavedSearchName)
+	}
+	if query == "" && len(req.GetFilters()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "a query or filters are required")
+	}
+	if len(req.GetFilters()) > maxSearchFilters {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d filters", maxSearchFilters)
+	}
+	filters := make(map[string]string, len(req.GetFilters()))
+	for k, v := range req.GetFilters() {
+		if k = strings.ToLower(strings.TrimSpace(k)); k != "" {
+			filters[k] = strings.TrimSpace(v)
+		}
+	}
+
+	collection := s.collection(ctx, "saved_searches")
+	now := time.Now()
+	search := SavedSearch{
+		UserID:    user.ID,
+		Name:      name,
+		Query:     query,
+		Filters:   filters,
+		Notify:    req.GetNotify(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// 2. Store it
+	if req.GetSearchId() != "" {
+		search.ID, err = parseObjectID(req.GetSearchId(), "saved search")
+		if err != nil {
+			return nil, err
+		}
+		err = collection.FindOneAndUpdate(ctx,
+			bson.M{"_id": search.ID, "user_id": user.ID},
+			bson.M{"$set": bson.M{"name": name, "query": query, "filters": filters, "notify": search.Notify, "updated_at": now}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&search)
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "saved search not found")
+		}
+	} else {
+		var count int64
+		count, err = collection.CountDocuments(ctx, bson.M{"user_id": user.ID})
+		if err == nil && count >= maxSavedSearchesPerUser {
+			return nil, status.Error(codes.ResourceExhausted, "too many saved searches")
+		}
+		if err == nil {
+			search.ID = primitive.NewObjectID()
+			_, err = collection.InsertOne(ctx, search)
+		}
+	}
+	if err != nil {
+		log.Printf("Failed to save search: %v", err)
+		return nil, status.Error(codes.Internal, "failed to save search")
+	}
+
+	// 3. Let the catalog match it
+	s.recordEvent(ctx, eventUserSavedSearchSaved, user.ID, map[string]interface{}{
+		"search_id": search.ID.Hex(),
+		"query":     search.Query,
+		"filters":   search.Filters,
+		"notify":    search.Notify,
+	})
+
+	return &pb.SaveSearchMessageResponse{SavedSearch: savedSearchToProto(&search), Message: "Search saved", Success: true}, nil
+}
+
+// ListSavedSearches returns a user's saved searches, newest first
+func (s *userService) ListSavedSearches(ctx context.Context, req *pb.ListSavedSearchesMessageRequest) (*pb.ListSavedSearchesMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "saved_searches")
+	cursor, err := collection.Find(ctx, bson.M{"user_id": id},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(maxSavedSearchesPerUser))
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list saved searches")
+	}
+	var searches []SavedSearch
+	if err := cursor.All(ctx, &searches); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list saved searches")
+	}
+
+	resp := &pb.ListSavedSearchesMessageResponse{}
+	for i := range searches {
+		resp.SavedSearches = append(resp.SavedSearches, savedSearchToProto(&searches[i]))
+	}
+	return resp, nil
+}
+
+// DeleteSavedSearch removes a saved search
+func (s *userService) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchMessageRequest) (*pb.DeleteSavedSearchMessageResponse, error) {
+	userID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	searchID, err := parseObjectID(req.GetSearchId(), "saved search")
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "saved_searches")
+	res, err := collection.DeleteOne(ctx, bson.M{"_id": searchID, "user_id": userID})
+	if err != nil {
+		log.Printf("Failed to delete saved search: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete saved search")
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Error(codes.NotFound, "saved search not found")
+	}
+	s.recordEvent(ctx, eventUserSavedSearchDeleted, userID, map[string]interface{}{"search_id": searchID.Hex()})
+
+	return &pb.DeleteSavedSearchMessageResponse{Message: "Saved search deleted", Success: true}, nil
+}
+
+// SubscribeProductAlert creates or updates a back-in-stock or price-drop
+// alert for a product
+func (s *userService) SubscribeProductAlert(ctx context.Context, req *pb.SubscribeProductAlertMessageRequest) (*pb.SubscribeProductAlertMessageResponse, error) {
+	// 1. Validate input
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	productID := strings.TrimSpace(req.GetProductId())
+	if productID == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	kind := strings.ToLower(strings.TrimSpace(req.GetKind()))
+	if !productAlertKinds[kind] {
+		return nil, status.Error(codes.InvalidArgument, "kind must be back_in_stock or price_drop")
+	}
+	alert := ProductAlert{UserID: user.ID, ProductID: productID, Kind: kind}
+	if kind == alertPriceDrop {
+		if req.GetTargetPriceMinor() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "price drop alerts need a positive target price")
+		}
+		alert.TargetPriceMinor = req.GetTargetPriceMinor()
+		alert.Currency = strings.ToUpper(strings.TrimSpace(req.GetCurrency()))
+		if alert.Currency == "" {
+			alert.Currency = defaultWalletCurrency
+		}
+		if len(alert.Currency) != 3 {
+			return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter ISO 4217 code")
+		}
+	}
+
+	collection := s.collection(ctx, "product_alerts")
+	count, err := collection.CountDocuments(ctx, bson.M{
+		"user_id": user.ID,
+		"$nor":    bson.A{bson.M{"product_id": productID, "kind": kind}},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to subscribe")
+	}
+	if count >= maxProductAlertsPerUser {
+		return nil, status.Error(codes.ResourceExhausted, "too many product alerts")
+	}
+
+	// 2. Upsert it, keeping the original subscription time
+	err = collection.FindOneAndUpdate(ctx,
+		bson.M{"user_id": user.ID, "product_id": productID, "kind": kind},
+		bson.M{
+			"$set":         bson.M{"target_price_minor": alert.TargetPriceMinor, "currency": alert.Currency},
+			"$setOnInsert": bson.M{"created_at": time.Now()},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&alert)
+	if err != nil {
+		log.Printf("Failed to save product alert: %v", err)
+		return nil, status.Error(codes.Internal, "failed to subscribe")
+	}
+
+	// 3. Let the catalog match it
+	s.recordEvent(ctx, eventUserProductAlertSubscribed, user.ID, map[string]interface{}{
+		"alert_id":           alert.ID.Hex(),
+		"product_id":         alert.ProductID,
+		"kind":               alert.Kind,
+		"target_price_minor": alert.TargetPriceMinor,
+		"currency":           alert.Currency,
+	})
+
+	return &pb.SubscribeProductAlertMessageResponse{Alert: productAlertToProto(&alert), Message: "Alert saved", Success: true}, nil
+}
+
+// ListProductAlerts returns a user's product alerts, newest first
+func (s *userService) ListProductAlerts(ctx context.Context, req *pb.ListProductAlertsMessageRequest) (*pb.ListProductAlertsMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "product_alerts")
+	cursor, err := collection.Find(ctx, bson.M{"user_id": id},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(maxProductAlertsPerUser))
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list product alerts")
+	}
+	var alerts []ProductAlert
+	if err := cursor.All(ctx, &alerts); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list product alerts")
+	}
+
+	resp := &pb.ListProductAlertsMessageResponse{}
+	for i := range alerts {
+		resp.Alerts = append(resp.Alerts, productAlertToProto(&alerts[i]))
+	}
+	return resp, nil
+}
+
+// DeleteProductAlert unsubscribes from a product alert
+func (s *userService) DeleteProductAlert(ctx context.Context, req *pb.DeleteProductAlertMessageRequest) (*pb.DeleteProductAlertMessageResponse, error) {
+	userID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	alertID, err := parseObjectID(req.GetAlertId(), "alert")
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "product_alerts")
+	var alert ProductAlert
+	err = collection.FindOneAndDelete(ctx, bson.M{"_id": alertID, "user_id": userID}).Decode(&alert)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "product alert not found")
+		}
+		log.Printf("Failed to delete product alert: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete product alert")
+	}
+	s.recordEvent(ctx, eventUserProductAlertDeleted, userID, map[string]interface{}{
+		"alert_id":   alert.ID.Hex(),
+		"product_id": alert.ProductID,
+		"kind":       alert.Kind,
+	})
+
+	return &pb.DeleteProductAlertMessageResponse{Message: "Product alert deleted", Success: true}, nil
+}
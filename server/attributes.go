@@ -0,0 +1,337 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	attributeDateLayout     = "2006-01-02"
+	maxAttributeStringBytes = 1000
+	maxAttributesPerUpdate  = 50
+)
+
+// Value types a custom attribute can hold
+const (
+	attributeString = "string"
+	attributeNumber = "number"
+	attributeBool   = "bool"
+	attributeDate   = "date"
+	attributeEnum   = "enum"
+)
+
+// Who can read a custom attribute. Only internal services can write
+// internal attributes.
+const (
+	attributePublic   = "public"
+	attributePrivate  = "private"
+	attributeInternal = "internal"
+)
+
+// Keys become field names under attributes, so they are kept path safe
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+
+// AttributeDefinition is an operator-defined custom profile field stored in
+// the attribute_schemas collection. Min and Max bound numbers, or the
+// length of strings.
+type AttributeDefinition struct {
+	Key        string    `bson:"key"`
+	Type       string    `bson:"type"`
+	Visibility string    `bson:"visibility"`
+	Pattern    string    `bson:"pattern,omitempty"`
+	Min        *float64  `bson:"min,omitempty"`
+	Max        *float64  `bson:"max,omitempty"`
+	Options    []string  `bson:"options,omitempty"`
+	UpdatedAt  time.Time `bson:"updated_at"`
+}
+
+func (d *AttributeDefinition) validate() error {
+	if !attributeKeyPattern.MatchString(d.Key) {
+		return errors.New("attribute keys must be lowercase letters, digits and underscores, starting with a letter")
+	}
+	switch d.Type {
+	case attributeString, attributeNumber, attributeBool, attributeDate:
+	case attributeEnum:
+		if len(d.Options) == 0 {
+			return errors.New("enum attributes need options")
+		}
+	default:
+		return fmt.Errorf("unknown attribute type %q", d.Type)
+	}
+	switch d.Visibility {
+	case attributePublic, attributePrivate, attributeInternal:
+	default:
+		return fmt.Errorf("unknown attribute visibility %q", d.Visibility)
+	}
+	if d.Pattern != "" {
+		if d.Type != attributeString {
+			return errors.New("patterns only apply to string attributes")
+		}
+		if _, err := regexp.Compile(d.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %v", err)
+		}
+	}
+	if d.Min != nil && d.Max != nil && *d.Min > *d.Max {
+		return errors.New("min must not exceed max")
+	}
+	return nil
+}
+
+// parse converts a raw value to its stored form, enforcing the definition
+func (d *AttributeDefinition) parse(raw string) (interface{}, error) {
+	inRange := func(v float64) bool {
+		return (d.Min == nil || v >= *d.Min) && (d.Max == nil || v <= *d.Max)
+	}
+	switch d.Type {
+	case attributeNumber:
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number", d.Key)
+		}
+		if !inRange(v) {
+			return nil, fmt.Errorf("%s is out of range", d.Key)
+		}
+		return v, nil
+	case attributeBool:
+		v, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false", d.Key)
+		}
+		return v, nil
+	case attributeDate:
+		v, err := time.Parse(attributeDateLayout, strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a date in YYYY-MM-DD form", d.Key)
+		}
+		return v, nil
+	case attributeEnum:
+		for _, o := range d.Options {
+			if o == raw {
+				return raw, nil
+			}
+		}
+		return nil, fmt.Errorf("%s must be one of %s", d.Key, strings.Join(d.Options, ", "))
+	default:
+		v := strings.TrimSpace(raw)
+		if len(v) > maxAttributeStringBytes || !inRange(float64(utf8.RuneCountInString(v))) {
+			return nil, fmt.Errorf("%s has an invalid length", d.Key)
+		}
+		if d.Pattern != "" && !regexp.MustCompile(d.Pattern).MatchString(v) {
+			return nil, fmt.Errorf("%s has an invalid format", d.Key)
+		}
+		return v, nil
+	}
+}
+
+// format renders a stored value back to its wire form
+func (d *AttributeDefinition) format(v interface{}) string {
+	switch t := v.(type) {
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case int32:
+		return strconv.FormatInt(int64(t), 10)
+	case int64:
+		return strconv.FormatInt(t, 10)
+	case bool:
+		return strconv.FormatBool(t)
+	case time.Time:
+		return t.UTC().Format(attributeDateLayout)
+	case primitive.DateTime:
+		return t.Time().UTC().Format(attributeDateLayout)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+func attributeDefinitionMessage(d *AttributeDefinition) *pb.AttributeDefinition {
+	msg := &pb.AttributeDefinition{
+		Key:        d.Key,
+		Type:       d.Type,
+		Visibility: d.Visibility,
+		Pattern:    d.Pattern,
+		Options:    d.Options,
+	}
+	if d.Min != nil {
+		msg.Min, msg.HasMin = *d.Min, true
+	}
+	if d.Max != nil {
+		msg.Max, msg.HasMax = *d.Max, true
+	}
+	return msg
+}
+
+// attributeDefinitions loads the schema keyed by attribute key
+func (s *userService) attributeDefinitions(ctx context.Context) (map[string]*AttributeDefinition, error) {
+	cursor, err := s.collection(ctx, "attribute_schemas").Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var defs []AttributeDefinition
+	if err := cursor.All(ctx, &defs); err != nil {
+		return nil, err
+	}
+	out := make(map[string]*AttributeDefinition, len(defs))
+	for i := range defs {
+		out[defs[i].Key] = &defs[i]
+	}
+	return out, nil
+}
+
+// DefineAttribute creates or replaces a custom attribute definition.
+// Values already stored are not revalidated.
+func (s *userService) DefineAttribute(ctx context.Context, req *pb.DefineAttributeMessageRequest) (*pb.DefineAttributeMessageResponse, error) {
+	in := req.GetDefinition()
+	def := AttributeDefinition{
+		Key:        strings.ToLower(strings.TrimSpace(in.GetKey())),
+		Type:       strings.ToLower(strings.TrimSpace(in.GetType())),
+		Visibility: strings.ToLower(strings.TrimSpace(in.GetVisibility())),
+		Pattern:    in.GetPattern(),
+		Options:    in.GetOptions(),
+		UpdatedAt:  time.Now(),
+	}
+	if def.Visibility == "" {
+		def.Visibility = attributePrivate
+	}
+	if in.GetHasMin() {
+		min := in.GetMin()
+		def.Min = &min
+	}
+	if in.GetHasMax() {
+		max := in.GetMax()
+		def.Max = &max
+	}
+	if err := def.validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err := s.collection(ctx, "attribute_schemas").ReplaceOne(ctx,
+		bson.M{"key": def.Key}, def, options.Replace().SetUpsert(true))
+	if err != nil {
+		log.Printf("Failed to store attribute definition: %v", err)
+		return nil, status.Error(codes.Internal, "failed to define attribute")
+	}
+	return &pb.DefineAttributeMessageResponse{Definition: attributeDefinitionMessage(&def), Message: "Attribute defined", Success: true}, nil
+}
+
+// ListAttributeDefinitions returns the custom attribute schema ordered by key
+func (s *userService) ListAttributeDefinitions(ctx context.Context, req *pb.ListAttributeDefinitionsMessageRequest) (*pb.ListAttributeDefinitionsMessageResponse, error) {
+	defs, err := s.attributeDefinitions(ctx)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load attribute definitions")
+	}
+	resp := &pb.ListAttributeDefinitionsMessageResponse{}
+	for _, d := range defs {
+		resp.Definitions = append(resp.Definitions, attributeDefinitionMessage(d))
+	}
+	sort.Slice(resp.Definitions, func(i, j int) bool { return resp.Definitions[i].Key < resp.Definitions[j].Key })
+	return resp, nil
+}
+
+// SetAttributes validates and stores custom attribute values against the
+// schema and removes the keys listed in remove
+func (s *userService) SetAttributes(ctx context.Context, req *pb.SetAttributesMessageRequest) (*pb.SetAttributesMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetAttributes())+len(req.GetRemove()) > maxAttributesPerUpdate {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d attributes can change at once", maxAttributesPerUpdate)
+	}
+	defs, err := s.attributeDefinitions(ctx)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update attributes")
+	}
+	internal := clientFromContext(ctx) != nil
+
+	// 1. Validate every change against its definition
+	lookup := func(key string) (*AttributeDefinition, error) {
+		def, ok := defs[key]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown attribute %q", key)
+		}
+		if def.Visibility == attributeInternal && !internal {
+			return nil, status.Errorf(codes.PermissionDenied, "attribute %q can only be set by internal services", key)
+		}
+		return def, nil
+	}
+	set := bson.M{"updated_at": time.Now()}
+	unset := bson.M{}
+	for key, raw := range req.GetAttributes() {
+		def, err := lookup(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := def.parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		set["attributes."+key] = value
+	}
+	for _, key := range req.GetRemove() {
+		if _, err := lookup(key); err != nil {
+			return nil, err
+		}
+		if _, ok := set["attributes."+key]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "attribute %q is both set and removed", key)
+		}
+		unset["attributes."+key] = ""
+	}
+
+	// 2. Apply them in one update
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	if _, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, update); err != nil {
+		log.Printf("Failed to update attributes: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update attributes")
+	}
+	return &pb.SetAttributesMessageResponse{Message: "Attributes updated", Success: true}, nil
+}
+
+// GetAttributes returns the custom attributes of a user that viewerID may
+// see. Private attributes are visible to the user themselves, internal ones
+// only to internal services, which also see everything else.
+func (s *userService) GetAttributes(ctx context.Context, req *pb.GetAttributesMessageRequest) (*pb.GetAttributesMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	defs, err := s.attributeDefinitions(ctx)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load attributes")
+	}
+	internal := clientFromContext(ctx) != nil
+	owner := strings.TrimSpace(req.GetViewerId()) == user.ID.Hex()
+
+	resp := &pb.GetAttributesMessageResponse{Attributes: make(map[string]string)}
+	for key, value := range user.Attributes {
+		def, ok := defs[key]
+		if !ok {
+			continue
+		}
+		switch {
+		case internal, def.Visibility == attributePublic, def.Visibility == attributePrivate && owner:
+			resp.Attributes[key] = def.format(value)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const actorGateway = "gateway"
+
+// AuditRecord is one entry of the append-only audit log
+type AuditRecord struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	Actor  string             `bson:"actor"`
+	Method string             `bson:"method"`
+	UserID primitive.ObjectID `bson:"user_id,omitempty"`
+	Code   string             `bson:"code"`
+	At     time.Time          `bson:"at"`
+
+	// Credential and PeerID record how the caller authenticated: the SPIFFE
+	// ID or common name of its certificate, or the fingerprint of its API key
+	Credential string `bson:"credential,omitempty"`
+	PeerID     string `bson:"peer_id,omitempty"`
+}
+
+// userScopedRequest is implemented by every request that targets a single user
+type userScopedRequest interface {
+	GetUserId() string
+}
+
+// auditInterceptor records who called which user-scoped or internal RPC and
+// with what outcome. Audit records are kept after account erasure.
+func auditInterceptor(s *userService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		scoped, isUserScoped := req.(userScopedRequest)
+		_, isInternal := methodScopes[info.FullMethod]
+		if !isUserScoped && !isInternal {
+			return resp, err
+		}
+
+		record := AuditRecord{
+			Actor:  actorGateway,
+			Method: info.FullMethod,
+			Code:   status.Code(err).String(),
+			At:     time.Now(),
+		}
+		if client := clientFromContext(ctx); client != nil {
+			record.Actor = client.Service
+		}
+		if id := peerFromContext(ctx); id.Credential != credentialNone {
+			record.Credential, record.PeerID = id.Credential, id.ID
+		}
+		if isUserScoped {
+			record.UserID, _ = primitive.ObjectIDFromHex(scoped.GetUserId())
+		}
+
+		collection := s.collection(ctx, "audit_log")
+		if _, auditErr := collection.InsertOne(context.WithoutCancel(ctx), record); auditErr != nil {
+			log.Printf("Failed to write audit record for %s: %v", info.FullMethod, auditErr)
+		}
+		return resp, err
+	}
+}
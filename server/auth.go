@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyHeader = "x-api-key"
+	spiffeScheme = "spiffe://"
+)
+
+// Scopes granted to internal services through their API key
+const (
+	scopeBillingRead     = "billing.read"
+	scopeBillingPII      = "billing.pii"
+	scopeBillingWrite    = "billing.write"
+	scopeSegmentsRead    = "segments.read"
+	scopeAdminStats      = "admin.stats"
+	scopeAdminMetrics    = "admin.metrics"
+	scopeAdminEvents     = "admin.events"
+	scopeAdminCompliance = "admin.compliance"
+	scopeTokensIssue     = "tokens.issue"
+	scopeTokensValidate  = "tokens.validate"
+	scopeTokensService   = "tokens.service"
+	scopeAdminKYC        = "admin.kyc"
+	scopeAdminModeration = "admin.moderation"
+	scopeDigestsRead     = "digests.read"
+	scopeWalletWrite     = "wallet.write"
+	scopeCouponsWrite    = "coupons.write"
+	scopeSupport         = "support"
+	scopeAdminUsers      = "admin.users"
+	scopeAdminOperations = "admin.operations"
+	scopeUsersRead       = "users.read"
+	scopeAdminDebug      = "admin.debug"
+	scopeUSSD            = "ussd"
+)
+
+// methodScopes lists the RPCs that may only be called by an internal service
+// holding an API key with the given scope. Methods not listed here stay open
+// to the API gateway as before.
+var methodScopes = map[string]string{
+	pb.UserService_GetBillingProfile_FullMethodName:         scopeBillingRead,
+	pb.UserService_UpdateBillingProfile_FullMethodName:      scopeBillingWrite,
+	pb.UserService_GetUserSegments_FullMethodName:           scopeSegmentsRead,
+	pb.UserService_GetUserStats_FullMethodName:              scopeAdminStats,
+	pb.UserService_GetFeedbackSummary_FullMethodName:        scopeAdminStats,
+	pb.UserService_WatchUserMetrics_FullMethodName:          scopeAdminMetrics,
+	pb.UserService_GetSLOStatus_FullMethodName:              scopeAdminMetrics,
+	pb.UserService_ListOutboxEvents_FullMethodName:          scopeAdminEvents,
+	pb.UserService_RepublishOutboxEvents_FullMethodName:     scopeAdminEvents,
+	pb.UserService_ListDeadLetters_FullMethodName:           scopeAdminEvents,
+	pb.UserService_RequeueDeadLetter_FullMethodName:         scopeAdminEvents,
+	pb.UserService_ExportComplianceRecords_FullMethodName:   scopeAdminCompliance,
+	pb.UserService_IssueUserToken_FullMethodName:            scopeTokensIssue,
+	pb.UserService_ValidateToken_FullMethodName:             scopeTokensValidate,
+	pb.UserService_IssueServiceToken_FullMethodName:         scopeTokensService,
+	pb.UserService_ExchangeToken_FullMethodName:             scopeTokensService,
+	pb.UserService_ListKYCReviewQueue_FullMethodName:        scopeAdminKYC,
+	pb.UserService_ApproveKYC_FullMethodName:                scopeAdminKYC,
+	pb.UserService_RejectKYC_FullMethodName:                 scopeAdminKYC,
+	pb.UserService_ListModerationQueue_FullMethodName:       scopeAdminModeration,
+	pb.UserService_ReviewModeration_FullMethodName:          scopeAdminModeration,
+	pb.UserService_SetShadowBan_FullMethodName:              scopeAdminModeration,
+	pb.UserService_GetDueDigests_FullMethodName:             scopeDigestsRead,
+	pb.UserService_DefineAttribute_FullMethodName:           scopeAdminUsers,
+	pb.UserService_CreditWallet_FullMethodName:              scopeWalletWrite,
+	pb.UserService_DebitWallet_FullMethodName:               scopeWalletWrite,
+	pb.UserService_GrantCoupon_FullMethodName:               scopeCouponsWrite,
+	pb.UserService_ReserveCoupon_FullMethodName:             scopeCouponsWrite,
+	pb.UserService_RedeemCoupon_FullMethodName:              scopeCouponsWrite,
+	pb.UserService_ReleaseCoupon_FullMethodName:             scopeCouponsWrite,
+	pb.UserService_LinkTicket_FullMethodName:                scopeSupport,
+	pb.UserService_ListTickets_FullMethodName:               scopeSupport,
+	pb.UserService_SuggestUsers_FullMethodName:              scopeAdminUsers,
+	pb.UserService_ListDuplicateCandidates_FullMethodName:   scopeAdminUsers,
+	pb.UserService_ResolveDuplicateCandidate_FullMethodName: scopeAdminUsers,
+	pb.UserService_BulkUpdateUsers_FullMethodName:           scopeAdminUsers,
+	pb.UserService_StartComplianceExport_FullMethodName:     scopeAdminCompliance,
+	pb.UserService_StartUserErasure_FullMethodName:          scopeAdminCompliance,
+	pb.UserService_StartUserImport_FullMethodName:           scopeAdminUsers,
+	pb.UserService_GetOperation_FullMethodName:              scopeAdminOperations,
+	pb.UserService_ListOperations_FullMethodName:            scopeAdminOperations,
+	pb.UserService_CancelOperation_FullMethodName:           scopeAdminOperations,
+	// The USSD gateway vouches for the phone number of the session
+	pb.UserService_RegisterUSSDUser_FullMethodName: scopeUSSD,
+	pb.UserService_LoginUSSDUser_FullMethodName:    scopeUSSD,
+	pb.UserService_ChangeUSSDPIN_FullMethodName:    scopeUSSD,
+}
+
+// apiClient is an internal service identified by its API key or client
+// certificate
+type apiClient struct {
+	Service string
+	Scopes  map[string]bool
+}
+
+func (c *apiClient) hasScope(scope string) bool {
+	return c != nil && c.Scopes[scope]
+}
+
+type apiClientKey struct{}
+
+// clientFromContext returns the authenticated internal service, if any
+func clientFromContext(ctx context.Context) *apiClient {
+	c, _ := ctx.Value(apiClientKey{}).(*apiClient)
+	return c
+}
+
+// parseAPIKeys reads API_KEYS entries of the form
+// "service:key:scope1,scope2;service2:key2:scope3". Keys are kept hashed.
+func parseAPIKeys(raw string) (map[string]*apiClient, error) {
+	clients := make(map[string]*apiClient)
+	for _, entry := range strings.Split(raw, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid API_KEYS entry %q", entry)
+		}
+		client := &apiClient{Service: parts[0], Scopes: make(map[string]bool)}
+		if len(parts) == 3 {
+			for _, scope := range strings.Split(parts[2], ",") {
+				if scope = strings.TrimSpace(scope); scope != "" {
+					client.Scopes[scope] = true
+				}
+			}
+		}
+		clients[hashAPIKey(parts[1])] = client
+	}
+	return clients, nil
+}
+
+// parseCertClients reads MTLS_CLIENTS entries of the form
+// "common-name:scope1,scope2;common-name2:scope3". The certificate common
+// name doubles as the service name. Mesh workloads are named by their SPIFFE
+// ID instead, e.g. "spiffe://cluster.local/ns/orders/sa/orders:scope1".
+func parseCertClients(raw string) (map[string]*apiClient, error) {
+	clients := make(map[string]*apiClient)
+	for _, entry := range strings.Split(raw, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		var parts []string
+		if rest, ok := strings.CutPrefix(entry, spiffeScheme); ok {
+			parts = strings.SplitN(rest, ":", 2)
+			parts[0] = spiffeScheme + parts[0]
+		} else {
+			parts = strings.SplitN(entry, ":", 2)
+		}
+		if parts[0] == "" {
+			return nil, fmt.Errorf("invalid MTLS_CLIENTS entry %q", entry)
+		}
+		client := &apiClient{Service: parts[0], Scopes: make(map[string]bool)}
+		if len(parts) == 2 {
+			for _, scope := range strings.Split(parts[1], ",") {
+				if scope = strings.TrimSpace(scope); scope != "" {
+					client.Scopes[scope] = true
+				}
+			}
+		}
+		clients[parts[0]] = client
+	}
+	return clients, nil
+}
+
+// clientRegistry resolves internal services by API key hash or by the
+// common name of a verified client certificate
+type clientRegistry struct {
+	keys  map[string]*apiClient
+	certs map[string]*apiClient
+}
+
+// fromPeer returns the service behind a verified mTLS client certificate and
+// the name the certificate matched under. Certificates that match no service
+// still return their SPIFFE ID or common name, with a nil service.
+func (r *clientRegistry) fromPeer(ctx context.Context) (*apiClient, string) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 {
+		return nil, ""
+	}
+	leaf := info.State.VerifiedChains[0][0]
+	var spiffeID string
+	for _, uri := range leaf.URIs {
+		if uri.Scheme == "spiffe" {
+			if client := r.certs[uri.String()]; client != nil {
+				return client, uri.String()
+			}
+			if spiffeID == "" {
+				spiffeID = uri.String()
+			}
+		}
+	}
+	if client := r.certs[leaf.Subject.CommonName]; client != nil || spiffeID == "" {
+		return client, leaf.Subject.CommonName
+	}
+	return nil, spiffeID
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// authenticate resolves the calling service from its API key or client
+// certificate and enforces the scope registered for the called method. The
+// returned context carries the peer identity even when the call is refused.
+func authenticate(ctx context.Context, clients *clientRegistry, method string) (context.Context, error) {
+	var client *apiClient
+	id := peerIdentity{Credential: credentialNone}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(apiKeyHeader); len(keys) > 0 {
+			hash := hashAPIKey(keys[0])
+			client = clients.keys[hash]
+			id = peerIdentity{Credential: credentialAPIKey, ID: apiKeyFingerprint(hash)}
+			if client == nil {
+				return withPeerIdentity(ctx, id), status.Error(codes.Unauthenticated, "invalid API key")
+			}
+		}
+	}
+	if client == nil {
+		var name string
+		if client, name = clients.fromPeer(ctx); name != "" {
+			id = peerIdentity{Credential: credentialMTLS, ID: name}
+		}
+	}
+	if client != nil {
+		id.Service = client.Service
+		ctx = context.WithValue(ctx, apiClientKey{}, client)
+	}
+	ctx = withPeerIdentity(ctx, id)
+
+	if scope, ok := methodScopes[method]; ok {
+		if client == nil {
+			return ctx, status.Error(codes.Unauthenticated, "API key or client certificate required")
+		}
+		if !client.hasScope(scope) {
+			return ctx, status.Errorf(codes.PermissionDenied, "service %s lacks scope %s", client.Service, scope)
+		}
+	}
+
+	return ctx, nil
+}
+
+// apiKeyInterceptor authenticates unary calls and records which peer called
+// which method
+func apiKeyInterceptor(clients *clientRegistry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, clients, info.FullMethod)
+		var resp interface{}
+		if err == nil {
+			resp, err = handler(ctx, req)
+		}
+		observePeerCall(ctx, info.FullMethod, err)
+		return resp, err
+	}
+}
+
+// apiKeyStreamInterceptor authenticates streaming calls
+func apiKeyStreamInterceptor(clients *clientRegistry) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), clients, info.FullMethod)
+		if err == nil {
+			err = handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		}
+		observePeerCall(ctx, info.FullMethod, err)
+		return err
+	}
+}
+
+// contextStream overrides the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// requireHTTPScope authenticates HTTP requests with the same API keys and
+// client certificates as gRPC calls and requires scope
+func requireHTTPScope(clients *clientRegistry, scope string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := authenticate(incomingContext(r.Context(), r.Header), clients, "")
+		if err == nil {
+			client := clientFromContext(ctx)
+			if client == nil {
+				err = status.Error(codes.Unauthenticated, "API key or client certificate required")
+			} else if !client.hasScope(scope) {
+				err = status.Errorf(codes.PermissionDenied, "service %s lacks scope %s", client.Service, scope)
+			}
+		}
+		if err != nil {
+			code := http.StatusUnauthorized
+			if status.Code(err) == codes.PermissionDenied {
+				code = http.StatusForbidden
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]string{{"message": status.Convert(err).Message()}},
+			})
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
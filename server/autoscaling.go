@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+)
+
+const (
+	queueDepthInterval = 15 * time.Second
+	// Counting stops here; a backlog this deep already means scale out
+	maxQueueDepthCount = 100000
+)
+
+// The metrics below are meant as HPA and KEDA scaling inputs. Each Help text
+// states the unit, what a rising value means and how to aggregate it, since
+// the scaler configuration lives in another repository.
+var (
+	inflightRequests = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "userservice_inflight_requests",
+		Help: "Requests currently being handled by this pod, by kind (unary, stream). " +
+			"Instantaneous per-pod value; average across pods for a target-per-pod HPA. " +
+			"Streams stay in flight for their whole lifetime, so scale on kind=\"unary\".",
+	}, []string{"kind"})
+
+	backgroundQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "userservice_background_queue_depth",
+		Help: "Items waiting for background workers, by queue (outbox, operations). " +
+			"Cluster-wide value sampled every 15s by every pod; take max across pods, not sum. " +
+			"Capped at 100000.",
+	}, []string{"queue"})
+
+	loginRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "userservice_login_requests_total",
+		Help: "LoginUser calls handled by this pod, by outcome (ok, error). " +
+			"Use sum(rate(...[1m])) across pods for login RPS.",
+	}, []string{"outcome"})
+
+	loginRPS = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "userservice_login_rps",
+		Help: "LoginUser calls per second on this pod over the last minute, including failures. " +
+			"Precomputed for scalers that cannot evaluate rate(); sum across pods.",
+	})
+)
+
+// loginWindow counts LoginUser calls of any outcome for userservice_login_rps
+var loginWindow rollingCounter
+
+// inflightInterceptor tracks unary calls in flight and counts logins
+func inflightInterceptor() grpc.UnaryServerInterceptor {
+	gauge := inflightRequests.WithLabelValues("unary")
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		gauge.Inc()
+		defer gauge.Dec()
+
+		resp, err := handler(ctx, req)
+		if info.FullMethod == pb.UserService_LoginUser_FullMethodName {
+			outcome := "ok"
+			if err != nil {
+				outcome = "error"
+			}
+			loginRequests.WithLabelValues(outcome).Inc()
+			loginWindow.inc(time.Now())
+		}
+		return resp, err
+	}
+}
+
+// inflightStreamInterceptor tracks open streams
+func inflightStreamInterceptor() grpc.StreamServerInterceptor {
+	gauge := inflightRequests.WithLabelValues("stream")
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		gauge.Inc()
+		defer gauge.Dec()
+		return handler(srv, ss)
+	}
+}
+
+// runAutoscalingSampler refreshes the gauges that need a query or a rolling
+// window rather than a per-request update
+func (s *userService) runAutoscalingSampler(ctx context.Context) {
+	ticker := time.NewTicker(queueDepthInterval)
+	defer ticker.Stop()
+	for {
+		s.sampleQueueDepths(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *userService) sampleQueueDepths(ctx context.Context) {
+	loginRPS.Set(float64(loginWindow.perMinute(time.Now())) / rollingWindow)
+
+	queues := map[string]struct {
+		collection string
+		filter     bson.M
+	}{
+		"outbox":     {"outbox", bson.M{"published_at": nil}},
+		"operations": {"operations", bson.M{"status": bson.M{"$in": []string{operationQueued, operationRunning}}}},
+	}
+	for queue, q := range queues {
+		count, err := s.collection(ctx, q.collection).CountDocuments(ctx, q.filter, options.Count().SetLimit(maxQueueDepthCount))
+		if err != nil {
+			log.Printf("Failed to sample %s queue depth: %v", queue, err)
+			continue
+		}
+		backgroundQueueDepth.WithLabelValues(queue).Set(float64(count))
+	}
+}
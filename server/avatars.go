@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Fallback avatar sources used when no avatar is approved
+const (
+	avatarFallbackInitials = "initials"
+	avatarFallbackGravatar = "gravatar"
+
+	gravatarLookupTimeout = 3 * time.Second
+	gravatarCacheTTL      = 24 * time.Hour
+	maxGravatarCacheSize  = 10000
+)
+
+// avatarPalette holds the background colours of generated avatars
+var avatarPalette = []string{
+	"#1abc9c", "#2ecc71", "#3498db", "#9b59b6", "#34495e",
+	"#16a085", "#27ae60", "#2980b9", "#8e44ad", "#e67e22",
+	"#e74c3c", "#d35400", "#c0392b", "#7f8c8d",
+}
+
+const initialsAvatarSVG = `<svg xmlns="http://www.w3.org/2000/svg" width="256" height="256" viewBox="0 0 256 256">` +
+	`<rect width="256" height="256" fill="%s"/>` +
+	`<text x="50%%" y="50%%" dy=".35em" text-anchor="middle" fill="#ffffff" font-family="Helvetica, Arial, sans-serif" font-size="104">%s</text>` +
+	`</svg>`
+
+func validateAvatarFallback(fallback string) error {
+	switch fallback {
+	case "", avatarFallbackInitials, avatarFallbackGravatar:
+		return nil
+	}
+	return fmt.Errorf("unknown avatar_fallback %q", fallback)
+}
+
+// avatarInitials takes the first letter of up to two words of the display
+// name, falling back to the full name and then the username
+func avatarInitials(user *User) string {
+	name := user.FullName
+	if user.DisplayName != nil && user.DisplayName.Value != "" {
+		name = user.DisplayName.Value
+	}
+	if strings.TrimSpace(name) == "" {
+		name = user.UserName
+	}
+	var initials []rune
+	for _, word := range strings.Fields(name) {
+		for _, r := range word {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				initials = append(initials, unicode.ToUpper(r))
+				break
+			}
+		}
+		if len(initials) == 2 {
+			break
+		}
+	}
+	if len(initials) == 0 {
+		return "?"
+	}
+	return string(initials)
+}
+
+// initialsAvatar renders the SVG avatar of a user and the storage key it is
+// cached under. The key changes with the initials, so renames regenerate it.
+func initialsAvatar(user *User) (string, []byte) {
+	initials := avatarInitials(user)
+	color := avatarPalette[experimentBucket("avatar", user.ID)%len(avatarPalette)]
+	svg := fmt.Sprintf(initialsAvatarSVG, color, html.EscapeString(initials))
+	sum := sha256.Sum256([]byte(svg))
+	return "avatars/" + user.ID.Hex() + "/initials-" + hex.EncodeToString(sum[:8]) + ".svg", []byte(svg)
+}
+
+// fallbackAvatarURL returns the avatar shown when none is approved: the
+// user's Gravatar when avatar_fallback is gravatar and one exists, otherwise
+// a generated initials avatar. During maintenance only an already
+// generated avatar is served, possibly stale, and none otherwise.
+func (s *userService) fallbackAvatarURL(ctx context.Context, user *User) (string, error) {
+	if s.config.get().AvatarFallback == avatarFallbackGravatar && user.EmailAddress != "" {
+		if url, ok := s.gravatars.lookup(ctx, user.EmailAddress); ok {
+			return url, nil
+		}
+	}
+
+	if s.config.inMaintenance() {
+		if user.GeneratedAvatar == "" {
+			return "", nil
+		}
+		return s.store.SignedURL(ctx, user.GeneratedAvatar, publicAvatarURLTTL)
+	}
+
+	key, svg := initialsAvatar(user)
+	if user.GeneratedAvatar != key {
+		if err := s.store.Put(ctx, key, svg, "image/svg+xml"); err != nil {
+			return "", err
+		}
+		_, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+			"$set": bson.M{"generated_avatar": key},
+		})
+		if err != nil {
+			return "", err
+		}
+		if user.GeneratedAvatar != "" {
+			if err := s.store.Delete(ctx, user.GeneratedAvatar); err != nil {
+				log.Printf("Failed to delete stale avatar %s: %v", user.GeneratedAvatar, err)
+			}
+		}
+		user.GeneratedAvatar = key
+	}
+	return s.store.SignedURL(ctx, key, publicAvatarURLTTL)
+}
+
+// gravatarCache remembers which email hashes have a Gravatar, so public
+// profiles do not query Gravatar on every read
+type gravatarCache struct {
+	mu      sync.Mutex
+	entries map[string]gravatarEntry
+}
+
+type gravatarEntry struct {
+	exists    bool
+	checkedAt time.Time
+}
+
+func newGravatarCache() *gravatarCache {
+	return &gravatarCache{entries: make(map[string]gravatarEntry)}
+}
+
+// lookup returns the Gravatar URL of email if one is registered. Lookup
+// failures are treated as no Gravatar and retried after the cache TTL.
+func (c *gravatarCache) lookup(ctx context.Context, email string) (string, bool) {
+	sum := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(email))))
+	hash := hex.EncodeToString(sum[:])
+	url := "https://www.gravatar.com/avatar/" + hash + "?s=256"
+
+	c.mu.Lock()
+	entry, ok := c.entries[hash]
+	c.mu.Unlock()
+	if ok && time.Since(entry.checkedAt) < gravatarCacheTTL {
+		return url, entry.exists
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gravatarLookupTimeout)
+	defer cancel()
+	exists := false
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url+"&d=404", nil)
+	if err == nil {
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			log.Printf("Failed to look up Gravatar: %v", err)
+		} else {
+			resp.Body.Close()
+			exists = resp.StatusCode == http.StatusOK
+		}
+	}
+
+	c.mu.Lock()
+	if len(c.entries) >= maxGravatarCacheSize {
+		c.entries = make(map[string]gravatarEntry)
+	}
+	c.entries[hash] = gravatarEntry{exists: exists, checkedAt: time.Now()}
+	c.mu.Unlock()
+	return url, exists
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserSellerAwayStarted = "user.seller_away_started"
+	eventUserSellerAwayEnded   = "user.seller_away_ended"
+
+	awayScanInterval     = time.Minute
+	maxAwayDuration      = 90 * 24 * time.Hour
+	maxAwayAutoReplySize = 500
+)
+
+// Away mode states. Scheduled away mode becomes active at its start time.
+const (
+	awayScheduled = "scheduled"
+	awayActive    = "active"
+)
+
+// SellerAway is a seller's vacation period. The catalog service pauses
+// their listings while it is active.
+type SellerAway struct {
+	State     string    `bson:"state"`
+	StartsAt  time.Time `bson:"starts_at"`
+	EndsAt    time.Time `bson:"ends_at"`
+	AutoReply string    `bson:"auto_reply,omitempty"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+func awayModeMessage(a *SellerAway) *pb.SellerAwayMode {
+	if a == nil {
+		return nil
+	}
+	return &pb.SellerAwayMode{
+		StartsAtUnix: a.StartsAt.Unix(),
+		EndsAtUnix:   a.EndsAt.Unix(),
+		AutoReply:    a.AutoReply,
+		Active:       a.State == awayActive,
+	}
+}
+
+func (s *userService) recordAwayStarted(ctx context.Context, user *User, a *SellerAway) {
+	s.recordEvent(ctx, eventUserSellerAwayStarted, user.ID, map[string]interface{}{
+		"starts_at":  a.StartsAt,
+		"ends_at":    a.EndsAt,
+		"auto_reply": a.AutoReply,
+	})
+}
+
+// SetAwayMode schedules or updates the away period of a verified seller.
+// A period starting now takes effect at once.
+func (s *userService) SetAwayMode(ctx context.Context, req *pb.SetAwayModeMessageRequest) (*pb.SetAwayModeMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.SellerStatus != sellerStatusVerified {
+		return nil, status.Error(codes.FailedPrecondition, "only verified sellers can set away mode")
+	}
+
+	// 1. Validate the period and auto-reply
+	now := time.Now()
+	startsAt := now
+	if req.GetStartsAtUnix() > 0 {
+		startsAt = time.Unix(req.GetStartsAtUnix(), 0)
+	}
+	endsAt := time.Unix(req.GetEndsAtUnix(), 0)
+	if req.GetEndsAtUnix() <= 0 || !endsAt.After(startsAt) || !endsAt.After(now) {
+		return nil, status.Error(codes.InvalidArgument, "away mode needs an end after its start and in the future")
+	}
+	if endsAt.Sub(startsAt) > maxAwayDuration {
+		return nil, status.Error(codes.InvalidArgument, "away mode can last at most 90 days")
+	}
+	reply := strings.TrimSpace(req.GetAutoReply())
+	if utf8.RuneCountInString(reply) > maxAwayAutoReplySize {
+		return nil, status.Errorf(codes.InvalidArgument, "auto-reply must be at most %d characters", maxAwayAutoReplySize)
+	}
+
+	// 2. Store it, activating periods that already started
+	away := &SellerAway{State: awayScheduled, StartsAt: startsAt, EndsAt: endsAt, AutoReply: reply, UpdatedAt: now}
+	if !startsAt.After(now) {
+		away.State = awayActive
+	}
+	_, err = s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$set": bson.M{"away": away, "updated_at": now},
+	})
+	if err != nil {
+		log.Printf("Failed to set away mode: %v", err)
+		return nil, status.Error(codes.Internal, "failed to set away mode")
+	}
+
+	// 3. Tell the catalog about the new period
+	wasActive := user.Away != nil && user.Away.State == awayActive
+	switch {
+	case away.State == awayActive:
+		s.recordAwayStarted(ctx, user, away)
+	case wasActive:
+		s.recordEvent(ctx, eventUserSellerAwayEnded, user.ID, map[string]interface{}{"ended_at": now})
+	}
+
+	return &pb.SetAwayModeMessageResponse{AwayMode: awayModeMessage(away), Message: "Away mode set", Success: true}, nil
+}
+
+// ClearAwayMode ends or cancels the seller's away period
+func (s *userService) ClearAwayMode(ctx context.Context, req *pb.ClearAwayModeMessageRequest) (*pb.ClearAwayModeMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.Away == nil {
+		return &pb.ClearAwayModeMessageResponse{Message: "Away mode not set", Success: true}, nil
+	}
+
+	now := time.Now()
+	_, err = s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$unset": bson.M{"away": ""},
+		"$set":   bson.M{"updated_at": now},
+	})
+	if err != nil {
+		log.Printf("Failed to clear away mode: %v", err)
+		return nil, status.Error(codes.Internal, "failed to clear away mode")
+	}
+	if user.Away.State == awayActive {
+		s.recordEvent(ctx, eventUserSellerAwayEnded, user.ID, map[string]interface{}{"ended_at": now})
+	}
+	return &pb.ClearAwayModeMessageResponse{Message: "Away mode cleared", Success: true}, nil
+}
+
+// GetAwayMode returns the seller's away period, if any, so buyers can be
+// shown the auto-reply
+func (s *userService) GetAwayMode(ctx context.Context, req *pb.GetAwayModeMessageRequest) (*pb.GetAwayModeMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAwayModeMessageResponse{AwayMode: awayModeMessage(user.Away)}, nil
+}
+
+func (s *userService) runAwayScheduler(ctx context.Context) {
+	ticker := time.NewTicker(awayScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if !s.config.inMaintenance() {
+			s.advanceAwayModes(ctx, time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// advanceAwayModes starts scheduled away periods and ends expired ones.
+// Each transition is claimed before its event is emitted so replicas do not
+// duplicate it.
+func (s *userService) advanceAwayModes(ctx context.Context, now time.Time) {
+	collection := s.collection(ctx, "users")
+
+	cursor, err := collection.Find(ctx, bson.M{"away.state": awayActive, "away.ends_at": bson.M{"$lte": now}})
+	if err != nil {
+		log.Printf("Failed to find expired away modes: %v", err)
+		return
+	}
+	var ended []User
+	if err := cursor.All(ctx, &ended); err != nil {
+		log.Printf("Failed to load expired away modes: %v", err)
+		return
+	}
+	for i := range ended {
+		user := &ended[i]
+		res, err := collection.UpdateOne(ctx,
+			bson.M{"_id": user.ID, "away.state": awayActive, "away.ends_at": user.Away.EndsAt},
+			bson.M{"$unset": bson.M{"away": ""}},
+		)
+		if err != nil {
+			log.Printf("Failed to end away mode for user %s: %v", user.ID.Hex(), err)
+			continue
+		}
+		if res.ModifiedCount > 0 {
+			s.recordEvent(ctx, eventUserSellerAwayEnded, user.ID, map[string]interface{}{"ended_at": user.Away.EndsAt})
+		}
+	}
+
+	cursor, err = collection.Find(ctx, bson.M{"away.state": awayScheduled, "away.starts_at": bson.M{"$lte": now}})
+	if err != nil {
+		log.Printf("Failed to find scheduled away modes: %v", err)
+		return
+	}
+	var started []User
+	if err := cursor.All(ctx, &started); err != nil {
+		log.Printf("Failed to load scheduled away modes: %v", err)
+		return
+	}
+	for i := range started {
+		user := &started[i]
+		res, err := collection.UpdateOne(ctx,
+			bson.M{"_id": user.ID, "away.state": awayScheduled, "away.starts_at": user.Away.StartsAt},
+			bson.M{"$set": bson.M{"away.state": awayActive}},
+		)
+		if err != nil {
+			log.Printf("Failed to start away mode for user %s: %v", user.ID.Hex(), err)
+			continue
+		}
+		if res.ModifiedCount > 0 {
+			s.recordAwayStarted(ctx, user, user.Away)
+		}
+	}
+}
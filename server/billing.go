@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BillingProfile struct {
+	Address      BillingAddress  `bson:"address"`
+	PaymentToken string          `bson:"payment_token"`
+	Currency     string          `bson:"currency"`
+	TaxIDs       []TaxIdentifier `bson:"tax_ids"`
+}
+
+type BillingAddress struct {
+	RecipientName string `bson:"recipient_name"`
+	Line1         string `bson:"line1"`
+	Line2         string `bson:"line2"`
+	City          string `bson:"city"`
+	Region        string `bson:"region"`
+	PostalCode    string `bson:"postal_code"`
+	Country       string `bson:"country"`
+
+	// Kenyan deliveries are routed by county and sub-county and found by
+	// landmark rather than street address
+	County            string `bson:"county,omitempty"`
+	SubCounty         string `bson:"sub_county,omitempty"`
+	Landmark          string `bson:"landmark,omitempty"`
+	RiderInstructions string `bson:"rider_instructions,omitempty"`
+
+	// Set when the address was geocoded on save
+	Normalized *NormalizedAddress `bson:"normalized,omitempty"`
+	Location   *GeoPoint          `bson:"location,omitempty"`
+}
+
+type TaxIdentifier struct {
+	Type  string `bson:"type"`
+	Value string `bson:"value"`
+}
+
+// GetBillingProfile returns everything the payments service needs to charge a user.
+// Street-level address details and tax numbers are only returned to callers
+// holding the billing.pii scope that forward a freshly authenticated user token.
+func (s *userService) GetBillingProfile(ctx context.Context, req *pb.GetBillingProfileMessageRequest) (*pb.GetBillingProfileMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	// Sub-accounts sharing payment check out with their parent's profile
+	holder := user
+	if user.ParentID != nil && user.SubAccount != nil && user.SubAccount.SharedPayment {
+		if holder, err = s.findUserByID(ctx, user.ParentID.Hex()); err != nil {
+			return nil, err
+		}
+	}
+	if holder.Billing == nil {
+		return nil, status.Error(codes.NotFound, "billing profile not found")
+	}
+
+	fullPII := clientFromContext(ctx).hasScope(scopeBillingPII) && s.requireFreshAuth(ctx, user.ID.Hex()) == nil
+	billing := holder.Billing
+
+	resp := &pb.GetBillingProfileMessageResponse{
+		UserId:        user.ID.Hex(),
+		BillingUserId: holder.ID.Hex(),
+		PaymentToken:  billing.PaymentToken,
+		Currency:      billing.Currency,
+		Redacted:      !fullPII,
+	}
+
+	addr := &pb.BillingAddress{
+		City:      billing.Address.City,
+		Region:    billing.Address.Region,
+		Country:   billing.Address.Country,
+		County:    billing.Address.County,
+		SubCounty: billing.Address.SubCounty,
+	}
+	if fullPII {
+		addr.RecipientName = billing.Address.RecipientName
+		addr.Line1 = billing.Address.Line1
+		addr.Line2 = billing.Address.Line2
+		addr.PostalCode = billing.Address.PostalCode
+		addr.Landmark = billing.Address.Landmark
+		addr.RiderInstructions = billing.Address.RiderInstructions
+	}
+	if n := billing.Address.Normalized; n != nil && fullPII {
+		addr.FormattedAddress = n.FormattedAddress
+	}
+	if loc := billing.Address.Location; loc != nil {
+		// Redacted coordinates are rounded to about a kilometre, which is
+		// still enough to pick a shipping zone
+		lat, lng := loc.Latitude(), loc.Longitude()
+		if !fullPII {
+			lat, lng = math.Round(lat*100)/100, math.Round(lng*100)/100
+		}
+		addr.Location = &pb.GeoPoint{Latitude: lat, Longitude: lng}
+	}
+	resp.BillingAddress = addr
+
+	for _, id := range billing.TaxIDs {
+		value := id.Value
+		if !fullPII {
+			value = maskValue(value, 3)
+		}
+		resp.TaxIdentifiers = append(resp.TaxIdentifiers, &pb.TaxIdentifier{Type: id.Type, Value: value})
+	}
+
+	return resp, nil
+}
+
+// UpdateBillingProfile replaces the stored billing profile of a user. The
+// billing service calls it for a user who confirmed their password
+// recently, since it replaces the payment token.
+func (s *userService) UpdateBillingProfile(ctx context.Context, req *pb.UpdateBillingProfileMessageRequest) (*pb.UpdateBillingProfileMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireFreshAuth(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.GetCurrency()))
+	if len(currency) != 3 {
+		return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter ISO 4217 code")
+	}
+
+	addr := req.GetBillingAddress()
+	if strings.TrimSpace(addr.GetLine1()) == "" || strings.TrimSpace(addr.GetCity()) == "" || strings.TrimSpace(addr.GetCountry()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "billing address requires line1, city and country")
+	}
+
+	billing := BillingProfile{
+		Address: BillingAddress{
+			RecipientName: strings.TrimSpace(addr.GetRecipientName()),
+			Line1:         strings.TrimSpace(addr.GetLine1()),
+			Line2:         strings.TrimSpace(addr.GetLine2()),
+			City:          strings.TrimSpace(addr.GetCity()),
+			Region:        strings.TrimSpace(addr.GetRegion()),
+			PostalCode:    strings.TrimSpace(addr.GetPostalCode()),
+			Country:       strings.ToUpper(strings.TrimSpace(addr.GetCountry())),
+
+			County:            strings.TrimSpace(addr.GetCounty()),
+			SubCounty:         strings.TrimSpace(addr.GetSubCounty()),
+			Landmark:          strings.TrimSpace(addr.GetLandmark()),
+			RiderInstructions: strings.TrimSpace(addr.GetRiderInstructions()),
+		},
+		PaymentToken: strings.TrimSpace(req.GetPaymentToken()),
+		Currency:     currency,
+	}
+	if err := validateLocalAddress(&billing.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.geocodeAddress(ctx, &billing.Address); err != nil {
+		return nil, err
+	}
+	for _, t := range req.GetTaxIdentifiers() {
+		if strings.TrimSpace(t.GetType()) == "" || strings.TrimSpace(t.GetValue()) == "" {
+			return nil, status.Error(codes.InvalidArgument, "tax identifiers require a type and value")
+		}
+		taxID := TaxIdentifier{
+			Type:  strings.ToLower(strings.TrimSpace(t.GetType())),
+			Value: strings.ToUpper(strings.TrimSpace(t.GetValue())),
+		}
+		if err := validateTaxIdentifier(taxID.Type, taxID.Value); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		billing.TaxIDs = append(billing.TaxIDs, taxID)
+	}
+
+	if err := s.users.SetBillingProfile(ctx, id, &billing, time.Now()); err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		log.Printf("Failed to update billing profile: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update billing profile")
+	}
+
+	s.recordEvent(ctx, eventUserBillingUpdated, id, map[string]interface{}{
+		"currency": billing.Currency,
+		"country":  billing.Address.Country,
+	})
+
+	return &pb.UpdateBillingProfileMessageResponse{
+		Message: "Billing profile updated",
+		Success: true,
+	}, nil
+}
+
+const (
+	maxLandmarkLength          = 200
+	maxRiderInstructionsLength = 500
+)
+
+// validateLocalAddress checks the delivery fields. Kenyan addresses need a
+// county from the official list, which is stored under its canonical name
+// and doubles as the region when none was given.
+func validateLocalAddress(addr *BillingAddress) error {
+	if len(addr.Landmark) > maxLandmarkLength {
+		return fmt.Errorf("landmark must be at most %d characters", maxLandmarkLength)
+	}
+	if len(addr.RiderInstructions) > maxRiderInstructionsLength {
+		return fmt.Errorf("rider instructions must be at most %d characters", maxRiderInstructionsLength)
+	}
+	if addr.Country != "KE" {
+		return nil
+	}
+
+	if addr.County == "" {
+		return errors.New("county is required for addresses in Kenya")
+	}
+	county, ok := geo.LookupKenyaCounty(addr.County)
+	if !ok {
+		return fmt.Errorf("unknown county %q", addr.County)
+	}
+	addr.County = county.Name
+	if addr.Region == "" {
+		addr.Region = county.Name
+	}
+	return nil
+}
+
+// maskValue hides all but the last `visible` characters of a value
+func maskValue(value string, visible int) string {
+	if len(value) <= visible {
+		return strings.Repeat("*", len(value))
+	}
+	return strings.Repeat("*", len(value)-visible) + value[len(value)-visible:]
+}
+
+// findUserByID loads a user by its hex ObjectID, mapping failures to gRPC errors
+func (s *userService) findUserByID(ctx context.Context, userID string) (*User, error) {
+	id, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	s.upgradeUser(ctx, user)
+	return user, nil
+}
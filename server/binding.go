@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+
+	"github.com/bruceoaudo/userService/internal/token"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Client metadata sessions are bound to. The gateway forwards the user
+// agent and app version of the end user's client like its IP address.
+const (
+	userAgentHeader  = "user-agent"
+	appVersionHeader = "x-app-version"
+)
+
+const securityEventTokenBindingMismatch = "token_binding_mismatch"
+
+// clientContext describes the end user's client of a request
+func clientContext(ctx context.Context) token.ClientContext {
+	return token.ClientContext{
+		UserAgent:  metadataValue(ctx, userAgentHeader),
+		AppVersion: requestFromContext(ctx).AppVersion,
+		IP:         metadataValue(ctx, clientIPHeader),
+	}
+}
+
+// parseAccessToken validates an access token presented by the end user and
+// checks it is used from the client it was issued to
+func (s *userService) parseAccessToken(ctx context.Context, raw string) (*token.Claims, error) {
+	claims, err := s.tokens.Parse(raw, requestFromContext(ctx).Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBinding(ctx, claims, clientContext(ctx)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// checkBinding rejects claims of a session bound to another client and
+// records the attempt, which usually means the token was stolen
+func (s *userService) checkBinding(ctx context.Context, claims *token.Claims, client token.ClientContext) error {
+	err := claims.CheckBinding(s.tokens.Binding(client))
+	if err == nil {
+		return nil
+	}
+	if id, idErr := primitive.ObjectIDFromHex(claims.Subject); idErr == nil {
+		s.recordSecurityEvent(ctx, id, securityEventTokenBindingMismatch, "", token.AgentClass(client.UserAgent))
+	}
+	return err
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	biometricChallengeTTL  = 2 * time.Minute
+	biometricChallengeSize = 32
+)
+
+// Platforms holding device keys
+const (
+	devicePlatformAndroid = "android"
+	devicePlatformIOS     = "ios"
+)
+
+const securityEventDeviceKeyRegistered = "device_key_registered"
+
+// DeviceKey is the public half of a key pair a trusted device keeps in the
+// Android Keystore or iOS Secure Enclave, stored in device_keys. The
+// private key is only usable after a biometric check on the device, so a
+// valid signature proves the user passed one.
+type DeviceKey struct {
+	UserID     primitive.ObjectID `bson:"user_id"`
+	DeviceID   string             `bson:"device_id"`
+	Platform   string             `bson:"platform"`
+	PublicKey  []byte             `bson:"public_key"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	LastUsedAt *time.Time         `bson:"last_used_at,omitempty"`
+}
+
+// BiometricChallenge is a one-time value a device signs, stored in
+// biometric_challenges until used or expired
+type BiometricChallenge struct {
+	Challenge string             `bson:"_id"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	DeviceID  string             `bson:"device_id"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+}
+
+// parseDeviceKey accepts a DER SubjectPublicKeyInfo holding a P-256 key,
+// the only curve both the Keystore and the Secure Enclave support
+func parseDeviceKey(der []byte) (*ecdsa.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.New("public key must be a DER SubjectPublicKeyInfo")
+	}
+	ec, ok := key.(*ecdsa.PublicKey)
+	if !ok || ec.Curve != elliptic.P256() {
+		return nil, errors.New("public key must be an ECDSA P-256 key")
+	}
+	return ec, nil
+}
+
+// RegisterDeviceKey stores the biometric-protected public key of a device
+// the user signed in from, after a recent password confirmation
+func (s *userService) RegisterDeviceKey(ctx context.Context, req *pb.RegisterDeviceKeyMessageRequest) (*pb.RegisterDeviceKeyMessageResponse, error) {
+	if err := s.requireFreshAuth(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	device := strings.TrimSpace(req.GetDeviceId())
+	if device == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+	if !user.trustedDevice(device) {
+		return nil, status.Error(codes.FailedPrecondition, "sign in on this device before registering a key")
+	}
+	platform := strings.ToLower(strings.TrimSpace(req.GetPlatform()))
+	if platform != devicePlatformAndroid && platform != devicePlatformIOS {
+		return nil, status.Error(codes.InvalidArgument, "platform must be android or ios")
+	}
+	if _, err := parseDeviceKey(req.GetPublicKey()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.collection(ctx, "device_keys").ReplaceOne(ctx,
+		bson.M{"user_id": user.ID, "device_id": device},
+		DeviceKey{UserID: user.ID, DeviceID: device, Platform: platform, PublicKey: req.GetPublicKey(), CreatedAt: time.Now()},
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		log.Printf("Failed to store device key: %v", err)
+		return nil, status.Error(codes.Internal, "failed to register device key")
+	}
+	s.recordSecurityEvent(ctx, user.ID, securityEventDeviceKeyRegistered, device, platform)
+
+	return &pb.RegisterDeviceKeyMessageResponse{Message: "Device key registered", Success: true}, nil
+}
+
+// RemoveDeviceKey turns biometric re-authentication off for a device
+func (s *userService) RemoveDeviceKey(ctx context.Context, req *pb.RemoveDeviceKeyMessageRequest) (*pb.RemoveDeviceKeyMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if s.bearerSubject(ctx) != id.Hex() {
+		return nil, status.Error(codes.PermissionDenied, "token does not belong to user")
+	}
+	_, err = s.collection(ctx, "device_keys").DeleteOne(ctx, bson.M{"user_id": id, "device_id": strings.TrimSpace(req.GetDeviceId())})
+	if err != nil {
+		log.Printf("Failed to remove device key: %v", err)
+		return nil, status.Error(codes.Internal, "failed to remove device key")
+	}
+	return &pb.RemoveDeviceKeyMessageResponse{Message: "Device key removed", Success: true}, nil
+}
+
+// IssueBiometricChallenge returns a one-time challenge for the device to
+// sign with its key once the user passes the biometric prompt
+func (s *userService) IssueBiometricChallenge(ctx context.Context, req *pb.IssueBiometricChallengeMessageRequest) (*pb.IssueBiometricChallengeMessageResponse, error) {
+	claims, err := s.parseAccessToken(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	userID, err := parseUserID(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	device := strings.TrimSpace(req.GetDeviceId())
+	err = s.collection(ctx, "device_keys").FindOne(ctx, bson.M{"user_id": userID, "device_id": device}).Err()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.FailedPrecondition, "no key is registered for this device")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	raw := make([]byte, biometricChallengeSize)
+	if _, err := rand.Read(raw); err != nil {
+		log.Printf("Failed to generate biometric challenge: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue challenge")
+	}
+	challenge := BiometricChallenge{
+		Challenge: base64.RawURLEncoding.EncodeToString(raw),
+		UserID:    userID,
+		DeviceID:  device,
+		ExpiresAt: time.Now().Add(biometricChallengeTTL),
+	}
+	if _, err := s.collection(ctx, "biometric_challenges").InsertOne(ctx, challenge); err != nil {
+		log.Printf("Failed to store biometric challenge: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue challenge")
+	}
+
+	return &pb.IssueBiometricChallengeMessageResponse{
+		Challenge:     challenge.Challenge,
+		ExpiresAtUnix: challenge.ExpiresAt.Unix(),
+	}, nil
+}
+
+// ReAuthenticateWithBiometric upgrades an existing session like
+// ReAuthenticate, with a challenge from IssueBiometricChallenge signed by
+// the device key. The signature is an ASN.1 ECDSA signature over the
+// SHA-256 of the challenge string, as produced by SHA256withECDSA on
+// Android and ecdsaSignatureMessageX962SHA256 on iOS.
+func (s *userService) ReAuthenticateWithBiometric(ctx context.Context, req *pb.ReAuthenticateWithBiometricMessageRequest) (*pb.ReAuthenticateWithBiometricMessageResponse, error) {
+	// 1. Validate the current session
+	claims, err := s.parseAccessToken(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	user, err := s.findUserByID(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user.PasswordResetRequired {
+		return nil, status.Error(codes.FailedPrecondition, "password reset required")
+	}
+
+	// 2. Use up the challenge, then check its signature
+	device := strings.TrimSpace(req.GetDeviceId())
+	res, err := s.collection(ctx, "biometric_challenges").DeleteOne(ctx, bson.M{
+		"_id":        req.GetChallenge(),
+		"user_id":    user.ID,
+		"device_id":  device,
+		"expires_at": bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired challenge")
+	}
+
+	var key DeviceKey
+	err = s.collection(ctx, "device_keys").FindOne(ctx, bson.M{"user_id": user.ID, "device_id": device}).Decode(&key)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.FailedPrecondition, "no key is registered for this device")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	pub, err := parseDeviceKey(key.PublicKey)
+	if err != nil {
+		log.Printf("Stored device key for user %s is invalid: %v", user.ID.Hex(), err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	digest := sha256.Sum256([]byte(req.GetChallenge()))
+	if !ecdsa.VerifyASN1(pub, digest[:], req.GetSignature()) {
+		return nil, status.Error(codes.Unauthenticated, "invalid signature")
+	}
+
+	now := time.Now()
+	if _, err := s.collection(ctx, "device_keys").UpdateOne(ctx, bson.M{"user_id": user.ID, "device_id": device}, bson.M{"$set": bson.M{"last_used_at": now}}); err != nil {
+		log.Printf("Failed to update device key: %v", err)
+	}
+
+	// 3. Reissue the token with a fresh auth time
+	signed, upgraded, err := s.tokens.Reauthenticate(claims)
+	if err != nil {
+		log.Printf("Failed to reissue token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+
+	return &pb.ReAuthenticateWithBiometricMessageResponse{
+		AccessToken:   signed,
+		ExpiresAtUnix: upgraded.ExpiresAt.Unix(),
+		AuthTimeUnix:  upgraded.AuthTime.Unix(),
+	}, nil
+}
@@ -0,0 +1,347 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserPasswordResetRequired = "user.password_reset_required"
+
+	operationBulkUpdateUsers = "bulk_update_users"
+	bulkUpdateBatchSize      = 500
+)
+
+// Field mask paths accepted by BulkUpdateUsers
+const (
+	bulkPathAddTags       = "add_tags"
+	bulkPathRemoveTags    = "remove_tags"
+	bulkPathTier          = "tier"
+	bulkPathLocale        = "locale"
+	bulkPathPasswordReset = "password_reset_required"
+)
+
+var errEmptyBulkFilter = errors.New("bulk update has an empty filter")
+
+var bulkPaths = map[string]bool{
+	bulkPathAddTags:       true,
+	bulkPathRemoveTags:    true,
+	bulkPathTier:          true,
+	bulkPathLocale:        true,
+	bulkPathPasswordReset: true,
+}
+
+// BulkUserFilter selects the users a bulk job applies to. All set criteria
+// must match.
+type BulkUserFilter struct {
+	UserIDs         []primitive.ObjectID `bson:"user_ids,omitempty"`
+	Tags            []string             `bson:"tags,omitempty"`
+	Tiers           []string             `bson:"tiers,omitempty"`
+	Countries       []string             `bson:"countries,omitempty"`
+	Locales         []string             `bson:"locales,omitempty"`
+	SellerStatuses  []string             `bson:"seller_statuses,omitempty"`
+	CreatedAfter    *time.Time           `bson:"created_after,omitempty"`
+	CreatedBefore   *time.Time           `bson:"created_before,omitempty"`
+	LastLoginBefore *time.Time           `bson:"last_login_before,omitempty"`
+}
+
+// BulkUserPatch holds the values applied for each path in the field mask
+type BulkUserPatch struct {
+	AddTags    []string `bson:"add_tags,omitempty"`
+	RemoveTags []string `bson:"remove_tags,omitempty"`
+	Tier       string   `bson:"tier,omitempty"`
+	Locale     string   `bson:"locale,omitempty"`
+}
+
+// bulkUpdateParams are the stored parameters of a bulk update operation
+type bulkUpdateParams struct {
+	Filter BulkUserFilter `bson:"filter"`
+	Patch  BulkUserPatch  `bson:"patch"`
+	Paths  []string       `bson:"paths"`
+	Reason string         `bson:"reason,omitempty"`
+}
+
+func init() {
+	operationRunners[operationBulkUpdateUsers] = (*userService).runBulkUpdate
+}
+
+func bulkFilterFromProto(f *pb.BulkUserFilter) (BulkUserFilter, error) {
+	var filter BulkUserFilter
+	for _, raw := range f.GetUserIds() {
+		id, err := parseUserID(raw)
+		if err != nil {
+			return filter, err
+		}
+		filter.UserIDs = append(filter.UserIDs, id)
+	}
+	filter.Tags = f.GetTags()
+	filter.Tiers = f.GetTiers()
+	filter.Countries = f.GetCountries()
+	filter.Locales = f.GetLocales()
+	filter.SellerStatuses = f.GetSellerStatuses()
+	unixPtr := func(v int64) *time.Time {
+		if v == 0 {
+			return nil
+		}
+		t := time.Unix(v, 0)
+		return &t
+	}
+	filter.CreatedAfter = unixPtr(f.GetCreatedAfterUnix())
+	filter.CreatedBefore = unixPtr(f.GetCreatedBeforeUnix())
+	filter.LastLoginBefore = unixPtr(f.GetLastLoginBeforeUnix())
+
+	if filter.query() == nil {
+		return filter, status.Error(codes.InvalidArgument, "filter must set at least one criterion")
+	}
+	return filter, nil
+}
+
+// query builds the Mongo filter, or nil when no criterion is set
+func (f *BulkUserFilter) query() bson.M {
+	q := bson.M{}
+	if len(f.UserIDs) > 0 {
+		q["_id"] = bson.M{"$in": f.UserIDs}
+	}
+	if len(f.Tags) > 0 {
+		q["tags"] = bson.M{"$in": f.Tags}
+	}
+	if len(f.Tiers) > 0 {
+		q["tier"] = bson.M{"$in": f.Tiers}
+	}
+	if len(f.Countries) > 0 {
+		q["billing.address.country"] = bson.M{"$in": f.Countries}
+	}
+	if len(f.Locales) > 0 {
+		q["locale"] = bson.M{"$in": f.Locales}
+	}
+	if len(f.SellerStatuses) > 0 {
+		q["seller_status"] = bson.M{"$in": f.SellerStatuses}
+	}
+	created := bson.M{}
+	if f.CreatedAfter != nil {
+		created["$gte"] = *f.CreatedAfter
+	}
+	if f.CreatedBefore != nil {
+		created["$lt"] = *f.CreatedBefore
+	}
+	if len(created) > 0 {
+		q["created_at"] = created
+	}
+	if f.LastLoginBefore != nil {
+		q["last_login_at"] = bson.M{"$lt": *f.LastLoginBefore}
+	}
+	if len(q) == 0 {
+		return nil
+	}
+	q["deleted_at"] = nil
+	return q
+}
+
+// update builds the Mongo update for the masked fields
+func (p *bulkUpdateParams) update(now time.Time) bson.M {
+	set := bson.M{"updated_at": now}
+	update := bson.M{}
+	for _, path := range p.Paths {
+		switch path {
+		case bulkPathAddTags:
+			update["$addToSet"] = bson.M{"tags": bson.M{"$each": p.Patch.AddTags}}
+		case bulkPathRemoveTags:
+			update["$pull"] = bson.M{"tags": bson.M{"$in": p.Patch.RemoveTags}}
+		case bulkPathTier:
+			set["tier"] = p.Patch.Tier
+		case bulkPathLocale:
+			set["locale"] = p.Patch.Locale
+		case bulkPathPasswordReset:
+			set["password_reset_required"] = true
+		}
+	}
+	update["$set"] = set
+	return update
+}
+
+// BulkUpdateUsers starts an operation applying the masked fields to every
+// user matching the filter. A dry run returns the diff instead.
+func (s *userService) BulkUpdateUsers(ctx context.Context, req *pb.BulkUpdateUsersMessageRequest) (*pb.BulkUpdateUsersMessageResponse, error) {
+	// 1. Validate the filter and mask
+	filter, err := bulkFilterFromProto(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetUpdateMask()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update mask is required")
+	}
+
+	params := bulkUpdateParams{
+		Filter: filter,
+		Reason: strings.TrimSpace(req.GetReason()),
+	}
+	patch := req.GetPatch()
+	for _, path := range req.GetUpdateMask() {
+		if !bulkPaths[path] {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path %q", path)
+		}
+		switch path {
+		case bulkPathAddTags:
+			if len(patch.GetAddTags()) == 0 {
+				return nil, status.Error(codes.InvalidArgument, "add_tags requires tags")
+			}
+			params.Patch.AddTags = patch.GetAddTags()
+		case bulkPathRemoveTags:
+			if len(patch.GetRemoveTags()) == 0 {
+				return nil, status.Error(codes.InvalidArgument, "remove_tags requires tags")
+			}
+			params.Patch.RemoveTags = patch.GetRemoveTags()
+		case bulkPathTier:
+			params.Patch.Tier = strings.TrimSpace(patch.GetTier())
+		case bulkPathLocale:
+			params.Patch.Locale = strings.TrimSpace(patch.GetLocale())
+		}
+		params.Paths = append(params.Paths, path)
+	}
+
+	// 2. Preview or queue the update
+	if req.GetDryRun() {
+		diff, err := s.previewBulkUpdate(ctx, &params)
+		if err != nil {
+			log.Printf("Failed to preview bulk update: %v", err)
+			return nil, status.Error(codes.Internal, "failed to preview bulk update")
+		}
+		return &pb.BulkUpdateUsersMessageResponse{Diff: diff}, nil
+	}
+	op, err := s.startOperation(ctx, operationBulkUpdateUsers, params)
+	if err != nil {
+		log.Printf("Failed to queue bulk update: %v", err)
+		return nil, status.Error(codes.Internal, "failed to queue bulk update")
+	}
+	return &pb.BulkUpdateUsersMessageResponse{Operation: operationToProto(op)}, nil
+}
+
+// runBulkUpdate applies the update batch by batch in _id order. Every
+// update is idempotent, so replaying the batch after the last checkpoint is
+// harmless. The profile history of each user is derived from the update.
+func (s *userService) runBulkUpdate(ctx context.Context, op *Operation) (map[string]string, error) {
+	var params bulkUpdateParams
+	if err := bson.Unmarshal(op.Params, &params); err != nil {
+		return nil, err
+	}
+	base := params.Filter.query()
+	if base == nil {
+		return nil, errEmptyBulkFilter
+	}
+	if op.Progress == nil {
+		op.Progress = map[string]int64{}
+	}
+
+	users := s.collection(ctx, "users")
+	for {
+		filter := base
+		if op.Cursor != nil {
+			filter = mergeFilters(base, bson.M{"_id": bson.M{"$gt": *op.Cursor}})
+		}
+		cursor, err := users.Find(ctx, filter, options.Find().
+			SetSort(bson.D{{Key: "_id", Value: 1}}).
+			SetLimit(bulkUpdateBatchSize))
+		if err != nil {
+			return nil, err
+		}
+		var batch []bson.M
+		if err := cursor.All(ctx, &batch); err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			return map[string]string{
+				"matched":  strconv.FormatInt(op.Progress["matched"], 10),
+				"modified": strconv.FormatInt(op.Progress["modified"], 10),
+			}, nil
+		}
+
+		ids := make([]primitive.ObjectID, len(batch))
+		for i := range batch {
+			ids[i], _ = batch[i]["_id"].(primitive.ObjectID)
+		}
+		actor := op.RequestedBy
+		if actor == "" {
+			actor = actorGateway
+		}
+		update := params.update(time.Now())
+		res, err := users.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, update)
+		if err != nil {
+			return nil, err
+		}
+		for i, before := range batch {
+			if after, err := simulateUpdate(before, update); err == nil {
+				s.recordProfileChanges(ctx, ids[i], before, after, actor, "", operationName(op.ID))
+			}
+		}
+		for _, path := range params.Paths {
+			if path == bulkPathPasswordReset {
+				for _, id := range ids {
+					s.recordEvent(ctx, eventUserPasswordResetRequired, id, map[string]interface{}{
+						"operation": operationName(op.ID),
+						"reason":    params.Reason,
+					})
+				}
+			}
+		}
+
+		op.Cursor = &ids[len(ids)-1]
+		op.Progress["matched"] += res.MatchedCount
+		op.Progress["modified"] += res.ModifiedCount
+		if err := s.checkpointOperation(ctx, op); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// previewBulkUpdate simulates the update the operation would apply on the
+// first matching users and counts the rest
+func (s *userService) previewBulkUpdate(ctx context.Context, params *bulkUpdateParams) (*pb.DryRunDiff, error) {
+	query := params.Filter.query()
+	if query == nil {
+		return nil, errEmptyBulkFilter
+	}
+	users := s.collection(ctx, "users")
+	total, err := users.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := users.Find(ctx, query, options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(maxDryRunChanges))
+	if err != nil {
+		return nil, err
+	}
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	d := newDryRunDiff()
+	update := params.update(time.Now())
+	for _, doc := range docs {
+		after, err := simulateUpdate(doc, update)
+		if err != nil {
+			return nil, err
+		}
+		id, _ := doc["_id"].(primitive.ObjectID)
+		d.add("users", id.Hex(), dryRunUpdate, diffDocuments(doc, after, false)...)
+	}
+	d.count("users", dryRunUpdate, total-int64(len(docs)))
+	for _, path := range params.Paths {
+		if path == bulkPathPasswordReset {
+			d.diff.Counts["outbox."+dryRunInsert] += total
+		}
+	}
+	return d.diff, nil
+}
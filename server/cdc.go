@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/bruceoaudo/userService/internal/config"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Event encodings selected with EVENTS_FORMAT
+const (
+	eventFormatNative   = "native"
+	eventFormatDebezium = "debezium"
+)
+
+// Debezium change operations
+const (
+	cdcOpCreate = "c"
+	cdcOpUpdate = "u"
+	cdcOpDelete = "d"
+)
+
+// cdcRedactedFields never leave the service in a row image
+var cdcRedactedFields = []string{"password_hash", "search_keys", "device_fingerprints", "push_tokens", "ussd"}
+
+// CDCImage is the last row image published for a user, used as the before
+// image of the next change
+type CDCImage struct {
+	UserID    primitive.ObjectID `bson:"_id"`
+	Image     string             `bson:"image"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// debeziumSource mirrors the source block of the Debezium MongoDB connector
+type debeziumSource struct {
+	Version    string `json:"version"`
+	Connector  string `json:"connector"`
+	Name       string `json:"name"`
+	TsMs       int64  `json:"ts_ms"`
+	Snapshot   string `json:"snapshot"`
+	DB         string `json:"db"`
+	Collection string `json:"collection"`
+	Ord        int    `json:"ord"`
+}
+
+// debeziumEnvelope is the value of a Debezium change event with schemas
+// disabled. As with the MongoDB connector, row images are relaxed extended
+// JSON strings.
+type debeziumEnvelope struct {
+	Before      *string        `json:"before"`
+	After       *string        `json:"after"`
+	Source      debeziumSource `json:"source"`
+	Op          string         `json:"op"`
+	TsMs        int64          `json:"ts_ms"`
+	Transaction interface{}    `json:"transaction"`
+}
+
+func cdcOp(eventType string) string {
+	switch eventType {
+	case eventUserRegistered, eventUserImported:
+		return cdcOpCreate
+	case eventUserDeleted:
+		return cdcOpDelete
+	}
+	return cdcOpUpdate
+}
+
+// captureRowImages returns the previous and current image of a user for a
+// change event and remembers the current one for the next event. Erasures
+// carry no images so personal data does not outlive the account.
+func (s *userService) captureRowImages(ctx context.Context, eventType string, userID primitive.ObjectID) (before, after string) {
+	images := s.collection(ctx, "cdc_images")
+	if cdcOp(eventType) == cdcOpDelete {
+		if _, err := images.DeleteOne(ctx, bson.M{"_id": userID}); err != nil {
+			log.Printf("Failed to drop row image for %s: %v", userID.Hex(), err)
+		}
+		return "", ""
+	}
+
+	var prev CDCImage
+	if err := images.FindOne(ctx, bson.M{"_id": userID}).Decode(&prev); err != nil && err != mongo.ErrNoDocuments {
+		log.Printf("Failed to load row image for %s: %v", userID.Hex(), err)
+	}
+
+	var doc bson.M
+	if err := s.collection(ctx, "users").FindOne(ctx, bson.M{"_id": userID}).Decode(&doc); err != nil {
+		log.Printf("Failed to load user %s for row image: %v", userID.Hex(), err)
+		return prev.Image, ""
+	}
+	for _, field := range cdcRedactedFields {
+		delete(doc, field)
+	}
+	raw, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		log.Printf("Failed to encode row image for %s: %v", userID.Hex(), err)
+		return prev.Image, ""
+	}
+
+	after = string(raw)
+	_, err = images.ReplaceOne(ctx, bson.M{"_id": userID},
+		CDCImage{UserID: userID, Image: after, UpdatedAt: time.Now()},
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		log.Printf("Failed to store row image for %s: %v", userID.Hex(), err)
+	}
+	return prev.Image, after
+}
+
+// debeziumEncoder renders outbox events as Debezium change events of the
+// users collection of the storage the relay publishes from
+func debeziumEncoder(mongoCfg config.MongoConfig) func(context.Context, *OutboxEvent) ([]byte, error) {
+	return func(ctx context.Context, event *OutboxEvent) ([]byte, error) {
+		tenant := storageTenant(ctx)
+		return encodeDebezium(event, mongoCfg.DatabaseName(tenant), mongoCfg.CollectionName(tenant, "users"))
+	}
+}
+
+// encodeDebezium renders an outbox event as a Debezium change event
+func encodeDebezium(event *OutboxEvent, db, collection string) ([]byte, error) {
+	optional := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	env := debeziumEnvelope{
+		Before: optional(event.Before),
+		After:  optional(event.After),
+		Source: debeziumSource{
+			Version:    "userservice",
+			Connector:  "mongodb",
+			Name:       "userservice",
+			TsMs:       event.CreatedAt.UnixMilli(),
+			Snapshot:   "false",
+			DB:         db,
+			Collection: collection,
+		},
+		Op:   cdcOp(event.Type),
+		TsMs: time.Now().UnixMilli(),
+	}
+	return json.Marshal(env)
+}
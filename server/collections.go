@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bruceoaudo/userService/internal/config"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// collectionFunc returns the collection the code calls name, for the tenant
+// of ctx
+type collectionFunc func(ctx context.Context, name string) *mongo.Collection
+
+// mongoCollections resolves collection names with the database, prefix and
+// per-tenant overrides in cfg
+func mongoCollections(client *mongo.Client, cfg config.MongoConfig) collectionFunc {
+	return func(ctx context.Context, name string) *mongo.Collection {
+		tenant := storageTenant(ctx)
+		return client.Database(cfg.DatabaseName(tenant)).Collection(cfg.CollectionName(tenant, name))
+	}
+}
+
+// collection is how handlers and jobs reach every collection, so none of
+// them hardcodes where it lives
+func (s *userService) collection(ctx context.Context, name string) *mongo.Collection {
+	return mongoCollections(s.db, s.mongo)(ctx, name)
+}
+
+type storageTenantKey struct{}
+
+// withStorageTenant points the collections of ctx at the storage of tenant,
+// for work done outside a request like startup and background jobs
+func withStorageTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, storageTenantKey{}, tenant)
+}
+
+// storageTenant returns the tenant whose storage ctx uses: the one set by
+// withStorageTenant, else the tenant of the request. Tenants without
+// storage of their own share the default one.
+func storageTenant(ctx context.Context) string {
+	if tenant, ok := ctx.Value(storageTenantKey{}).(string); ok {
+		return tenant
+	}
+	return requestFromContext(ctx).Tenant
+}
+
+// perStorage runs a background job against the default storage and, next
+// to it, against the storage of every tenant that has its own. It returns
+// once all of them have.
+func (s *userService) perStorage(job func(context.Context)) func(context.Context) {
+	return func(ctx context.Context) {
+		var wg sync.WaitGroup
+		for _, tenant := range s.mongo.TenantIDs() {
+			wg.Add(1)
+			go func(tenant string) {
+				defer wg.Done()
+				job(withStorageTenant(ctx, tenant))
+			}(tenant)
+		}
+		job(withStorageTenant(ctx, ""))
+		wg.Wait()
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	eventUserProfileNudge = "user.profile_nudge"
+
+	nudgeScanInterval = time.Hour
+	// New users get a day to finish onboarding before the first nudge
+	nudgeGracePeriod = 24 * time.Hour
+	nudgeInterval    = 7 * 24 * time.Hour
+	maxProfileNudges = 3
+	nudgeScanBatch   = 500
+)
+
+// ProfileNudges records the onboarding reminders sent to a user
+type ProfileNudges struct {
+	Count  int       `bson:"count"`
+	LastAt time.Time `bson:"last_at"`
+}
+
+// profileCheck is one item counted towards profile completeness. Weights
+// add up to 100.
+type profileCheck struct {
+	Field  string
+	Weight int
+	Done   func(u *User) bool
+}
+
+var profileChecks = []profileCheck{
+	{"email_verified", 25, func(u *User) bool { return u.EmailVerifiedAt != nil }},
+	{"phone_verified", 25, func(u *User) bool { return u.PhoneVerifiedAt != nil }},
+	{"address", 20, func(u *User) bool {
+		return u.Billing != nil && u.Billing.Address.Line1 != "" && u.Billing.Address.City != ""
+	}},
+	{"avatar", 15, func(u *User) bool { return u.Avatar != nil && u.Avatar.Value != "" }},
+	{"preferences", 15, func(u *User) bool {
+		return len(u.NotificationPrefs) > 0 || u.Digest != nil || u.Locale != ""
+	}},
+}
+
+// profileCompleteness scores the profile from 0 to 100 and lists the
+// missing items in profileChecks order
+func (u *User) profileCompleteness() (int, []string) {
+	score := 0
+	var missing []string
+	for _, c := range profileChecks {
+		if c.Done(u) {
+			score += c.Weight
+		} else {
+			missing = append(missing, c.Field)
+		}
+	}
+	return score, missing
+}
+
+// incompleteProfileFilter matches users missing at least one profileChecks
+// item, so complete profiles are never loaded by the nudge scan
+func incompleteProfileFilter() bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"email_verified_at": nil},
+		bson.M{"phone_verified_at": nil},
+		bson.M{"billing.address.line1": bson.M{"$in": bson.A{nil, ""}}},
+		bson.M{"billing.address.city": bson.M{"$in": bson.A{nil, ""}}},
+		bson.M{"avatar.value": bson.M{"$in": bson.A{nil, ""}}},
+		bson.M{"notification_prefs": nil, "digest": nil, "locale": bson.M{"$in": bson.A{nil, ""}}},
+	}}
+}
+
+func (s *userService) runProfileNudger(ctx context.Context) {
+	ticker := time.NewTicker(nudgeScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if !s.config.inMaintenance() {
+			s.emitProfileNudges(ctx, time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// emitProfileNudges records ProfileNudge events for users whose profile is
+// still incomplete after the grace period. Each user is nudged at most
+// maxProfileNudges times, a week apart, and each nudge is claimed before it
+// is emitted so replicas do not duplicate it.
+func (s *userService) emitProfileNudges(ctx context.Context, now time.Time) {
+	collection := s.collection(ctx, "users")
+	filter := bson.M{
+		"deleted_at":           nil,
+		"created_at":           bson.M{"$lte": now.Add(-nudgeGracePeriod)},
+		"profile_nudges.count": bson.M{"$not": bson.M{"$gte": maxProfileNudges}},
+		"$and": bson.A{
+			bson.M{"$or": bson.A{
+				bson.M{"profile_nudges": nil},
+				bson.M{"profile_nudges.last_at": bson.M{"$lte": now.Add(-nudgeInterval)}},
+			}},
+			incompleteProfileFilter(),
+		},
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetLimit(nudgeScanBatch))
+	if err != nil {
+		log.Printf("Failed to find profile nudge candidates: %v", err)
+		return
+	}
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Printf("Failed to load profile nudge candidates: %v", err)
+		return
+	}
+
+	sent := 0
+	for i := range users {
+		user := &users[i]
+		score, missing := user.profileCompleteness()
+		if len(missing) == 0 {
+			continue
+		}
+
+		claim := bson.M{"_id": user.ID, "profile_nudges": nil}
+		count := 1
+		if n := user.ProfileNudges; n != nil {
+			claim = bson.M{"_id": user.ID, "profile_nudges.last_at": n.LastAt}
+			count = n.Count + 1
+		}
+		res, err := collection.UpdateOne(ctx, claim, bson.M{
+			"$set": bson.M{"profile_nudges": ProfileNudges{Count: count, LastAt: now}},
+		})
+		if err != nil {
+			log.Printf("Failed to claim profile nudge for user %s: %v", user.ID.Hex(), err)
+			continue
+		}
+		if res.ModifiedCount == 0 {
+			continue
+		}
+		s.recordEvent(ctx, eventUserProfileNudge, user.ID, map[string]interface{}{
+			"completeness": score,
+			"missing":      missing,
+			"nudge":        count,
+		})
+		sent++
+	}
+	if sent > 0 {
+		log.Printf("Recorded %d %s events", sent, eventUserProfileNudge)
+	}
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const complianceExportURLTTL = 24 * time.Hour
+
+func complianceExportKey(exportID string) string {
+	return "exports/compliance/" + exportID + ".jsonl"
+}
+
+// complianceEntry is one line of a compliance export. Hash covers PrevHash
+// and every other field, so editing, dropping or reordering lines breaks the
+// chain from that point on.
+type complianceEntry struct {
+	Seq        int                    `json:"seq"`
+	Kind       string                 `json:"kind"`
+	RecordID   string                 `json:"record_id"`
+	UserID     string                 `json:"user_id,omitempty"`
+	RecordedAt time.Time              `json:"recorded_at"`
+	Data       map[string]interface{} `json:"data"`
+	PrevHash   string                 `json:"prev_hash"`
+	Hash       string                 `json:"hash,omitempty"`
+}
+
+// complianceManifest closes an export with the chain head and its signature
+type complianceManifest struct {
+	Kind        string    `json:"kind"`
+	GeneratedAt time.Time `json:"generated_at"`
+	RecordCount int       `json:"record_count"`
+	HeadHash    string    `json:"head_hash"`
+	Signature   string    `json:"signature"`
+	PublicKey   string    `json:"public_key"`
+	Algorithm   string    `json:"algorithm"`
+}
+
+// loadComplianceSigningKey reads a base64 Ed25519 seed from
+// COMPLIANCE_SIGNING_KEY, generating a throwaway key when unset.
+func loadComplianceSigningKey() (ed25519.PrivateKey, error) {
+	raw := os.Getenv("COMPLIANCE_SIGNING_KEY")
+	if raw == "" {
+		log.Printf("COMPLIANCE_SIGNING_KEY not set, exports are signed with an ephemeral key")
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	}
+	seed, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("COMPLIANCE_SIGNING_KEY must be a base64 encoded %d byte seed", ed25519.SeedSize)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func validateComplianceExport(userID string, fromUnix int64) error {
+	if userID != "" {
+		if _, err := parseUserID(userID); err != nil {
+			return err
+		}
+	}
+	if userID == "" && fromUnix == 0 {
+		return status.Error(codes.InvalidArgument, "user id or start of date range is required")
+	}
+	return nil
+}
+
+// ExportComplianceRecords produces a signed, hash-chained dump of consent and
+// audit records for a user and/or date range, for regulator requests.
+func (s *userService) ExportComplianceRecords(ctx context.Context, req *pb.ExportComplianceRecordsMessageRequest) (*pb.ExportComplianceRecordsMessageResponse, error) {
+	if err := validateComplianceExport(req.GetUserId(), req.GetFromUnix()); err != nil {
+		return nil, err
+	}
+	filter := bson.M{}
+	if req.GetUserId() != "" {
+		id, _ := parseUserID(req.GetUserId())
+		filter["user_id"] = id
+	}
+
+	var consentTime, auditTime bson.M
+	if req.GetFromUnix() > 0 || req.GetToUnix() > 0 {
+		r := bson.M{}
+		if req.GetFromUnix() > 0 {
+			r["$gte"] = time.Unix(req.GetFromUnix(), 0)
+		}
+		if req.GetToUnix() > 0 {
+			r["$lt"] = time.Unix(req.GetToUnix(), 0)
+		}
+		consentTime, auditTime = bson.M{"recorded_at": r}, bson.M{"at": r}
+	}
+
+	// 1. Load the records
+	var consents []ConsentRecord
+	cursor, err := s.collection(ctx, "consents").Find(ctx, mergeFilters(filter, consentTime))
+	if err == nil {
+		err = cursor.All(ctx, &consents)
+	}
+	if err != nil {
+		log.Printf("Failed to load consent records: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export records")
+	}
+
+	var audits []AuditRecord
+	cursor, err = s.collection(ctx, "audit_log").Find(ctx, mergeFilters(filter, auditTime))
+	if err == nil {
+		err = cursor.All(ctx, &audits)
+	}
+	if err != nil {
+		log.Printf("Failed to load audit records: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export records")
+	}
+
+	entries := make([]complianceEntry, 0, len(consents)+len(audits))
+	for _, c := range consents {
+		entries = append(entries, complianceEntry{
+			Kind:       "consent",
+			RecordID:   c.ID.Hex(),
+			UserID:     c.UserID.Hex(),
+			RecordedAt: c.RecordedAt.UTC(),
+			Data:       map[string]interface{}{"purpose": c.Purpose, "granted": c.Granted, "source": c.Source},
+		})
+	}
+	for _, a := range audits {
+		e := complianceEntry{
+			Kind:       "audit",
+			RecordID:   a.ID.Hex(),
+			RecordedAt: a.At.UTC(),
+			Data:       map[string]interface{}{"actor": a.Actor, "method": a.Method, "code": a.Code},
+		}
+		if !a.UserID.IsZero() {
+			e.UserID = a.UserID.Hex()
+		}
+		if a.Credential != "" {
+			e.Data["credential"], e.Data["peer_id"] = a.Credential, a.PeerID
+		}
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].RecordedAt.Equal(entries[j].RecordedAt) {
+			return entries[i].RecordID < entries[j].RecordID
+		}
+		return entries[i].RecordedAt.Before(entries[j].RecordedAt)
+	})
+
+	// 2. Chain and sign
+	var buf bytes.Buffer
+	headHash, err := chainComplianceEntries(&buf, entries)
+	if err != nil {
+		log.Printf("Failed to encode compliance entry: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export records")
+	}
+	signature := ed25519.Sign(s.complianceKey, []byte(headHash))
+	publicKey := s.complianceKey.Public().(ed25519.PublicKey)
+	manifest, _ := json.Marshal(complianceManifest{
+		Kind:        "manifest",
+		GeneratedAt: time.Now().UTC(),
+		RecordCount: len(entries),
+		HeadHash:    headHash,
+		Signature:   base64.StdEncoding.EncodeToString(signature),
+		PublicKey:   base64.StdEncoding.EncodeToString(publicKey),
+		Algorithm:   "sha256-chain+ed25519",
+	})
+	buf.Write(manifest)
+	buf.WriteByte('\n')
+
+	// 3. Store and link
+	exportID := primitive.NewObjectID().Hex()
+	key := complianceExportKey(exportID)
+	if err := s.store.Put(ctx, key, buf.Bytes(), "application/x-ndjson"); err != nil {
+		log.Printf("Failed to store compliance export: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export records")
+	}
+	url, err := s.store.SignedURL(ctx, key, complianceExportURLTTL)
+	if err != nil {
+		log.Printf("Failed to sign compliance export URL: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export records")
+	}
+
+	log.Printf("Compliance export %s generated with %d records", exportID, len(entries))
+	return &pb.ExportComplianceRecordsMessageResponse{
+		ExportId:      exportID,
+		DownloadUrl:   url,
+		ExpiresAtUnix: time.Now().Add(complianceExportURLTTL).Unix(),
+		RecordCount:   int64(len(entries)),
+		HeadHash:      headHash,
+		Signature:     base64.StdEncoding.EncodeToString(signature),
+		PublicKey:     base64.StdEncoding.EncodeToString(publicKey),
+	}, nil
+}
+
+// chainComplianceEntries numbers and hash-chains entries, writing each as a
+// line to w, and returns the hash of the last one
+func chainComplianceEntries(w *bytes.Buffer, entries []complianceEntry) (string, error) {
+	prev := ""
+	for i := range entries {
+		e := &entries[i]
+		e.Seq = i + 1
+		e.PrevHash = prev
+		e.Hash = ""
+		body, err := json.Marshal(e)
+		if err != nil {
+			return "", err
+		}
+		sum := sha256.Sum256(body)
+		e.Hash = hex.EncodeToString(sum[:])
+		prev = e.Hash
+
+		line, err := json.Marshal(e)
+		if err != nil {
+			return "", err
+		}
+		w.Write(line)
+		w.WriteByte('\n')
+	}
+	return prev, nil
+}
+
+func mergeFilters(filters ...bson.M) bson.M {
+	out := bson.M{}
+	for _, f := range filters {
+		for k, v := range f {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+const operationComplianceExport = "compliance_export"
+
+type complianceExportParams struct {
+	UserID   string `bson:"user_id,omitempty"`
+	FromUnix int64  `bson:"from_unix,omitempty"`
+	ToUnix   int64  `bson:"to_unix,omitempty"`
+}
+
+func init() {
+	operationRunners[operationComplianceExport] = (*userService).runComplianceExport
+}
+
+// StartComplianceExport runs ExportComplianceRecords as an operation for
+// ranges too large to wait on
+func (s *userService) StartComplianceExport(ctx context.Context, req *pb.StartComplianceExportMessageRequest) (*pb.StartComplianceExportMessageResponse, error) {
+	if err := validateComplianceExport(req.GetUserId(), req.GetFromUnix()); err != nil {
+		return nil, err
+	}
+	op, err := s.startOperation(ctx, operationComplianceExport, complianceExportParams{
+		UserID:   req.GetUserId(),
+		FromUnix: req.GetFromUnix(),
+		ToUnix:   req.GetToUnix(),
+	})
+	if err != nil {
+		log.Printf("Failed to queue compliance export: %v", err)
+		return nil, status.Error(codes.Internal, "failed to queue compliance export")
+	}
+	return &pb.StartComplianceExportMessageResponse{Operation: operationToProto(op)}, nil
+}
+
+func (s *userService) runComplianceExport(ctx context.Context, op *Operation) (map[string]string, error) {
+	var params complianceExportParams
+	if err := bson.Unmarshal(op.Params, &params); err != nil {
+		return nil, err
+	}
+	resp, err := s.ExportComplianceRecords(ctx, &pb.ExportComplianceRecordsMessageRequest{
+		UserId:   params.UserID,
+		FromUnix: params.FromUnix,
+		ToUnix:   params.ToUnix,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"export_id":       resp.GetExportId(),
+		"download_url":    resp.GetDownloadUrl(),
+		"expires_at_unix": fmt.Sprint(resp.GetExpiresAtUnix()),
+		"record_count":    fmt.Sprint(resp.GetRecordCount()),
+		"head_hash":       resp.GetHeadHash(),
+		"signature":       resp.GetSignature(),
+		"public_key":      resp.GetPublicKey(),
+	}, nil
+}
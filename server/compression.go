@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"sync"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// zstdName is the grpc-encoding of zstd compressed messages
+const zstdName = "zstd"
+
+// zstdMaxWindow bounds the memory a peer can make the decoder allocate. A
+// message never exceeds maxMessageBytes, so a larger window gains nothing.
+const zstdMaxWindow = maxMessageBytes
+
+// compressedMethods return payloads large enough for compression to pay
+// for itself. Their responses are compressed whenever the client accepts a
+// registered compressor, even if it sent its request uncompressed.
+var compressedMethods = map[string]bool{
+	pb.UserService_ExportComplianceRecords_FullMethodName: true,
+	pb.UserService_ExportSecurityEvents_FullMethodName:    true,
+	pb.UserService_GenerateAccessReport_FullMethodName:    true,
+	pb.UserService_GetPublicProfiles_FullMethodName:       true,
+	pb.UserService_GetPresence_FullMethodName:             true,
+	pb.UserService_GetProfileHistory_FullMethodName:       true,
+}
+
+// preferredCompressors are tried in order when picking the response
+// encoding. zstd compresses protobuf better than gzip at a lower CPU cost.
+var preferredCompressors = []string{zstdName, gzip.Name}
+
+// Registering a compressor also adds it to the grpc-accept-encoding header
+// of every response, which is how clients learn they may use it. gzip
+// registers itself when its package is imported.
+func init() {
+	encoding.RegisterCompressor(&zstdCompressor{})
+}
+
+// zstdCompressor implements encoding.Compressor with pooled single-threaded
+// encoders and decoders; gRPC already compresses messages concurrently
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+type zstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *zstdWriter) Close() error {
+	err := w.Encoder.Close()
+	w.pool.Put(w)
+	return err
+}
+
+type zstdReader struct {
+	*zstd.Decoder
+	pool *sync.Pool
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	n, err := r.Decoder.Read(p)
+	if err == io.EOF {
+		r.pool.Put(r)
+	}
+	return n, err
+}
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	if zw, ok := c.encoders.Get().(*zstdWriter); ok {
+		zw.Reset(w)
+		return zw, nil
+	}
+	enc, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1), zstd.WithWindowSize(1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return &zstdWriter{Encoder: enc, pool: &c.encoders}, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	if zr, ok := c.decoders.Get().(*zstdReader); ok {
+		if err := zr.Reset(r); err != nil {
+			c.decoders.Put(zr)
+			return nil, err
+		}
+		return zr, nil
+	}
+	dec, err := zstd.NewReader(r,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxWindow(zstdMaxWindow),
+		zstd.WithDecoderMaxMemory(maxMessageBytes),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdReader{Decoder: dec, pool: &c.decoders}, nil
+}
+
+func (c *zstdCompressor) Name() string {
+	return zstdName
+}
+
+// responseCompressor picks the preferred compressor the caller accepts, or
+// "" when it accepts none
+func responseCompressor(ctx context.Context) string {
+	accepted, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return ""
+	}
+	for _, name := range preferredCompressors {
+		for _, a := range accepted {
+			if a == name {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// compressResponse switches the response of a compressedMethods call to
+// the preferred compressor. It has to run before the handler sends headers.
+func compressResponse(ctx context.Context, fullMethod string) {
+	if !compressedMethods[fullMethod] {
+		return
+	}
+	name := responseCompressor(ctx)
+	if name == "" {
+		return
+	}
+	if err := grpc.SetSendCompressor(ctx, name); err != nil {
+		log.Printf("Failed to compress %s response with %s: %v", fullMethod, name, err)
+	}
+}
+
+// compressionInterceptor compresses the responses of compressedMethods
+func compressionInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		compressResponse(ctx, info.FullMethod)
+		return handler(ctx, req)
+	}
+}
+
+// compressionStreamInterceptor does the same for streams
+func compressionStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		compressResponse(ss.Context(), info.FullMethod)
+		return handler(srv, ss)
+	}
+}
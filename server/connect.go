@@ -0,0 +1,329 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The Connect listener serves the methods registered on the gRPC service
+// descriptor, so every RPC is reachable over Connect, gRPC-Web and gRPC on
+// HTTP/1.1 or HTTP/2 without a separate code generator. Messages pass through
+// connect as undecoded frames and are decoded by the gRPC method handlers
+// themselves, which keeps the same interceptors (API keys, mTLS identity,
+// traffic metrics, audit) in front of both transports.
+
+// frame is a message body in the codec the client used
+type frame struct {
+	codec string
+	data  []byte
+}
+
+// frameCodec hands message bodies through unchanged
+type frameCodec struct{ name string }
+
+func (c frameCodec) Name() string { return c.name }
+
+func (c frameCodec) Marshal(v any) ([]byte, error) {
+	f, ok := v.(*frame)
+	if !ok {
+		return nil, fmt.Errorf("connect: unexpected message type %T", v)
+	}
+	return f.data, nil
+}
+
+func (c frameCodec) Unmarshal(data []byte, v any) error {
+	f, ok := v.(*frame)
+	if !ok {
+		return fmt.Errorf("connect: unexpected message type %T", v)
+	}
+	f.codec = c.name
+	f.data = append([]byte(nil), data...)
+	return nil
+}
+
+var connectJSONUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// decode unmarshals a frame into a generated message
+func (f *frame) decode(m any) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return fmt.Errorf("connect: %T is not a protobuf message", m)
+	}
+	if strings.HasPrefix(f.codec, "json") {
+		return connectJSONUnmarshal.Unmarshal(f.data, msg)
+	}
+	return proto.Unmarshal(f.data, msg)
+}
+
+// encode marshals a generated message in the codec of the request
+func encodeFrame(codec string, m any) (*frame, error) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("connect: %T is not a protobuf message", m)
+	}
+	var data []byte
+	var err error
+	if strings.HasPrefix(codec, "json") {
+		data, err = protojson.Marshal(msg)
+	} else {
+		data, err = proto.Marshal(msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &frame{codec: codec, data: data}, nil
+}
+
+// connectError converts a gRPC status into the equivalent Connect error.
+// Both protocols share the same code numbering.
+func connectError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var ce *connect.Error
+	if errors.As(err, &ce) {
+		return ce
+	}
+	st := status.Convert(err)
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
+
+// incomingContext exposes the request headers as gRPC metadata so the
+// interceptors and handlers read API keys and bearer tokens the same way
+func incomingContext(ctx context.Context, header http.Header) context.Context {
+	md := metadata.MD{}
+	for key, values := range header {
+		key = strings.ToLower(key)
+		if strings.HasSuffix(key, "-bin") {
+			continue
+		}
+		md[key] = append(md[key], values...)
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// withHTTPPeer records the caller address and TLS state the way the gRPC
+// transport does, so client certificates identify services on both
+func withHTTPPeer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := &peer.Peer{}
+		if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+			p.Addr = addr
+		}
+		if r.TLS != nil {
+			p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+		}
+		next.ServeHTTP(w, r.WithContext(peer.NewContext(r.Context(), p)))
+	})
+}
+
+// chainUnaryInterceptors composes interceptors in the order gRPC applies them
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			next, interceptor := chained, interceptors[i]
+			chained = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+// chainStreamInterceptors composes stream interceptors in gRPC order
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			next, interceptor := chained, interceptors[i]
+			chained = func(srv any, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return chained(srv, ss)
+	}
+}
+
+// connectStream lets a gRPC stream handler read and write through a
+// Connect stream
+type connectStream struct {
+	ctx     context.Context
+	codec   string
+	recv    func() (*frame, error)
+	send    func(*frame) error
+	headers http.Header
+}
+
+func (s *connectStream) Context() context.Context { return s.ctx }
+
+func (s *connectStream) SetHeader(md metadata.MD) error {
+	for k, v := range md {
+		s.headers[k] = append(s.headers[k], v...)
+	}
+	return nil
+}
+
+func (s *connectStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *connectStream) SetTrailer(metadata.MD) {}
+
+func (s *connectStream) SendMsg(m any) error {
+	if s.send == nil {
+		return errors.New("connect: stream does not send messages")
+	}
+	f, err := encodeFrame(s.codec, m)
+	if err != nil {
+		return err
+	}
+	return s.send(f)
+}
+
+func (s *connectStream) RecvMsg(m any) error {
+	if s.recv == nil {
+		return io.EOF
+	}
+	f, err := s.recv()
+	if err != nil {
+		return err
+	}
+	if s.codec == "" {
+		s.codec = f.codec
+	}
+	return f.decode(m)
+}
+
+// newConnectHandler mounts every method of the gRPC service descriptor on a
+// Connect handler mux
+func newConnectHandler(svc *userService, unary grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor) http.Handler {
+	desc := pb.UserService_ServiceDesc
+	opts := []connect.HandlerOption{
+		connect.WithCodec(frameCodec{name: "proto"}),
+		connect.WithCodec(frameCodec{name: "json"}),
+		connect.WithCodec(frameCodec{name: "json; charset=utf-8"}),
+		connect.WithReadMaxBytes(maxMessageBytes),
+	}
+	mux := http.NewServeMux()
+
+	for _, m := range desc.Methods {
+		method := m
+		procedure := "/" + desc.ServiceName + "/" + method.MethodName
+		mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+			func(ctx context.Context, req *connect.Request[frame]) (*connect.Response[frame], error) {
+				ctx = incomingContext(ctx, req.Header())
+				out, err := method.Handler(svc, ctx, req.Msg.decode, unary)
+				if err != nil {
+					return nil, connectError(err)
+				}
+				f, err := encodeFrame(req.Msg.codec, out)
+				if err != nil {
+					return nil, connect.NewError(connect.CodeInternal, err)
+				}
+				return connect.NewResponse(f), nil
+			}, opts...))
+	}
+
+	for _, sd := range desc.Streams {
+		sd := sd
+		procedure := "/" + desc.ServiceName + "/" + sd.StreamName
+		info := &grpc.StreamServerInfo{FullMethod: procedure, IsClientStream: sd.ClientStreams, IsServerStream: sd.ServerStreams}
+		run := func(ss *connectStream) error {
+			return connectError(stream(svc, ss, info, sd.Handler))
+		}
+
+		switch {
+		case sd.ServerStreams && !sd.ClientStreams:
+			mux.Handle(procedure, connect.NewServerStreamHandler(procedure,
+				func(ctx context.Context, req *connect.Request[frame], out *connect.ServerStream[frame]) error {
+					sent := false
+					return run(&connectStream{
+						ctx:     incomingContext(ctx, req.Header()),
+						codec:   req.Msg.codec,
+						headers: out.ResponseHeader(),
+						recv: func() (*frame, error) {
+							if sent {
+								return nil, io.EOF
+							}
+							sent = true
+							return req.Msg, nil
+						},
+						send: out.Send,
+					})
+				}, opts...))
+
+		case sd.ClientStreams && !sd.ServerStreams:
+			mux.Handle(procedure, connect.NewClientStreamHandler(procedure,
+				func(ctx context.Context, in *connect.ClientStream[frame]) (*connect.Response[frame], error) {
+					var result *frame
+					err := run(&connectStream{
+						ctx:     incomingContext(ctx, in.RequestHeader()),
+						headers: http.Header{},
+						recv: func() (*frame, error) {
+							if !in.Receive() {
+								if err := in.Err(); err != nil {
+									return nil, err
+								}
+								return nil, io.EOF
+							}
+							return in.Msg(), nil
+						},
+						send: func(f *frame) error {
+							result = f
+							return nil
+						},
+					})
+					if err != nil {
+						return nil, err
+					}
+					if result == nil {
+						return nil, connect.NewError(connect.CodeInternal, errors.New("no response sent"))
+					}
+					return connect.NewResponse(result), nil
+				}, opts...))
+
+		default:
+			log.Printf("Connect: bidirectional stream %s is not served", procedure)
+		}
+	}
+
+	return withHTTPPeer(mux)
+}
+
+// serveHTTP serves an internal HTTP API on addr with the gRPC TLS settings.
+// Without TLS, HTTP/2 is accepted in cleartext (h2c) next to HTTP/1.1 so
+// curl and browsers both work.
+func serveHTTP(name, addr string, handler http.Handler, tlsConfig *tls.Config, d *drainer) {
+	server := &http.Server{Addr: addr, Handler: handler, TLSConfig: tlsConfig}
+	d.addHTTP(server)
+	log.Printf("%s listening on %s", name, addr)
+
+	var err error
+	if tlsConfig != nil {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		server.Handler = h2c.NewHandler(handler, &http2.Server{})
+		err = server.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
+		log.Fatalf("Failed to serve %s: %v", name, err)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Processing purposes a user can grant or withdraw consent for
+const (
+	consentMarketingEmail = "marketing_email"
+	consentMarketingSMS   = "marketing_sms"
+	consentMarketingPush  = "marketing_push"
+	consentPersonalize    = "personalization"
+	consentAnalytics      = "analytics"
+)
+
+var consentPurposes = map[string]bool{
+	consentMarketingEmail: true,
+	consentMarketingSMS:   true,
+	consentMarketingPush:  true,
+	consentPersonalize:    true,
+	consentAnalytics:      true,
+}
+
+// ConsentRecord is an immutable entry in the consent history. The current
+// state is mirrored on the user document for fast reads.
+type ConsentRecord struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	Purpose    string             `bson:"purpose"`
+	Granted    bool               `bson:"granted"`
+	Source     string             `bson:"source"`
+	RecordedAt time.Time          `bson:"recorded_at"`
+}
+
+// SetConsent grants or withdraws consent for a processing purpose
+func (s *userService) SetConsent(ctx context.Context, req *pb.SetConsentMessageRequest) (*pb.SetConsentMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	purpose := strings.ToLower(strings.TrimSpace(req.GetPurpose()))
+	if !consentPurposes[purpose] {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown consent purpose %q", req.GetPurpose())
+	}
+	source := strings.TrimSpace(req.GetSource())
+	if source == "" {
+		source = "unspecified"
+	}
+
+	now := time.Now()
+	res, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": id, "deleted_at": nil}, bson.M{
+		"$set": bson.M{"consents." + purpose: req.GetGranted(), "updated_at": now},
+	})
+	if err != nil {
+		log.Printf("Failed to update consent: %v", err)
+		return nil, status.Error(codes.Internal, "failed to record consent")
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	_, err = s.collection(ctx, "consents").InsertOne(ctx, ConsentRecord{
+		UserID:     id,
+		Purpose:    purpose,
+		Granted:    req.GetGranted(),
+		Source:     source,
+		RecordedAt: now,
+	})
+	if err != nil {
+		log.Printf("Failed to append consent record: %v", err)
+		return nil, status.Error(codes.Internal, "failed to record consent")
+	}
+
+	return &pb.SetConsentMessageResponse{Message: "Consent recorded", Success: true}, nil
+}
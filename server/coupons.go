@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserCouponRedeemed = "user.coupon_redeemed"
+
+	couponReservationTTL  = 15 * time.Minute
+	welcomeCouponPercent  = 10
+	welcomeCouponValidity = 30 * 24 * time.Hour
+	maxCouponValidity     = 365 * 24 * time.Hour
+)
+
+// Coupon states
+const (
+	couponAvailable = "available"
+	couponReserved  = "reserved"
+	couponRedeemed  = "redeemed"
+)
+
+// Why a coupon was granted
+var couponSources = map[string]bool{
+	"welcome":  true,
+	"referral": true,
+	"promo":    true,
+	"support":  true,
+}
+
+// Coupon is a discount granted to one user. A reserved coupon whose
+// reservation lapsed counts as available again.
+type Coupon struct {
+	ID                   primitive.ObjectID `bson:"_id,omitempty"`
+	UserID               primitive.ObjectID `bson:"user_id"`
+	Code                 string             `bson:"code"`
+	Source               string             `bson:"source"`
+	PercentOff           int32              `bson:"percent_off,omitempty"`
+	AmountOffMinor       int64              `bson:"amount_off_minor,omitempty"`
+	Currency             string             `bson:"currency,omitempty"`
+	MinOrderMinor        int64              `bson:"min_order_minor,omitempty"`
+	Status               string             `bson:"status"`
+	GrantedAt            time.Time          `bson:"granted_at"`
+	ExpiresAt            time.Time          `bson:"expires_at"`
+	OrderID              string             `bson:"order_id,omitempty"`
+	ReservationExpiresAt *time.Time         `bson:"reservation_expires_at,omitempty"`
+	RedeemedAt           *time.Time         `bson:"redeemed_at,omitempty"`
+}
+
+// effectiveStatus reports lapsed reservations and expiry as clients see them
+func (c *Coupon) effectiveStatus(now time.Time) string {
+	if c.Status == couponRedeemed {
+		return couponRedeemed
+	}
+	if !now.Before(c.ExpiresAt) {
+		return "expired"
+	}
+	if c.Status == couponReserved && c.ReservationExpiresAt != nil && !now.Before(*c.ReservationExpiresAt) {
+		return couponAvailable
+	}
+	return c.Status
+}
+
+func couponToProto(c *Coupon, now time.Time) *pb.Coupon {
+	msg := &pb.Coupon{
+		Id:             c.ID.Hex(),
+		Code:           c.Code,
+		Source:         c.Source,
+		PercentOff:     c.PercentOff,
+		AmountOffMinor: c.AmountOffMinor,
+		Currency:       c.Currency,
+		MinOrderMinor:  c.MinOrderMinor,
+		Status:         c.effectiveStatus(now),
+		ExpiresAtUnix:  c.ExpiresAt.Unix(),
+		OrderId:        c.OrderID,
+	}
+	if c.RedeemedAt != nil {
+		msg.RedeemedAtUnix = c.RedeemedAt.Unix()
+	}
+	return msg
+}
+
+func newCouponCode(prefix string) (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return prefix + "-" + base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
+
+// grantCoupon stores a coupon for a user under a fresh code
+func (s *userService) grantCoupon(ctx context.Context, c Coupon) (*Coupon, error) {
+	code, err := newCouponCode(strings.ToUpper(c.Source))
+	if err != nil {
+		return nil, err
+	}
+	c.ID = primitive.NewObjectID()
+	c.Code = code
+	c.Status = couponAvailable
+	c.GrantedAt = time.Now()
+	if _, err := s.collection(ctx, "coupons").InsertOne(ctx, c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// grantWelcomeCoupon gives new users a percentage off their first order
+func (s *userService) grantWelcomeCoupon(ctx context.Context, userID primitive.ObjectID) {
+	_, err := s.grantCoupon(ctx, Coupon{
+		UserID:     userID,
+		Source:     "welcome",
+		PercentOff: welcomeCouponPercent,
+		ExpiresAt:  time.Now().Add(welcomeCouponValidity),
+	})
+	if err != nil {
+		log.Printf("Failed to grant welcome coupon to user %s: %v", userID.Hex(), err)
+	}
+}
+
+// GrantCoupon issues a coupon to a user, e.g. a referral reward
+func (s *userService) GrantCoupon(ctx context.Context, req *pb.GrantCouponMessageRequest) (*pb.GrantCouponMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	source := strings.ToLower(strings.TrimSpace(req.GetSource()))
+	if !couponSources[source] {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown coupon source %q", req.GetSource())
+	}
+	percent, amount := req.GetPercentOff(), req.GetAmountOffMinor()
+	if (percent > 0) == (amount > 0) {
+		return nil, status.Error(codes.InvalidArgument, "set exactly one of percent off or amount off")
+	}
+	if percent > 100 || percent < 0 || amount < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid discount")
+	}
+	currency := strings.ToUpper(strings.TrimSpace(req.GetCurrency()))
+	if amount > 0 && len(currency) != 3 {
+		return nil, status.Error(codes.InvalidArgument, "fixed discounts need a 3-letter currency")
+	}
+	validity := time.Duration(req.GetValidDays()) * 24 * time.Hour
+	if validity <= 0 || validity > maxCouponValidity {
+		return nil, status.Error(codes.InvalidArgument, "validity must be between 1 and 365 days")
+	}
+
+	coupon, err := s.grantCoupon(ctx, Coupon{
+		UserID:         user.ID,
+		Source:         source,
+		PercentOff:     percent,
+		AmountOffMinor: amount,
+		Currency:       currency,
+		MinOrderMinor:  req.GetMinOrderMinor(),
+		ExpiresAt:      time.Now().Add(validity),
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to grant coupon")
+	}
+	return &pb.GrantCouponMessageResponse{Coupon: couponToProto(coupon, time.Now())}, nil
+}
+
+// ListCoupons returns all coupons of a user, newest first
+func (s *userService) ListCoupons(ctx context.Context, req *pb.ListCouponsMessageRequest) (*pb.ListCouponsMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.collection(ctx, "coupons").Find(ctx, bson.M{"user_id": id},
+		options.Find().SetSort(bson.D{{Key: "granted_at", Value: -1}}),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list coupons")
+	}
+	var coupons []Coupon
+	if err := cursor.All(ctx, &coupons); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list coupons")
+	}
+
+	now := time.Now()
+	resp := &pb.ListCouponsMessageResponse{}
+	for i := range coupons {
+		resp.Coupons = append(resp.Coupons, couponToProto(&coupons[i], now))
+	}
+	return resp, nil
+}
+
+// transitionCoupon atomically moves a coupon matching filter and reports a
+// precondition failure when it is in the wrong state
+func (s *userService) transitionCoupon(ctx context.Context, filter, update bson.M, failure string) (*Coupon, error) {
+	var coupon Coupon
+	err := s.collection(ctx, "coupons").FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&coupon)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.FailedPrecondition, failure)
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update coupon")
+	}
+	return &coupon, nil
+}
+
+func couponFilter(userID, code string) (bson.M, error) {
+	id, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "coupon code is required")
+	}
+	return bson.M{"user_id": id, "code": code}, nil
+}
+
+// ReserveCoupon holds a coupon for an order during checkout. Reserving again
+// for the same order extends the hold.
+func (s *userService) ReserveCoupon(ctx context.Context, req *pb.ReserveCouponMessageRequest) (*pb.ReserveCouponMessageResponse, error) {
+	filter, err := couponFilter(req.GetUserId(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetOrderId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+
+	now := time.Now()
+	filter["expires_at"] = bson.M{"$gt": now}
+	filter["$or"] = []bson.M{
+		{"status": couponAvailable},
+		{"status": couponReserved, "order_id": req.GetOrderId()},
+		{"status": couponReserved, "reservation_expires_at": bson.M{"$lte": now}},
+	}
+	coupon, err := s.transitionCoupon(ctx, filter, bson.M{"$set": bson.M{
+		"status":                 couponReserved,
+		"order_id":               req.GetOrderId(),
+		"reservation_expires_at": now.Add(couponReservationTTL),
+	}}, "coupon is not available")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReserveCouponMessageResponse{Coupon: couponToProto(coupon, now)}, nil
+}
+
+// RedeemCoupon consumes a coupon reserved for the order once it is paid.
+// Redeeming an already redeemed coupon for the same order succeeds.
+func (s *userService) RedeemCoupon(ctx context.Context, req *pb.RedeemCouponMessageRequest) (*pb.RedeemCouponMessageResponse, error) {
+	filter, err := couponFilter(req.GetUserId(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetOrderId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+
+	now := time.Now()
+	filter["order_id"] = req.GetOrderId()
+	coupons := s.collection(ctx, "coupons")
+
+	var coupon Coupon
+	err = coupons.FindOneAndUpdate(ctx,
+		mergeFilters(filter, bson.M{"status": couponReserved}),
+		bson.M{
+			"$set":   bson.M{"status": couponRedeemed, "redeemed_at": now},
+			"$unset": bson.M{"reservation_expires_at": ""},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&coupon)
+	if err == mongo.ErrNoDocuments {
+		// A retried redemption finds the coupon already consumed by this order
+		err = coupons.FindOne(ctx, mergeFilters(filter, bson.M{"status": couponRedeemed})).Decode(&coupon)
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.FailedPrecondition, "coupon is not reserved for this order")
+		}
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to update coupon")
+		}
+		return &pb.RedeemCouponMessageResponse{Coupon: couponToProto(&coupon, now)}, nil
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update coupon")
+	}
+
+	s.recordEvent(ctx, eventUserCouponRedeemed, coupon.UserID, map[string]interface{}{
+		"code":     coupon.Code,
+		"order_id": coupon.OrderID,
+		"source":   coupon.Source,
+	})
+	return &pb.RedeemCouponMessageResponse{Coupon: couponToProto(&coupon, now)}, nil
+}
+
+// ReleaseCoupon returns a reserved coupon when checkout is abandoned
+func (s *userService) ReleaseCoupon(ctx context.Context, req *pb.ReleaseCouponMessageRequest) (*pb.ReleaseCouponMessageResponse, error) {
+	filter, err := couponFilter(req.GetUserId(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	filter["status"] = couponReserved
+	filter["order_id"] = req.GetOrderId()
+
+	coupon, err := s.transitionCoupon(ctx, filter, bson.M{
+		"$set":   bson.M{"status": couponAvailable},
+		"$unset": bson.M{"order_id": "", "reservation_expires_at": ""},
+	}, "coupon is not reserved for this order")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseCouponMessageResponse{Coupon: couponToProto(coupon, time.Now())}, nil
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	outboxPublishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "userservice_outbox_publish_failures_total",
+		Help: "Failed attempts to publish an outbox event, by event type.",
+	}, []string{"type"})
+
+	outboxDeadLettered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "userservice_outbox_dead_letters_total",
+		Help: "Outbox events moved to the dead-letter collection, by event type.",
+	}, []string{"type"})
+
+	outboxDeadLetterBacklog = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "userservice_outbox_dead_letter_backlog",
+		Help: "Dead-lettered events waiting to be requeued. Alert when above zero.",
+	})
+)
+
+type DeadLetter struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	Event          OutboxEvent        `bson:"event"`
+	DeadLetteredAt time.Time          `bson:"dead_lettered_at"`
+	Reason         string             `bson:"reason"`
+}
+
+// deadLetterEvent moves an event that exhausted its retries out of the outbox
+func (s *userService) deadLetterEvent(ctx context.Context, event *OutboxEvent, reason string) {
+	event.NextAttemptAt = nil
+
+	_, err := s.collection(ctx, "dead_letters").InsertOne(ctx, DeadLetter{
+		Event:          *event,
+		DeadLetteredAt: time.Now(),
+		Reason:         reason,
+	})
+	if err != nil {
+		log.Printf("Failed to dead-letter event %s: %v", event.ID.Hex(), err)
+		return
+	}
+	if _, err := s.collection(ctx, "outbox").DeleteOne(ctx, bson.M{"_id": event.ID}); err != nil {
+		log.Printf("Failed to remove dead-lettered event %s from outbox: %v", event.ID.Hex(), err)
+	}
+
+	outboxDeadLettered.WithLabelValues(event.Type).Inc()
+	log.Printf("Event %s (%s) dead-lettered after %d attempts: %s", event.ID.Hex(), event.Type, event.Attempts, event.LastError)
+}
+
+func (s *userService) updateDeadLetterBacklog(ctx context.Context) {
+	count, err := s.collection(ctx, "dead_letters").EstimatedDocumentCount(ctx)
+	if err != nil {
+		log.Printf("Failed to count dead letters: %v", err)
+		return
+	}
+	outboxDeadLetterBacklog.Set(float64(count))
+}
+
+// ListDeadLetters returns events that could not be delivered, newest first
+func (s *userService) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersMessageRequest) (*pb.ListDeadLettersMessageResponse, error) {
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultOutboxLimit
+	}
+	if limit > maxOutboxLimit {
+		limit = maxOutboxLimit
+	}
+
+	filter := bson.M{}
+	if req.GetAggregateId() != "" {
+		filter["event.aggregate_id"] = req.GetAggregateId()
+	}
+	if req.GetType() != "" {
+		filter["event.type"] = req.GetType()
+	}
+
+	collection := s.collection(ctx, "dead_letters")
+	cursor, err := collection.Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "dead_lettered_at", Value: -1}}).SetLimit(limit),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list dead letters")
+	}
+
+	var letters []DeadLetter
+	if err := cursor.All(ctx, &letters); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list dead letters")
+	}
+
+	resp := &pb.ListDeadLettersMessageResponse{}
+	for i := range letters {
+		event, err := outboxEventToProto(&letters[i].Event)
+		if err != nil {
+			log.Printf("Failed to encode dead letter %s: %v", letters[i].ID.Hex(), err)
+			return nil, status.Error(codes.Internal, "failed to list dead letters")
+		}
+		resp.DeadLetters = append(resp.DeadLetters, &pb.DeadLetter{
+			Id:                 letters[i].ID.Hex(),
+			Event:              event,
+			DeadLetteredAtUnix: letters[i].DeadLetteredAt.Unix(),
+			Reason:             letters[i].Reason,
+		})
+	}
+	return resp, nil
+}
+
+// RequeueDeadLetter puts a dead-lettered event back in the outbox with a fresh
+// retry budget. The original event ID is kept so consumers can deduplicate.
+func (s *userService) RequeueDeadLetter(ctx context.Context, req *pb.RequeueDeadLetterMessageRequest) (*pb.RequeueDeadLetterMessageResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid dead letter id")
+	}
+
+	var letter DeadLetter
+	err = s.collection(ctx, "dead_letters").FindOne(ctx, bson.M{"_id": id}).Decode(&letter)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "dead letter not found")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to requeue dead letter")
+	}
+
+	event := letter.Event
+	event.Attempts = 0
+	event.PublishedAt = nil
+	event.NextAttemptAt = nil
+	if _, err := s.collection(ctx, "outbox").InsertOne(ctx, event); err != nil && !mongo.IsDuplicateKeyError(err) {
+		log.Printf("Failed to requeue event %s: %v", event.ID.Hex(), err)
+		return nil, status.Error(codes.Internal, "failed to requeue dead letter")
+	}
+	if _, err := s.collection(ctx, "dead_letters").DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+		log.Printf("Failed to delete requeued dead letter %s: %v", id.Hex(), err)
+	}
+
+	log.Printf("Requeued dead-lettered event %s", event.ID.Hex())
+	return &pb.RequeueDeadLetterMessageResponse{EventId: event.ID.Hex()}, nil
+}
@@ -0,0 +1,483 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	deletionSweepInterval = time.Hour
+	eventUserDeleted      = "user.deleted"
+)
+
+// deletionReminderDays are the days before erasure on which a reminder is sent
+var deletionReminderDays = []int{7, 1}
+
+// userOwnedCollections hold documents keyed by user_id that are purged on erasure
+var userOwnedCollections = []string{
+	"email_verifications",
+	"phone_verifications",
+	"access_reports",
+	"kyc_documents",
+	"gift_cards",
+	"coupons",
+	"feedback",
+	"support_tickets",
+	"org_members",
+	"saved_searches",
+	"product_alerts",
+	"recently_viewed",
+	"experiment_assignments",
+	"security_events",
+	"recovery_verifications",
+	"account_recoveries",
+	"profile_history",
+	"quarantined_uploads",
+	"device_pins",
+	"device_keys",
+	"biometric_challenges",
+	"refresh_tokens",
+}
+
+// RequestAccountDeletion schedules the erasure of an account after the grace period
+func (s *userService) RequestAccountDeletion(ctx context.Context, req *pb.RequestAccountDeletionMessageRequest) (*pb.RequestAccountDeletionMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.DeletionScheduledFor != nil {
+		return &pb.RequestAccountDeletionMessageResponse{
+			ScheduledForUnix: user.DeletionScheduledFor.Unix(),
+			Message:          "Account deletion already scheduled",
+		}, nil
+	}
+
+	now := time.Now()
+	scheduledFor := now.AddDate(0, 0, s.deletionGraceDays)
+	collection := s.collection(ctx, "users")
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$set": bson.M{
+			"deletion_requested_at":  now,
+			"deletion_scheduled_for": scheduledFor,
+			"updated_at":             now,
+		},
+		"$unset": bson.M{"deletion_reminders_sent": ""},
+	})
+	if err != nil {
+		log.Printf("Failed to schedule deletion: %v", err)
+		return nil, status.Error(codes.Internal, "failed to schedule account deletion")
+	}
+
+	s.notifyUser(user, notify.KindDeletionPending, map[string]string{"date": scheduledFor.UTC().Format("2 January 2006")})
+
+	return &pb.RequestAccountDeletionMessageResponse{
+		ScheduledForUnix: scheduledFor.Unix(),
+		Message:          fmt.Sprintf("Account will be deleted in %d days", s.deletionGraceDays),
+	}, nil
+}
+
+// CancelAccountDeletion stops a pending deletion while still inside the grace period
+func (s *userService) CancelAccountDeletion(ctx context.Context, req *pb.CancelAccountDeletionMessageRequest) (*pb.CancelAccountDeletionMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.DeletionScheduledFor == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no account deletion is scheduled")
+	}
+
+	collection := s.collection(ctx, "users")
+	res, err := collection.UpdateOne(ctx,
+		bson.M{"_id": user.ID, "deletion_scheduled_for": bson.M{"$gt": time.Now()}},
+		bson.M{
+			"$unset": bson.M{"deletion_requested_at": "", "deletion_scheduled_for": "", "deletion_reminders_sent": ""},
+			"$set":   bson.M{"updated_at": time.Now()},
+		},
+	)
+	if err != nil {
+		log.Printf("Failed to cancel deletion: %v", err)
+		return nil, status.Error(codes.Internal, "failed to cancel account deletion")
+	}
+	if res.ModifiedCount == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "the deletion grace period has ended")
+	}
+
+	s.notifyUser(user, notify.KindDeletionCancelled, nil)
+
+	return &pb.CancelAccountDeletionMessageResponse{Message: "Account deletion cancelled", Success: true}, nil
+}
+
+// runDeletionScheduler sends reminders and erases accounts whose grace period ended
+func (s *userService) runDeletionScheduler(ctx context.Context) {
+	ticker := time.NewTicker(deletionSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		if !s.config.inMaintenance() {
+			s.sendDeletionReminders(ctx)
+			s.eraseDueAccounts(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *userService) sendDeletionReminders(ctx context.Context) {
+	collection := s.collection(ctx, "users")
+	now := time.Now()
+
+	for _, days := range deletionReminderDays {
+		cursor, err := collection.Find(ctx, bson.M{
+			"deleted_at":              nil,
+			"deletion_scheduled_for":  bson.M{"$gt": now, "$lte": now.AddDate(0, 0, days)},
+			"deletion_reminders_sent": bson.M{"$ne": days},
+		})
+		if err != nil {
+			log.Printf("Failed to load pending deletions: %v", err)
+			return
+		}
+
+		var users []User
+		if err := cursor.All(ctx, &users); err != nil {
+			log.Printf("Failed to decode pending deletions: %v", err)
+			return
+		}
+
+		for i := range users {
+			user := &users[i]
+			_, err := collection.UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+				"$addToSet": bson.M{"deletion_reminders_sent": days},
+			})
+			if err != nil {
+				log.Printf("Failed to record deletion reminder: %v", err)
+				continue
+			}
+			s.notifyUser(user, notify.KindDeletionReminder, map[string]string{
+				"days": strconv.Itoa(days),
+				"date": user.DeletionScheduledFor.UTC().Format("2 January 2006"),
+			})
+		}
+	}
+}
+
+func (s *userService) eraseDueAccounts(ctx context.Context) {
+	collection := s.collection(ctx, "users")
+	cursor, err := collection.Find(ctx, bson.M{
+		"deleted_at":             nil,
+		"deletion_scheduled_for": bson.M{"$lte": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Failed to load due deletions: %v", err)
+		return
+	}
+
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Printf("Failed to decode due deletions: %v", err)
+		return
+	}
+
+	for i := range users {
+		if err := s.eraseUser(ctx, users[i].ID); err != nil {
+			log.Printf("Failed to erase user %s: %v", users[i].ID.Hex(), err)
+		}
+	}
+}
+
+// objectCollections are user-owned collections whose documents point at a
+// stored object under key
+var objectCollections = []string{"access_reports", "kyc_documents", "quarantined_uploads"}
+
+// userObjectKeys lists the stored objects of a user's records
+func (s *userService) userObjectKeys(ctx context.Context, id primitive.ObjectID) ([]string, error) {
+	var keys []string
+	for _, name := range objectCollections {
+		cursor, err := s.collection(ctx, name).Find(ctx, bson.M{"user_id": id},
+			options.Find().SetProjection(bson.M{"key": 1}))
+		if err != nil {
+			return nil, fmt.Errorf("load %s: %w", name, err)
+		}
+		var docs []struct {
+			Key string `bson:"key"`
+		}
+		if err := cursor.All(ctx, &docs); err != nil {
+			return nil, fmt.Errorf("load %s: %w", name, err)
+		}
+		for _, d := range docs {
+			if d.Key != "" {
+				keys = append(keys, d.Key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// erasureTarget selects the documents of a user purged from a collection
+type erasureTarget struct {
+	collection string
+	filter     bson.M
+}
+
+func erasureTargets(id primitive.ObjectID) []erasureTarget {
+	targets := make([]erasureTarget, 0, len(userOwnedCollections)+3)
+	for _, name := range userOwnedCollections {
+		targets = append(targets, erasureTarget{name, bson.M{"user_id": id}})
+	}
+	return append(targets,
+		erasureTarget{"duplicate_candidates", bson.M{"$or": bson.A{bson.M{"user_a": id}, bson.M{"user_b": id}}}},
+		erasureTarget{"invites", bson.M{"inviter_id": id}},
+		// Row images are kept only with EVENTS_FORMAT=debezium, but one left
+		// from before a switch back still holds the user's data
+		erasureTarget{"cdc_images", bson.M{"_id": id}},
+	)
+}
+
+// rowImageCopies hold outbox events, whose before and after row images carry
+// the personal data of a user, under path: the outbox itself and the events
+// dead-lettered from it
+var rowImageCopies = []struct{ collection, path string }{
+	{"outbox", ""},
+	{"dead_letters", "event."},
+}
+
+// rowImageFilter selects the copies of a user's events that keep an image
+func rowImageFilter(path string, id primitive.ObjectID) bson.M {
+	return bson.M{
+		path + "aggregate_id": id.Hex(),
+		"$or": bson.A{
+			bson.M{path + "before": bson.M{"$exists": true}},
+			bson.M{path + "after": bson.M{"$exists": true}},
+		},
+	}
+}
+
+// erasedUser is the tombstone that replaces an erased user document
+func erasedUser(user *User, now time.Time) User {
+	placeholder := "deleted-" + user.ID.Hex()
+	return User{
+		UserName:     placeholder,
+		EmailAddress: placeholder + "@deleted.invalid",
+		PhoneNumber:  placeholder,
+		CreatedAt:    user.CreatedAt,
+		UpdatedAt:    now,
+		DeletedAt:    &now,
+		// Kept so a re-run of a legacy import does not bring the account back
+		LegacyID: user.LegacyID,
+	}
+}
+
+// eraseUser runs the erasure pipeline: personal data is scrubbed from the
+// user document, which is kept as a tombstone so statistics and foreign
+// references stay consistent, and user-owned collections are purged.
+func (s *userService) eraseUser(ctx context.Context, id primitive.ObjectID) error {
+
+	// Stored objects go before the records that point at them
+	keys, err := s.userObjectKeys(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			return fmt.Errorf("delete object %s: %w", key, err)
+		}
+	}
+
+	for _, t := range erasureTargets(id) {
+		if _, err := s.collection(ctx, t.collection).DeleteMany(ctx, t.filter); err != nil {
+			return fmt.Errorf("purge %s: %w", t.collection, err)
+		}
+	}
+
+	for _, c := range rowImageCopies {
+		_, err = s.collection(ctx, c.collection).UpdateMany(ctx, rowImageFilter(c.path, id),
+			bson.M{"$unset": bson.M{c.path + "before": "", c.path + "after": ""}})
+		if err != nil {
+			return fmt.Errorf("scrub %s row images: %w", c.collection, err)
+		}
+	}
+	if s.redis != nil {
+		if err := s.redis.Del(ctx, recentlyViewedKey(id.Hex())).Err(); err != nil {
+			return fmt.Errorf("purge recently viewed: %w", err)
+		}
+	}
+
+	var user User
+	if err := s.collection(ctx, "users").FindOne(ctx, bson.M{"_id": id}).Decode(&user); err != nil {
+		return fmt.Errorf("load user: %w", err)
+	}
+	for _, key := range user.avatarKeys() {
+		if err := s.store.Delete(ctx, key); err != nil {
+			return fmt.Errorf("delete avatar %s: %w", key, err)
+		}
+	}
+
+	now := time.Now()
+	_, err = s.collection(ctx, "users").ReplaceOne(ctx, bson.M{"_id": id}, erasedUser(&user, now))
+	if err != nil {
+		return fmt.Errorf("scrub user: %w", err)
+	}
+
+	s.recordEvent(ctx, eventUserDeleted, id, map[string]interface{}{"deleted_at": now})
+	log.Printf("Erased user %s", id.Hex())
+	return nil
+}
+
+// previewErasure reports what eraseUser would delete and scrub for id,
+// following the same steps without writing. Erased values are masked.
+func (s *userService) previewErasure(ctx context.Context, id primitive.ObjectID) (*pb.DryRunDiff, error) {
+	d := newDryRunDiff()
+
+	raw, err := s.collection(ctx, "users").FindOne(ctx, bson.M{"_id": id}).DecodeBytes()
+	if err != nil {
+		return nil, fmt.Errorf("load user: %w", err)
+	}
+	var user User
+	var before bson.M
+	if err := bson.Unmarshal(raw, &user); err != nil {
+		return nil, fmt.Errorf("decode user: %w", err)
+	}
+	if err := bson.Unmarshal(raw, &before); err != nil {
+		return nil, fmt.Errorf("decode user: %w", err)
+	}
+
+	// Stored objects
+	keys, err := s.userObjectKeys(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		d.add("storage", key, dryRunDelete)
+	}
+	for _, key := range user.avatarKeys() {
+		d.add("storage", key, dryRunDelete)
+	}
+
+	// Purged and scrubbed documents
+	idsOnly := options.Find().SetProjection(bson.M{"_id": 1})
+	for _, t := range erasureTargets(id) {
+		cursor, err := s.collection(ctx, t.collection).Find(ctx, t.filter, idsOnly)
+		if err != nil {
+			return nil, fmt.Errorf("load %s: %w", t.collection, err)
+		}
+		var found []bson.M
+		if err := cursor.All(ctx, &found); err != nil {
+			return nil, fmt.Errorf("load %s: %w", t.collection, err)
+		}
+		for _, f := range found {
+			d.add(t.collection, formatDiffValue(f["_id"]), dryRunDelete)
+		}
+	}
+	for _, c := range rowImageCopies {
+		cursor, err := s.collection(ctx, c.collection).Find(ctx, rowImageFilter(c.path, id), idsOnly)
+		if err != nil {
+			return nil, fmt.Errorf("load %s row images: %w", c.collection, err)
+		}
+		var rows []bson.M
+		if err := cursor.All(ctx, &rows); err != nil {
+			return nil, fmt.Errorf("load %s row images: %w", c.collection, err)
+		}
+		for _, row := range rows {
+			d.add(c.collection, formatDiffValue(row["_id"]), dryRunUpdate,
+				&pb.FieldChange{Path: c.path + "before", Before: "[redacted]"},
+				&pb.FieldChange{Path: c.path + "after", Before: "[redacted]"})
+		}
+	}
+	if s.redis != nil {
+		key := recentlyViewedKey(id.Hex())
+		n, err := s.redis.Exists(ctx, key).Result()
+		if err != nil {
+			return nil, fmt.Errorf("check recently viewed: %w", err)
+		}
+		if n > 0 {
+			d.add("redis", key, dryRunDelete)
+		}
+	}
+
+	// The tombstone and its change event
+	now := time.Now()
+	tombstone, err := bson.Marshal(erasedUser(&user, now))
+	if err != nil {
+		return nil, fmt.Errorf("encode tombstone: %w", err)
+	}
+	var after bson.M
+	if err := bson.Unmarshal(tombstone, &after); err != nil {
+		return nil, fmt.Errorf("encode tombstone: %w", err)
+	}
+	d.add("users", id.Hex(), dryRunReplace, diffDocuments(before, after, true)...)
+	d.add("outbox", "", dryRunInsert, &pb.FieldChange{Path: "type", After: eventUserDeleted})
+	return d.diff, nil
+}
+
+const operationUserErasure = "user_erasure"
+
+type userErasureParams struct {
+	UserID primitive.ObjectID `bson:"user_id"`
+	Reason string             `bson:"reason,omitempty"`
+}
+
+func init() {
+	operationRunners[operationUserErasure] = (*userService).runUserErasure
+}
+
+// StartUserErasure erases an account immediately, skipping the grace period,
+// for erasure requests handled by the compliance team. A dry run returns
+// what the erasure would delete and scrub instead.
+func (s *userService) StartUserErasure(ctx context.Context, req *pb.StartUserErasureMessageRequest) (*pb.StartUserErasureMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	if req.GetDryRun() {
+		diff, err := s.previewErasure(ctx, user.ID)
+		if err != nil {
+			log.Printf("Failed to preview erasure: %v", err)
+			return nil, status.Error(codes.Internal, "failed to preview erasure")
+		}
+		return &pb.StartUserErasureMessageResponse{Diff: diff}, nil
+	}
+
+	op, err := s.startOperation(ctx, operationUserErasure, userErasureParams{UserID: user.ID, Reason: reason})
+	if err != nil {
+		log.Printf("Failed to queue erasure: %v", err)
+		return nil, status.Error(codes.Internal, "failed to queue erasure")
+	}
+	return &pb.StartUserErasureMessageResponse{Operation: operationToProto(op)}, nil
+}
+
+func (s *userService) runUserErasure(ctx context.Context, op *Operation) (map[string]string, error) {
+	var params userErasureParams
+	if err := bson.Unmarshal(op.Params, &params); err != nil {
+		return nil, err
+	}
+	if _, err := s.findUserByID(ctx, params.UserID.Hex()); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return map[string]string{"user_id": params.UserID.Hex(), "erased": "already"}, nil
+		}
+		return nil, err
+	}
+	if err := s.eraseUser(ctx, params.UserID); err != nil {
+		return nil, err
+	}
+	return map[string]string{"user_id": params.UserID.Hex(), "erased": "true"}, nil
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	eventUserEmailUndeliverable = "user.email_undeliverable"
+
+	maxEmailFeedbackBytes  = 1 << 20
+	emailFeedbackTimeout   = 10 * time.Second
+	softBouncesBeforePause = 3
+)
+
+// Deliverability of a user's email address. Sends are paused for any status
+// other than deliverable.
+const (
+	emailStatusDeliverable = "deliverable"
+	emailStatusBounced     = "bounced"
+	emailStatusComplained  = "complained"
+)
+
+// EmailDeliverability tracks bounce and complaint feedback for the address
+// on a user record
+type EmailDeliverability struct {
+	Status      string    `bson:"status"`
+	Reason      string    `bson:"reason,omitempty"`
+	Provider    string    `bson:"provider,omitempty"`
+	SoftBounces int       `bson:"soft_bounces,omitempty"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+// emailUndeliverable reports whether email sends to the user are paused
+func (u *User) emailUndeliverable() bool {
+	return u.EmailDeliverability != nil && u.EmailDeliverability.Status != emailStatusDeliverable
+}
+
+// emailFeedback is one bounce or complaint in a provider-neutral form
+type emailFeedback struct {
+	Email     string
+	Complaint bool
+	// Permanent bounces pause sends at once; transient ones only after
+	// repeated failures
+	Permanent bool
+	Reason    string
+}
+
+// sesNotification is an SNS envelope or, with raw message delivery, the SES
+// notification itself
+type sesNotification struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+
+	NotificationType string `json:"notificationType"`
+	Bounce           struct {
+		BounceType        string `json:"bounceType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplainedRecipients []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+	} `json:"complaint"`
+}
+
+func parseSESFeedback(n *sesNotification) []emailFeedback {
+	var out []emailFeedback
+	switch n.NotificationType {
+	case "Bounce":
+		for _, r := range n.Bounce.BouncedRecipients {
+			reason := r.DiagnosticCode
+			if reason == "" {
+				reason = n.Bounce.BounceType + " bounce"
+			}
+			out = append(out, emailFeedback{Email: r.EmailAddress, Permanent: n.Bounce.BounceType == "Permanent", Reason: reason})
+		}
+	case "Complaint":
+		for _, r := range n.Complaint.ComplainedRecipients {
+			reason := "complaint"
+			if n.Complaint.ComplaintFeedbackType != "" {
+				reason += ": " + n.Complaint.ComplaintFeedbackType
+			}
+			out = append(out, emailFeedback{Email: r.EmailAddress, Complaint: true, Reason: reason})
+		}
+	}
+	return out
+}
+
+type sendGridEvent struct {
+	Email  string `json:"email"`
+	Event  string `json:"event"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+func parseSendGridFeedback(events []sendGridEvent) []emailFeedback {
+	var out []emailFeedback
+	for _, e := range events {
+		switch e.Event {
+		case "bounce":
+			// SendGrid reports blocks, which are usually temporary, as bounces too
+			out = append(out, emailFeedback{Email: e.Email, Permanent: e.Type != "blocked", Reason: e.Reason})
+		case "spamreport":
+			out = append(out, emailFeedback{Email: e.Email, Complaint: true, Reason: "spam report"})
+		}
+	}
+	return out
+}
+
+// webhookTokenValid checks the shared token configured in the
+// provider's webhook URL
+func webhookTokenValid(r *http.Request, token string) bool {
+	got := r.URL.Query().Get("token")
+	return got != "" && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
+
+// sesWebhookHandler receives SES bounce and complaint notifications from SNS
+func (s *userService) sesWebhookHandler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !webhookTokenValid(r, token) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxEmailFeedbackBytes))
+		if err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		var n sesNotification
+		if err := json.Unmarshal(body, &n); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		switch n.Type {
+		case "SubscriptionConfirmation":
+			confirmSNSSubscription(r.Context(), n.SubscribeURL)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case "Notification":
+			if err := json.Unmarshal([]byte(n.Message), &n); err != nil {
+				http.Error(w, "bad request", http.StatusBadRequest)
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), emailFeedbackTimeout)
+		defer cancel()
+		if err := s.applyEmailFeedback(ctx, "ses", parseSESFeedback(&n)); err != nil {
+			log.Printf("Failed to record SES feedback: %v", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// confirmSNSSubscription follows the confirmation link SNS sends when the
+// webhook is subscribed to a topic
+func confirmSNSSubscription(ctx context.Context, subscribeURL string) {
+	u, err := url.Parse(subscribeURL)
+	if err != nil || u.Scheme != "https" || !strings.HasSuffix(u.Hostname(), ".amazonaws.com") {
+		log.Printf("Ignored SNS subscription confirmation for %q", subscribeURL)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, emailFeedbackTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Printf("Failed to confirm SNS subscription: %v", err)
+		return
+	}
+	resp.Body.Close()
+	log.Printf("Confirmed SNS subscription (%s)", resp.Status)
+}
+
+// sendGridWebhookHandler receives SendGrid event webhook batches
+func (s *userService) sendGridWebhookHandler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !webhookTokenValid(r, token) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxEmailFeedbackBytes))
+		if err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		var events []sendGridEvent
+		if err := json.Unmarshal(body, &events); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), emailFeedbackTimeout)
+		defer cancel()
+		if err := s.applyEmailFeedback(ctx, "sendgrid", parseSendGridFeedback(events)); err != nil {
+			log.Printf("Failed to record SendGrid feedback: %v", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// applyEmailFeedback updates the deliverability of every user on the
+// affected addresses. Complaints are never downgraded to bounces.
+func (s *userService) applyEmailFeedback(ctx context.Context, provider string, feedback []emailFeedback) error {
+	collection := s.collection(ctx, "users")
+	for _, f := range feedback {
+		email := strings.TrimSpace(f.Email)
+		if email == "" {
+			continue
+		}
+		cursor, err := collection.Find(ctx, bson.M{"email": email, "deleted_at": nil}, options.Find().
+			SetCollation(caseInsensitive).SetProjection(bson.M{"email_deliverability": 1}))
+		if err != nil {
+			return err
+		}
+		var users []User
+		if err := cursor.All(ctx, &users); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for i := range users {
+			user := &users[i]
+			if user.EmailDeliverability != nil && user.EmailDeliverability.Status == emailStatusComplained {
+				continue
+			}
+			newStatus := ""
+			set := bson.M{"email_deliverability.provider": provider, "email_deliverability.updated_at": now, "updated_at": now}
+			switch {
+			case f.Complaint:
+				newStatus = emailStatusComplained
+			case f.Permanent:
+				newStatus = emailStatusBounced
+			default:
+				soft := 1
+				if user.EmailDeliverability != nil {
+					soft += user.EmailDeliverability.SoftBounces
+				}
+				set["email_deliverability.soft_bounces"] = soft
+				if soft >= softBouncesBeforePause {
+					newStatus = emailStatusBounced
+				}
+			}
+			if newStatus != "" {
+				set["email_deliverability.status"] = newStatus
+				set["email_deliverability.reason"] = f.Reason
+			} else if user.EmailDeliverability == nil {
+				set["email_deliverability.status"] = emailStatusDeliverable
+			}
+
+			if _, err := collection.UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{"$set": set}); err != nil {
+				return err
+			}
+			if newStatus != "" && !user.emailUndeliverable() {
+				log.Printf("Paused email to user %s: %s", user.ID.Hex(), newStatus)
+				s.recordEvent(ctx, eventUserEmailUndeliverable, user.ID, map[string]interface{}{
+					"status":   newStatus,
+					"provider": provider,
+					"at":       now,
+				})
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxDevicePINFailures wrong PINs disable the PIN of a device until the user
+// confirms their password with ReAuthenticate
+const maxDevicePINFailures = 5
+
+// Sign-in activity of device PINs
+const (
+	securityEventDevicePINSet      = "device_pin_set"
+	securityEventDevicePINFailed   = "device_pin_failed"
+	securityEventDevicePINDisabled = "device_pin_disabled"
+)
+
+// DevicePIN is a short PIN for re-authenticating on one trusted device of
+// the app, stored in device_pins. It is hashed separately from the
+// password and only works on the device it was set on.
+type DevicePIN struct {
+	UserID         primitive.ObjectID `bson:"user_id"`
+	DeviceID       string             `bson:"device_id"`
+	PINHash        string             `bson:"pin_hash"`
+	FailedAttempts int                `bson:"failed_attempts,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	LastUsedAt     *time.Time         `bson:"last_used_at,omitempty"`
+}
+
+// Errors of devicePINStore.ReserveAttempt
+var (
+	errDevicePINNotFound = errors.New("device PIN not found")
+	errDevicePINDisabled = errors.New("device PIN disabled")
+)
+
+// devicePINStore keeps device PINs and counts the attempts against them
+type devicePINStore interface {
+	Set(ctx context.Context, pin *DevicePIN) error
+	Remove(ctx context.Context, userID primitive.ObjectID, device string) error
+	// ReserveAttempt counts one attempt against the PIN before it is
+	// checked, and returns the PIN with the attempt counted. It fails with
+	// errDevicePINDisabled once limit attempts are counted, so concurrent
+	// guesses can't check more than limit PINs between two resets.
+	ReserveAttempt(ctx context.Context, userID primitive.ObjectID, device string, limit int) (*DevicePIN, error)
+	// RecordSuccess clears the attempts after a correct PIN
+	RecordSuccess(ctx context.Context, userID primitive.ObjectID, device string, at time.Time) error
+	// ResetFailures clears the attempts on every PIN of the user
+	ResetFailures(ctx context.Context, userID primitive.ObjectID) error
+}
+
+// mongoDevicePINStore keeps device PINs in device_pins
+type mongoDevicePINStore struct {
+	collection collectionFunc
+}
+
+func newMongoDevicePINStore(collection collectionFunc) *mongoDevicePINStore {
+	return &mongoDevicePINStore{collection: collection}
+}
+
+func (m *mongoDevicePINStore) pins(ctx context.Context) *mongo.Collection {
+	return m.collection(ctx, "device_pins")
+}
+
+func (m *mongoDevicePINStore) Set(ctx context.Context, pin *DevicePIN) error {
+	_, err := m.pins(ctx).ReplaceOne(ctx,
+		bson.M{"user_id": pin.UserID, "device_id": pin.DeviceID},
+		pin,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+func (m *mongoDevicePINStore) Remove(ctx context.Context, userID primitive.ObjectID, device string) error {
+	_, err := m.pins(ctx).DeleteOne(ctx, bson.M{"user_id": userID, "device_id": device})
+	return err
+}
+
+func (m *mongoDevicePINStore) ReserveAttempt(ctx context.Context, userID primitive.ObjectID, device string, limit int) (*DevicePIN, error) {
+	var pin DevicePIN
+	// failed_attempts is left out while it is 0, which $lt wouldn't match
+	err := m.pins(ctx).FindOneAndUpdate(ctx,
+		bson.M{"user_id": userID, "device_id": device, "failed_attempts": bson.M{"$not": bson.M{"$gte": limit}}},
+		bson.M{"$inc": bson.M{"failed_attempts": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&pin)
+	if err == nil {
+		return &pin, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+	// Nothing matched: either there is no PIN or it is used up
+	err = m.pins(ctx).FindOne(ctx, bson.M{"user_id": userID, "device_id": device}).Err()
+	if err == mongo.ErrNoDocuments {
+		return nil, errDevicePINNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, errDevicePINDisabled
+}
+
+func (m *mongoDevicePINStore) RecordSuccess(ctx context.Context, userID primitive.ObjectID, device string, at time.Time) error {
+	_, err := m.pins(ctx).UpdateOne(ctx, bson.M{"user_id": userID, "device_id": device}, bson.M{
+		"$set": bson.M{"failed_attempts": 0, "last_used_at": at},
+	})
+	return err
+}
+
+func (m *mongoDevicePINStore) ResetFailures(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := m.pins(ctx).UpdateMany(ctx,
+		bson.M{"user_id": userID, "failed_attempts": bson.M{"$gt": 0}},
+		bson.M{"$set": bson.M{"failed_attempts": 0}},
+	)
+	return err
+}
+
+// trustedDevice reports whether the user signed in from device before
+func (u *User) trustedDevice(device string) bool {
+	for _, d := range u.DeviceFingerprints {
+		if d == device {
+			return true
+		}
+	}
+	return false
+}
+
+// SetDevicePIN sets or replaces the PIN of a device the user signed in from,
+// after a recent password confirmation
+func (s *userService) SetDevicePIN(ctx context.Context, req *pb.SetDevicePINMessageRequest) (*pb.SetDevicePINMessageResponse, error) {
+	if err := s.requireFreshAuth(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	device := strings.TrimSpace(req.GetDeviceId())
+	if device == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+	if !user.trustedDevice(device) {
+		return nil, status.Error(codes.FailedPrecondition, "sign in on this device before setting a PIN")
+	}
+	if err := validatePIN(req.GetPin()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hash, err := s.passwords.Hash(req.GetPin())
+	if err != nil {
+		log.Printf("Failed to hash PIN: %v", err)
+		return nil, status.Error(codes.Internal, "failed to set PIN")
+	}
+	err = s.devicePINs.Set(ctx, &DevicePIN{UserID: user.ID, DeviceID: device, PINHash: hash, CreatedAt: time.Now()})
+	if err != nil {
+		log.Printf("Failed to store device PIN: %v", err)
+		return nil, status.Error(codes.Internal, "failed to set PIN")
+	}
+	s.recordSecurityEvent(ctx, user.ID, securityEventDevicePINSet, device, "")
+
+	return &pb.SetDevicePINMessageResponse{Message: "PIN set", Success: true}, nil
+}
+
+// RemoveDevicePIN turns PIN re-authentication off for a device
+func (s *userService) RemoveDevicePIN(ctx context.Context, req *pb.RemoveDevicePINMessageRequest) (*pb.RemoveDevicePINMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if s.bearerSubject(ctx) != id.Hex() {
+		return nil, status.Error(codes.PermissionDenied, "token does not belong to user")
+	}
+	if err := s.devicePINs.Remove(ctx, id, strings.TrimSpace(req.GetDeviceId())); err != nil {
+		log.Printf("Failed to remove device PIN: %v", err)
+		return nil, status.Error(codes.Internal, "failed to remove PIN")
+	}
+	return &pb.RemoveDevicePINMessageResponse{Message: "PIN removed", Success: true}, nil
+}
+
+// ReAuthenticateWithPIN upgrades an existing session like ReAuthenticate,
+// with the PIN of the device instead of the password. After
+// maxDevicePINFailures wrong PINs the PIN stops working until the user
+// confirms their password.
+func (s *userService) ReAuthenticateWithPIN(ctx context.Context, req *pb.ReAuthenticateWithPINMessageRequest) (*pb.ReAuthenticateWithPINMessageResponse, error) {
+	// 1. Validate the current session
+	claims, err := s.parseAccessToken(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	user, err := s.findUserByID(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user.PasswordResetRequired {
+		return nil, status.Error(codes.FailedPrecondition, "password reset required")
+	}
+
+	// 2. Check the PIN of the device, counting the attempt first
+	device := strings.TrimSpace(req.GetDeviceId())
+	pin, err := s.devicePINs.ReserveAttempt(ctx, user.ID, device, maxDevicePINFailures)
+	switch {
+	case err == errDevicePINNotFound:
+		return nil, status.Error(codes.FailedPrecondition, "no PIN is set on this device")
+	case err == errDevicePINDisabled:
+		return nil, status.Error(codes.FailedPrecondition, "PIN disabled after too many attempts, confirm your password")
+	case err != nil:
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if _, err := s.passwords.Verify(req.GetPin(), pin.PINHash); err != nil {
+		s.recordSecurityEvent(ctx, user.ID, securityEventDevicePINFailed, device, "")
+		if left := maxDevicePINFailures - pin.FailedAttempts; left > 0 {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid PIN, %d attempts left", left)
+		}
+		s.recordSecurityEvent(ctx, user.ID, securityEventDevicePINDisabled, device, "")
+		return nil, status.Error(codes.FailedPrecondition, "PIN disabled after too many attempts, confirm your password")
+	}
+	if err := s.devicePINs.RecordSuccess(ctx, user.ID, device, time.Now()); err != nil {
+		log.Printf("Failed to update device PIN: %v", err)
+	}
+
+	// 3. Reissue the token with a fresh auth time
+	signed, upgraded, err := s.tokens.Reauthenticate(claims)
+	if err != nil {
+		log.Printf("Failed to reissue token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+
+	return &pb.ReAuthenticateWithPINMessageResponse{
+		AccessToken:   signed,
+		ExpiresAtUnix: upgraded.ExpiresAt.Unix(),
+		AuthTimeUnix:  upgraded.AuthTime.Unix(),
+	}, nil
+}
+
+// resetDevicePINFailures re-enables the PINs of a user who confirmed their
+// password
+func (s *userService) resetDevicePINFailures(ctx context.Context, userID primitive.ObjectID) {
+	if err := s.devicePINs.ResetFailures(ctx, userID); err != nil {
+		log.Printf("Failed to reset device PIN failures for user %s: %v", userID.Hex(), err)
+	}
+}
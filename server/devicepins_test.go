@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testDevice = "pixel-7"
+
+// newDevicePINTest seeds a user with a PIN on testDevice and returns an
+// access token of theirs
+func newDevicePINTest(t *testing.T, pin string) (*userService, *memoryDevicePINStore, string) {
+	t.Helper()
+	svc, users, _ := newTestService(t)
+	pins := newMemoryDevicePINStore()
+	svc.devicePINs = pins
+
+	user := &User{
+		FullName:           "Amina Otieno",
+		UserName:           "amina",
+		EmailAddress:       "amina@example.com",
+		PhoneNumber:        "254712345678",
+		DeviceFingerprints: []string{testDevice},
+		SchemaVersion:      currentUserSchemaVersion,
+	}
+	if err := users.Create(context.Background(), user); err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+	hash, err := svc.passwords.Hash(pin)
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	err = pins.Set(context.Background(), &DevicePIN{UserID: user.ID, DeviceID: testDevice, PINHash: hash, CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("seed PIN: %v", err)
+	}
+	signed, _, err := svc.tokens.IssueUserToken(token.Subject{UserID: user.ID.Hex()}, token.DefaultLoginClient, nil)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	return svc, pins, signed
+}
+
+func TestReAuthenticateWithPIN(t *testing.T) {
+	const pin = "4821"
+
+	tests := []struct {
+		name     string
+		attempts []string
+		device   string
+		code     codes.Code
+	}{
+		{
+			name:     "correct PIN",
+			attempts: []string{pin},
+			code:     codes.OK,
+		},
+		{
+			name:     "wrong PIN",
+			attempts: []string{"0000"},
+			code:     codes.Unauthenticated,
+		},
+		{
+			name:     "correct PIN clears earlier failures",
+			attempts: []string{"0000", "0000", "0000", "0000", pin, "0000", "0000", "0000", "0000", pin},
+			code:     codes.OK,
+		},
+		{
+			name:     "disabled after too many failures",
+			attempts: []string{"0000", "0000", "0000", "0000", "0000", pin},
+			code:     codes.FailedPrecondition,
+		},
+		{
+			name:     "no PIN on device",
+			attempts: []string{pin},
+			device:   "other-phone",
+			code:     codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, access := newDevicePINTest(t, pin)
+			device := testDevice
+			if tt.device != "" {
+				device = tt.device
+			}
+			var err error
+			for _, attempt := range tt.attempts {
+				_, err = svc.ReAuthenticateWithPIN(context.Background(), &pb.ReAuthenticateWithPINMessageRequest{
+					AccessToken: access,
+					DeviceId:    device,
+					Pin:         attempt,
+				})
+			}
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("ReAuthenticateWithPIN() code = %v, want %v (err %v)", got, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestReAuthenticateWithPINConcurrentGuesses(t *testing.T) {
+	const guesses = 4 * maxDevicePINFailures
+	svc, pins, access := newDevicePINTest(t, "4821")
+
+	var wg sync.WaitGroup
+	codesSeen := make(chan codes.Code, guesses)
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := svc.ReAuthenticateWithPIN(context.Background(), &pb.ReAuthenticateWithPINMessageRequest{
+				AccessToken: access,
+				DeviceId:    testDevice,
+				Pin:         "0000",
+			})
+			codesSeen <- status.Code(err)
+		}()
+	}
+	wg.Wait()
+	close(codesSeen)
+
+	for code := range codesSeen {
+		if code == codes.OK {
+			t.Fatal("a wrong PIN was accepted")
+		}
+	}
+	if pins.reserved > maxDevicePINFailures {
+		t.Errorf("%d PINs checked, want at most %d", pins.reserved, maxDevicePINFailures)
+	}
+	_, err := svc.ReAuthenticateWithPIN(context.Background(), &pb.ReAuthenticateWithPINMessageRequest{
+		AccessToken: access,
+		DeviceId:    testDevice,
+		Pin:         "4821",
+	})
+	if got := status.Code(err); got != codes.FailedPrecondition {
+		t.Errorf("correct PIN after lockout code = %v, want %v", got, codes.FailedPrecondition)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+)
+
+// runtimeStats are published under the "runtime" expvar
+type runtimeStats struct {
+	Goroutines     int     `json:"goroutines"`
+	HeapAllocBytes uint64  `json:"heap_alloc_bytes"`
+	HeapObjects    uint64  `json:"heap_objects"`
+	SysBytes       uint64  `json:"sys_bytes"`
+	NumGC          uint32  `json:"num_gc"`
+	PauseTotalMs   float64 `json:"gc_pause_total_ms"`
+	LastPauseMs    float64 `json:"gc_last_pause_ms"`
+	LastGCUnix     int64   `json:"gc_last_unix"`
+	UptimeSeconds  int64   `json:"uptime_seconds"`
+}
+
+func readRuntimeStats() runtimeStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	stats := runtimeStats{
+		Goroutines:     runtime.NumGoroutine(),
+		HeapAllocBytes: m.HeapAlloc,
+		HeapObjects:    m.HeapObjects,
+		SysBytes:       m.Sys,
+		NumGC:          m.NumGC,
+		PauseTotalMs:   float64(m.PauseTotalNs) / 1e6,
+		UptimeSeconds:  int64(time.Since(startedAt).Seconds()),
+	}
+	if m.NumGC > 0 {
+		stats.LastPauseMs = float64(m.PauseNs[(m.NumGC+255)%256]) / 1e6
+		stats.LastGCUnix = time.Unix(0, int64(m.LastGC)).Unix()
+	}
+	return stats
+}
+
+func init() {
+	expvar.Publish("runtime", expvar.Func(func() interface{} { return readRuntimeStats() }))
+	expvar.Publish("build", expvar.Func(func() interface{} { return currentBuild }))
+}
+
+// mountDiagnostics adds pprof profiles, expvar and runtime stats under
+// /debug/ for callers holding the admin.debug scope. Profiles can be large
+// and slow the process while they run, so they never go on the public ports.
+func mountDiagnostics(mux *http.ServeMux, clients *clientRegistry) {
+	debug := http.NewServeMux()
+	debug.HandleFunc("/debug/pprof/", pprof.Index)
+	debug.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	debug.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	debug.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	debug.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	debug.Handle("/debug/vars", expvar.Handler())
+	debug.HandleFunc("/debug/runtime", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("gc") == "1" {
+			runtime.GC()
+		}
+		writeJSON(w, http.StatusOK, readRuntimeStats())
+	})
+
+	mux.Handle("/debug/", requireHTTPScope(clients, scopeAdminDebug, debug))
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Digests go out at this local hour, weekly ones on Mondays
+	digestLocalHour        = 8
+	defaultDueDigestsBatch = 100
+	maxDueDigestsBatch     = 500
+)
+
+// Delivery frequencies a notification category can use
+const (
+	digestImmediate = "immediate"
+	digestDaily     = "daily"
+	digestWeekly    = "weekly"
+)
+
+// digestCategories are the notification categories that may be batched.
+// Security and account notifications are always sent immediately.
+var digestCategories = map[string]bool{
+	"order_updates":   true,
+	"price_alerts":    true,
+	"back_in_stock":   true,
+	"recommendations": true,
+	"promotions":      true,
+}
+
+// DigestSchedule holds the digest frequency per category and when the next
+// daily and weekly digests are due in the user's timezone
+type DigestSchedule struct {
+	Frequencies map[string]string `bson:"frequencies"`
+	Timezone    string            `bson:"timezone"`
+	DailyDueAt  *time.Time        `bson:"daily_due_at,omitempty"`
+	WeeklyDueAt *time.Time        `bson:"weekly_due_at,omitempty"`
+}
+
+// nextDigestAt returns the first delivery slot for frequency after t
+func nextDigestAt(frequency string, loc *time.Location, t time.Time) time.Time {
+	local := t.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), digestLocalHour, 0, 0, 0, loc)
+	if frequency == digestWeekly {
+		next = next.AddDate(0, 0, (int(time.Monday)-int(next.Weekday())+7)%7)
+		if !next.After(t) {
+			next = next.AddDate(0, 0, 7)
+		}
+		return next
+	}
+	if !next.After(t) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// digestSchedule builds the schedule for frequencies in the user's timezone
+func digestSchedule(user *User, frequencies map[string]string, now time.Time) *DigestSchedule {
+	loc := userLocation(user)
+	schedule := &DigestSchedule{Frequencies: frequencies, Timezone: loc.String()}
+	for _, f := range frequencies {
+		switch f {
+		case digestDaily:
+			if schedule.DailyDueAt == nil {
+				due := nextDigestAt(digestDaily, loc, now)
+				schedule.DailyDueAt = &due
+			}
+		case digestWeekly:
+			if schedule.WeeklyDueAt == nil {
+				due := nextDigestAt(digestWeekly, loc, now)
+				schedule.WeeklyDueAt = &due
+			}
+		}
+	}
+	return schedule
+}
+
+// rescheduleDigests moves pending digests to the user's current timezone
+func (s *userService) rescheduleDigests(ctx context.Context, user *User) error {
+	if user.Digest == nil {
+		return nil
+	}
+	schedule := digestSchedule(user, user.Digest.Frequencies, time.Now())
+	_, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$set": bson.M{"digest": schedule},
+	})
+	return err
+}
+
+// SetDigestPreferences chooses immediate, daily or weekly delivery per
+// notification category. Categories left out keep immediate delivery.
+func (s *userService) SetDigestPreferences(ctx context.Context, req *pb.SetDigestPreferencesMessageRequest) (*pb.SetDigestPreferencesMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	frequencies := make(map[string]string)
+	for _, p := range req.GetPreferences() {
+		category := strings.ToLower(strings.TrimSpace(p.GetCategory()))
+		if !digestCategories[category] {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown notification category %q", p.GetCategory())
+		}
+		frequency := strings.ToLower(strings.TrimSpace(p.GetFrequency()))
+		switch frequency {
+		case digestImmediate:
+			continue
+		case digestDaily, digestWeekly:
+			frequencies[category] = frequency
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown digest frequency %q", p.GetFrequency())
+		}
+	}
+
+	update := bson.M{"$unset": bson.M{"digest": ""}, "$set": bson.M{"updated_at": time.Now()}}
+	if len(frequencies) > 0 {
+		update = bson.M{"$set": bson.M{"digest": digestSchedule(user, frequencies, time.Now()), "updated_at": time.Now()}}
+	}
+	if _, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, update); err != nil {
+		log.Printf("Failed to update digest preferences: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update digest preferences")
+	}
+	return &pb.SetDigestPreferencesMessageResponse{Message: "Digest preferences updated", Success: true}, nil
+}
+
+// GetDigestPreferences returns the delivery frequency of every category
+func (s *userService) GetDigestPreferences(ctx context.Context, req *pb.GetDigestPreferencesMessageRequest) (*pb.GetDigestPreferencesMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetDigestPreferencesMessageResponse{Timezone: userLocation(user).String()}
+	var frequencies map[string]string
+	if d := user.Digest; d != nil {
+		frequencies = d.Frequencies
+		resp.Timezone = d.Timezone
+		if d.DailyDueAt != nil {
+			resp.NextDailyUnix = d.DailyDueAt.Unix()
+		}
+		if d.WeeklyDueAt != nil {
+			resp.NextWeeklyUnix = d.WeeklyDueAt.Unix()
+		}
+	}
+	for category := range digestCategories {
+		frequency, ok := frequencies[category]
+		if !ok {
+			frequency = digestImmediate
+		}
+		resp.Preferences = append(resp.Preferences, &pb.DigestPreference{Category: category, Frequency: frequency})
+	}
+	sort.Slice(resp.Preferences, func(i, j int) bool { return resp.Preferences[i].Category < resp.Preferences[j].Category })
+	return resp, nil
+}
+
+// GetDueDigests hands the notification service the digests due now and
+// moves each user to their next slot, so concurrent pollers never receive
+// the same digest twice
+func (s *userService) GetDueDigests(ctx context.Context, req *pb.GetDueDigestsMessageRequest) (*pb.GetDueDigestsMessageResponse, error) {
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultDueDigestsBatch
+	}
+	if limit > maxDueDigestsBatch {
+		limit = maxDueDigestsBatch
+	}
+
+	collection := s.collection(ctx, "users")
+	now := time.Now()
+	resp := &pb.GetDueDigestsMessageResponse{}
+	for _, frequency := range []string{digestDaily, digestWeekly} {
+		remaining := limit - int64(len(resp.Digests))
+		if remaining <= 0 {
+			break
+		}
+		field := "digest." + frequency + "_due_at"
+		cursor, err := collection.Find(ctx,
+			bson.M{field: bson.M{"$lte": now}, "deleted_at": nil},
+			options.Find().SetSort(bson.D{{Key: field, Value: 1}}).SetLimit(remaining).SetProjection(bson.M{"digest": 1, "timezone": 1}),
+		)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to load due digests")
+		}
+		var users []User
+		if err := cursor.All(ctx, &users); err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to load due digests")
+		}
+
+		for i := range users {
+			d := users[i].Digest
+			due := d.DailyDueAt
+			if frequency == digestWeekly {
+				due = d.WeeklyDueAt
+			}
+			loc, err := time.LoadLocation(d.Timezone)
+			if err != nil {
+				loc = userLocation(&users[i])
+			}
+			// Slots missed while nobody polled are skipped, not replayed
+			next := nextDigestAt(frequency, loc, now)
+			res, err := collection.UpdateOne(ctx, bson.M{"_id": users[i].ID, field: *due}, bson.M{"$set": bson.M{field: next}})
+			if err != nil {
+				log.Printf("Database error: %v", err)
+				return nil, status.Error(codes.Internal, "failed to claim due digests")
+			}
+			if res.ModifiedCount == 0 {
+				continue
+			}
+
+			digest := &pb.DueDigest{UserId: users[i].ID.Hex(), Frequency: frequency, DueAtUnix: due.Unix(), Timezone: d.Timezone}
+			for category, f := range d.Frequencies {
+				if f == frequency {
+					digest.Categories = append(digest.Categories, category)
+				}
+			}
+			sort.Strings(digest.Categories)
+			resp.Digests = append(resp.Digests, digest)
+		}
+	}
+	return resp, nil
+}
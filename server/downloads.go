@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const downloadURLTTL = 5 * time.Minute
+
+// Objects GetDownloadURL can sign
+const (
+	downloadAvatar           = "avatar"
+	downloadKYCDocument      = "kyc_document"
+	downloadAccessReport     = "access_report"
+	downloadComplianceExport = "compliance_export"
+)
+
+// GetDownloadURL returns a short-lived signed URL for a stored object, so
+// clients download it from object storage rather than through the service.
+// Avatars are public. KYC documents and access reports are only signed for
+// their owner, shown by a bearer token for the user, or for a service with
+// the matching admin scope. Compliance exports need admin.compliance.
+func (s *userService) GetDownloadURL(ctx context.Context, req *pb.GetDownloadURLMessageRequest) (*pb.GetDownloadURLMessageResponse, error) {
+	kind := strings.TrimSpace(req.GetKind())
+	owner := func(userID string, adminScope string) error {
+		if s.bearerSubject(ctx) == userID || clientFromContext(ctx).hasScope(adminScope) {
+			return nil
+		}
+		return status.Error(codes.PermissionDenied, "not allowed to download this object")
+	}
+
+	// 1. Resolve the object and check who may read it
+	var key string
+	switch kind {
+	case downloadAvatar:
+		user, err := s.findUserByID(ctx, req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		if user.Avatar == nil || user.Avatar.Value == "" {
+			return nil, status.Error(codes.NotFound, "user has no approved avatar")
+		}
+		key = user.Avatar.Value
+
+	case downloadKYCDocument, downloadAccessReport:
+		userID, err := parseUserID(req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		id, err := primitive.ObjectIDFromHex(req.GetId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid object id")
+		}
+		scope, collection := scopeAdminKYC, "kyc_documents"
+		if kind == downloadAccessReport {
+			scope, collection = scopeAdminCompliance, "access_reports"
+		}
+		if err := owner(userID.Hex(), scope); err != nil {
+			return nil, err
+		}
+		// Both kinds store the object key under key
+		var doc struct {
+			Key       string     `bson:"key"`
+			ExpiresAt *time.Time `bson:"expires_at"`
+		}
+		err = s.collection(ctx, collection).FindOne(ctx, bson.M{"_id": id, "user_id": userID}).Decode(&doc)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, status.Error(codes.NotFound, "object not found")
+			}
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to sign download URL")
+		}
+		if doc.ExpiresAt != nil && time.Now().After(*doc.ExpiresAt) {
+			return nil, status.Error(codes.NotFound, "object not found")
+		}
+		key = doc.Key
+
+	case downloadComplianceExport:
+		if !clientFromContext(ctx).hasScope(scopeAdminCompliance) {
+			return nil, status.Error(codes.PermissionDenied, "not allowed to download this object")
+		}
+		if _, err := primitive.ObjectIDFromHex(req.GetId()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid object id")
+		}
+		key = complianceExportKey(req.GetId())
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown download kind %q", kind)
+	}
+
+	// 2. Sign it
+	url, err := s.store.SignedURL(ctx, key, downloadURLTTL)
+	if err != nil {
+		log.Printf("Failed to sign download URL: %v", err)
+		return nil, status.Error(codes.Internal, "failed to sign download URL")
+	}
+	return &pb.GetDownloadURLMessageResponse{
+		Url:           url,
+		ExpiresAtUnix: time.Now().Add(downloadURLTTL).Unix(),
+	}, nil
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// maxDryRunChanges caps the changes listed in a dry-run diff. Counts always
+// cover every change.
+const maxDryRunChanges = 500
+
+// Actions of a dry-run change
+const (
+	dryRunInsert  = "insert"
+	dryRunUpdate  = "update"
+	dryRunReplace = "replace"
+	dryRunDelete  = "delete"
+)
+
+// dryRunDiff collects what a destructive admin operation would change
+// without writing anything. Counts are keyed by collection and action,
+// e.g. "users.update".
+type dryRunDiff struct {
+	diff *pb.DryRunDiff
+}
+
+func newDryRunDiff() *dryRunDiff {
+	return &dryRunDiff{diff: &pb.DryRunDiff{Counts: make(map[string]int64)}}
+}
+
+// add lists one change and counts it
+func (d *dryRunDiff) add(collection, id, action string, fields ...*pb.FieldChange) {
+	d.diff.Counts[collection+"."+action]++
+	if len(d.diff.Changes) >= maxDryRunChanges {
+		d.diff.Truncated = true
+		return
+	}
+	d.diff.Changes = append(d.diff.Changes, &pb.DryRunChange{
+		Collection: collection,
+		DocumentId: id,
+		Action:     action,
+		Fields:     fields,
+	})
+}
+
+// count adds changes that are counted but not listed
+func (d *dryRunDiff) count(collection, action string, n int64) {
+	if n <= 0 {
+		return
+	}
+	d.diff.Counts[collection+"."+action] += n
+	d.diff.Truncated = true
+}
+
+// simulateUpdate applies a Mongo update to a copy of doc. It supports the
+// operators our admin jobs use, on top-level fields only.
+func simulateUpdate(doc bson.M, update bson.M) (bson.M, error) {
+	out := make(bson.M, len(doc))
+	for k, v := range doc {
+		out[k] = v
+	}
+	for op, arg := range update {
+		fields, ok := arg.(bson.M)
+		if !ok {
+			return nil, fmt.Errorf("unsupported %s argument", op)
+		}
+		for field, value := range fields {
+			if strings.Contains(field, ".") {
+				return nil, fmt.Errorf("unsupported nested path %s", field)
+			}
+			current, _ := out[field].(primitive.A)
+			switch op {
+			case "$set":
+				out[field] = value
+			case "$unset":
+				delete(out, field)
+			case "$addToSet":
+				items := primitive.A{value}
+				if m, ok := value.(bson.M); ok && m["$each"] != nil {
+					items = toArray(m["$each"])
+				}
+				next := append(primitive.A{}, current...)
+				for _, item := range items {
+					if !containsValue(next, item) {
+						next = append(next, item)
+					}
+				}
+				out[field] = next
+			case "$pull":
+				items := primitive.A{value}
+				if m, ok := value.(bson.M); ok && m["$in"] != nil {
+					items = toArray(m["$in"])
+				}
+				next := primitive.A{}
+				for _, item := range current {
+					if !containsValue(items, item) {
+						next = append(next, item)
+					}
+				}
+				out[field] = next
+			default:
+				return nil, fmt.Errorf("unsupported update operator %s", op)
+			}
+		}
+	}
+	return out, nil
+}
+
+func toArray(v interface{}) primitive.A {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return primitive.A{v}
+	}
+	out := make(primitive.A, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out
+}
+
+func containsValue(items primitive.A, v interface{}) bool {
+	for _, item := range items {
+		if reflect.DeepEqual(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// diffDocuments lists the top-level fields that differ between two images
+// of a document. With redact set, old values are masked so a preview of an
+// erasure does not itself leak the data being erased. Fields that never
+// leave the service are always redacted.
+func diffDocuments(before, after bson.M, redact bool) []*pb.FieldChange {
+	paths := make([]string, 0, len(before)+len(after))
+	for k := range before {
+		paths = append(paths, k)
+	}
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			paths = append(paths, k)
+		}
+	}
+	sort.Strings(paths)
+
+	var changes []*pb.FieldChange
+	for _, path := range paths {
+		if path == "_id" || path == "updated_at" {
+			continue
+		}
+		b, a := normalizeDiffValue(before[path]), normalizeDiffValue(after[path])
+		if reflect.DeepEqual(b, a) {
+			continue
+		}
+		change := &pb.FieldChange{Path: path, Before: formatDiffValue(b), After: formatDiffValue(a)}
+		for _, f := range cdcRedactedFields {
+			if f == path {
+				change.Before, change.After = redactedDiffValue(change.Before), redactedDiffValue(change.After)
+			}
+		}
+		if redact {
+			if _, ok := b.(string); ok {
+				change.Before = maskValue(change.Before, 3)
+			} else {
+				change.Before = redactedDiffValue(change.Before)
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func redactedDiffValue(v string) string {
+	if v == "" {
+		return ""
+	}
+	return "[redacted]"
+}
+
+// normalizeDiffValue makes values decoded from Mongo and values built in Go
+// compare equal
+func normalizeDiffValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case []string:
+		return normalizeDiffValue(toArray(t))
+	case time.Time:
+		return primitive.NewDateTimeFromTime(t)
+	case primitive.A:
+		if len(t) == 0 {
+			return nil
+		}
+	}
+	return v
+}
+
+func formatDiffValue(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case primitive.DateTime:
+		return t.Time().UTC().Format(time.RFC3339)
+	case primitive.ObjectID:
+		return t.Hex()
+	case bool, int32, int64, float64:
+		return fmt.Sprint(t)
+	}
+	raw, err := bson.MarshalExtJSON(bson.M{"v": v}, false, false)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(string(raw), `{"v":`), "}")
+}
@@ -0,0 +1,440 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserDuplicateDetected = "user.duplicate_detected"
+
+	duplicateScanInterval  = 24 * time.Hour
+	maxDeviceFingerprints  = 20
+	minDuplicateScore      = 0.5
+	maxDuplicateBucketSize = 25
+	maxDuplicatesListed    = 100
+)
+
+// Duplicate candidate statuses
+const (
+	duplicateOpen      = "open"
+	duplicateMerged    = "merged"
+	duplicateDismissed = "dismissed"
+)
+
+// DuplicateCandidate is a pair of accounts that probably belong to the same
+// person. UserA always holds the smaller ID so a pair is stored once.
+type DuplicateCandidate struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserA      primitive.ObjectID `bson:"user_a"`
+	UserB      primitive.ObjectID `bson:"user_b"`
+	Score      float64            `bson:"score"`
+	Reasons    []string           `bson:"reasons"`
+	Status     string             `bson:"status"`
+	DetectedAt time.Time          `bson:"detected_at"`
+	UpdatedAt  time.Time          `bson:"updated_at"`
+	ResolvedBy string             `bson:"resolved_by,omitempty"`
+}
+
+// dedupeProfile is the projection of a user the scanner compares
+type dedupeProfile struct {
+	ID                 primitive.ObjectID `bson:"_id"`
+	FullName           string             `bson:"full_name"`
+	EmailAddress       string             `bson:"email"`
+	PhoneNumber        string             `bson:"phone"`
+	DeviceFingerprints []string           `bson:"device_fingerprints"`
+}
+
+// recordDeviceFingerprint keeps the most recent fingerprints a user signed
+// in from for duplicate detection
+func (s *userService) recordDeviceFingerprint(ctx context.Context, userID primitive.ObjectID, fingerprint string) {
+	fingerprint = strings.TrimSpace(fingerprint)
+	if fingerprint == "" {
+		return
+	}
+	if err := s.users.AddDeviceFingerprint(ctx, userID, fingerprint, maxDeviceFingerprints); err != nil {
+		log.Printf("Failed to update device fingerprints: %v", err)
+	}
+}
+
+// canonicalEmail folds the variations mailbox providers deliver to the same
+// inbox: case, +tags and, for Gmail, dots in the local part
+func canonicalEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return email
+	}
+	local, domain := email[:at], email[at+1:]
+	if i := strings.Index(local, "+"); i >= 0 {
+		local = local[:i]
+	}
+	if domain == "googlemail.com" {
+		domain = "gmail.com"
+	}
+	if domain == "gmail.com" {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	return local + "@" + domain
+}
+
+// phoneSuffix returns the subscriber part of a phone number so local and
+// international formats of the same number compare equal
+func phoneSuffix(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) > 9 {
+		digits = digits[len(digits)-9:]
+	}
+	return digits
+}
+
+// nameKey orders the name words so "Jane Wanjiku" and "Wanjiku Jane" match
+func nameKey(name string) string {
+	words := strings.Fields(strings.ToLower(name))
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+// levenshtein is the edit distance between two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// similarity scales the edit distance to 0..1
+func similarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	longest := max(len([]rune(a)), len([]rune(b)))
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+// scoreDuplicate combines the signals linking two accounts. Each signal is
+// treated as independent evidence, so the score is 1 - Π(1 - weight).
+func scoreDuplicate(a, b *dedupeProfile) (float64, []string) {
+	var reasons []string
+	miss := 1.0
+	add := func(weight float64, reason string) {
+		miss *= 1 - weight
+		reasons = append(reasons, reason)
+	}
+
+	for _, fa := range a.DeviceFingerprints {
+		shared := false
+		for _, fb := range b.DeviceFingerprints {
+			if fa == fb {
+				shared = true
+				break
+			}
+		}
+		if shared {
+			add(0.6, "shared_device")
+			break
+		}
+	}
+
+	ea, eb := canonicalEmail(a.EmailAddress), canonicalEmail(b.EmailAddress)
+	switch {
+	case ea == eb:
+		add(0.9, "same_email")
+	case emailDomain(ea) == emailDomain(eb) && levenshtein(ea, eb) <= 2:
+		add(0.5, "similar_email")
+	}
+
+	pa, pb := phoneSuffix(a.PhoneNumber), phoneSuffix(b.PhoneNumber)
+	switch {
+	case pa == "" || pb == "":
+	case pa == pb:
+		add(0.9, "same_phone")
+	case len(pa) == len(pb) && levenshtein(pa, pb) == 1:
+		add(0.4, "similar_phone")
+	}
+
+	if sim := similarity(nameKey(a.FullName), nameKey(b.FullName)); sim >= 0.85 {
+		add(0.4*sim, "similar_name")
+	}
+
+	return 1 - miss, reasons
+}
+
+func emailDomain(email string) string {
+	if at := strings.LastIndex(email, "@"); at >= 0 {
+		return email[at+1:]
+	}
+	return ""
+}
+
+func (s *userService) runDuplicateScanner(ctx context.Context) {
+	ticker := time.NewTicker(duplicateScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if !s.config.inMaintenance() {
+			s.scanDuplicates(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scanDuplicates groups users on blocking keys (device, canonical email and
+// its local part, phone suffix and name) and scores every pair sharing a
+// bucket. Very large buckets, such as a shared cybercafé device, carry no
+// signal and are skipped rather than compared pairwise.
+func (s *userService) scanDuplicates(ctx context.Context) {
+	cursor, err := s.collection(ctx, "users").Find(ctx, bson.M{"deleted_at": nil},
+		options.Find().SetProjection(bson.M{"full_name": 1, "email": 1, "phone": 1, "device_fingerprints": 1}))
+	if err != nil {
+		log.Printf("Failed to load users for duplicate scan: %v", err)
+		return
+	}
+	defer cursor.Close(ctx)
+
+	profiles := make(map[primitive.ObjectID]*dedupeProfile)
+	buckets := make(map[string][]primitive.ObjectID)
+	for cursor.Next(ctx) {
+		var p dedupeProfile
+		if err := cursor.Decode(&p); err != nil {
+			log.Printf("Failed to decode user for duplicate scan: %v", err)
+			continue
+		}
+		profiles[p.ID] = &p
+
+		keys := []string{"name:" + nameKey(p.FullName)}
+		if email := canonicalEmail(p.EmailAddress); email != "" {
+			keys = append(keys, "email:"+email)
+			if at := strings.LastIndex(email, "@"); at > 0 {
+				keys = append(keys, "local:"+email[:at])
+			}
+		}
+		if phone := phoneSuffix(p.PhoneNumber); phone != "" {
+			keys = append(keys, "phone:"+phone)
+		}
+		for _, f := range p.DeviceFingerprints {
+			keys = append(keys, "device:"+f)
+		}
+		for _, k := range keys {
+			buckets[k] = append(buckets[k], p.ID)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		log.Printf("Failed to scan users for duplicates: %v", err)
+		return
+	}
+
+	type pair struct{ a, b primitive.ObjectID }
+	compared := make(map[pair]bool)
+	found := 0
+	for _, ids := range buckets {
+		if len(ids) < 2 || len(ids) > maxDuplicateBucketSize {
+			continue
+		}
+		for i := 0; i < len(ids); i++ {
+			for j := i + 1; j < len(ids); j++ {
+				a, b := ids[i], ids[j]
+				if a.Hex() > b.Hex() {
+					a, b = b, a
+				}
+				if a == b || compared[pair{a, b}] {
+					continue
+				}
+				compared[pair{a, b}] = true
+
+				score, reasons := scoreDuplicate(profiles[a], profiles[b])
+				if score < minDuplicateScore {
+					continue
+				}
+				if s.saveDuplicateCandidate(ctx, a, b, score, reasons) {
+					found++
+				}
+			}
+		}
+	}
+	if found > 0 {
+		log.Printf("Duplicate scan found %d new candidate pairs", found)
+	}
+}
+
+// saveDuplicateCandidate upserts a pair, refreshing the score of pairs that
+// are still open. It reports whether the pair is new.
+func (s *userService) saveDuplicateCandidate(ctx context.Context, a, b primitive.ObjectID, score float64, reasons []string) bool {
+	collection := s.collection(ctx, "duplicate_candidates")
+	now := time.Now()
+	res, err := collection.UpdateOne(ctx,
+		bson.M{"user_a": a, "user_b": b},
+		bson.M{
+			"$set":         bson.M{"score": score, "reasons": reasons, "updated_at": now},
+			"$setOnInsert": bson.M{"status": duplicateOpen, "detected_at": now},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		log.Printf("Failed to save duplicate candidate %s/%s: %v", a.Hex(), b.Hex(), err)
+		return false
+	}
+	if res.UpsertedCount == 0 {
+		return false
+	}
+
+	s.recordEvent(ctx, eventUserDuplicateDetected, a, map[string]interface{}{
+		"duplicate_of": b.Hex(),
+		"score":        score,
+		"reasons":      reasons,
+	})
+	return true
+}
+
+// ListDuplicateCandidates returns probable duplicate accounts for review,
+// highest score first
+func (s *userService) ListDuplicateCandidates(ctx context.Context, req *pb.ListDuplicateCandidatesMessageRequest) (*pb.ListDuplicateCandidatesMessageResponse, error) {
+	limit := int64(req.GetLimit())
+	if limit <= 0 || limit > maxDuplicatesListed {
+		limit = maxDuplicatesListed
+	}
+	state := req.GetStatus()
+	if state == "" {
+		state = duplicateOpen
+	}
+
+	cursor, err := s.collection(ctx, "duplicate_candidates").Find(ctx,
+		bson.M{"status": state, "score": bson.M{"$gte": req.GetMinScore()}},
+		options.Find().SetSort(bson.D{{Key: "score", Value: -1}, {Key: "detected_at", Value: 1}}).SetLimit(limit),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list duplicate candidates")
+	}
+	var candidates []DuplicateCandidate
+	if err := cursor.All(ctx, &candidates); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list duplicate candidates")
+	}
+
+	ids := make([]primitive.ObjectID, 0, 2*len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.UserA, c.UserB)
+	}
+	users := make(map[primitive.ObjectID]*pb.DuplicateUser)
+	if len(ids) > 0 {
+		cursor, err = s.collection(ctx, "users").Find(ctx, bson.M{"_id": bson.M{"$in": ids}},
+			options.Find().SetProjection(bson.M{"full_name": 1, "user_name": 1, "email": 1, "phone": 1, "created_at": 1}))
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to list duplicate candidates")
+		}
+		var found []User
+		if err := cursor.All(ctx, &found); err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to list duplicate candidates")
+		}
+		for i := range found {
+			users[found[i].ID] = &pb.DuplicateUser{
+				UserId:        found[i].ID.Hex(),
+				FullName:      found[i].FullName,
+				UserName:      found[i].UserName,
+				EmailAddress:  found[i].EmailAddress,
+				PhoneNumber:   found[i].PhoneNumber,
+				CreatedAtUnix: found[i].CreatedAt.Unix(),
+			}
+		}
+	}
+
+	resp := &pb.ListDuplicateCandidatesMessageResponse{}
+	for _, c := range candidates {
+		resp.Candidates = append(resp.Candidates, &pb.DuplicateCandidate{
+			Id:             c.ID.Hex(),
+			UserA:          users[c.UserA],
+			UserB:          users[c.UserB],
+			Score:          c.Score,
+			Reasons:        c.Reasons,
+			Status:         c.Status,
+			DetectedAtUnix: c.DetectedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// ResolveDuplicateCandidate closes a candidate once it has been merged or
+// judged a false positive. Later scans leave resolved pairs alone. A dry run
+// returns the change without applying it.
+func (s *userService) ResolveDuplicateCandidate(ctx context.Context, req *pb.ResolveDuplicateCandidateMessageRequest) (*pb.ResolveDuplicateCandidateMessageResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid duplicate candidate id")
+	}
+	resolution := req.GetResolution()
+	if resolution != duplicateMerged && resolution != duplicateDismissed {
+		return nil, status.Error(codes.InvalidArgument, "resolution must be merged or dismissed")
+	}
+
+	update := bson.M{"status": resolution, "updated_at": time.Now()}
+	if client := clientFromContext(ctx); client != nil {
+		update["resolved_by"] = client.Service
+	}
+	collection := s.collection(ctx, "duplicate_candidates")
+	if req.GetDryRun() {
+		var candidate bson.M
+		if err := collection.FindOne(ctx, bson.M{"_id": id, "status": duplicateOpen}).Decode(&candidate); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, status.Error(codes.NotFound, "open duplicate candidate not found")
+			}
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to resolve duplicate candidate")
+		}
+		after, err := simulateUpdate(candidate, bson.M{"$set": update})
+		if err != nil {
+			log.Printf("Failed to preview duplicate resolution: %v", err)
+			return nil, status.Error(codes.Internal, "failed to resolve duplicate candidate")
+		}
+		d := newDryRunDiff()
+		d.add("duplicate_candidates", id.Hex(), dryRunUpdate, diffDocuments(candidate, after, false)...)
+		return &pb.ResolveDuplicateCandidateMessageResponse{Message: "Dry run, nothing changed", Success: true, Diff: d.diff}, nil
+	}
+	err = collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "status": duplicateOpen}, bson.M{"$set": update}).Err()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "open duplicate candidate not found")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to resolve duplicate candidate")
+	}
+
+	return &pb.ResolveDuplicateCandidateMessageResponse{Message: "Duplicate candidate resolved", Success: true}, nil
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserExperimentAssigned = "user.experiment_assigned"
+
+	// Users are hashed into this many buckets before weights are applied
+	experimentBuckets = 10000
+)
+
+// ExperimentVariant is one arm of an experiment. Weights are relative.
+type ExperimentVariant struct {
+	Name   string  `yaml:"name"`
+	Weight float64 `yaml:"weight"`
+}
+
+// Experiment lists the variants users are split across
+type Experiment struct {
+	Variants []ExperimentVariant `yaml:"variants"`
+}
+
+// ExperimentAssignment is the variant a user was first placed in. Stored
+// assignments keep users in their variant when weights change later.
+type ExperimentAssignment struct {
+	UserID     primitive.ObjectID `bson:"user_id"`
+	Experiment string             `bson:"experiment"`
+	Variant    string             `bson:"variant"`
+	AssignedAt time.Time          `bson:"assigned_at"`
+}
+
+func validateExperiments(experiments map[string]Experiment, rollouts map[string]float64) error {
+	for key, e := range experiments {
+		if len(e.Variants) < 2 {
+			return fmt.Errorf("experiment %s needs at least two variants", key)
+		}
+		seen := make(map[string]bool)
+		for _, v := range e.Variants {
+			if v.Name == "" || seen[v.Name] {
+				return fmt.Errorf("experiment %s has an empty or duplicate variant name", key)
+			}
+			if v.Weight <= 0 {
+				return fmt.Errorf("variant %s of experiment %s needs a positive weight", v.Name, key)
+			}
+			seen[v.Name] = true
+		}
+	}
+	for flag, fraction := range rollouts {
+		if fraction < 0 || fraction > 1 {
+			return fmt.Errorf("rollout of flag %s must be between 0 and 1", flag)
+		}
+	}
+	return nil
+}
+
+// experimentBucket hashes a user into a stable bucket per key, so the same
+// user lands in independent buckets across experiments
+func experimentBucket(key string, userID primitive.ObjectID) int {
+	sum := sha256.Sum256([]byte(key + ":" + userID.Hex()))
+	return int(binary.BigEndian.Uint64(sum[:8]) % experimentBuckets)
+}
+
+// variantFor deterministically picks the variant of userID in experiment key
+func (e Experiment) variantFor(key string, userID primitive.ObjectID) string {
+	var total float64
+	for _, v := range e.Variants {
+		total += v.Weight
+	}
+	point := float64(experimentBucket(key, userID)) / experimentBuckets * total
+	for _, v := range e.Variants {
+		if point < v.Weight {
+			return v.Name
+		}
+		point -= v.Weight
+	}
+	return e.Variants[len(e.Variants)-1].Name
+}
+
+func (e Experiment) hasVariant(name string) bool {
+	for _, v := range e.Variants {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAssignments returns the user's variant in each running experiment,
+// assigning and storing it on first request, along with the per-user state
+// of flags under a gradual rollout
+func (s *userService) GetAssignments(ctx context.Context, req *pb.GetAssignmentsMessageRequest) (*pb.GetAssignmentsMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	tunables := s.config.get()
+
+	var keys []string
+	for _, key := range req.GetExperiments() {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		for key := range tunables.Experiments {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	// 1. Load what was assigned before
+	collection := s.collection(ctx, "experiment_assignments")
+	cursor, err := collection.Find(ctx, bson.M{"user_id": id, "experiment": bson.M{"$in": keys}})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load assignments")
+	}
+	var stored []ExperimentAssignment
+	if err := cursor.All(ctx, &stored); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load assignments")
+	}
+	existing := make(map[string]ExperimentAssignment, len(stored))
+	for _, a := range stored {
+		existing[a.Experiment] = a
+	}
+
+	// 2. Assign the rest. Variants dropped from the config are reassigned.
+	resp := &pb.GetAssignmentsMessageResponse{Flags: make(map[string]bool)}
+	now := time.Now()
+	for _, key := range keys {
+		experiment, ok := tunables.Experiments[key]
+		if !ok {
+			continue
+		}
+		a, ok := existing[key]
+		if !ok || !experiment.hasVariant(a.Variant) {
+			a = ExperimentAssignment{UserID: id, Experiment: key, Variant: experiment.variantFor(key, id), AssignedAt: now}
+			_, err := collection.UpdateOne(ctx,
+				bson.M{"user_id": id, "experiment": key},
+				bson.M{"$set": bson.M{"variant": a.Variant, "assigned_at": now}},
+				options.Update().SetUpsert(true),
+			)
+			if err != nil {
+				log.Printf("Failed to store experiment assignment: %v", err)
+				return nil, status.Error(codes.Internal, "failed to assign experiments")
+			}
+			s.recordEvent(ctx, eventUserExperimentAssigned, id, map[string]interface{}{"experiment": key, "variant": a.Variant, "assigned_at": now})
+		}
+		resp.Assignments = append(resp.Assignments, &pb.ExperimentAssignment{
+			Experiment:     key,
+			Variant:        a.Variant,
+			AssignedAtUnix: a.AssignedAt.Unix(),
+		})
+	}
+
+	for flag, fraction := range tunables.FlagRollouts {
+		resp.Flags[flag] = float64(experimentBucket("flag:"+flag, id)) < fraction*experimentBuckets
+	}
+	return resp, nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxFeedbackPerDay     = 5
+	maxFeedbackComment    = 2000
+	defaultFeedbackSource = "post_purchase"
+	defaultFeedbackDays   = 30
+	maxFeedbackDays       = 365
+)
+
+var feedbackSources = map[string]bool{
+	"post_purchase": true,
+	"post_support":  true,
+	"app":           true,
+}
+
+// Feedback is one NPS survey answer
+type Feedback struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID      primitive.ObjectID `bson:"user_id"`
+	Score       int32              `bson:"score"`
+	Comment     string             `bson:"comment,omitempty"`
+	Source      string             `bson:"source"`
+	OrderID     string             `bson:"order_id,omitempty"`
+	SubmittedAt time.Time          `bson:"submitted_at"`
+}
+
+// SubmitFeedback stores an NPS score and optional comment. Users may answer
+// once per order and a few times a day overall.
+func (s *userService) SubmitFeedback(ctx context.Context, req *pb.SubmitFeedbackMessageRequest) (*pb.SubmitFeedbackMessageResponse, error) {
+	// 1. Validate the answer
+	if req.GetScore() < 0 || req.GetScore() > 10 {
+		return nil, status.Error(codes.InvalidArgument, "score must be between 0 and 10")
+	}
+	comment := strings.TrimSpace(req.GetComment())
+	if utf8.RuneCountInString(comment) > maxFeedbackComment {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must be at most %d characters", maxFeedbackComment)
+	}
+	source := strings.ToLower(strings.TrimSpace(req.GetSource()))
+	if source == "" {
+		source = defaultFeedbackSource
+	}
+	if !feedbackSources[source] {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown feedback source %q", req.GetSource())
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Rate limit
+	collection := s.collection(ctx, "feedback")
+	now := time.Now()
+	recent, err := collection.CountDocuments(ctx, bson.M{"user_id": user.ID, "submitted_at": bson.M{"$gte": now.Add(-24 * time.Hour)}})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to store feedback")
+	}
+	if recent >= maxFeedbackPerDay {
+		return nil, status.Error(codes.ResourceExhausted, "too much feedback submitted today, try again tomorrow")
+	}
+
+	// 3. Store it
+	_, err = collection.InsertOne(ctx, Feedback{
+		UserID:      user.ID,
+		Score:       req.GetScore(),
+		Comment:     comment,
+		Source:      source,
+		OrderID:     strings.TrimSpace(req.GetOrderId()),
+		SubmittedAt: now,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "feedback for this order was already submitted")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to store feedback")
+	}
+
+	return &pb.SubmitFeedbackMessageResponse{Message: "Thanks for your feedback", Success: true}, nil
+}
+
+// GetFeedbackSummary computes the Net Promoter Score over recent answers:
+// the share of promoters (9-10) minus the share of detractors (0-6).
+func (s *userService) GetFeedbackSummary(ctx context.Context, req *pb.GetFeedbackSummaryMessageRequest) (*pb.GetFeedbackSummaryMessageResponse, error) {
+	days := int(req.GetDays())
+	if days <= 0 {
+		days = defaultFeedbackDays
+	}
+	if days > maxFeedbackDays {
+		return nil, status.Errorf(codes.InvalidArgument, "days must not exceed %d", maxFeedbackDays)
+	}
+
+	match := bson.M{"submitted_at": bson.M{"$gte": time.Now().AddDate(0, 0, -days)}}
+	if src := strings.ToLower(strings.TrimSpace(req.GetSource())); src != "" {
+		match["source"] = src
+	}
+
+	var totals []struct {
+		Responses  int64   `bson:"responses"`
+		Promoters  int64   `bson:"promoters"`
+		Passives   int64   `bson:"passives"`
+		Detractors int64   `bson:"detractors"`
+		Average    float64 `bson:"average"`
+		Comments   int64   `bson:"comments"`
+	}
+	err := aggregate(ctx, s.collection(ctx, "feedback"), mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        nil,
+			"responses":  bson.M{"$sum": 1},
+			"promoters":  bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gte": bson.A{"$score", 9}}, 1, 0}}},
+			"detractors": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$lte": bson.A{"$score", 6}}, 1, 0}}},
+			"passives": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$and": bson.A{
+				bson.M{"$gte": bson.A{"$score", 7}}, bson.M{"$lte": bson.A{"$score", 8}},
+			}}, 1, 0}}},
+			"average":  bson.M{"$avg": "$score"},
+			"comments": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{"$comment", ""}}, 1, 0}}},
+		}}},
+	}, &totals)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetFeedbackSummaryMessageResponse{Days: int32(days)}
+	if len(totals) == 0 || totals[0].Responses == 0 {
+		return resp, nil
+	}
+	t := totals[0]
+	resp.Responses = t.Responses
+	resp.Promoters = t.Promoters
+	resp.Passives = t.Passives
+	resp.Detractors = t.Detractors
+	resp.AverageScore = math.Round(t.Average*100) / 100
+	resp.Nps = math.Round(float64(t.Promoters-t.Detractors)*1000/float64(t.Responses)) / 10
+	resp.Comments = t.Comments
+	return resp, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bruceoaudo/userService/internal/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const geocodeTimeout = 5 * time.Second
+
+// NormalizedAddress holds the provider's canonical form of an address
+type NormalizedAddress struct {
+	FormattedAddress string    `bson:"formatted_address"`
+	Street           string    `bson:"street,omitempty"`
+	City             string    `bson:"city,omitempty"`
+	Region           string    `bson:"region,omitempty"`
+	PostalCode       string    `bson:"postal_code,omitempty"`
+	Country          string    `bson:"country"`
+	PlaceID          string    `bson:"place_id,omitempty"`
+	Provider         string    `bson:"provider"`
+	GeocodedAt       time.Time `bson:"geocoded_at"`
+}
+
+// GeoPoint is a GeoJSON point so addresses can be queried with a 2dsphere index
+type GeoPoint struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+func newGeoPoint(lat, lng float64) *GeoPoint {
+	return &GeoPoint{Type: "Point", Coordinates: []float64{lng, lat}}
+}
+
+func (p *GeoPoint) Latitude() float64  { return p.Coordinates[1] }
+func (p *GeoPoint) Longitude() float64 { return p.Coordinates[0] }
+
+// newGeocoder selects the address provider from GEOCODER. Addresses are
+// stored as entered when it is unset.
+func newGeocoder() (geo.Geocoder, error) {
+	switch strings.ToLower(os.Getenv("GEOCODER")) {
+	case "":
+		return nil, nil
+	case "google":
+		key := os.Getenv("GOOGLE_MAPS_API_KEY")
+		if key == "" {
+			return nil, fmt.Errorf("GOOGLE_MAPS_API_KEY is required for the google geocoder")
+		}
+		return &geo.Google{APIKey: key}, nil
+	case "nominatim", "osm":
+		n := &geo.Nominatim{BaseURL: os.Getenv("NOMINATIM_URL"), UserAgent: os.Getenv("NOMINATIM_USER_AGENT")}
+		if n.BaseURL == "" {
+			n.BaseURL = geo.DefaultNominatimURL
+		}
+		if n.UserAgent == "" {
+			n.UserAgent = "ai-shop-user-service"
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("unknown GEOCODER %q", os.Getenv("GEOCODER"))
+	}
+}
+
+// geocodeAddress validates addr with the configured provider and attaches
+// its normalized components and coordinates. Addresses the provider cannot
+// place are rejected; provider outages only skip geocoding so saves keep working.
+func (s *userService) geocodeAddress(ctx context.Context, addr *BillingAddress) error {
+	if s.geocoder == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, geocodeTimeout)
+	defer cancel()
+	res, err := s.geocoder.Geocode(ctx, geo.Query{
+		Line1:      addr.Line1,
+		Line2:      addr.Line2,
+		City:       addr.City,
+		Region:     addr.Region,
+		PostalCode: addr.PostalCode,
+		Country:    addr.Country,
+	})
+	if errors.Is(err, geo.ErrNoMatch) {
+		return status.Error(codes.InvalidArgument, "address could not be found")
+	}
+	if err != nil {
+		log.Printf("Geocoding with %s failed, saving address unverified: %v", s.geocoder.Name(), err)
+		return nil
+	}
+	if res.Country != "" && res.Country != addr.Country {
+		return status.Errorf(codes.InvalidArgument, "address is located in %s, not %s", res.Country, addr.Country)
+	}
+
+	addr.Normalized = &NormalizedAddress{
+		FormattedAddress: res.FormattedAddress,
+		Street:           res.Street,
+		City:             res.City,
+		Region:           res.Region,
+		PostalCode:       res.PostalCode,
+		Country:          addr.Country,
+		PlaceID:          res.PlaceID,
+		Provider:         s.geocoder.Name(),
+		GeocodedAt:       time.Now(),
+	}
+	addr.Location = newGeoPoint(res.Latitude, res.Longitude)
+	return nil
+}
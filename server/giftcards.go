@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/promotions"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxGiftCardsPerUser = 20
+
+// UserGiftCard links a gift card code to an account. The balance fields
+// cache the last answer from the promotions service.
+type UserGiftCard struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	UserID           primitive.ObjectID `bson:"user_id"`
+	Code             string             `bson:"code"`
+	Status           string             `bson:"status"`
+	BalanceMinor     int64              `bson:"balance_minor"`
+	Currency         string             `bson:"currency"`
+	ExpiresAt        *time.Time         `bson:"expires_at,omitempty"`
+	AttachedAt       time.Time          `bson:"attached_at"`
+	BalanceCheckedAt time.Time          `bson:"balance_checked_at"`
+}
+
+// newPromotionsClient returns nil when PROMOTIONS_URL is unset
+func newPromotionsClient() *promotions.Client {
+	baseURL := os.Getenv("PROMOTIONS_URL")
+	if baseURL == "" {
+		return nil
+	}
+	return &promotions.Client{BaseURL: baseURL, APIKey: os.Getenv("PROMOTIONS_API_KEY")}
+}
+
+func normalizeGiftCardCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
+
+func giftCardToProto(c *UserGiftCard) *pb.GiftCard {
+	msg := &pb.GiftCard{
+		Id:                   c.ID.Hex(),
+		MaskedCode:           maskValue(c.Code, 4),
+		Status:               c.Status,
+		BalanceMinor:         c.BalanceMinor,
+		Currency:             c.Currency,
+		AttachedAtUnix:       c.AttachedAt.Unix(),
+		BalanceCheckedAtUnix: c.BalanceCheckedAt.Unix(),
+	}
+	if c.ExpiresAt != nil {
+		msg.ExpiresAtUnix = c.ExpiresAt.Unix()
+	}
+	return msg
+}
+
+// AttachGiftCard validates a code with the promotions service and adds it
+// to the user's account. A card can only belong to one account.
+func (s *userService) AttachGiftCard(ctx context.Context, req *pb.AttachGiftCardMessageRequest) (*pb.AttachGiftCardMessageResponse, error) {
+	if s.promotions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "gift cards are not available")
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	code := normalizeGiftCardCode(req.GetCode())
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "gift card code is required")
+	}
+
+	// 1. Validate the card
+	card, err := s.promotions.GiftCard(ctx, code)
+	if errors.Is(err, promotions.ErrNotFound) {
+		return nil, status.Error(codes.InvalidArgument, "invalid gift card code")
+	}
+	if err != nil {
+		log.Printf("Promotions service error: %v", err)
+		return nil, status.Error(codes.Unavailable, "gift card validation unavailable")
+	}
+	if card.Status != promotions.GiftCardActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "gift card is %s", card.Status)
+	}
+
+	// 2. Link it to the account
+	collection := s.collection(ctx, "gift_cards")
+	count, err := collection.CountDocuments(ctx, bson.M{"user_id": user.ID})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to attach gift card")
+	}
+	if count >= maxGiftCardsPerUser {
+		return nil, status.Error(codes.ResourceExhausted, "too many gift cards on this account")
+	}
+
+	now := time.Now()
+	gc := UserGiftCard{
+		ID:               primitive.NewObjectID(),
+		UserID:           user.ID,
+		Code:             code,
+		Status:           card.Status,
+		BalanceMinor:     card.BalanceMinor,
+		Currency:         card.Currency,
+		ExpiresAt:        card.ExpiresAt,
+		AttachedAt:       now,
+		BalanceCheckedAt: now,
+	}
+	if _, err := collection.InsertOne(ctx, gc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "gift card is already attached to an account")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to attach gift card")
+	}
+
+	return &pb.AttachGiftCardMessageResponse{GiftCard: giftCardToProto(&gc)}, nil
+}
+
+// ListGiftCards returns the user's cards with their last known balances
+func (s *userService) ListGiftCards(ctx context.Context, req *pb.ListGiftCardsMessageRequest) (*pb.ListGiftCardsMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.collection(ctx, "gift_cards").Find(ctx, bson.M{"user_id": id},
+		options.Find().SetSort(bson.D{{Key: "attached_at", Value: -1}}),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list gift cards")
+	}
+	var cards []UserGiftCard
+	if err := cursor.All(ctx, &cards); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list gift cards")
+	}
+
+	resp := &pb.ListGiftCardsMessageResponse{}
+	for i := range cards {
+		resp.GiftCards = append(resp.GiftCards, giftCardToProto(&cards[i]))
+	}
+	return resp, nil
+}
+
+// GetGiftCardBalance fetches the live balance of one of the user's cards for checkout
+func (s *userService) GetGiftCardBalance(ctx context.Context, req *pb.GetGiftCardBalanceMessageRequest) (*pb.GetGiftCardBalanceMessageResponse, error) {
+	if s.promotions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "gift cards are not available")
+	}
+	userID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	cardID, err := primitive.ObjectIDFromHex(req.GetGiftCardId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid gift card id")
+	}
+
+	collection := s.collection(ctx, "gift_cards")
+	var gc UserGiftCard
+	err = collection.FindOne(ctx, bson.M{"_id": cardID, "user_id": userID}).Decode(&gc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "gift card not found")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load gift card")
+	}
+
+	card, err := s.promotions.GiftCard(ctx, gc.Code)
+	if err != nil {
+		log.Printf("Promotions service error: %v", err)
+		return nil, status.Error(codes.Unavailable, "gift card balance unavailable")
+	}
+
+	gc.Status, gc.BalanceMinor, gc.Currency, gc.ExpiresAt = card.Status, card.BalanceMinor, card.Currency, card.ExpiresAt
+	gc.BalanceCheckedAt = time.Now()
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": gc.ID}, bson.M{"$set": bson.M{
+		"status":             gc.Status,
+		"balance_minor":      gc.BalanceMinor,
+		"currency":           gc.Currency,
+		"expires_at":         gc.ExpiresAt,
+		"balance_checked_at": gc.BalanceCheckedAt,
+	}})
+	if err != nil {
+		log.Printf("Failed to cache gift card balance: %v", err)
+	}
+
+	return &pb.GetGiftCardBalanceMessageResponse{GiftCard: giftCardToProto(&gc)}, nil
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/graph"
+	"github.com/bruceoaudo/userService/internal/graph/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const graphqlComplexityLimit = 500
+
+// graphBackend resolves GraphQL fields. Fields that have an RPC are loaded by
+// calling it through the interceptor chain, so scopes, PII redaction and the
+// audit log apply exactly as they do for gRPC callers.
+type graphBackend struct {
+	svc   *userService
+	unary grpc.UnaryServerInterceptor
+}
+
+// invoke calls a UserService method by name through the interceptor chain
+func (b *graphBackend) invoke(ctx context.Context, method string, req proto.Message) (interface{}, error) {
+	for _, m := range pb.UserService_ServiceDesc.Methods {
+		if m.MethodName != method {
+			continue
+		}
+		dec := func(v interface{}) error {
+			proto.Merge(v.(proto.Message), req)
+			return nil
+		}
+		return m.Handler(b.svc, ctx, dec, b.unary)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "unknown method %s", method)
+}
+
+func (b *graphBackend) User(ctx context.Context, id string) (*model.User, error) {
+	user, err := b.svc.findUserByID(ctx, id)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	tier := user.Tier
+	if tier == "" {
+		tier = defaultTier
+	}
+	prefs := &model.Preferences{
+		Locale:        user.Locale,
+		Timezone:      user.Timezone,
+		Notifications: []*model.NotificationPreference{},
+		Consents:      []*model.Consent{},
+	}
+	for kind, channels := range user.NotificationPrefs {
+		prefs.Notifications = append(prefs.Notifications, &model.NotificationPreference{Kind: kind, Channels: channels})
+	}
+	sort.Slice(prefs.Notifications, func(i, j int) bool { return prefs.Notifications[i].Kind < prefs.Notifications[j].Kind })
+	for purpose, granted := range user.Consents {
+		prefs.Consents = append(prefs.Consents, &model.Consent{Purpose: purpose, Granted: granted})
+	}
+	sort.Slice(prefs.Consents, func(i, j int) bool { return prefs.Consents[i].Purpose < prefs.Consents[j].Purpose })
+
+	return &model.User{
+		ID:            user.ID.Hex(),
+		FullName:      user.FullName,
+		UserName:      user.UserName,
+		Email:         user.EmailAddress,
+		Phone:         user.PhoneNumber,
+		Tier:          tier,
+		EmailVerified: user.EmailVerifiedAt != nil,
+		CreatedAt:     user.CreatedAt,
+		LastLoginAt:   user.LastLoginAt,
+		Preferences:   prefs,
+	}, nil
+}
+
+// Addresses returns the billing address as served by GetBillingProfile,
+// redacted unless the caller holds billing.pii
+func (b *graphBackend) Addresses(ctx context.Context, userID string) ([]*model.Address, error) {
+	out, err := b.invoke(ctx, "GetBillingProfile", &pb.GetBillingProfileMessageRequest{UserId: userID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return []*model.Address{}, nil
+		}
+		return nil, err
+	}
+
+	resp := out.(*pb.GetBillingProfileMessageResponse)
+	addr := resp.GetBillingAddress()
+	return []*model.Address{{
+		Kind:             "billing",
+		RecipientName:    addr.GetRecipientName(),
+		Line1:            addr.GetLine1(),
+		Line2:            addr.GetLine2(),
+		City:             addr.GetCity(),
+		Region:           addr.GetRegion(),
+		PostalCode:       addr.GetPostalCode(),
+		Country:          addr.GetCountry(),
+		County:           addr.GetCounty(),
+		SubCounty:        addr.GetSubCounty(),
+		Landmark:         addr.GetLandmark(),
+		FormattedAddress: addr.GetFormattedAddress(),
+		Redacted:         resp.GetRedacted(),
+	}}, nil
+}
+
+// Sessions reports the live presence session of a user. It is empty when
+// presence tracking is not configured.
+func (b *graphBackend) Sessions(ctx context.Context, userID string) ([]*model.Session, error) {
+	out, err := b.invoke(ctx, "GetPresence", &pb.GetPresenceMessageRequest{UserIds: []string{userID}})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return []*model.Session{}, nil
+		}
+		return nil, err
+	}
+
+	sessions := []*model.Session{}
+	for _, p := range out.(*pb.GetPresenceMessageResponse).GetPresences() {
+		session := &model.Session{State: p.GetStatus(), Online: p.GetOnline()}
+		if p.GetLastSeenUnix() > 0 {
+			seen := time.Unix(p.GetLastSeenUnix(), 0)
+			session.LastSeenAt = &seen
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// graphqlError exposes the gRPC status code of a failed resolver as an
+// error extension
+func graphqlError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	var unwrapped *gqlerror.Error
+	if errors.As(err, &unwrapped) && unwrapped.Err != nil {
+		err = unwrapped.Err
+	}
+	if st, ok := status.FromError(err); ok {
+		gqlErr.Message = st.Message()
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		gqlErr.Extensions["code"] = st.Code().String()
+	}
+	return gqlErr
+}
+
+// newGraphQLHandler serves the user graph over HTTP POST and GET. Callers
+// authenticate like gRPC clients and need the users.read scope.
+func newGraphQLHandler(svc *userService, clients *clientRegistry, unary grpc.UnaryServerInterceptor) http.Handler {
+	schema := graph.NewExecutableSchema(graph.Config{
+		Resolvers: &graph.Resolver{Backend: &graphBackend{svc: svc, unary: unary}},
+	})
+	srv := handler.New(schema)
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(graphqlComplexityLimit))
+	srv.SetErrorPresenter(graphqlError)
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", requireHTTPScope(clients, scopeUsersRead, srv))
+	return withHTTPPeer(mux)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// setServing reports the service status to gRPC health checks, both for the
+// UserService and for the server as a whole
+func (s *userService) setServing(serving bool) {
+	st := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		st = healthpb.HealthCheckResponse_SERVING
+	}
+	s.health.SetServingStatus("", st)
+	s.health.SetServingStatus(pb.UserService_ServiceDesc.ServiceName, st)
+}
+
+const (
+	healthCheckInterval = 5 * time.Second
+	// healthFailureThreshold consecutive failed pings mark the service
+	// NOT_SERVING, so a single slow ping does not pull the pod out of the
+	// load balancer
+	healthFailureThreshold = 2
+)
+
+// runHealthMonitor keeps gRPC health in step with MongoDB once startup is
+// done: the service goes NOT_SERVING while pings fail and back to SERVING
+// when they succeed again. Probes and load balancers then stop routing to
+// a pod that has lost its database. It stops once shutdown begins.
+func (s *userService) runHealthMonitor(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	failures := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if s.draining.Load() {
+			return
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, mongoPingTimeout)
+		err := s.db.Ping(pingCtx, nil)
+		cancel()
+		if s.draining.Load() {
+			return
+		}
+		switch {
+		case err == nil && failures >= healthFailureThreshold:
+			log.Printf("MongoDB is reachable again, serving")
+			s.setServing(true)
+		case err != nil && failures+1 == healthFailureThreshold:
+			log.Printf("MongoDB ping failed %d times, not serving: %v", healthFailureThreshold, err)
+			s.setServing(false)
+		}
+		if err != nil {
+			failures++
+		} else {
+			failures = 0
+		}
+	}
+}
+
+// mongoIsReady reports whether startup against MongoDB has completed
+func (s *userService) mongoIsReady() bool {
+	select {
+	case <-s.mongoReady:
+		return true
+	default:
+		return false
+	}
+}
+
+// startJob runs a background job until shutdown cancels its context
+func (s *userService) startJob(job func(context.Context)) {
+	s.jobsDone.Add(1)
+	go func() {
+		defer s.jobsDone.Done()
+		job(s.jobs)
+	}()
+}
+
+// whenMongoReady starts a background job once startup against MongoDB has
+// completed
+func (s *userService) whenMongoReady(job func(context.Context)) {
+	s.startJob(func(ctx context.Context) {
+		select {
+		case <-s.mongoReady:
+			job(ctx)
+		case <-ctx.Done():
+		}
+	})
+}
+
+// needsMongo reports whether a method reads or writes the database. Health
+// checks and server info answer while MongoDB is still connecting.
+func needsMongo(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+pb.UserService_ServiceDesc.ServiceName+"/") &&
+		fullMethod != pb.UserService_GetServerInfo_FullMethodName &&
+		fullMethod != pb.UserService_GetSLOStatus_FullMethodName
+}
+
+// readinessInterceptor fails calls fast while MongoDB is not ready
+func readinessInterceptor(s *userService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if needsMongo(info.FullMethod) && !s.mongoIsReady() {
+			return nil, status.Error(codes.Unavailable, "service is starting")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// readinessStreamInterceptor does the same for streams
+func readinessStreamInterceptor(s *userService) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if needsMongo(info.FullMethod) && !s.mongoIsReady() {
+			return status.Error(codes.Unavailable, "service is starting")
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHistoryListed = 50
+	maxHistoryListed     = 200
+)
+
+// historyIgnoredFields change as a side effect of normal use rather than by
+// anyone editing the profile, so they are left out of the history
+var historyIgnoredFields = []string{
+	"schema_version",
+	"search_keys",
+	"last_login_at",
+	"risk",
+	"profile_nudges",
+	"generated_avatar",
+	"email_deliverability",
+	"phone_reachability",
+	"phone_type",
+	"ussd.failed_attempts",
+	"ussd.locked_until",
+}
+
+// historyRedactedFields are recorded as changed without their values
+var historyRedactedFields = []string{
+	"billing.payment_token",
+	"billing.tax_ids",
+	"tax.kra_pin",
+}
+
+// ProfileChange is one field of a user document changing, stored in the
+// profile_history collection. Nested fields use dotted paths.
+type ProfileChange struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID      primitive.ObjectID `bson:"user_id"`
+	Field       string             `bson:"field"`
+	Old         string             `bson:"old,omitempty"`
+	New         string             `bson:"new,omitempty"`
+	Actor       string             `bson:"actor"`
+	ActorUserID string             `bson:"actor_user_id,omitempty"`
+	Method      string             `bson:"method"`
+	At          time.Time          `bson:"at"`
+}
+
+// flattenDocument maps nested documents to dotted paths so a change to one
+// billing field is not recorded as a change to the whole billing profile
+func flattenDocument(prefix string, doc bson.M, out bson.M) bson.M {
+	for k, v := range doc {
+		if nested, ok := v.(bson.M); ok && len(nested) > 0 {
+			flattenDocument(prefix+k+".", nested, out)
+			continue
+		}
+		out[prefix+k] = v
+	}
+	return out
+}
+
+func fieldListed(fields []string, field string) bool {
+	for _, f := range fields {
+		if field == f || strings.HasPrefix(field, f+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// userImage loads the raw user document, or nil when there is none
+func (s *userService) userImage(ctx context.Context, id primitive.ObjectID) bson.M {
+	var doc bson.M
+	if err := s.collection(ctx, "users").FindOne(ctx, bson.M{"_id": id}).Decode(&doc); err != nil {
+		return nil
+	}
+	return doc
+}
+
+// changeActor identifies who made a change: the calling service, or the
+// gateway, and the user whose token was forwarded, if any
+func (s *userService) changeActor(ctx context.Context) (string, string) {
+	actor := actorGateway
+	if client := clientFromContext(ctx); client != nil {
+		actor = client.Service
+	}
+	return actor, s.bearerSubject(ctx)
+}
+
+// recordProfileChanges stores one history entry per field that differs
+// between two images of a user. Failures are logged; the change itself has
+// already been written.
+func (s *userService) recordProfileChanges(ctx context.Context, userID primitive.ObjectID, before, after bson.M, actor, actorUserID, method string) {
+	now := time.Now()
+	var entries []interface{}
+	for _, c := range diffDocuments(flattenDocument("", before, bson.M{}), flattenDocument("", after, bson.M{}), false) {
+		if fieldListed(historyIgnoredFields, c.Path) {
+			continue
+		}
+		if strings.HasSuffix(c.Path, "_hash") || fieldListed(historyRedactedFields, c.Path) {
+			c.Before, c.After = redactedDiffValue(c.Before), redactedDiffValue(c.After)
+		}
+		entries = append(entries, ProfileChange{
+			UserID:      userID,
+			Field:       c.Path,
+			Old:         c.Before,
+			New:         c.After,
+			Actor:       actor,
+			ActorUserID: actorUserID,
+			Method:      method,
+			At:          now,
+		})
+	}
+	if len(entries) == 0 {
+		return
+	}
+	if _, err := s.collection(ctx, "profile_history").InsertMany(ctx, entries); err != nil {
+		log.Printf("Failed to record profile history for %s: %v", userID.Hex(), err)
+	}
+}
+
+// profileHistoryInterceptor records what every mutating user-scoped RPC
+// changed on the target user by comparing the document before and after
+// the call. This costs two reads per write, but catches changes made by
+// handlers that emit no event.
+func profileHistoryInterceptor(s *userService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		scoped, ok := req.(userScopedRequest)
+		if !ok || readOnlyMethods[info.FullMethod] || !needsMongo(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		id, err := primitive.ObjectIDFromHex(scoped.GetUserId())
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		before := s.userImage(ctx, id)
+		resp, err := handler(ctx, req)
+		if before != nil {
+			ctx := context.WithoutCancel(ctx)
+			if after := s.userImage(ctx, id); after != nil {
+				actor, actorUserID := s.changeActor(ctx)
+				s.recordProfileChanges(ctx, id, before, after, actor, actorUserID, info.FullMethod)
+			}
+		}
+		return resp, err
+	}
+}
+
+// GetProfileHistory pages through the changes made to a user's profile,
+// newest first, optionally for one field and the fields nested under it.
+// The page token is the id of the last change of the previous page.
+func (s *userService) GetProfileHistory(ctx context.Context, req *pb.GetProfileHistoryMessageRequest) (*pb.GetProfileHistoryMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetPageSize())
+	if limit <= 0 {
+		limit = defaultHistoryListed
+	}
+	if limit > maxHistoryListed {
+		limit = maxHistoryListed
+	}
+
+	filter := bson.M{"user_id": id}
+	if field := strings.TrimSpace(req.GetField()); field != "" {
+		filter["field"] = bson.M{"$regex": "^" + regexp.QuoteMeta(field) + `(\.|$)`}
+	}
+	if req.GetPageToken() != "" {
+		after, err := primitive.ObjectIDFromHex(req.GetPageToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		filter["_id"] = bson.M{"$lt": after}
+	}
+
+	cursor, err := s.collection(ctx, "profile_history").Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(limit))
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load profile history")
+	}
+	var changes []ProfileChange
+	if err := cursor.All(ctx, &changes); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load profile history")
+	}
+
+	resp := &pb.GetProfileHistoryMessageResponse{}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &pb.ProfileChange{
+			Field:         c.Field,
+			OldValue:      c.Old,
+			NewValue:      c.New,
+			Actor:         c.Actor,
+			ActorUserId:   c.ActorUserID,
+			Method:        c.Method,
+			ChangedAtUnix: c.At.Unix(),
+		})
+	}
+	if int64(len(changes)) == limit {
+		resp.NextPageToken = changes[len(changes)-1].ID.Hex()
+	}
+	return resp, nil
+}
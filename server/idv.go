@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/idv"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserIdentityVerified = "user.identity_verified"
+	eventUserIdentityFailed   = "user.identity_failed"
+
+	defaultIDVCountry   = "KE"
+	maxIDVCallbackBytes = 1 << 20
+	idvCallbackTimeout  = 10 * time.Second
+)
+
+// IdentityVerification is the latest identity check of a user
+type IdentityVerification struct {
+	Provider    string     `bson:"provider"`
+	SessionID   string     `bson:"session_id"`
+	Status      idv.Status `bson:"status"`
+	Reason      string     `bson:"reason,omitempty"`
+	StartedAt   time.Time  `bson:"started_at"`
+	CompletedAt *time.Time `bson:"completed_at,omitempty"`
+}
+
+// newIDVProvider selects the identity verification vendor from IDV_PROVIDER.
+// Verification stays disabled when it is unset.
+func newIDVProvider() (idv.Provider, error) {
+	callbackURL := strings.TrimRight(os.Getenv("PUBLIC_BASE_URL"), "/") + "/webhooks/idv"
+
+	switch strings.ToLower(os.Getenv("IDV_PROVIDER")) {
+	case "":
+		return nil, nil
+
+	case "onfido":
+		p := &idv.Onfido{
+			BaseURL:      os.Getenv("ONFIDO_API_URL"),
+			APIToken:     os.Getenv("ONFIDO_API_TOKEN"),
+			WebhookToken: os.Getenv("ONFIDO_WEBHOOK_TOKEN"),
+			WorkflowID:   os.Getenv("ONFIDO_WORKFLOW_ID"),
+		}
+		if p.BaseURL == "" {
+			p.BaseURL = idv.DefaultOnfidoURL
+		}
+		if p.APIToken == "" || p.WebhookToken == "" || p.WorkflowID == "" {
+			return nil, fmt.Errorf("ONFIDO_API_TOKEN, ONFIDO_WEBHOOK_TOKEN and ONFIDO_WORKFLOW_ID are required for onfido")
+		}
+		return p, nil
+
+	case "smile_identity":
+		p := &idv.SmileIdentity{
+			BaseURL:     os.Getenv("SMILE_API_URL"),
+			PartnerID:   os.Getenv("SMILE_PARTNER_ID"),
+			APIKey:      os.Getenv("SMILE_API_KEY"),
+			CallbackURL: callbackURL,
+			CompanyName: "AI-Shop",
+		}
+		if p.BaseURL == "" {
+			p.BaseURL = idv.DefaultSmileIdentityURL
+		}
+		if p.PartnerID == "" || p.APIKey == "" {
+			return nil, fmt.Errorf("SMILE_PARTNER_ID and SMILE_API_KEY are required for smile_identity")
+		}
+		return p, nil
+
+	default:
+		return nil, fmt.Errorf("unknown IDV_PROVIDER %q", os.Getenv("IDV_PROVIDER"))
+	}
+}
+
+// StartIdentityVerification opens a session with the verification provider
+// before a high-risk action. Users who are already verified get no new session.
+func (s *userService) StartIdentityVerification(ctx context.Context, req *pb.StartIdentityVerificationMessageRequest) (*pb.StartIdentityVerificationMessageResponse, error) {
+	if s.idv == nil {
+		return nil, status.Error(codes.FailedPrecondition, "identity verification is not configured")
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.Identity != nil && user.Identity.Status == idv.StatusVerified {
+		return &pb.StartIdentityVerificationMessageResponse{Status: string(idv.StatusVerified)}, nil
+	}
+
+	country := strings.ToUpper(strings.TrimSpace(req.GetCountry()))
+	if country == "" {
+		country = defaultIDVCountry
+	}
+	session, err := s.idv.StartSession(ctx, idv.Applicant{
+		UserID:   user.ID.Hex(),
+		FullName: user.FullName,
+		Email:    user.EmailAddress,
+		Phone:    user.PhoneNumber,
+		Country:  country,
+	})
+	if err != nil {
+		log.Printf("Failed to start %s verification: %v", s.idv.Name(), err)
+		return nil, status.Error(codes.Unavailable, "identity verification provider unavailable")
+	}
+
+	verification := IdentityVerification{
+		Provider:  s.idv.Name(),
+		SessionID: session.ID,
+		Status:    idv.StatusPending,
+		StartedAt: time.Now(),
+	}
+	_, err = s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$set": bson.M{"identity": verification, "updated_at": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start identity verification")
+	}
+
+	return &pb.StartIdentityVerificationMessageResponse{
+		SessionId:       session.ID,
+		VerificationUrl: session.URL,
+		Status:          string(idv.StatusPending),
+	}, nil
+}
+
+// GetIdentityVerification reports the latest identity check of a user
+func (s *userService) GetIdentityVerification(ctx context.Context, req *pb.GetIdentityVerificationMessageRequest) (*pb.GetIdentityVerificationMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.Identity == nil {
+		return &pb.GetIdentityVerificationMessageResponse{Status: "not_started"}, nil
+	}
+
+	resp := &pb.GetIdentityVerificationMessageResponse{
+		Status:        string(user.Identity.Status),
+		Provider:      user.Identity.Provider,
+		StartedAtUnix: user.Identity.StartedAt.Unix(),
+		Reason:        user.Identity.Reason,
+	}
+	if user.Identity.CompletedAt != nil {
+		resp.CompletedAtUnix = user.Identity.CompletedAt.Unix()
+	}
+	return resp, nil
+}
+
+// idvWebhookHandler receives result callbacks from the verification provider
+func (s *userService) idvWebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxIDVCallbackBytes))
+		if err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		result, err := s.idv.ParseCallback(r.Header, body)
+		switch {
+		case errors.Is(err, idv.ErrIgnoredCallback):
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case errors.Is(err, idv.ErrInvalidSignature):
+			log.Printf("Rejected %s callback with invalid signature", s.idv.Name())
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		case err != nil:
+			log.Printf("Failed to parse %s callback: %v", s.idv.Name(), err)
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), idvCallbackTimeout)
+		defer cancel()
+		if err := s.applyIdentityResult(ctx, result); err != nil {
+			log.Printf("Failed to record %s result for session %s: %v", s.idv.Name(), result.SessionID, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// applyIdentityResult stores the provider's decision on the user owning the session
+func (s *userService) applyIdentityResult(ctx context.Context, result idv.Result) error {
+	collection := s.collection(ctx, "users")
+	filter := bson.M{"identity.provider": s.idv.Name(), "identity.session_id": result.SessionID}
+
+	var user User
+	if err := collection.FindOne(ctx, filter).Decode(&user); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	set := bson.M{"identity.status": result.Status, "identity.reason": result.Reason, "updated_at": now}
+	if result.Status != idv.StatusReview {
+		set["identity.completed_at"] = now
+	}
+	if _, err := collection.UpdateOne(ctx, filter, bson.M{"$set": set}); err != nil {
+		return err
+	}
+
+	switch result.Status {
+	case idv.StatusVerified:
+		s.recordEvent(ctx, eventUserIdentityVerified, user.ID, map[string]interface{}{"provider": s.idv.Name(), "verified_at": now})
+	case idv.StatusFailed:
+		s.recordEvent(ctx, eventUserIdentityFailed, user.ID, map[string]interface{}{"provider": s.idv.Name(), "reason": result.Reason})
+	}
+	return nil
+}
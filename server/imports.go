@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/legacyimport"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	operationUserImport = "user_import"
+	eventUserImported   = "user.imported"
+
+	importCheckpointRows = 200
+	importReportURLTTL   = 7 * 24 * time.Hour
+)
+
+// importDateLayouts are the timestamp formats seen in legacy dumps
+var importDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02", "02/01/2006"}
+
+type userImportParams struct {
+	SourceKey string               `bson:"source_key"`
+	Format    string               `bson:"format"`
+	Mapping   legacyimport.Mapping `bson:"mapping"`
+	DryRun    bool                 `bson:"dry_run,omitempty"`
+}
+
+// ImportRowError records why a row of an import was rejected
+type ImportRowError struct {
+	OperationID string `bson:"operation_id" json:"-"`
+	Row         int    `bson:"row" json:"row"`
+	LegacyID    string `bson:"legacy_id,omitempty" json:"legacy_id,omitempty"`
+	Field       string `bson:"field,omitempty" json:"field,omitempty"`
+	Error       string `bson:"error" json:"error"`
+}
+
+type importRowError struct {
+	field string
+	msg   string
+}
+
+func (e *importRowError) Error() string { return e.msg }
+
+func rowError(field, format string, args ...interface{}) *importRowError {
+	return &importRowError{field: field, msg: fmt.Sprintf(format, args...)}
+}
+
+func init() {
+	operationRunners[operationUserImport] = (*userService).runUserImport
+}
+
+// StartUserImport loads users from a dump of the previous shop platform
+// that was uploaded to object storage. Rows are mapped with the given
+// mapping (or the default one), normalized and validated; rejected rows are
+// listed in a downloadable report when the operation finishes.
+func (s *userService) StartUserImport(ctx context.Context, req *pb.StartUserImportMessageRequest) (*pb.StartUserImportMessageResponse, error) {
+	sourceKey := strings.TrimSpace(req.GetSourceKey())
+	if sourceKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "source key is required")
+	}
+	format := strings.ToLower(req.GetFormat())
+	if format != legacyimport.FormatCSV && format != legacyimport.FormatJSON {
+		return nil, status.Error(codes.InvalidArgument, "format must be csv or json")
+	}
+
+	mapping := legacyimport.DefaultMapping
+	if req.GetMappingJson() != "" {
+		var err error
+		mapping, err = legacyimport.ParseMapping([]byte(req.GetMappingJson()))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	op, err := s.startOperation(ctx, operationUserImport, userImportParams{
+		SourceKey: sourceKey,
+		Format:    format,
+		Mapping:   mapping,
+		DryRun:    req.GetDryRun(),
+	})
+	if err != nil {
+		log.Printf("Failed to queue user import: %v", err)
+		return nil, status.Error(codes.Internal, "failed to queue user import")
+	}
+	return &pb.StartUserImportMessageResponse{Operation: operationToProto(op)}, nil
+}
+
+// runUserImport processes the dump row by row. Re-running, or resuming
+// after a restart, is safe: rows whose legacy ID was already imported are
+// skipped.
+func (s *userService) runUserImport(ctx context.Context, op *Operation) (map[string]string, error) {
+	var params userImportParams
+	if err := bson.Unmarshal(op.Params, &params); err != nil {
+		return nil, err
+	}
+	data, err := s.store.Get(ctx, params.SourceKey)
+	if err != nil {
+		return nil, fmt.Errorf("read dump %s: %w", params.SourceKey, err)
+	}
+	if op.Progress == nil {
+		op.Progress = map[string]int64{}
+	}
+	resumeAfter := op.Progress["rows"]
+
+	errorsColl := s.collection(ctx, "import_row_errors")
+	opID := op.ID.Hex()
+
+	err = legacyimport.Read(bytes.NewReader(data), params.Format, func(row int, rec legacyimport.Record, parseErr error) error {
+		if int64(row) <= resumeAfter {
+			return nil
+		}
+
+		var legacyID string
+		var outcome error = parseErr
+		if outcome == nil {
+			fields := params.Mapping.Apply(rec)
+			legacyID = fields[legacyimport.FieldLegacyID]
+			var imported bool
+			imported, outcome = s.importUser(ctx, fields, params.DryRun)
+			switch {
+			case outcome != nil:
+			case imported:
+				op.Progress["imported"]++
+			default:
+				op.Progress["skipped"]++
+			}
+		}
+		if outcome != nil {
+			op.Progress["failed"]++
+			entry := ImportRowError{OperationID: opID, Row: row, LegacyID: legacyID, Error: outcome.Error()}
+			if re, ok := outcome.(*importRowError); ok {
+				entry.Field = re.field
+			}
+			_, err := errorsColl.ReplaceOne(ctx, bson.M{"operation_id": opID, "row": row}, entry, options.Replace().SetUpsert(true))
+			if err != nil {
+				return err
+			}
+		}
+
+		op.Progress["rows"] = int64(row)
+		if row%importCheckpointRows == 0 {
+			return s.checkpointOperation(ctx, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{
+		"rows":     strconv.FormatInt(op.Progress["rows"], 10),
+		"imported": strconv.FormatInt(op.Progress["imported"], 10),
+		"skipped":  strconv.FormatInt(op.Progress["skipped"], 10),
+		"failed":   strconv.FormatInt(op.Progress["failed"], 10),
+		"dry_run":  strconv.FormatBool(params.DryRun),
+	}
+	if op.Progress["failed"] > 0 {
+		url, err := s.writeImportReport(ctx, opID)
+		if err != nil {
+			return nil, err
+		}
+		result["error_report_url"] = url
+	}
+	return result, nil
+}
+
+// importUser validates a mapped row and inserts it. It reports false when
+// the legacy ID was imported before.
+func (s *userService) importUser(ctx context.Context, fields map[string]string, dryRun bool) (bool, error) {
+	legacyID := fields[legacyimport.FieldLegacyID]
+	if legacyID == "" {
+		return false, rowError(legacyimport.FieldLegacyID, "legacy id is missing")
+	}
+
+	now := time.Now()
+	user := User{
+		LegacyID:     legacyID,
+		FullName:     fields[legacyimport.FieldFullName],
+		UserName:     fields[legacyimport.FieldUserName],
+		EmailAddress: fields[legacyimport.FieldEmail],
+		PhoneNumber:  normalizePhoneNumber(strings.TrimPrefix(strings.NewReplacer("-", "", "(", "", ")", "").Replace(fields[legacyimport.FieldPhone]), "+")),
+		Gender:       fields[legacyimport.FieldGender],
+		Locale:       fields[legacyimport.FieldLocale],
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	// 1. Validate and normalize
+	if user.FullName == "" {
+		return false, rowError(legacyimport.FieldFullName, "full name is required")
+	}
+	if user.UserName == "" {
+		user.UserName = legacyUserName(user.EmailAddress, legacyID)
+	}
+	if len(user.UserName) < 4 || !isAlphanumeric(user.UserName) {
+		return false, rowError(legacyimport.FieldUserName, "username %q must be at least 4 letters or numbers", user.UserName)
+	}
+	if isUSSDUserName(user.UserName) {
+		return false, rowError(legacyimport.FieldUserName, "username %q is reserved for USSD accounts", user.UserName)
+	}
+	if !strings.Contains(user.EmailAddress, "@") || !strings.Contains(user.EmailAddress, ".") {
+		return false, rowError(legacyimport.FieldEmail, "invalid email %q", user.EmailAddress)
+	}
+	if len(user.PhoneNumber) != 12 || !strings.HasPrefix(user.PhoneNumber, "254") {
+		return false, rowError(legacyimport.FieldPhone, "phone %q is not a Kenyan number", fields[legacyimport.FieldPhone])
+	}
+	user.PhoneType = detectPhoneType(user.PhoneNumber)
+	if v := fields[legacyimport.FieldCreatedAt]; v != "" {
+		t, err := parseImportTime(v)
+		if err != nil {
+			return false, rowError(legacyimport.FieldCreatedAt, "unrecognized date %q", v)
+		}
+		user.CreatedAt = t
+	}
+	if v := fields[legacyimport.FieldDateOfBirth]; v != "" {
+		t, err := parseImportTime(v)
+		if err != nil {
+			return false, rowError(legacyimport.FieldDateOfBirth, "unrecognized date %q", v)
+		}
+		user.DateOfBirth = &t
+	}
+	if v := fields[legacyimport.FieldTags]; v != "" {
+		for _, tag := range strings.Split(v, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				user.Tags = append(user.Tags, tag)
+			}
+		}
+	}
+
+	// Hashes from schemes this service cannot verify are dropped, and users
+	// without a usable hash must reset their password on first login
+	if hash := fields[legacyimport.FieldPasswordHash]; hash != "" {
+		if _, err := s.passwords.Identify(hash); err == nil {
+			user.PasswordHash = hash
+		}
+	}
+	user.PasswordResetRequired = user.PasswordHash == ""
+	user.SearchKeys = searchKeys(&user)
+	user.SchemaVersion = currentUserSchemaVersion
+
+	// 2. Insert unless the row was imported before
+	collection := s.collection(ctx, "users")
+	if dryRun {
+		count, err := collection.CountDocuments(ctx, bson.M{"legacy_id": legacyID})
+		if err != nil {
+			return false, err
+		}
+		return count == 0, nil
+	}
+
+	res, err := collection.InsertOne(ctx, user)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			count, countErr := collection.CountDocuments(ctx, bson.M{"legacy_id": legacyID})
+			if countErr == nil && count > 0 {
+				return false, nil
+			}
+			return false, rowError("", "a user with this email, username or phone already exists")
+		}
+		return false, err
+	}
+
+	s.recordEvent(ctx, eventUserImported, res.InsertedID.(primitive.ObjectID), map[string]interface{}{
+		"legacy_id":  legacyID,
+		"user_name":  user.UserName,
+		"created_at": user.CreatedAt,
+	})
+	return true, nil
+}
+
+// legacyUserName derives a username for legacy accounts that never had one
+func legacyUserName(email, legacyID string) string {
+	local := email
+	if at := strings.LastIndex(email, "@"); at > 0 {
+		local = email[:at]
+	}
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, local+legacyID)
+	return name
+}
+
+func parseImportTime(v string) (time.Time, error) {
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	for _, layout := range importDateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", v)
+}
+
+// writeImportReport stores the rejected rows as JSON lines and links them
+func (s *userService) writeImportReport(ctx context.Context, opID string) (string, error) {
+	cursor, err := s.collection(ctx, "import_row_errors").Find(ctx,
+		bson.M{"operation_id": opID},
+		options.Find().SetSort(bson.D{{Key: "row", Value: 1}}),
+	)
+	if err != nil {
+		return "", err
+	}
+	var rows []ImportRowError
+	if err := cursor.All(ctx, &rows); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, r := range rows {
+		line, _ := json.Marshal(r)
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	key := "imports/" + opID + "/errors.jsonl"
+	if err := s.store.Put(ctx, key, buf.Bytes(), "application/x-ndjson"); err != nil {
+		return "", err
+	}
+	return s.store.SignedURL(ctx, key, importReportURLTTL)
+}
@@ -0,0 +1,315 @@
+//go:build integration
+
+// Integration tests against a real MongoDB started with testcontainers-go.
+// They need Docker and are left out of the default build:
+//
+//	go test -tags integration ./server/
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/config"
+	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// integration is the server shared by the tests in this file
+var integration struct {
+	svc    *userService
+	client pb.UserServiceClient
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(runIntegration(m))
+}
+
+func runIntegration(m *testing.M) int {
+	ctx := context.Background()
+	container, err := mongodb.Run(ctx, "mongo:7")
+	if err != nil {
+		log.Printf("Failed to start MongoDB container: %v", err)
+		return 1
+	}
+	defer container.Terminate(ctx)
+	uri, err := container.ConnectionString(ctx)
+	if err != nil {
+		log.Printf("Failed to get MongoDB connection string: %v", err)
+		return 1
+	}
+
+	svc, err := newIntegrationService(uri)
+	if err != nil {
+		log.Printf("Failed to set up service: %v", err)
+		return 1
+	}
+	startCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go svc.runStartup(startCtx)
+
+	// Boot the gRPC server the way main does, on a random port
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		log.Printf("Failed to listen: %v", err)
+		return 1
+	}
+	apiClients := &clientRegistry{}
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMessageBytes),
+		grpc.ChainUnaryInterceptor(
+			readinessInterceptor(svc),
+			maintenanceInterceptor(svc.config),
+			apiKeyInterceptor(apiClients),
+			limitsInterceptor(),
+		),
+	)
+	pb.RegisterUserServiceServer(server, svc)
+	healthpb.RegisterHealthServer(server, svc.health)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Printf("Failed to dial server: %v", err)
+		return 1
+	}
+	defer conn.Close()
+	if err := waitForServing(ctx, healthpb.NewHealthClient(conn)); err != nil {
+		log.Printf("Server never became ready: %v", err)
+		return 1
+	}
+
+	integration.svc = svc
+	integration.client = pb.NewUserServiceClient(conn)
+	return m.Run()
+}
+
+func newIntegrationService(uri string) (*userService, error) {
+	mongoCfg := config.Default().Mongo
+	mongoCfg.URI = uri
+	svc, err := NewUserService(mongoCfg)
+	if err != nil {
+		return nil, err
+	}
+	if svc.config, err = newRuntimeConfig(); err != nil {
+		return nil, err
+	}
+	if svc.passwords, err = newPasswordRegistry(); err != nil {
+		return nil, err
+	}
+	if svc.tokens, err = newTokenIssuer(); err != nil {
+		return nil, err
+	}
+	if svc.pow, err = newHashcash(); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+// waitForServing polls gRPC health until startup built the indexes
+func waitForServing(ctx context.Context, health healthpb.HealthClient) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	for {
+		resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err == nil && resp.GetStatus() == healthpb.HealthCheckResponse_SERVING {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
+var registrations atomic.Int64
+
+// uniqueRegistration returns a registration no other test uses. Every run
+// gets a fresh container, so a counter is enough.
+func uniqueRegistration() *pb.RegisterMessageRequest {
+	n := registrations.Add(1)
+	return &pb.RegisterMessageRequest{
+		FullName:     "Integration User",
+		UserName:     fmt.Sprintf("it%06d", n),
+		EmailAddress: fmt.Sprintf("it%06d@example.com", n),
+		PhoneNumber:  fmt.Sprintf("2547%08d", n),
+		Password:     "correct horse battery",
+	}
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	req := uniqueRegistration()
+
+	reg, err := integration.client.RegisterUser(ctx, req)
+	if err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+	if !reg.GetSuccess() {
+		t.Fatalf("RegisterUser() = %+v", reg)
+	}
+
+	login, err := integration.client.LoginUser(ctx, &pb.LoginMessageRequest{Email: req.EmailAddress, Password: req.Password})
+	if err != nil {
+		t.Fatalf("LoginUser: %v", err)
+	}
+	claims, err := integration.svc.tokens.Parse(login.GetAccessToken(), "")
+	if err != nil {
+		t.Fatalf("access token does not parse: %v", err)
+	}
+	if claims.Subject != login.GetUserId() {
+		t.Errorf("token subject = %q, want %q", claims.Subject, login.GetUserId())
+	}
+
+	_, err = integration.client.LoginUser(ctx, &pb.LoginMessageRequest{Email: req.EmailAddress, Password: "wrong password"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("LoginUser with wrong password: %v, want Unauthenticated", err)
+	}
+
+	refreshed, err := integration.client.RefreshToken(ctx, &pb.RefreshTokenMessageRequest{RefreshToken: login.GetRefreshToken()})
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	_, err = integration.client.RefreshToken(ctx, &pb.RefreshTokenMessageRequest{RefreshToken: login.GetRefreshToken()})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("reusing a refresh token: %v, want Unauthenticated", err)
+	}
+	_, err = integration.client.RefreshToken(ctx, &pb.RefreshTokenMessageRequest{RefreshToken: refreshed.GetRefreshToken()})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("refreshing a session revoked for reuse: %v, want Unauthenticated", err)
+	}
+}
+
+func TestRegisterDuplicates(t *testing.T) {
+	ctx := context.Background()
+	base := uniqueRegistration()
+	if _, err := integration.client.RegisterUser(ctx, base); err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *pb.RegisterMessageRequest, other *pb.RegisterMessageRequest)
+	}{
+		{"email differing in case", func(r, other *pb.RegisterMessageRequest) { r.EmailAddress = "IT" + other.EmailAddress[2:] }},
+		{"username differing in case", func(r, other *pb.RegisterMessageRequest) { r.UserName = "IT" + other.UserName[2:] }},
+		{"phone in local format", func(r, other *pb.RegisterMessageRequest) { r.PhoneNumber = "0" + other.PhoneNumber[3:] }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := uniqueRegistration()
+			tt.modify(req, base)
+			_, err := integration.client.RegisterUser(ctx, req)
+			if status.Code(err) != codes.AlreadyExists {
+				t.Fatalf("RegisterUser: %v, want AlreadyExists", err)
+			}
+		})
+	}
+}
+
+// TestUniqueIndexes bypasses the lookup in RegisterUser to check the
+// indexes themselves refuse duplicates, which is what protects concurrent
+// registrations
+func TestUniqueIndexes(t *testing.T) {
+	ctx := context.Background()
+	users := integration.svc.collection(ctx, "users")
+	req := uniqueRegistration()
+	if _, err := users.InsertOne(ctx, User{UserName: req.UserName, EmailAddress: req.EmailAddress, PhoneNumber: req.PhoneNumber}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	other := uniqueRegistration()
+	tests := []struct {
+		name string
+		user User
+	}{
+		{"email differing in case", User{UserName: other.UserName, EmailAddress: "IT" + req.EmailAddress[2:], PhoneNumber: other.PhoneNumber}},
+		{"username differing in case", User{UserName: "IT" + req.UserName[2:], EmailAddress: other.EmailAddress, PhoneNumber: other.PhoneNumber}},
+		{"phone", User{UserName: other.UserName, EmailAddress: other.EmailAddress, PhoneNumber: req.PhoneNumber}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := users.InsertOne(ctx, tt.user)
+			if !mongo.IsDuplicateKeyError(err) {
+				t.Fatalf("insert: %v, want a duplicate key error", err)
+			}
+		})
+	}
+
+	// Accounts without an email, like USSD ones, do not collide
+	for i := 0; i < 2; i++ {
+		u := uniqueRegistration()
+		if _, err := users.InsertOne(ctx, User{UserName: u.UserName, PhoneNumber: u.PhoneNumber}); err != nil {
+			t.Fatalf("insert user without email: %v", err)
+		}
+	}
+}
+
+func TestConcurrentRegistrations(t *testing.T) {
+	ctx := context.Background()
+	base := uniqueRegistration()
+
+	const attempts = 8
+	var wg sync.WaitGroup
+	codesSeen := make([]codes.Code, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Same email, otherwise distinct, so only the email index can
+			// tell them apart once they pass the lookup together
+			req := uniqueRegistration()
+			req.EmailAddress = base.EmailAddress
+			_, err := integration.client.RegisterUser(ctx, req)
+			codesSeen[i] = status.Code(err)
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, code := range codesSeen {
+		switch code {
+		case codes.OK:
+			created++
+		case codes.AlreadyExists:
+		default:
+			t.Errorf("RegisterUser: unexpected code %v", code)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d registrations with the same email succeeded, want 1", created)
+	}
+}
+
+func TestProofOfWorkReplay(t *testing.T) {
+	ctx := context.Background()
+	const subject = "replay@example.com"
+	token, err := integration.svc.pow.Issue(8, subject, time.Now())
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	solution := solvePow(token, 8)
+
+	if err := integration.svc.checkProofOfWork(ctx, token, solution, subject); err != nil {
+		t.Fatalf("first redemption: %v", err)
+	}
+	if err := integration.svc.checkProofOfWork(ctx, token, solution, subject); !errors.Is(err, errPowReplayed) {
+		t.Fatalf("second redemption error = %v, want %v", err, errPowReplayed)
+	}
+}
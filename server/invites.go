@@ -0,0 +1,384 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserReferred = "user.referred"
+
+	inviteKindOrg      = "org"
+	inviteKindReferral = "referral"
+
+	defaultInviteTTL    = 7 * 24 * time.Hour
+	maxInviteTTL        = 90 * 24 * time.Hour
+	maxInviteUses       = 1000
+	maxInvitesPerDay    = 50
+	inviteSignatureSize = 16
+)
+
+var errInvalidInvite = status.Error(codes.InvalidArgument, "invalid or expired invitation")
+
+// Invite is a pending invitation. Email invites are bound to one address
+// and used once; link invites can be shared and used up to MaxUses times.
+type Invite struct {
+	ID         primitive.ObjectID   `bson:"_id,omitempty"`
+	Kind       string               `bson:"kind"`
+	OrgID      primitive.ObjectID   `bson:"org_id,omitempty"`
+	Role       string               `bson:"role,omitempty"`
+	InviterID  primitive.ObjectID   `bson:"inviter_id"`
+	Email      string               `bson:"email,omitempty"`
+	FullName   string               `bson:"full_name,omitempty"`
+	MaxUses    int                  `bson:"max_uses"`
+	Uses       int                  `bson:"uses"`
+	AcceptedBy []primitive.ObjectID `bson:"accepted_by,omitempty"`
+	CreatedAt  time.Time            `bson:"created_at"`
+	ExpiresAt  time.Time            `bson:"expires_at"`
+}
+
+// inviteSigner turns invite ids into tamper-proof tokens. A token is the id
+// and expiry followed by a truncated HMAC, so unknown tokens are rejected
+// without a database lookup.
+type inviteSigner struct {
+	key     []byte
+	baseURL string
+}
+
+// newInviteSigner reads INVITE_SIGNING_KEY and INVITE_BASE_URL, the page
+// that receives ?invite=<token> and pre-fills registration
+func newInviteSigner() (*inviteSigner, error) {
+	key := []byte(os.Getenv("INVITE_SIGNING_KEY"))
+	if len(key) == 0 {
+		log.Printf("INVITE_SIGNING_KEY not set, invitations will not survive a restart")
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+	return &inviteSigner{key: key, baseURL: os.Getenv("INVITE_BASE_URL")}, nil
+}
+
+func (s *inviteSigner) mac(payload []byte) []byte {
+	m := hmac.New(sha256.New, s.key)
+	m.Write(payload)
+	return m.Sum(nil)[:inviteSignatureSize]
+}
+
+func (s *inviteSigner) sign(inv *Invite) string {
+	payload := make([]byte, 12+8)
+	copy(payload, inv.ID[:])
+	binary.BigEndian.PutUint64(payload[12:], uint64(inv.ExpiresAt.Unix()))
+	return base64.RawURLEncoding.EncodeToString(append(payload, s.mac(payload)...))
+}
+
+// verify returns the invite id of a token that is authentic and unexpired
+func (s *inviteSigner) verify(tok string) (primitive.ObjectID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(tok))
+	if err != nil || len(raw) != 12+8+inviteSignatureSize {
+		return primitive.NilObjectID, errInvalidInvite
+	}
+	payload, sig := raw[:20], raw[20:]
+	if !hmac.Equal(sig, s.mac(payload)) {
+		return primitive.NilObjectID, errInvalidInvite
+	}
+	if time.Now().Unix() > int64(binary.BigEndian.Uint64(payload[12:])) {
+		return primitive.NilObjectID, errInvalidInvite
+	}
+	var id primitive.ObjectID
+	copy(id[:], payload[:12])
+	return id, nil
+}
+
+func (s *inviteSigner) url(tok string) string {
+	if s.baseURL == "" {
+		return ""
+	}
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("invite", tok)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func (s *userService) inviteToProto(ctx context.Context, inv *Invite) *pb.Invite {
+	msg := &pb.Invite{
+		Id:            inv.ID.Hex(),
+		Kind:          inv.Kind,
+		Role:          inv.Role,
+		InviterUserId: inv.InviterID.Hex(),
+		EmailAddress:  inv.Email,
+		FullName:      inv.FullName,
+		ExpiresAtUnix: inv.ExpiresAt.Unix(),
+		MaxUses:       int32(inv.MaxUses),
+		Uses:          int32(inv.Uses),
+	}
+	if inviter, err := s.findUserByID(ctx, inv.InviterID.Hex()); err == nil {
+		msg.InviterName = inviter.FullName
+	}
+	if !inv.OrgID.IsZero() {
+		msg.OrgId = inv.OrgID.Hex()
+		if org, err := s.findOrganization(ctx, inv.OrgID); err == nil {
+			msg.OrgName = org.Name
+		}
+	}
+	return msg
+}
+
+// notifyInvitee emails an invitation to an address that may not have an
+// account yet
+func (s *userService) notifyInvitee(email, name string, data map[string]string) {
+	data["name"] = name
+	if data["name"] == "" {
+		data["name"] = "there"
+	}
+	msg, err := notify.Render(notify.KindInvite, data)
+	if err != nil {
+		log.Printf("Failed to render %s notification: %v", notify.KindInvite, err)
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+		defer cancel()
+		if err := s.notifier.Dispatch(ctx, notify.Recipient{Name: name, Email: email}, msg); err != nil {
+			log.Printf("Failed to deliver invitation: %v", err)
+		}
+	}()
+}
+
+// CreateInvite issues a signed invitation to join an organization or, as a
+// referral, the shop itself. With an email address the invitation is bound
+// to it and sent; without one the link can be shared.
+func (s *userService) CreateInvite(ctx context.Context, req *pb.CreateInviteMessageRequest) (*pb.CreateInviteMessageResponse, error) {
+	// 1. Validate input
+	inviter, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	ttl := defaultInviteTTL
+	if req.GetTtlSeconds() > 0 {
+		ttl = time.Duration(req.GetTtlSeconds()) * time.Second
+	}
+	if ttl > maxInviteTTL {
+		return nil, status.Error(codes.InvalidArgument, "invitations can be valid for at most 90 days")
+	}
+	email := strings.TrimSpace(req.GetEmailAddress())
+	if email != "" && (!strings.Contains(email, "@") || !strings.Contains(email, ".")) {
+		return nil, status.Error(codes.InvalidArgument, "invalid email format")
+	}
+	maxUses := int(req.GetMaxUses())
+	switch {
+	case email != "":
+		maxUses = 1
+	case maxUses <= 0:
+		maxUses = 1
+	case maxUses > maxInviteUses:
+		return nil, status.Errorf(codes.InvalidArgument, "invitations can be used at most %d times", maxInviteUses)
+	}
+
+	now := time.Now()
+	inv := Invite{
+		ID:        primitive.NewObjectID(),
+		Kind:      strings.ToLower(strings.TrimSpace(req.GetKind())),
+		InviterID: inviter.ID,
+		Email:     email,
+		FullName:  strings.TrimSpace(req.GetFullName()),
+		MaxUses:   maxUses,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	// 2. Check the inviter may invite for this purpose
+	switch inv.Kind {
+	case inviteKindOrg:
+		if inv.OrgID, err = parseOrgID(req.GetOrgId()); err != nil {
+			return nil, err
+		}
+		if inv.Role, err = parseOrgRole(req.GetRole()); err != nil {
+			return nil, err
+		}
+		actor, err := s.requireOrgManager(ctx, inv.OrgID, inviter.ID.Hex())
+		if err != nil {
+			return nil, err
+		}
+		if (inv.Role == orgRoleOwner || inv.Role == orgRoleAdmin) && actor.Role != orgRoleOwner {
+			return nil, status.Error(codes.PermissionDenied, "only owners can grant the owner or admin role")
+		}
+	case inviteKindReferral:
+		if inviter.ParentID != nil {
+			return nil, status.Error(codes.FailedPrecondition, "sub-accounts cannot refer new users")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "kind must be org or referral")
+	}
+
+	collection := s.collection(ctx, "invites")
+	count, err := collection.CountDocuments(ctx, bson.M{"inviter_id": inviter.ID, "created_at": bson.M{"$gte": now.Add(-24 * time.Hour)}})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create invitation")
+	}
+	if count >= maxInvitesPerDay {
+		return nil, status.Error(codes.ResourceExhausted, "too many invitations today")
+	}
+
+	// 3. Store, sign and send it
+	if _, err := collection.InsertOne(ctx, inv); err != nil {
+		log.Printf("Failed to create invitation: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create invitation")
+	}
+	tok := s.invites.sign(&inv)
+	link := s.invites.url(tok)
+	msg := s.inviteToProto(ctx, &inv)
+
+	if email != "" {
+		target := "AI-Shop"
+		if msg.OrgName != "" {
+			target = msg.OrgName
+		}
+		s.notifyInvitee(email, inv.FullName, map[string]string{"inviter": inviter.FullName, "target": target, "link": link})
+	}
+
+	return &pb.CreateInviteMessageResponse{
+		Invite:  msg,
+		Token:   tok,
+		Url:     link,
+		Message: "Invitation created",
+		Success: true,
+	}, nil
+}
+
+// loadInvite verifies a token and loads the invitation it names
+func (s *userService) loadInvite(ctx context.Context, tok string) (*Invite, error) {
+	id, err := s.invites.verify(tok)
+	if err != nil {
+		return nil, err
+	}
+	var inv Invite
+	err = s.collection(ctx, "invites").FindOne(ctx, bson.M{"_id": id}).Decode(&inv)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errInvalidInvite
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if inv.Uses >= inv.MaxUses || time.Now().After(inv.ExpiresAt) {
+		return nil, errInvalidInvite
+	}
+	return &inv, nil
+}
+
+// GetInvite describes an invitation so the registration form can be
+// pre-filled with the invitee's name and email
+func (s *userService) GetInvite(ctx context.Context, req *pb.GetInviteMessageRequest) (*pb.GetInviteMessageResponse, error) {
+	inv, err := s.loadInvite(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetInviteMessageResponse{Invite: s.inviteToProto(ctx, inv)}, nil
+}
+
+// redeemInvite attaches user to the organization or referrer of an invite
+func (s *userService) redeemInvite(ctx context.Context, tok string, user *User) (*Invite, error) {
+	// 1. Check the user may use it
+	inv, err := s.loadInvite(ctx, tok)
+	if err != nil {
+		return nil, err
+	}
+	if inv.Email != "" && !strings.EqualFold(inv.Email, user.EmailAddress) {
+		return nil, status.Error(codes.PermissionDenied, "this invitation was sent to another email address")
+	}
+	if inv.InviterID == user.ID {
+		return nil, status.Error(codes.InvalidArgument, "you cannot accept your own invitation")
+	}
+	if inv.Kind == inviteKindReferral && (user.ReferredBy != nil || user.CreatedAt.Before(inv.CreatedAt)) {
+		return nil, status.Error(codes.FailedPrecondition, "referrals are only for new accounts")
+	}
+
+	// 2. Take one use
+	res, err := s.collection(ctx, "invites").UpdateOne(ctx,
+		bson.M{"_id": inv.ID, "uses": bson.M{"$lt": inv.MaxUses}, "accepted_by": bson.M{"$ne": user.ID}},
+		bson.M{"$inc": bson.M{"uses": 1}, "$push": bson.M{"accepted_by": user.ID}},
+	)
+	if err != nil {
+		log.Printf("Failed to redeem invitation: %v", err)
+		return nil, status.Error(codes.Internal, "failed to accept invitation")
+	}
+	if res.ModifiedCount == 0 {
+		return nil, errInvalidInvite
+	}
+	inv.Uses++
+
+	// 3. Attach the user
+	now := time.Now()
+	switch inv.Kind {
+	case inviteKindOrg:
+		_, err = s.collection(ctx, "org_members").UpdateOne(ctx,
+			bson.M{"org_id": inv.OrgID, "user_id": user.ID},
+			bson.M{
+				"$set":         bson.M{"role": inv.Role, "status": memberStatusActive, "joined_at": now},
+				"$setOnInsert": bson.M{"invited_by": inv.InviterID, "invited_at": inv.CreatedAt},
+			},
+			options.Update().SetUpsert(true),
+		)
+		if err == nil {
+			s.recordEvent(ctx, eventUserOrgJoined, user.ID, map[string]interface{}{"org_id": inv.OrgID.Hex(), "role": inv.Role})
+		}
+	case inviteKindReferral:
+		_, err = s.collection(ctx, "users").UpdateOne(ctx,
+			bson.M{"_id": user.ID, "referred_by": nil},
+			bson.M{"$set": bson.M{"referred_by": inv.InviterID, "updated_at": now}},
+		)
+		if err == nil {
+			s.recordEvent(ctx, eventUserReferred, user.ID, map[string]interface{}{"referrer_id": inv.InviterID.Hex(), "invite_id": inv.ID.Hex()})
+		}
+	default:
+		err = errors.New("unknown invite kind " + inv.Kind)
+	}
+	if err != nil {
+		log.Printf("Failed to apply invitation %s: %v", inv.ID.Hex(), err)
+		return nil, status.Error(codes.Internal, "failed to accept invitation")
+	}
+	return inv, nil
+}
+
+// AcceptInvite redeems an invitation for a signed-in user. Registration
+// redeems the invite token it is given the same way.
+func (s *userService) AcceptInvite(ctx context.Context, req *pb.AcceptInviteMessageRequest) (*pb.AcceptInviteMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	inv, err := s.redeemInvite(ctx, req.GetToken(), user)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AcceptInviteMessageResponse{
+		Invite:  s.inviteToProto(ctx, inv),
+		Message: "Invitation accepted",
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/scan"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserKYCSubmitted = "user.kyc_submitted"
+	eventUserKYCApproved  = "user.kyc_approved"
+	eventUserKYCRejected  = "user.kyc_rejected"
+
+	maxKYCDocumentBytes = 10 << 20
+	kycReviewURLTTL     = 15 * time.Minute
+	defaultKYCQueueSize = 20
+	maxKYCQueueSize     = 100
+)
+
+// Seller lifecycle driven by KYC review
+const (
+	sellerStatusPending  = "kyc_pending"
+	sellerStatusVerified = "verified"
+	sellerStatusRejected = "rejected"
+)
+
+// Review state of a single document
+const (
+	kycDocPending  = "pending"
+	kycDocApproved = "approved"
+	kycDocRejected = "rejected"
+)
+
+// kycDocumentTypes lists accepted documents. A seller needs one proof of
+// identity and a business registration certificate.
+var kycDocumentTypes = map[string]bool{
+	"national_id":          true,
+	"passport":             true,
+	"business_certificate": true,
+}
+
+var kycContentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+type KYCDocument struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID      primitive.ObjectID `bson:"user_id"`
+	Type        string             `bson:"type"`
+	FileName    string             `bson:"file_name"`
+	ContentType string             `bson:"content_type"`
+	Key         string             `bson:"key"`
+	Size        int64              `bson:"size"`
+	SHA256      string             `bson:"sha256"`
+	Status      string             `bson:"status"`
+	UploadedAt  time.Time          `bson:"uploaded_at"`
+	ReviewedAt  *time.Time         `bson:"reviewed_at,omitempty"`
+	Reviewer    string             `bson:"reviewer,omitempty"`
+	Reason      string             `bson:"reason,omitempty"`
+}
+
+// errInfected is matched by the error of a documentScanner that found
+// malware. scan.Signature extracts what it found.
+var errInfected = scan.ErrInfected
+
+// documentScanner inspects uploads before they are stored
+type documentScanner interface {
+	Scan(ctx context.Context, name string, data []byte) error
+}
+
+// noopScanner accepts every document. It is used until a scanner is configured.
+type noopScanner struct{}
+
+func (noopScanner) Scan(context.Context, string, []byte) error { return nil }
+
+// newDocumentScanner picks the scanner named by VIRUS_SCANNER: clamd, at
+// CLAMD_ADDRESS, or icap, at ICAP_URL
+func newDocumentScanner() (documentScanner, error) {
+	switch strings.ToLower(os.Getenv("VIRUS_SCANNER")) {
+	case "":
+		return noopScanner{}, nil
+	case "clamd", "clamav":
+		addr := os.Getenv("CLAMD_ADDRESS")
+		if addr == "" {
+			addr = "localhost:3310"
+		}
+		return &scan.Clamd{Address: addr}, nil
+	case "icap":
+		u := os.Getenv("ICAP_URL")
+		if u == "" {
+			return nil, fmt.Errorf("ICAP_URL is required for the icap scanner")
+		}
+		return &scan.ICAP{URL: u}, nil
+	default:
+		return nil, fmt.Errorf("unknown VIRUS_SCANNER %q", os.Getenv("VIRUS_SCANNER"))
+	}
+}
+
+// UploadKYCDocument receives a seller document as a stream: the first
+// message carries its metadata and the rest carry file chunks. Once proof of
+// identity and a business certificate are on file the seller joins the
+// review queue.
+func (s *userService) UploadKYCDocument(stream grpc.ClientStreamingServer[pb.UploadKYCDocumentMessageRequest, pb.UploadKYCDocumentMessageResponse]) error {
+	ctx := stream.Context()
+
+	// 1. Read and validate the metadata
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "document metadata is required")
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry document metadata")
+	}
+	docType := strings.ToLower(strings.TrimSpace(info.GetDocumentType()))
+	if !kycDocumentTypes[docType] {
+		return status.Errorf(codes.InvalidArgument, "unsupported document type %q", info.GetDocumentType())
+	}
+	ext, ok := kycContentTypes[info.GetContentType()]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "document must be a PDF, JPEG or PNG")
+	}
+	user, err := s.findUserByID(ctx, info.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// 2. Collect the file
+	var buf bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if buf.Len()+len(msg.GetChunk()) > maxKYCDocumentBytes {
+			return status.Error(codes.InvalidArgument, "document exceeds 10 MB")
+		}
+		buf.Write(msg.GetChunk())
+	}
+	if buf.Len() == 0 {
+		return status.Error(codes.InvalidArgument, "document is empty")
+	}
+
+	// 3. Scan and store it
+	if err := s.scanner.Scan(ctx, info.GetFileName(), buf.Bytes()); err != nil {
+		if errors.Is(err, errInfected) {
+			s.quarantineUpload(ctx, user, uploadKindKYC, info.GetFileName(), info.GetContentType(), buf.Bytes(), err)
+			return status.Error(codes.InvalidArgument, "document failed virus scan")
+		}
+		log.Printf("Virus scan failed: %v", err)
+		return status.Error(codes.Unavailable, "document scanning unavailable, try again later")
+	}
+
+	docID := primitive.NewObjectID()
+	key := "kyc/" + user.ID.Hex() + "/" + docID.Hex() + ext
+	if err := s.store.Put(ctx, key, buf.Bytes(), info.GetContentType()); err != nil {
+		log.Printf("Failed to store KYC document: %v", err)
+		return status.Error(codes.Internal, "failed to store document")
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	doc := KYCDocument{
+		ID:          docID,
+		UserID:      user.ID,
+		Type:        docType,
+		FileName:    path.Base(info.GetFileName()),
+		ContentType: info.GetContentType(),
+		Key:         key,
+		Size:        int64(buf.Len()),
+		SHA256:      hex.EncodeToString(sum[:]),
+		Status:      kycDocPending,
+		UploadedAt:  time.Now(),
+	}
+	if _, err := s.collection(ctx, "kyc_documents").InsertOne(ctx, doc); err != nil {
+		log.Printf("Database error: %v", err)
+		return status.Error(codes.Internal, "failed to store document")
+	}
+
+	// 4. Queue the seller for review once the set is complete
+	sellerStatus := user.SellerStatus
+	if sellerStatus != sellerStatusVerified {
+		complete, err := s.kycSubmissionComplete(ctx, user.ID)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return status.Error(codes.Internal, "internal server error")
+		}
+		if complete && sellerStatus != sellerStatusPending {
+			now := time.Now()
+			_, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+				"$set":   bson.M{"seller_status": sellerStatusPending, "kyc_submitted_at": now, "updated_at": now},
+				"$unset": bson.M{"kyc_rejection_reason": ""},
+			})
+			if err != nil {
+				log.Printf("Failed to queue KYC review: %v", err)
+				return status.Error(codes.Internal, "internal server error")
+			}
+			sellerStatus = sellerStatusPending
+			s.recordEvent(ctx, eventUserKYCSubmitted, user.ID, map[string]interface{}{"submitted_at": now})
+		}
+	}
+
+	return stream.SendAndClose(&pb.UploadKYCDocumentMessageResponse{
+		DocumentId:   docID.Hex(),
+		SellerStatus: sellerStatus,
+		Message:      "Document uploaded",
+		Success:      true,
+	})
+}
+
+// kycSubmissionComplete reports whether a user has a pending or approved
+// proof of identity and business certificate on file
+func (s *userService) kycSubmissionComplete(ctx context.Context, userID primitive.ObjectID) (bool, error) {
+	types, err := s.collection(ctx, "kyc_documents").Distinct(ctx, "type", bson.M{
+		"user_id": userID,
+		"status":  bson.M{"$in": []string{kycDocPending, kycDocApproved}},
+	})
+	if err != nil {
+		return false, err
+	}
+	have := make(map[string]bool)
+	for _, t := range types {
+		if name, ok := t.(string); ok {
+			have[name] = true
+		}
+	}
+	return (have["national_id"] || have["passport"]) && have["business_certificate"], nil
+}
+
+// ListKYCReviewQueue returns sellers awaiting review, oldest submission first,
+// with short-lived links to their pending documents
+func (s *userService) ListKYCReviewQueue(ctx context.Context, req *pb.ListKYCReviewQueueMessageRequest) (*pb.ListKYCReviewQueueMessageResponse, error) {
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultKYCQueueSize
+	}
+	if limit > maxKYCQueueSize {
+		limit = maxKYCQueueSize
+	}
+
+	cursor, err := s.collection(ctx, "users").Find(ctx,
+		bson.M{"seller_status": sellerStatusPending, "deleted_at": nil},
+		options.Find().SetSort(bson.D{{Key: "kyc_submitted_at", Value: 1}}).SetLimit(limit),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list review queue")
+	}
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list review queue")
+	}
+
+	resp := &pb.ListKYCReviewQueueMessageResponse{}
+	for _, user := range users {
+		item := &pb.KYCReviewItem{UserId: user.ID.Hex(), FullName: user.FullName}
+		if user.KYCSubmittedAt != nil {
+			item.SubmittedAtUnix = user.KYCSubmittedAt.Unix()
+		}
+
+		cursor, err := s.collection(ctx, "kyc_documents").Find(ctx,
+			bson.M{"user_id": user.ID, "status": kycDocPending},
+			options.Find().SetSort(bson.D{{Key: "uploaded_at", Value: 1}}),
+		)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to list review queue")
+		}
+		var docs []KYCDocument
+		if err := cursor.All(ctx, &docs); err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to list review queue")
+		}
+		for _, d := range docs {
+			url, err := s.store.SignedURL(ctx, d.Key, kycReviewURLTTL)
+			if err != nil {
+				log.Printf("Failed to sign KYC document URL: %v", err)
+				return nil, status.Error(codes.Internal, "failed to list review queue")
+			}
+			item.Documents = append(item.Documents, &pb.KYCDocument{
+				Id:             d.ID.Hex(),
+				DocumentType:   d.Type,
+				FileName:       d.FileName,
+				ContentType:    d.ContentType,
+				SizeBytes:      d.Size,
+				Status:         d.Status,
+				UploadedAtUnix: d.UploadedAt.Unix(),
+				DownloadUrl:    url,
+			})
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
+
+// ApproveKYC verifies a seller whose documents passed review
+func (s *userService) ApproveKYC(ctx context.Context, req *pb.ApproveKYCMessageRequest) (*pb.ApproveKYCMessageResponse, error) {
+	if strings.TrimSpace(req.GetReviewer()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewer is required")
+	}
+	if err := s.reviewKYC(ctx, req.GetUserId(), req.GetReviewer(), sellerStatusVerified, kycDocApproved, ""); err != nil {
+		return nil, err
+	}
+	return &pb.ApproveKYCMessageResponse{Message: "Seller verified", Success: true}, nil
+}
+
+// RejectKYC turns a seller down. The seller may upload new documents, which
+// puts them back in the queue.
+func (s *userService) RejectKYC(ctx context.Context, req *pb.RejectKYCMessageRequest) (*pb.RejectKYCMessageResponse, error) {
+	if strings.TrimSpace(req.GetReviewer()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewer is required")
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "rejection reason is required")
+	}
+	if err := s.reviewKYC(ctx, req.GetUserId(), req.GetReviewer(), sellerStatusRejected, kycDocRejected, reason); err != nil {
+		return nil, err
+	}
+	return &pb.RejectKYCMessageResponse{Message: "Seller rejected", Success: true}, nil
+}
+
+// reviewKYC moves a pending seller and their pending documents to the outcome
+func (s *userService) reviewKYC(ctx context.Context, userID, reviewer, sellerStatus, docStatus, reason string) error {
+	id, err := parseUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	update := bson.M{"seller_status": sellerStatus, "kyc_reviewed_at": now, "updated_at": now}
+	if reason != "" {
+		update["kyc_rejection_reason"] = reason
+	}
+	res, err := s.collection(ctx, "users").UpdateOne(ctx,
+		bson.M{"_id": id, "seller_status": sellerStatusPending, "deleted_at": nil},
+		bson.M{"$set": update},
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return status.Error(codes.Internal, "failed to record review")
+	}
+	if res.MatchedCount == 0 {
+		return status.Error(codes.FailedPrecondition, "seller is not awaiting KYC review")
+	}
+
+	docUpdate := bson.M{"status": docStatus, "reviewed_at": now, "reviewer": reviewer}
+	if reason != "" {
+		docUpdate["reason"] = reason
+	}
+	_, err = s.collection(ctx, "kyc_documents").UpdateMany(ctx,
+		bson.M{"user_id": id, "status": kycDocPending},
+		bson.M{"$set": docUpdate},
+	)
+	if err != nil {
+		log.Printf("Failed to update KYC documents for user %s: %v", id.Hex(), err)
+	}
+
+	eventType, payload := eventUserKYCApproved, map[string]interface{}{"reviewed_at": now}
+	if sellerStatus == sellerStatusRejected {
+		eventType = eventUserKYCRejected
+		payload["reason"] = reason
+	}
+	s.recordEvent(ctx, eventType, id, payload)
+	return nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// maxMessageBytes caps every received message, including each chunk of
+	// a KYC upload. It is the gRPC default, made explicit so the Connect
+	// handler enforces the same limit.
+	maxMessageBytes = 4 << 20
+	// maxUnaryRequestBytes is the tighter cap for ordinary unary requests
+	maxUnaryRequestBytes = 256 << 10
+	// defaultMaxStringLength applies to string fields not listed below
+	defaultMaxStringLength = 1024
+	maxRepeatedItems       = 1000
+)
+
+// fieldLengthLimits are the maximum lengths in characters of string fields,
+// keyed by proto field name across all messages
+var fieldLengthLimits = map[string]int{
+	"fullName":          100,
+	"userName":          32,
+	"displayName":       maxDisplayNameLength,
+	"email":             254,
+	"emailAddress":      254,
+	"phone":             20,
+	"phoneNumber":       20,
+	"password":          128,
+	"recipientName":     100,
+	"line1":             200,
+	"line2":             200,
+	"city":              100,
+	"region":            100,
+	"postalCode":        20,
+	"country":           56,
+	"county":            100,
+	"subCounty":         100,
+	"landmark":          maxLandmarkLength,
+	"riderInstructions": maxRiderInstructionsLength,
+	"formattedAddress":  500,
+	"userId":            64,
+	"comment":           maxFeedbackComment,
+	"description":       2000,
+	"mappingJson":       64 << 10,
+}
+
+// checkFieldLengths rejects a message whose strings or lists exceed their
+// limits, descending into nested messages
+func checkFieldLengths(m protoreflect.Message) error {
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		switch {
+		case fd.IsList():
+			list := v.List()
+			if list.Len() > maxRepeatedItems {
+				err = status.Errorf(codes.InvalidArgument, "%s has more than %d items", name, maxRepeatedItems)
+				return false
+			}
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = checkFieldValue(fd, name, list.Get(i))
+			}
+		case fd.IsMap():
+			mp := v.Map()
+			if mp.Len() > maxRepeatedItems {
+				err = status.Errorf(codes.InvalidArgument, "%s has more than %d entries", name, maxRepeatedItems)
+				return false
+			}
+			mp.Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				if err = checkString(name, k.String()); err == nil {
+					err = checkFieldValue(fd.MapValue(), name, mv)
+				}
+				return err == nil
+			})
+		default:
+			err = checkFieldValue(fd, name, v)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func checkFieldValue(fd protoreflect.FieldDescriptor, name string, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return checkString(name, v.String())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return checkFieldLengths(v.Message())
+	}
+	return nil
+}
+
+func checkString(name, v string) error {
+	limit, ok := fieldLengthLimits[name]
+	if !ok {
+		limit = defaultMaxStringLength
+	}
+	// Byte length bounds the rune count, so most values skip the count
+	if len(v) > limit && utf8.RuneCountInString(v) > limit {
+		return status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", name, limit)
+	}
+	return nil
+}
+
+// validateRequest applies the size and field-length guards to a message
+func validateRequest(req interface{}, maxBytes int) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if proto.Size(msg) > maxBytes {
+		return status.Errorf(codes.ResourceExhausted, "request exceeds %d bytes", maxBytes)
+	}
+	return checkFieldLengths(msg.ProtoReflect())
+}
+
+// limitsInterceptor rejects oversized unary requests before they reach a
+// handler, and so before anything is written to Mongo
+func limitsInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateRequest(req, maxUnaryRequestBytes); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// limitedStream checks every message a stream receives
+type limitedStream struct {
+	grpc.ServerStream
+}
+
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m, maxMessageBytes)
+}
+
+// limitsStreamInterceptor applies the guards to each streamed message
+func limitsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &limitedStream{ss})
+	}
+}
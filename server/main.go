@@ -2,60 +2,255 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
+	"flag"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 	"unicode"
 
 	pb "github.com/bruceoaudo/userService/gen/user"
-	"github.com/joho/godotenv"
+	"github.com/bruceoaudo/userService/internal/config"
+	"github.com/bruceoaudo/userService/internal/geo"
+	"github.com/bruceoaudo/userService/internal/idv"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"github.com/bruceoaudo/userService/internal/password"
+	"github.com/bruceoaudo/userService/internal/promotions"
+	"github.com/bruceoaudo/userService/internal/storage"
+	"github.com/bruceoaudo/userService/internal/token"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
 type userService struct {
 	pb.UnimplementedUserServiceServer
-	db *mongo.Client
+	db            *mongo.Client
+	mongo         config.MongoConfig
+	users         userRepository
+	refreshTokens refreshTokenStore
+	devicePINs    devicePINStore
+	metrics       *trafficMetrics
+	notifier      *notify.Dispatcher
+
+	deletionGraceDays int
+	store             storage.Store
+	complianceKey     ed25519.PrivateKey
+	passwords         *password.Registry
+	tokens            *token.Issuer
+	scanner           documentScanner
+	idv               idv.Provider
+	geocoder          geo.Geocoder
+	payouts           *payoutVerifier
+	promotions        *promotions.Client
+	redis             *redis.Client
+	warehouse         *warehouseExporter
+	cdcImages         bool
+	config            *runtimeConfig
+	health            *health.Server
+	mongoReady        chan struct{}
+	slo               *sloTracker
+	invites           *inviteSigner
+	pow               proofOfWork
+	risk              *riskEngine
+	gravatars         *gravatarCache
+	draining          atomic.Bool
+
+	// jobs is the root context of background jobs, cancelled on shutdown
+	// before the database connections close
+	jobs     context.Context
+	stopJobs context.CancelFunc
+	jobsDone sync.WaitGroup
 }
 
 type User struct {
-	FullName     string    `bson:"full_name"`
-	UserName     string    `bson:"user_name"`
-	EmailAddress string    `bson:"email"`
-	PhoneNumber  string    `bson:"phone"`
-	PasswordHash string    `bson:"password_hash"`
-	CreatedAt    time.Time `bson:"created_at"`
-	UpdatedAt    time.Time `bson:"updated_at"`
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	FullName     string             `bson:"full_name"`
+	UserName     string             `bson:"user_name"`
+	EmailAddress string             `bson:"email"`
+	PhoneNumber  string             `bson:"phone"`
+	PasswordHash string             `bson:"password_hash"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+	// SchemaVersion is the shape of the document, see userUpgrades
+	SchemaVersion int `bson:"schema_version,omitempty"`
+
+	Billing *BillingProfile `bson:"billing,omitempty"`
+
+	Tier        string     `bson:"tier,omitempty"`
+	Tags        []string   `bson:"tags,omitempty"`
+	Locale      string     `bson:"locale,omitempty"`
+	Timezone    string     `bson:"timezone,omitempty"`
+	DateOfBirth *time.Time `bson:"date_of_birth,omitempty"`
+	Gender      string     `bson:"gender,omitempty"`
+
+	EmailVerifiedAt *time.Time `bson:"email_verified_at,omitempty"`
+	LastLoginAt     *time.Time `bson:"last_login_at,omitempty"`
+	DeletedAt       *time.Time `bson:"deleted_at,omitempty"`
+
+	NotificationPrefs map[string][]string `bson:"notification_prefs,omitempty"`
+	PushTokens        []string            `bson:"push_tokens,omitempty"`
+
+	DeletionRequestedAt  *time.Time `bson:"deletion_requested_at,omitempty"`
+	DeletionScheduledFor *time.Time `bson:"deletion_scheduled_for,omitempty"`
+
+	Consents map[string]bool `bson:"consents,omitempty"`
+
+	Roles    []string `bson:"roles,omitempty"`
+	TenantID string   `bson:"tenant_id,omitempty"`
+
+	SellerStatus       string     `bson:"seller_status,omitempty"`
+	KYCSubmittedAt     *time.Time `bson:"kyc_submitted_at,omitempty"`
+	KYCReviewedAt      *time.Time `bson:"kyc_reviewed_at,omitempty"`
+	KYCRejectionReason string     `bson:"kyc_rejection_reason,omitempty"`
+
+	Identity *IdentityVerification `bson:"identity,omitempty"`
+	Payout   *PayoutVerification   `bson:"payout_verification,omitempty"`
+
+	SearchKeys         []string `bson:"search_keys,omitempty"`
+	DeviceFingerprints []string `bson:"device_fingerprints,omitempty"`
+
+	PasswordResetRequired bool `bson:"password_reset_required,omitempty"`
+
+	LegacyID string `bson:"legacy_id,omitempty"`
+
+	ParentID   *primitive.ObjectID `bson:"parent_id,omitempty"`
+	SubAccount *SubAccountSettings `bson:"sub_account,omitempty"`
+	ReferredBy *primitive.ObjectID `bson:"referred_by,omitempty"`
+
+	DisplayName     *ModeratedField `bson:"display_name,omitempty"`
+	Avatar          *ModeratedField `bson:"avatar,omitempty"`
+	GeneratedAvatar string          `bson:"generated_avatar,omitempty"`
+
+	Risk *RiskAssessment `bson:"risk,omitempty"`
+
+	ShadowBanned bool       `bson:"shadow_banned,omitempty"`
+	ShadowBan    *ShadowBan `bson:"shadow_ban,omitempty"`
+
+	EmailDeliverability *EmailDeliverability `bson:"email_deliverability,omitempty"`
+	PhoneVerifiedAt     *time.Time           `bson:"phone_verified_at,omitempty"`
+	PhoneReachability   *PhoneReachability   `bson:"phone_reachability,omitempty"`
+	PhoneType           string               `bson:"phone_type,omitempty"`
+
+	USSD *USSDAccount `bson:"ussd,omitempty"`
+
+	Digest *DigestSchedule `bson:"digest,omitempty"`
+
+	ProfileNudges *ProfileNudges `bson:"profile_nudges,omitempty"`
+
+	PasswordChangedAt  *time.Time `bson:"password_changed_at,omitempty"`
+	TwoFactorEnabledAt *time.Time `bson:"two_factor_enabled_at,omitempty"`
+
+	Recovery *RecoveryContacts `bson:"recovery,omitempty"`
+
+	Away *SellerAway `bson:"away,omitempty"`
+	Tax  *TaxProfile `bson:"tax,omitempty"`
+
+	// Attributes hold values of the operator-defined fields in attribute_schemas
+	Attributes map[string]interface{} `bson:"attributes,omitempty"`
 }
 
-// LoginUser remains exactly the same
+// LoginUser verifies an email and password and returns an access token for
+// the calling client. The stored hash never leaves the service.
 func (s *userService) LoginUser(ctx context.Context, req *pb.LoginMessageRequest) (*pb.LoginMessageResponse, error) {
-	
+
 	// 1. Find user by email
-	collection := s.db.Database("userdb").Collection("users")
-	var user User
-	err := collection.FindOne(ctx, bson.M{"email": strings.ToLower(req.GetEmail())}).Decode(&user)
+	if strings.TrimSpace(req.GetEmail()) == "" || req.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email and password are required")
+	}
+	found, err := s.users.FindByEmail(ctx, strings.TrimSpace(req.GetEmail()))
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, status.Error(codes.NotFound, "Invalid credentials")
+		if errors.Is(err, errUserNotFound) {
+			s.verifyDummyPassword(req.GetPassword())
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 		}
 		log.Printf("Database error: %v", err)
 		return nil, status.Error(codes.Internal, "login failed")
 	}
-	
-	
+	user := *found
+	s.upgradeUser(ctx, &user)
+
+	// 2. Verify the password, upgrading an outdated hash
+	if err := s.checkPassword(ctx, &user, req.GetPassword()); err != nil {
+		if !errors.Is(err, password.ErrMismatch) && !errors.Is(err, password.ErrUnknownScheme) {
+			log.Printf("Failed to verify password for user %s: %v", user.ID.Hex(), err)
+		}
+		s.recordSecurityEvent(ctx, user.ID, securityEventLoginFailed, req.GetDeviceFingerprint(), "wrong password")
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	if user.PasswordResetRequired {
+		return nil, status.Error(codes.FailedPrecondition, "password reset required")
+	}
+
+	// 3. Score the attempt for fraud risk
+	risk := s.assessRisk(ctx, riskEventLogin, &user, req.GetDeviceFingerprint())
+	if risk.Action == riskActionBlock {
+		if err := s.users.SetRisk(ctx, user.ID, risk); err != nil {
+			log.Printf("Failed to record risk assessment: %v", err)
+		}
+		s.recordSecurityEvent(ctx, user.ID, securityEventLoginBlocked, req.GetDeviceFingerprint(), strings.Join(risk.Reasons, "; "))
+		return nil, errRiskBlocked(riskEventLogin)
+	}
+
+	// 4. Issue the session token
+	client := strings.TrimSpace(req.GetClient())
+	if client == "" {
+		client = s.tokens.Config().LoginClient
+	}
+	pair, err := s.tokens.IssueSession(tokenSubject(&user), client, nil, s.tokens.Binding(clientContext(ctx)))
+	if err != nil {
+		if errors.Is(err, token.ErrUnknownClient) || errors.Is(err, token.ErrUnknownTenant) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("Failed to issue token: %v", err)
+		return nil, status.Error(codes.Internal, "login failed")
+	}
+	if err := s.storeRefreshToken(ctx, user.ID, pair.RefreshClaims); err != nil {
+		log.Printf("Failed to store refresh token: %v", err)
+		return nil, status.Error(codes.Internal, "login failed")
+	}
+
+	// 5. Record the login for activity statistics
+	now := time.Now()
+	if err := s.users.RecordLogin(ctx, user.ID, now, risk); err != nil {
+		log.Printf("Failed to record login time: %v", err)
+	}
+	s.recordDeviceFingerprint(ctx, user.ID, req.GetDeviceFingerprint())
+	detail := ""
+	if risk.Action != riskActionAllow {
+		detail = "risk " + risk.Action
+	}
+	s.recordSecurityEvent(ctx, user.ID, securityEventLogin, req.GetDeviceFingerprint(), detail)
+	if s.config.featureEnabled("login_notifications", true) {
+		s.notifyUser(&user, notify.KindNewLogin, map[string]string{"time": now.UTC().Format(time.RFC1123)})
+	}
+
 	return &pb.LoginMessageResponse{
-		Email:    user.EmailAddress,
-		UserName: user.UserName,
-		Password: user.PasswordHash,
+		UserId:               user.ID.Hex(),
+		Email:                user.EmailAddress,
+		UserName:             user.UserName,
+		RiskAction:           risk.Action,
+		AccessToken:          pair.Access,
+		ExpiresAtUnix:        pair.AccessClaims.ExpiresAt.Unix(),
+		Scopes:               pair.AccessClaims.Scopes(),
+		RefreshToken:         pair.Refresh,
+		RefreshExpiresAtUnix: pair.RefreshClaims.ExpiresAt.Unix(),
 	}, nil
 }
 
@@ -65,90 +260,550 @@ func (s *userService) RegisterUser(ctx context.Context, req *pb.RegisterMessageR
 	if err := validateRegistration(req); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-
-	collection := s.db.Database("userdb").Collection("users")
-
-	// 2. Check for existing user
-	existingFilter := bson.M{
-		"$or": []bson.M{
-			{"email": strings.ToLower(strings.TrimSpace(req.GetEmailAddress()))},
-			{"user_name": strings.ToLower(strings.TrimSpace(req.GetUserName()))},
-			{"phone": normalizePhoneNumber(req.GetPhoneNumber())},
-		},
+	if err := s.checkNewPassword(req.GetPassword()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	var existingUser User
-	err := collection.FindOne(ctx, existingFilter).Decode(&existingUser)
-	if err == nil {
-		return nil, status.Error(codes.AlreadyExists, "user with this email, username or phone already exists")
-	}
-	if err != mongo.ErrNoDocuments {
+	// 2. Check for existing user
+	exists, err := s.users.ExistsByEmailUsernamePhone(ctx,
+		strings.TrimSpace(req.GetEmailAddress()),
+		strings.TrimSpace(req.GetUserName()),
+		normalizePhoneNumber(req.GetPhoneNumber()),
+	)
+	if err != nil {
 		log.Printf("Database error: %v", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "user with this email, username or phone already exists")
+	}
 
 	// 3. Create user document
+	passwordHash, err := s.passwords.Hash(req.GetPassword())
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create user")
+	}
+
 	user := User{
 		FullName:     strings.TrimSpace(req.GetFullName()),
-		UserName:     strings.ToLower(strings.TrimSpace(req.GetUserName())),
-		EmailAddress: strings.ToLower(strings.TrimSpace(req.GetEmailAddress())),
+		UserName:     strings.TrimSpace(req.GetUserName()),
+		EmailAddress: strings.TrimSpace(req.GetEmailAddress()),
 		PhoneNumber:  normalizePhoneNumber(req.GetPhoneNumber()),
-		PasswordHash: req.GetPassword(),
+		PhoneType:    detectPhoneType(normalizePhoneNumber(req.GetPhoneNumber())),
+		PasswordHash: passwordHash,
+		Locale:       requestFromContext(ctx).Locale,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
+	if fingerprint := strings.TrimSpace(req.GetDeviceFingerprint()); fingerprint != "" {
+		user.DeviceFingerprints = []string{fingerprint}
+	}
+	user.SearchKeys = searchKeys(&user)
+	user.SchemaVersion = currentUserSchemaVersion
 
-	_, err = collection.InsertOne(ctx, user)
+	// Blocked attempts are refused before anything is stored. Velocity
+	// bursts short of a block get the account challenged.
+	velocityFlags, err := s.checkRegistrationVelocity(ctx, req.GetDeviceFingerprint())
 	if err != nil {
-		if mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+	user.Risk = s.assessRisk(ctx, riskEventRegistration, &user, req.GetDeviceFingerprint())
+	if user.Risk.Action == riskActionBlock {
+		return nil, errRiskBlocked(riskEventRegistration)
+	}
+	if len(velocityFlags) > 0 {
+		user.Risk.Reasons = append(user.Risk.Reasons, velocityFlags...)
+		if user.Risk.Action == riskActionAllow {
+			user.Risk.Action = riskActionChallenge
+		}
+	}
+
+	// Risky sources solve a proof of work first, which makes registering
+	// accounts in bulk expensive without a CAPTCHA. A missing, expired or
+	// used challenge gets a fresh one back.
+	if bits := s.config.get().powBits(user.Risk.Score); bits > 0 {
+		err := s.checkProofOfWork(ctx, req.GetPowChallenge(), req.GetPowSolution(), user.EmailAddress)
+		if errors.Is(err, errPowSolution) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			challenge, err := s.pow.Issue(bits, user.EmailAddress, time.Now())
+			if err != nil {
+				log.Printf("Failed to issue proof-of-work challenge: %v", err)
+				return nil, status.Error(codes.Internal, "failed to create user")
+			}
+			return &pb.RegisterMessageResponse{
+				Message:       "Proof of work required",
+				RiskAction:    user.Risk.Action,
+				PowChallenge:  challenge,
+				PowDifficulty: int32(bits),
+			}, nil
+		}
+	}
+
+	if err := s.users.Create(ctx, &user); err != nil {
+		if errors.Is(err, errUserExists) {
 			return nil, status.Error(codes.AlreadyExists, "user with these details already exists")
 		}
 		log.Printf("Failed to create user: %v", err)
 		return nil, status.Error(codes.Internal, "failed to create user")
 	}
 
+	// 4. Publish the registration to downstream services
+	s.recordEvent(ctx, eventUserRegistered, user.ID, map[string]interface{}{
+		"user_name":  user.UserName,
+		"created_at": user.CreatedAt,
+	})
+	if len(velocityFlags) > 0 {
+		s.recordEvent(ctx, eventUserVelocityFlagged, user.ID, map[string]interface{}{"flags": velocityFlags})
+	}
+	s.sendEmailVerification(ctx, &user)
+	s.grantWelcomeCoupon(ctx, user.ID)
+	// A bad invite never blocks the registration itself
+	if tok := strings.TrimSpace(req.GetInviteToken()); tok != "" {
+		if _, err := s.redeemInvite(ctx, tok, &user); err != nil {
+			log.Printf("Invitation not applied for user %s: %v", user.ID.Hex(), err)
+		}
+	}
+
 	return &pb.RegisterMessageResponse{
-		UserName: user.UserName,
-		Message:  "Registered successfully",
-		Success:  true,
+		UserName:   user.UserName,
+		Message:    "Registered successfully",
+		Success:    true,
+		RiskAction: user.Risk.Action,
 	}, nil
 }
 
 // Initialize MongoDB connection
-func NewUserService(mongoURI string) (*userService, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+func NewUserService(cfg config.MongoConfig) (*userService, error) {
+	// Connect only validates the URI; the first operation dials the server,
+	// so the service can start while MongoDB is still unreachable
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI(cfg.URI).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetServerSelectionTimeout(cfg.ServerSelectionTimeout))
 	if err != nil {
 		return nil, err
 	}
 
-	// Create unique indexes
-	db := client.Database("userdb")
-	collection := db.Collection("users")
+	svc := &userService{
+		db:                client,
+		mongo:             cfg,
+		users:             newMongoUserRepository(mongoCollections(client, cfg)),
+		refreshTokens:     newMongoRefreshTokenStore(mongoCollections(client, cfg)),
+		devicePINs:        newMongoDevicePINStore(mongoCollections(client, cfg)),
+		metrics:           &trafficMetrics{},
+		notifier:          newNotifier(),
+		deletionGraceDays: config.Default().AccountDeletionGraceDays,
+		scanner:           noopScanner{},
+		promotions:        newPromotionsClient(),
+		health:            health.NewServer(),
+		mongoReady:        make(chan struct{}),
+		slo:               newSLOTracker(),
+		risk:              newRiskEngine(),
+		gravatars:         newGravatarCache(),
+	}
+	svc.jobs, svc.stopJobs = context.WithCancel(context.Background())
+	svc.setServing(false)
+	return svc, nil
+}
 
-	_, err = collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+// collectionIndexes are the indexes one createIndexes call builds
+type collectionIndexes struct {
+	collection string
+	models     []mongo.IndexModel
+}
+
+// indexSpecs lists the indexes every collection relies on
+var indexSpecs = []collectionIndexes{
+	{"users", []mongo.IndexModel{
 		{
-			Keys:    bson.D{primitive.E{Key: "email", Value: 1}},
-			Options: options.Index().SetUnique(true),
+			// USSD accounts have no email, so only set addresses are unique
+			Keys: bson.D{primitive.E{Key: "email", Value: 1}},
+			Options: options.Index().SetName("email_present_ci").SetUnique(true).SetCollation(caseInsensitive).
+				SetPartialFilterExpression(bson.M{"email": bson.M{"$gt": ""}}),
 		},
 		{
 			Keys:    bson.D{primitive.E{Key: "user_name", Value: 1}},
+			Options: options.Index().SetName("user_name_ci").SetUnique(true).SetCollation(caseInsensitive),
+		},
+		{
+			// Sub-accounts may have no phone, so only set numbers are unique
+			Keys: bson.D{primitive.E{Key: "phone", Value: 1}},
+			Options: options.Index().SetName("phone_present").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"phone": bson.M{"$gt": ""}}),
+		},
+		{
+			Keys: bson.D{primitive.E{Key: "created_at", Value: 1}},
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "last_login_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "search_keys", Value: 1}},
+			Options: options.Index().SetName(searchKeysIndex),
+		},
+		{
+			Keys: bson.D{primitive.E{Key: "device_fingerprints", Value: 1}},
+		},
+		{
+			Keys: bson.D{primitive.E{Key: "tags", Value: 1}},
+		},
+		{
+			Keys: bson.D{primitive.E{Key: "updated_at", Value: 1}, primitive.E{Key: "_id", Value: 1}},
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "legacy_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "parent_id", Value: 1}, primitive.E{Key: "created_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			// Moderation queues only index the profiles awaiting review
+			Keys: bson.D{primitive.E{Key: "display_name.submitted_at", Value: 1}},
+			Options: options.Index().SetName("display_name_review").
+				SetPartialFilterExpression(bson.M{"display_name.status": moderationPending}),
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "digest.daily_due_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "digest.weekly_due_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "away.state", Value: 1}, primitive.E{Key: "away.starts_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "away.state", Value: 1}, primitive.E{Key: "away.ends_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys: bson.D{primitive.E{Key: "avatar.submitted_at", Value: 1}},
+			Options: options.Index().SetName("avatar_review").
+				SetPartialFilterExpression(bson.M{"avatar.status": moderationPending}),
+		},
+	}},
+	{"org_members", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "org_id", Value: 1}, {Key: "user_id", Value: 1}},
 			Options: options.Index().SetUnique(true),
 		},
 		{
-			Keys:    bson.D{primitive.E{Key: "phone", Value: 1}},
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	}},
+	{"invites", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "inviter_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32((30 * 24 * time.Hour).Seconds())),
+		},
+	}},
+	{"saved_searches", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}},
+	{"product_alerts", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "product_id", Value: 1}, {Key: "kind", Value: 1}},
 			Options: options.Index().SetUnique(true),
 		},
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return &userService{db: client}, nil
+		{
+			Keys: bson.D{{Key: "product_id", Value: 1}, {Key: "kind", Value: 1}},
+		},
+	}},
+	{"registration_attempts", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "scope", Value: 1}, {Key: "value", Value: 1}, {Key: "at", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(maxVelocityWindow.Seconds())),
+		},
+	}},
+	{"experiment_assignments", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "experiment", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "experiment", Value: 1}, {Key: "variant", Value: 1}},
+		},
+	}},
+	{"recovery_verifications", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "channel", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"account_recoveries", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "recovery_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "token_hash", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"quarantined_uploads", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	}},
+	{"device_pins", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "device_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}},
+	{"device_keys", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "device_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}},
+	{"refresh_tokens", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "session_id", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"quota_counters", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"biometric_challenges", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"pow_redemptions", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"attribute_schemas", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}},
+	{"security_events", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "at", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(securityEventRetention.Seconds())),
+		},
+	}},
+	{"profile_history", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "_id", Value: -1}},
+		},
+	}},
+	{"recently_viewed", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}},
+	{"outbox", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "published_at", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "aggregate_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}},
+	{"dead_letters", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "event.aggregate_id", Value: 1}, {Key: "dead_lettered_at", Value: -1}},
+		},
+	}},
+	{"email_verifications", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"phone_verifications", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}},
+	{"consents", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "recorded_at", Value: 1}},
+		},
+	}},
+	{"audit_log", []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "at", Value: 1}}},
+		{Keys: bson.D{{Key: "at", Value: 1}}},
+		// Which peers called a method, for spotting unexpected callers
+		{Keys: bson.D{{Key: "method", Value: 1}, {Key: "at", Value: 1}}},
+	}},
+	{"access_reports", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "expires_at", Value: 1}},
+		},
+	}},
+	{"users", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "deletion_scheduled_for", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	}},
+	{"users", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "seller_status", Value: 1}, {Key: "kyc_submitted_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	}},
+	{"users", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "billing.address.location", Value: "2dsphere"}},
+		},
+	}},
+	{"users", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "identity.session_id", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	}},
+	{"users", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "payout_verification.conversation_id", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	}},
+	{"wallet_transactions", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "idempotency_key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}},
+	{"gift_cards", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "code", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "attached_at", Value: -1}},
+		},
+	}},
+	{"coupons", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "code", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "granted_at", Value: -1}},
+		},
+	}},
+	{"feedback", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "submitted_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "order_id", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"order_id": bson.M{"$type": "string"}}),
+		},
+		{
+			Keys: bson.D{{Key: "submitted_at", Value: -1}},
+		},
+	}},
+	{"support_tickets", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "system", Value: 1}, {Key: "external_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "updated_at", Value: -1}},
+		},
+	}},
+	{"duplicate_candidates", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_a", Value: 1}, {Key: "user_b", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_b", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "score", Value: -1}},
+		},
+	}},
+	{"operations", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "kind", Value: 1}, {Key: "_id", Value: -1}},
+		},
+	}},
+	{"import_row_errors", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "operation_id", Value: 1}, {Key: "row", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}},
+	{"kyc_documents", []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "uploaded_at", Value: 1}},
+		},
+	}},
 }
 
+// caseInsensitive compares strings ignoring case but not diacritics. Queries
+// on email and user_name must use it to match the unique indexes.
+var caseInsensitive = &options.Collation{Locale: "en", Strength: 2}
+
+func findCaseInsensitive() *options.FindOneOptions {
+	return options.FindOne().SetCollation(caseInsensitive)
+}
 
 func validateRegistration(req *pb.RegisterMessageRequest) error {
 	if strings.TrimSpace(req.GetFullName()) == "" {
@@ -162,6 +817,9 @@ func validateRegistration(req *pb.RegisterMessageRequest) error {
 	if !isAlphanumeric(username) {
 		return errors.New("username can only contain letters and numbers")
 	}
+	if isUSSDUserName(username) {
+		return errors.New("usernames of the form ussd and digits are reserved")
+	}
 
 	email := strings.TrimSpace(req.GetEmailAddress())
 	if !strings.Contains(email, "@") || !strings.Contains(email, ".") {
@@ -172,6 +830,13 @@ func validateRegistration(req *pb.RegisterMessageRequest) error {
 	if len(phone) != 12 || !strings.HasPrefix(phone, "254") {
 		return errors.New("phone must be in 254XXXXXXXXX format (12 digits)")
 	}
+	if detectPhoneType(phone) == phoneTypePremium {
+		return errors.New("premium-rate numbers cannot be used as a phone number")
+	}
+
+	if req.GetPassword() == "" {
+		return errors.New("password is required")
+	}
 
 	return nil
 }
@@ -190,6 +855,14 @@ func normalizePhoneNumber(phone string) string {
 	}
 }
 
+func parseUserID(userID string) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(strings.TrimSpace(userID))
+	if err != nil {
+		return primitive.NilObjectID, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	return id, nil
+}
+
 func isAlphanumeric(s string) bool {
 	for _, r := range s {
 		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
@@ -200,34 +873,286 @@ func isAlphanumeric(s string) bool {
 }
 
 func main() {
-	// Load .env file
-	err := godotenv.Load()
+	// Load .env file when there is one; deployments set the environment
+	// directly
+	if err := config.LoadDotEnv(".env"); err != nil {
+		log.Fatalf("Error loading .env file: %v", err)
+	}
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	userSvc, err := NewUserService(cfg.Mongo)
+	if err != nil {
+		log.Fatalf("Invalid MONGODB_URI: %v", err)
+	}
+	var closeShadow func(context.Context) error
+	userSvc.users, closeShadow, err = newShadowUserRepository(userSvc.users, cfg.Mongo)
+	if err != nil {
+		log.Fatalf("Invalid shadow storage configuration: %v", err)
+	}
+	// Servers that handle calls, stopped gracefully on SIGTERM
+	drain := &drainer{}
+	if closeShadow != nil {
+		drain.addCloser("shadow store", closeShadow)
+	}
+	userSvc.startJob(userSvc.runStartup)
+	// Row images are captured from the first event on so Debezium consumers
+	// get before images for every change
+	userSvc.cdcImages = cfg.Events.Format == eventFormatDebezium
+
+	// Tunables that can be reloaded without a restart
+	userSvc.config, err = newRuntimeConfig()
+	if err != nil {
+		log.Fatalf("Invalid RUNTIME_CONFIG_FILE: %v", err)
+	}
+	go userSvc.config.watch(context.Background())
+
+	userSvc.deletionGraceDays = cfg.AccountDeletionGraceDays
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.runDeletionScheduler))
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.runRewardScheduler))
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.runProfileNudger))
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.runAwayScheduler))
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.backfillSchemaVersions))
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.runDuplicateScanner))
+	userSvc.whenMongoReady(userSvc.runAutoscalingSampler)
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.runOperationWorker))
+	userSvc.whenMongoReady(userSvc.runHealthMonitor)
+
+	store, downloads, err := newObjectStore()
+	if err != nil {
+		log.Fatalf("Failed to configure object storage: %v", err)
+	}
+	userSvc.store = store
+	userSvc.whenMongoReady(userSvc.perStorage(userSvc.runAccessReportSweeper))
+
+	userSvc.complianceKey, err = loadComplianceSigningKey()
 	if err != nil {
-		log.Fatal("Error loading .env file")
+		log.Fatalf("Invalid compliance signing key: %v", err)
 	}
 
-	// Get MongoDB URI from environment
-	mongoURI := os.Getenv("MONGODB_URI")
-	if mongoURI == "" {
-		log.Fatal("MONGODB_URI not set in .env file")
+	userSvc.passwords, err = newPasswordRegistry()
+	if err != nil {
+		log.Fatalf("Invalid password hashing configuration: %v", err)
+	}
+
+	userSvc.tokens, err = newTokenIssuer()
+	if err != nil {
+		log.Fatalf("Invalid token configuration: %v", err)
+	}
+
+	userSvc.invites, err = newInviteSigner()
+	if err != nil {
+		log.Fatalf("Invalid invitation configuration: %v", err)
+	}
+
+	userSvc.scanner, err = newDocumentScanner()
+	if err != nil {
+		log.Fatalf("Invalid virus scanner configuration: %v", err)
+	}
+
+	userSvc.pow, err = newHashcash()
+	if err != nil {
+		log.Fatalf("Invalid proof-of-work configuration: %v", err)
+	}
+
+	userSvc.geocoder, err = newGeocoder()
+	if err != nil {
+		log.Fatalf("Invalid geocoder configuration: %v", err)
 	}
 
-	userSvc, err := NewUserService(mongoURI)
+	userSvc.idv, err = newIDVProvider()
 	if err != nil {
-		log.Fatalf("Failed to connect to MongoDB: %v", err)
+		log.Fatalf("Invalid identity verification configuration: %v", err)
 	}
 
+	userSvc.redis, err = newRedisClient()
+	if err != nil {
+		log.Fatalf("Invalid REDIS_URL: %v", err)
+	}
+
+	userSvc.warehouse, err = newWarehouseExporter()
+	if err != nil {
+		log.Fatalf("Invalid warehouse export configuration: %v", err)
+	}
+	if userSvc.warehouse != nil {
+		userSvc.whenMongoReady(userSvc.runWarehouseExporter)
+	}
+
+	userSvc.payouts, err = newPayoutVerifier()
+	if err != nil {
+		log.Fatalf("Invalid M-Pesa configuration: %v", err)
+	}
+
+	// Serve signed downloads for the file storage backend and provider webhooks
+	emailWebhookToken := cfg.Webhooks.EmailToken
+	smsWebhookToken := cfg.Webhooks.SMSToken
+	if downloads != nil || userSvc.idv != nil || userSvc.payouts != nil || emailWebhookToken != "" || smsWebhookToken != "" {
+		httpAddr := cfg.HTTPAddr
+		mux := http.NewServeMux()
+		if downloads != nil {
+			mux.Handle("/files/", downloads)
+		}
+		if userSvc.idv != nil {
+			mux.Handle("/webhooks/idv", userSvc.idvWebhookHandler())
+		}
+		if userSvc.payouts != nil {
+			mux.Handle("/webhooks/mpesa/b2c/", userSvc.payoutCallbackHandler())
+		}
+		if emailWebhookToken != "" {
+			mux.Handle("/webhooks/email/ses", userSvc.sesWebhookHandler(emailWebhookToken))
+			mux.Handle("/webhooks/email/sendgrid", userSvc.sendGridWebhookHandler(emailWebhookToken))
+		}
+		if smsWebhookToken != "" {
+			mux.Handle("/webhooks/sms/receipts", userSvc.smsReceiptHandler(smsWebhookToken))
+		}
+		server := &http.Server{Addr: httpAddr, Handler: mux}
+		drain.addHTTP(server)
+		go func() {
+			log.Printf("HTTP server listening on %s", httpAddr)
+			if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("Failed to serve HTTP: %v", err)
+			}
+		}()
+	}
+
+	apiKeys, err := parseAPIKeys(cfg.APIKeys)
+	if err != nil {
+		log.Fatalf("Invalid API_KEYS: %v", err)
+	}
+	certClients, err := parseCertClients(cfg.MTLSClients)
+	if err != nil {
+		log.Fatalf("Invalid MTLS_CLIENTS: %v", err)
+	}
+	apiClients := &clientRegistry{keys: apiKeys, certs: certClients}
+
+	// Relay outbox events to downstream consumers
+	publisher, err := newEventPublisher(cfg.Events.WebhookURL, cfg.Events.Format, cfg.Mongo)
+	if err != nil {
+		log.Fatalf("Invalid EVENTS_FORMAT: %v", err)
+	}
+	siblings, err := newSiblingClients(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Failed to set up sibling clients: %v", err)
+	}
+	defer siblings.Close()
+	relay := &outboxRelay{
+		svc:         userSvc,
+		publisher:   withSiblingReactions(publisher, siblings),
+		maxAttempts: cfg.Events.OutboxMaxAttempts,
+	}
+	userSvc.whenMongoReady(userSvc.perStorage(relay.run))
+
+	// Expose Prometheus metrics
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", promhttp.Handler())
+	metricsServer := &http.Server{Addr: cfg.MetricsAddr, Handler: metricsMux}
+	drain.addHTTP(metricsServer)
+	go func() {
+		log.Printf("Metrics listening on %s", metricsServer.Addr)
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to serve metrics: %v", err)
+		}
+	}()
+
+	// Serve liveness, readiness and build information for deployment tooling
+	adminServer := &http.Server{Addr: cfg.AdminAddr, Handler: userSvc.newAdminHandler(apiClients)}
+	drain.addHTTP(adminServer)
+	go func() {
+		log.Printf("Admin listening on %s", adminServer.Addr)
+		if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to serve admin endpoints: %v", err)
+		}
+	}()
+
 	// Start gRPC server
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterUserServiceServer(grpcServer, userSvc)
+	tlsConfig, err := newServerTLSConfig(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS configuration: %v", err)
+	}
 
-	log.Printf("gRPC server listening on port: %s", "50051")
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve gRPC server: %v", err)
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		inflightInterceptor(),
+		compressionInterceptor(),
+		requestContextInterceptor(),
+		sloInterceptor(userSvc.slo),
+		readinessInterceptor(userSvc),
+		maintenanceInterceptor(userSvc.config),
+		apiKeyInterceptor(apiClients),
+		quotaInterceptor(userSvc),
+		rateLimitInterceptor(userSvc.config),
+		limitsInterceptor(),
+		trafficInterceptor(userSvc.metrics),
+		profileHistoryInterceptor(userSvc),
+		auditInterceptor(userSvc),
+		payloadLogInterceptor(userSvc.config),
 	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		inflightStreamInterceptor(),
+		compressionStreamInterceptor(),
+		requestContextStreamInterceptor(),
+		readinessStreamInterceptor(userSvc),
+		maintenanceStreamInterceptor(userSvc.config),
+		apiKeyStreamInterceptor(apiClients),
+		quotaStreamInterceptor(userSvc),
+		rateLimitStreamInterceptor(userSvc.config),
+		limitsStreamInterceptor(),
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(maxMessageBytes),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	register := func(srv grpc.ServiceRegistrar) {
+		pb.RegisterUserServiceServer(srv, userSvc)
+		healthpb.RegisterHealthServer(srv, userSvc.health)
+	}
+
+	grpcServer, err := newGRPCServer(tlsConfig, serverOptions...)
+	if err != nil {
+		log.Fatalf("Invalid xDS configuration: %v", err)
+	}
+	register(grpcServer)
+	drain.addGRPC(grpcServer)
+
+	// Optional Unix socket for a co-located gateway or sidecar
+	if socketPath := os.Getenv("GRPC_UNIX_SOCKET"); socketPath != "" {
+		go serveUnixSocket(socketPath, register, drain, serverOptions...)
+	}
+
+	// Serve the same methods over the Connect protocol for browsers and curl
+	if connectAddr := os.Getenv("CONNECT_ADDR"); connectAddr != "" {
+		handler := newConnectHandler(userSvc, chainUnaryInterceptors(unaryInterceptors...), chainStreamInterceptors(streamInterceptors...))
+		go serveHTTP("Connect", connectAddr, handler, tlsConfig, drain)
+	}
+
+	// Optional GraphQL facade for backend-for-frontend services
+	if graphqlAddr := os.Getenv("GRAPHQL_ADDR"); graphqlAddr != "" {
+		handler := newGraphQLHandler(userSvc, apiClients, chainUnaryInterceptors(unaryInterceptors...))
+		go serveHTTP("GraphQL", graphqlAddr, handler, tlsConfig, drain)
+	}
+
+	// Serve until SIGTERM or SIGINT, then drain. A second signal kills the
+	// process right away.
+	stopping, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		log.Printf("gRPC server listening on %s", cfg.ListenAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("Failed to serve gRPC server: %v", err)
+		}
+	}()
+	<-stopping.Done()
+	stop()
+	userSvc.shutdown(drain, cfg.ShutdownGracePeriod)
 }
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errDatabaseDown = errors.New("server selection timeout")
+
+func validRegistration() *pb.RegisterMessageRequest {
+	return &pb.RegisterMessageRequest{
+		FullName:     "Amina Otieno",
+		UserName:     "amina",
+		EmailAddress: "amina@example.com",
+		PhoneNumber:  "0712345678",
+		Password:     "correct horse battery",
+	}
+}
+
+func TestRegisterUser(t *testing.T) {
+	existing := &User{
+		FullName:     "Brian Kamau",
+		UserName:     "brian",
+		EmailAddress: "brian@example.com",
+		PhoneNumber:  "254722000111",
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*pb.RegisterMessageRequest)
+		setup  func(*memoryUserRepository)
+		code   codes.Code
+	}{
+		{name: "success", code: codes.OK},
+		{
+			name:   "duplicate email differing in case",
+			modify: func(r *pb.RegisterMessageRequest) { r.EmailAddress = "BRIAN@example.com" },
+			code:   codes.AlreadyExists,
+		},
+		{
+			name:   "duplicate username",
+			modify: func(r *pb.RegisterMessageRequest) { r.UserName = "Brian" },
+			code:   codes.AlreadyExists,
+		},
+		{
+			name:   "duplicate phone in local format",
+			modify: func(r *pb.RegisterMessageRequest) { r.PhoneNumber = "0722000111" },
+			code:   codes.AlreadyExists,
+		},
+		{
+			name:   "invalid phone",
+			modify: func(r *pb.RegisterMessageRequest) { r.PhoneNumber = "12345" },
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "premium-rate phone",
+			modify: func(r *pb.RegisterMessageRequest) { r.PhoneNumber = "254900123456" },
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "short username",
+			modify: func(r *pb.RegisterMessageRequest) { r.UserName = "ami" },
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "username of a USSD account",
+			modify: func(r *pb.RegisterMessageRequest) { r.UserName = "USSD712345678" },
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "missing password",
+			modify: func(r *pb.RegisterMessageRequest) { r.Password = "" },
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:  "database error on lookup",
+			setup: func(r *memoryUserRepository) { r.err = errDatabaseDown },
+			code:  codes.Internal,
+		},
+		{
+			name:  "lost race against a concurrent registration",
+			setup: func(r *memoryUserRepository) { r.createErr = errUserExists },
+			code:  codes.AlreadyExists,
+		},
+		{
+			name:  "database error on insert",
+			setup: func(r *memoryUserRepository) { r.createErr = errDatabaseDown },
+			code:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, users, _ := newTestService(t)
+			seeded := *existing
+			if err := users.Create(context.Background(), &seeded); err != nil {
+				t.Fatalf("seed: %v", err)
+			}
+			if tt.setup != nil {
+				tt.setup(users)
+			}
+			req := validRegistration()
+			if tt.modify != nil {
+				tt.modify(req)
+			}
+
+			resp, err := svc.RegisterUser(context.Background(), req)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("RegisterUser() code = %v, want %v (err %v)", got, tt.code, err)
+			}
+			if tt.code != codes.OK {
+				return
+			}
+			if !resp.GetSuccess() || resp.GetUserName() != "amina" {
+				t.Fatalf("RegisterUser() = %+v", resp)
+			}
+			stored, err := users.FindByEmail(context.Background(), "amina@example.com")
+			if err != nil {
+				t.Fatalf("registered user not stored: %v", err)
+			}
+			if stored.PhoneNumber != "254712345678" || stored.PhoneType != phoneTypeMobile {
+				t.Errorf("phone stored as %q (%s), want 254712345678 (mobile)", stored.PhoneNumber, stored.PhoneType)
+			}
+			if stored.PasswordHash == req.Password {
+				t.Error("password stored in plain text")
+			}
+			if _, err := svc.passwords.Verify(req.Password, stored.PasswordHash); err != nil {
+				t.Errorf("stored hash does not verify: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoginUser(t *testing.T) {
+	const plain = "correct horse battery"
+
+	tests := []struct {
+		name  string
+		req   *pb.LoginMessageRequest
+		user  func(*User)
+		setup func(*memoryUserRepository, *memoryRefreshTokenStore)
+		code  codes.Code
+	}{
+		{
+			name: "success",
+			req:  &pb.LoginMessageRequest{Email: "amina@example.com", Password: plain},
+			code: codes.OK,
+		},
+		{
+			name: "email differing in case",
+			req:  &pb.LoginMessageRequest{Email: "Amina@Example.com", Password: plain},
+			code: codes.OK,
+		},
+		{
+			name: "wrong password",
+			req:  &pb.LoginMessageRequest{Email: "amina@example.com", Password: "wrong"},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "unknown email",
+			req:  &pb.LoginMessageRequest{Email: "nobody@example.com", Password: plain},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "missing password",
+			req:  &pb.LoginMessageRequest{Email: "amina@example.com"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "missing email",
+			req:  &pb.LoginMessageRequest{Password: plain},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "password reset required",
+			req:  &pb.LoginMessageRequest{Email: "amina@example.com", Password: plain},
+			user: func(u *User) { u.PasswordResetRequired = true },
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "unknown client",
+			req:  &pb.LoginMessageRequest{Email: "amina@example.com", Password: plain, Client: "kiosk"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "database error",
+			req:   &pb.LoginMessageRequest{Email: "amina@example.com", Password: plain},
+			setup: func(r *memoryUserRepository, _ *memoryRefreshTokenStore) { r.err = errDatabaseDown },
+			code:  codes.Internal,
+		},
+		{
+			name:  "refresh token not stored",
+			req:   &pb.LoginMessageRequest{Email: "amina@example.com", Password: plain},
+			setup: func(_ *memoryUserRepository, s *memoryRefreshTokenStore) { s.err = errDatabaseDown },
+			code:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, users, sessions := newTestService(t)
+			hash, err := svc.passwords.Hash(plain)
+			if err != nil {
+				t.Fatalf("hash: %v", err)
+			}
+			user := &User{
+				FullName:      "Amina Otieno",
+				UserName:      "amina",
+				EmailAddress:  "amina@example.com",
+				PhoneNumber:   "254712345678",
+				PasswordHash:  hash,
+				SchemaVersion: currentUserSchemaVersion,
+			}
+			if tt.user != nil {
+				tt.user(user)
+			}
+			if err := users.Create(context.Background(), user); err != nil {
+				t.Fatalf("seed: %v", err)
+			}
+			if tt.setup != nil {
+				tt.setup(users, sessions)
+			}
+
+			resp, err := svc.LoginUser(context.Background(), tt.req)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("LoginUser() code = %v, want %v (err %v)", got, tt.code, err)
+			}
+			if tt.code != codes.OK {
+				return
+			}
+			if resp.GetUserId() != user.ID.Hex() {
+				t.Errorf("user id = %q, want %q", resp.GetUserId(), user.ID.Hex())
+			}
+			claims, err := svc.tokens.Parse(resp.GetAccessToken(), "")
+			if err != nil {
+				t.Fatalf("access token does not parse: %v", err)
+			}
+			if claims.Subject != user.ID.Hex() {
+				t.Errorf("token subject = %q, want %q", claims.Subject, user.ID.Hex())
+			}
+			refresh, err := svc.tokens.ParseRefreshToken(resp.GetRefreshToken(), "")
+			if err != nil {
+				t.Fatalf("refresh token does not parse: %v", err)
+			}
+			if _, err := sessions.Find(context.Background(), refresh.ID); err != nil {
+				t.Errorf("refresh token not stored: %v", err)
+			}
+			stored, _ := users.FindByID(context.Background(), user.ID)
+			if stored.LastLoginAt == nil {
+				t.Error("login time not recorded")
+			}
+		})
+	}
+}
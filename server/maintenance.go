@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultMaintenanceMessage = "The service is read-only for scheduled maintenance, please try again shortly"
+
+// MaintenanceMode makes the service read-only, e.g. while a database
+// migration runs. AllowLogins keeps sign-in and token refresh working even
+// though they record the login.
+type MaintenanceMode struct {
+	Enabled     bool   `yaml:"enabled"`
+	Message     string `yaml:"message"`
+	AllowLogins bool   `yaml:"allow_logins"`
+}
+
+func (m MaintenanceMode) message() string {
+	if m.Message != "" {
+		return m.Message
+	}
+	return defaultMaintenanceMessage
+}
+
+// readOnlyMethods answer during maintenance. Anything not listed is
+// treated as a write, so new RPCs are blocked until added here. Reads that
+// claim work (GetDueDigests) or assign state (GetAssignments, compliance
+// exports) are deliberately left out.
+var readOnlyMethods = map[string]bool{
+	pb.UserService_GetBillingProfile_FullMethodName:        true,
+	pb.UserService_GetUserSegments_FullMethodName:          true,
+	pb.UserService_GetUserStats_FullMethodName:             true,
+	pb.UserService_WatchUserMetrics_FullMethodName:         true,
+	pb.UserService_ListOutboxEvents_FullMethodName:         true,
+	pb.UserService_ListDeadLetters_FullMethodName:          true,
+	pb.UserService_IssueUserToken_FullMethodName:           true,
+	pb.UserService_ValidateToken_FullMethodName:            true,
+	pb.UserService_IssueServiceToken_FullMethodName:        true,
+	pb.UserService_ListKYCReviewQueue_FullMethodName:       true,
+	pb.UserService_GetIdentityVerification_FullMethodName:  true,
+	pb.UserService_GetPayoutVerification_FullMethodName:    true,
+	pb.UserService_GetWallet_FullMethodName:                true,
+	pb.UserService_ListGiftCards_FullMethodName:            true,
+	pb.UserService_GetGiftCardBalance_FullMethodName:       true,
+	pb.UserService_ListCoupons_FullMethodName:              true,
+	pb.UserService_GetFeedbackSummary_FullMethodName:       true,
+	pb.UserService_ListTickets_FullMethodName:              true,
+	pb.UserService_GetPresence_FullMethodName:              true,
+	pb.UserService_SuggestUsers_FullMethodName:             true,
+	pb.UserService_ListDuplicateCandidates_FullMethodName:  true,
+	pb.UserService_GetOperation_FullMethodName:             true,
+	pb.UserService_ListOperations_FullMethodName:           true,
+	pb.UserService_GetServerInfo_FullMethodName:            true,
+	pb.UserService_GetSLOStatus_FullMethodName:             true,
+	pb.UserService_ListSubAccounts_FullMethodName:          true,
+	pb.UserService_ListOrgMembers_FullMethodName:           true,
+	pb.UserService_ListUserOrganizations_FullMethodName:    true,
+	pb.UserService_GetInvite_FullMethodName:                true,
+	pb.UserService_ListSavedSearches_FullMethodName:        true,
+	pb.UserService_ListProductAlerts_FullMethodName:        true,
+	pb.UserService_GetRecentlyViewed_FullMethodName:        true,
+	pb.UserService_ListModerationQueue_FullMethodName:      true,
+	pb.UserService_GetPublicProfile_FullMethodName:         true,
+	pb.UserService_GetPublicProfiles_FullMethodName:        true,
+	pb.UserService_GetUserProfile_FullMethodName:           true,
+	pb.UserService_GetDigestPreferences_FullMethodName:     true,
+	pb.UserService_GetSecurityStatus_FullMethodName:        true,
+	pb.UserService_ExportSecurityEvents_FullMethodName:     true,
+	pb.UserService_GetAwayMode_FullMethodName:              true,
+	pb.UserService_GetTaxProfile_FullMethodName:            true,
+	pb.UserService_ListAttributeDefinitions_FullMethodName: true,
+	pb.UserService_GetAttributes_FullMethodName:            true,
+	pb.UserService_GetProfileHistory_FullMethodName:        true,
+	pb.UserService_GetDownloadURL_FullMethodName:           true,
+}
+
+// inMaintenance reports whether writes are currently paused
+func (rc *runtimeConfig) inMaintenance() bool {
+	return rc.get().Maintenance.Enabled
+}
+
+// maintenanceCheck rejects fullMethod when maintenance mode is on and the
+// method writes. Health checks and reflection are not UserService methods
+// and always pass.
+func (rc *runtimeConfig) maintenanceCheck(fullMethod string) error {
+	m := rc.get().Maintenance
+	if !m.Enabled || !needsMongo(fullMethod) || readOnlyMethods[fullMethod] {
+		return nil
+	}
+	if m.AllowLogins && (fullMethod == pb.UserService_LoginUser_FullMethodName || fullMethod == pb.UserService_LoginUSSDUser_FullMethodName || fullMethod == pb.UserService_RefreshToken_FullMethodName) {
+		return nil
+	}
+	return status.Error(codes.Unavailable, m.message())
+}
+
+// maintenanceInterceptor fails writes while maintenance mode is on
+func maintenanceInterceptor(rc *runtimeConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := rc.maintenanceCheck(info.FullMethod); err != nil {
+			rc.debugf("Rejected %s during maintenance", info.FullMethod)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// maintenanceStreamInterceptor does the same for streams
+func maintenanceStreamInterceptor(rc *runtimeConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := rc.maintenanceCheck(info.FullMethod); err != nil {
+			rc.debugf("Rejected %s during maintenance", info.FullMethod)
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMetricsInterval = 5 * time.Second
+	minMetricsInterval     = time.Second
+	rollingWindow          = 60
+)
+
+// rollingCounter counts events over the last minute using one bucket per second
+type rollingCounter struct {
+	mu      sync.Mutex
+	buckets [rollingWindow]int64
+	stamps  [rollingWindow]int64
+}
+
+func (c *rollingCounter) inc(now time.Time) {
+	sec := now.Unix()
+	i := sec % rollingWindow
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stamps[i] != sec {
+		c.stamps[i] = sec
+		c.buckets[i] = 0
+	}
+	c.buckets[i]++
+}
+
+// perMinute sums the buckets that still fall inside the window
+func (c *rollingCounter) perMinute(now time.Time) int64 {
+	sec := now.Unix()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var total int64
+	for i := range c.buckets {
+		if sec-c.stamps[i] < rollingWindow {
+			total += c.buckets[i]
+		}
+	}
+	return total
+}
+
+// trafficMetrics holds the live counters pushed to the ops dashboard
+type trafficMetrics struct {
+	signups  rollingCounter
+	logins   rollingCounter
+	failures rollingCounter
+}
+
+func (m *trafficMetrics) snapshot(now time.Time) *pb.UserMetricsSnapshot {
+	return &pb.UserMetricsSnapshot{
+		TimestampUnix:     now.Unix(),
+		SignupsPerMinute:  m.signups.perMinute(now),
+		LoginsPerMinute:   m.logins.perMinute(now),
+		FailuresPerMinute: m.failures.perMinute(now),
+	}
+}
+
+// trafficInterceptor feeds the rolling counters from RegisterUser and LoginUser outcomes
+func trafficInterceptor(m *trafficMetrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		var counter *rollingCounter
+		switch info.FullMethod {
+		case pb.UserService_RegisterUser_FullMethodName, pb.UserService_RegisterUSSDUser_FullMethodName:
+			counter = &m.signups
+		case pb.UserService_LoginUser_FullMethodName, pb.UserService_LoginUSSDUser_FullMethodName:
+			counter = &m.logins
+		default:
+			return resp, err
+		}
+		if err != nil {
+			counter = &m.failures
+		}
+		counter.inc(time.Now())
+
+		return resp, err
+	}
+}
+
+// WatchUserMetrics streams rolling signup, login and failure rates until the client disconnects
+func (s *userService) WatchUserMetrics(req *pb.WatchUserMetricsMessageRequest, stream grpc.ServerStreamingServer[pb.UserMetricsSnapshot]) error {
+	interval := defaultMetricsInterval
+	if req.GetIntervalSeconds() > 0 {
+		interval = time.Duration(req.GetIntervalSeconds()) * time.Second
+	}
+	if interval < minMetricsInterval {
+		return status.Error(codes.InvalidArgument, "interval must be at least one second")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := stream.Send(s.metrics.snapshot(time.Now())); err != nil {
+			return err
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserProfileModerated = "user.profile_moderated"
+
+	maxDisplayNameLength       = 40
+	maxAvatarBytes             = 2 << 20
+	moderationReviewURLTTL     = 15 * time.Minute
+	publicAvatarURLTTL         = time.Hour
+	defaultModerationQueueSize = 20
+	maxModerationQueueSize     = 100
+)
+
+// Moderation state of user-generated profile content
+const (
+	moderationPending  = "pending"
+	moderationApproved = "approved"
+	moderationRejected = "rejected"
+)
+
+// Moderated profile fields, named after their key on the user document
+const (
+	moderationFieldDisplayName = "display_name"
+	moderationFieldAvatar      = "avatar"
+)
+
+var moderationFields = []string{moderationFieldDisplayName, moderationFieldAvatar}
+
+var avatarContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// ModeratedField holds user-generated content that needs review before it is
+// shown publicly. Value is the last approved content and stays visible while
+// a newer submission waits in Pending. Avatars store their object key.
+type ModeratedField struct {
+	Value       string     `bson:"value,omitempty"`
+	Pending     string     `bson:"pending,omitempty"`
+	Status      string     `bson:"status"`
+	SubmittedAt *time.Time `bson:"submitted_at,omitempty"`
+	ReviewedAt  *time.Time `bson:"reviewed_at,omitempty"`
+	Reviewer    string     `bson:"reviewer,omitempty"`
+	Reason      string     `bson:"reason,omitempty"`
+}
+
+func (u *User) moderatedField(field string) *ModeratedField {
+	switch field {
+	case moderationFieldDisplayName:
+		return u.DisplayName
+	case moderationFieldAvatar:
+		return u.Avatar
+	}
+	return nil
+}
+
+// avatarKeys lists the stored avatar objects of a user, including the
+// generated fallback
+func (u *User) avatarKeys() []string {
+	var keys []string
+	if u.GeneratedAvatar != "" {
+		keys = append(keys, u.GeneratedAvatar)
+	}
+	if u.Avatar != nil {
+		for _, key := range []string{u.Avatar.Value, u.Avatar.Pending} {
+			if key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+func parseModerationField(field string) (string, error) {
+	field = strings.ToLower(strings.TrimSpace(field))
+	for _, f := range moderationFields {
+		if f == field {
+			return field, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unknown moderation field %q", field)
+}
+
+// submitForModeration stores new content as pending, clearing the outcome of
+// any earlier review
+func (s *userService) submitForModeration(ctx context.Context, userID primitive.ObjectID, field, value string) error {
+	now := time.Now()
+	_, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": userID}, bson.M{
+		"$set": bson.M{
+			field + ".pending":      value,
+			field + ".status":       moderationPending,
+			field + ".submitted_at": now,
+			"updated_at":            now,
+		},
+		"$unset": bson.M{field + ".reviewed_at": "", field + ".reviewer": "", field + ".reason": ""},
+	})
+	return err
+}
+
+// UpdateDisplayName submits a new display name for review. An empty name
+// removes the display name straight away.
+func (s *userService) UpdateDisplayName(ctx context.Context, req *pb.UpdateDisplayNameMessageRequest) (*pb.UpdateDisplayNameMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.GetDisplayName())
+	collection := s.collection(ctx, "users")
+	if name == "" {
+		_, err := collection.UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+			"$unset": bson.M{moderationFieldDisplayName: ""},
+			"$set":   bson.M{"updated_at": time.Now()},
+		})
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to update display name")
+		}
+		return &pb.UpdateDisplayNameMessageResponse{Message: "Display name removed", Success: true}, nil
+	}
+
+	if utf8.RuneCountInString(name) > maxDisplayNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "display name must be at most %d characters", maxDisplayNameLength)
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return nil, status.Error(codes.InvalidArgument, "display name contains invalid characters")
+		}
+	}
+	if current := user.DisplayName; current != nil && current.Value == name && current.Pending == "" {
+		return &pb.UpdateDisplayNameMessageResponse{ModerationStatus: current.Status, Message: "Display name unchanged", Success: true}, nil
+	}
+
+	if err := s.submitForModeration(ctx, user.ID, moderationFieldDisplayName, name); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update display name")
+	}
+	return &pb.UpdateDisplayNameMessageResponse{
+		ModerationStatus: moderationPending,
+		Message:          "Display name submitted for review",
+		Success:          true,
+	}, nil
+}
+
+// UploadAvatar receives a profile picture as a stream: the first message
+// carries its metadata and the rest carry image chunks. The avatar is held
+// for review and the previous one stays visible until it is approved.
+func (s *userService) UploadAvatar(stream grpc.ClientStreamingServer[pb.UploadAvatarMessageRequest, pb.UploadAvatarMessageResponse]) error {
+	ctx := stream.Context()
+
+	// 1. Read and validate the metadata
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "avatar metadata is required")
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry avatar metadata")
+	}
+	ext, ok := avatarContentTypes[info.GetContentType()]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "avatar must be a JPEG, PNG or WebP image")
+	}
+	user, err := s.findUserByID(ctx, info.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// 2. Collect the image
+	var buf bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if buf.Len()+len(msg.GetChunk()) > maxAvatarBytes {
+			return status.Error(codes.InvalidArgument, "avatar exceeds 2 MB")
+		}
+		buf.Write(msg.GetChunk())
+	}
+	if buf.Len() == 0 {
+		return status.Error(codes.InvalidArgument, "avatar is empty")
+	}
+
+	// 3. Scan and store it
+	if err := s.scanner.Scan(ctx, "avatar"+ext, buf.Bytes()); err != nil {
+		if errors.Is(err, errInfected) {
+			s.quarantineUpload(ctx, user, uploadKindAvatar, "avatar"+ext, info.GetContentType(), buf.Bytes(), err)
+			return status.Error(codes.InvalidArgument, "avatar failed virus scan")
+		}
+		log.Printf("Virus scan failed: %v", err)
+		return status.Error(codes.Unavailable, "image scanning unavailable, try again later")
+	}
+
+	key := "avatars/" + user.ID.Hex() + "/" + primitive.NewObjectID().Hex() + ext
+	if err := s.store.Put(ctx, key, buf.Bytes(), info.GetContentType()); err != nil {
+		log.Printf("Failed to store avatar: %v", err)
+		return status.Error(codes.Internal, "failed to store avatar")
+	}
+
+	// 4. Queue it for review, replacing any avatar still waiting
+	if err := s.submitForModeration(ctx, user.ID, moderationFieldAvatar, key); err != nil {
+		log.Printf("Database error: %v", err)
+		return status.Error(codes.Internal, "failed to store avatar")
+	}
+	if user.Avatar != nil && user.Avatar.Pending != "" {
+		if err := s.store.Delete(ctx, user.Avatar.Pending); err != nil {
+			log.Printf("Failed to delete replaced avatar %s: %v", user.Avatar.Pending, err)
+		}
+	}
+
+	return stream.SendAndClose(&pb.UploadAvatarMessageResponse{
+		ModerationStatus: moderationPending,
+		Message:          "Avatar submitted for review",
+		Success:          true,
+	})
+}
+
+// ListModerationQueue returns profile content awaiting review, oldest
+// submission first. Avatars come with a short-lived link to the image.
+func (s *userService) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueMessageRequest) (*pb.ListModerationQueueMessageResponse, error) {
+	fields := moderationFields
+	if req.GetField() != "" {
+		field, err := parseModerationField(req.GetField())
+		if err != nil {
+			return nil, err
+		}
+		fields = []string{field}
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultModerationQueueSize
+	}
+	if limit > maxModerationQueueSize {
+		limit = maxModerationQueueSize
+	}
+
+	collection := s.collection(ctx, "users")
+	resp := &pb.ListModerationQueueMessageResponse{}
+	for _, field := range fields {
+		cursor, err := collection.Find(ctx,
+			bson.M{field + ".status": moderationPending, "deleted_at": nil},
+			options.Find().SetSort(bson.D{{Key: field + ".submitted_at", Value: 1}}).SetLimit(limit),
+		)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to list moderation queue")
+		}
+		var users []User
+		if err := cursor.All(ctx, &users); err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to list moderation queue")
+		}
+
+		for i := range users {
+			content := users[i].moderatedField(field)
+			if content == nil {
+				continue
+			}
+			item := &pb.ModerationItem{UserId: users[i].ID.Hex(), Field: field, Value: content.Pending}
+			if content.SubmittedAt != nil {
+				item.SubmittedAtUnix = content.SubmittedAt.Unix()
+			}
+			if field == moderationFieldAvatar {
+				url, err := s.store.SignedURL(ctx, content.Pending, moderationReviewURLTTL)
+				if err != nil {
+					log.Printf("Failed to sign avatar URL: %v", err)
+					return nil, status.Error(codes.Internal, "failed to list moderation queue")
+				}
+				item.Value = url
+			}
+			resp.Items = append(resp.Items, item)
+		}
+	}
+
+	sort.SliceStable(resp.Items, func(i, j int) bool {
+		return resp.Items[i].SubmittedAtUnix < resp.Items[j].SubmittedAtUnix
+	})
+	if int64(len(resp.Items)) > limit {
+		resp.Items = resp.Items[:limit]
+	}
+	return resp, nil
+}
+
+// ReviewModeration approves or rejects pending profile content. Approved
+// content replaces what is shown publicly; rejected content is discarded.
+func (s *userService) ReviewModeration(ctx context.Context, req *pb.ReviewModerationMessageRequest) (*pb.ReviewModerationMessageResponse, error) {
+	field, err := parseModerationField(req.GetField())
+	if err != nil {
+		return nil, err
+	}
+	reviewer := strings.TrimSpace(req.GetReviewer())
+	if reviewer == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewer is required")
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if !req.GetApprove() && reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "rejection reason is required")
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	content := user.moderatedField(field)
+	if content == nil || content.Status != moderationPending {
+		return nil, status.Error(codes.FailedPrecondition, "nothing is awaiting review")
+	}
+
+	// 1. Record the outcome, unless a newer submission arrived meanwhile
+	now := time.Now()
+	outcome := moderationRejected
+	set := bson.M{field + ".reviewed_at": now, field + ".reviewer": reviewer, "updated_at": now}
+	if req.GetApprove() {
+		outcome = moderationApproved
+		set[field+".value"] = content.Pending
+	} else {
+		set[field+".reason"] = reason
+	}
+	set[field+".status"] = outcome
+
+	res, err := s.collection(ctx, "users").UpdateOne(ctx,
+		bson.M{"_id": user.ID, field + ".status": moderationPending, field + ".pending": content.Pending},
+		bson.M{"$set": set, "$unset": bson.M{field + ".pending": ""}},
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to record review")
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.Aborted, "content changed during review, reload the queue")
+	}
+
+	// 2. Drop the avatar image that is no longer in use
+	if field == moderationFieldAvatar {
+		unused := content.Pending
+		if req.GetApprove() {
+			unused = content.Value
+		}
+		if unused != "" {
+			if err := s.store.Delete(ctx, unused); err != nil {
+				log.Printf("Failed to delete avatar %s: %v", unused, err)
+			}
+		}
+	}
+
+	payload := map[string]interface{}{"field": field, "outcome": outcome, "reviewed_at": now}
+	if reason != "" {
+		payload["reason"] = reason
+	}
+	s.recordEvent(ctx, eventUserProfileModerated, user.ID, payload)
+
+	return &pb.ReviewModerationMessageResponse{Message: "Review recorded", Success: true}, nil
+}
+
+// GetPublicProfile returns what other shoppers may see of a user. Only
+// approved display names and avatars are included.
+func (s *userService) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileMessageRequest) (*pb.GetPublicProfileMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.publicProfile(ctx, user, req.GetViewerId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetPublicProfileMessageResponse{
+		UserId:      profile.UserId,
+		UserName:    profile.UserName,
+		DisplayName: profile.DisplayName,
+		AvatarUrl:   profile.AvatarUrl,
+		Hidden:      profile.Hidden,
+	}, nil
+}
+
+// publicProfile renders the public view of a user for viewerID, who may be
+// empty for anonymous shoppers
+func (s *userService) publicProfile(ctx context.Context, user *User, viewerID string) (*pb.PublicProfile, error) {
+	profile := &pb.PublicProfile{
+		UserId:   user.ID.Hex(),
+		UserName: user.UserName,
+		Hidden:   user.hiddenFrom(viewerID),
+	}
+	if user.DisplayName != nil {
+		profile.DisplayName = user.DisplayName.Value
+	}
+	if user.Avatar != nil && user.Avatar.Value != "" {
+		url, err := s.store.SignedURL(ctx, user.Avatar.Value, publicAvatarURLTTL)
+		if err != nil {
+			log.Printf("Failed to sign avatar URL: %v", err)
+			return nil, status.Error(codes.Internal, "failed to load profile")
+		}
+		profile.AvatarUrl = url
+	} else {
+		url, err := s.fallbackAvatarURL(ctx, user)
+		if err != nil {
+			log.Printf("Failed to generate fallback avatar: %v", err)
+			return nil, status.Error(codes.Internal, "failed to load profile")
+		}
+		profile.AvatarUrl = url
+	}
+	return profile, nil
+}
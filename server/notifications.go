@@ -0,0 +1,340 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	notificationTimeout    = 2 * time.Minute
+	emailVerificationTTL   = 24 * time.Hour
+	maxPushTokensPerUser   = 10
+	africasTalkingSMSURL   = "https://api.africastalking.com/version1/messaging"
+	whatsAppAPIURL         = "https://graph.facebook.com/v19.0"
+	verificationCodeDigits = 6
+)
+
+type EmailVerification struct {
+	UserID    primitive.ObjectID `bson:"user_id"`
+	CodeHash  string             `bson:"code_hash"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+}
+
+// newNotifier registers a sender per channel from the environment, falling
+// back to log output for channels without a configured provider.
+func newNotifier() *notify.Dispatcher {
+	d := notify.NewDispatcher()
+
+	if host := os.Getenv("SMTP_ADDR"); host != "" {
+		d.Register(&notify.SMTPSender{
+			Addr:     host,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
+		}, notify.RetryPolicy{})
+	} else {
+		d.Register(notify.LogSender{Ch: notify.ChannelEmail}, notify.RetryPolicy{})
+	}
+
+	if key := os.Getenv("SMS_API_KEY"); key != "" {
+		smsURL := os.Getenv("SMS_API_URL")
+		if smsURL == "" {
+			smsURL = africasTalkingSMSURL
+		}
+		d.Register(&notify.AfricasTalkingSender{
+			URL:      smsURL,
+			Username: os.Getenv("SMS_USERNAME"),
+			APIKey:   key,
+			SenderID: os.Getenv("SMS_SENDER_ID"),
+		}, notify.RetryPolicy{})
+	} else {
+		d.Register(notify.LogSender{Ch: notify.ChannelSMS}, notify.RetryPolicy{})
+	}
+
+	if pushURL := os.Getenv("PUSH_WEBHOOK_URL"); pushURL != "" {
+		d.Register(&notify.WebhookPushSender{URL: pushURL}, notify.RetryPolicy{})
+	} else {
+		d.Register(notify.LogSender{Ch: notify.ChannelPush}, notify.RetryPolicy{})
+	}
+
+	// WhatsApp has no log stand-in: without a provider, users preferring it
+	// fall back to SMS rather than to the log
+	if token := os.Getenv("WHATSAPP_TOKEN"); token != "" {
+		apiURL := os.Getenv("WHATSAPP_API_URL")
+		if apiURL == "" {
+			apiURL = whatsAppAPIURL
+		}
+		sender := &notify.WhatsAppSender{
+			URL:      strings.TrimSuffix(apiURL, "/") + "/" + os.Getenv("WHATSAPP_PHONE_NUMBER_ID") + "/messages",
+			Token:    token,
+			Language: os.Getenv("WHATSAPP_TEMPLATE_LANGUAGE"),
+		}
+		if name := os.Getenv("WHATSAPP_OTP_TEMPLATE"); name != "" {
+			sender.Templates = map[notify.Kind]string{notify.KindOTP: name}
+		}
+		d.Register(sender, notify.RetryPolicy{})
+	}
+
+	return d
+}
+
+func recipientFor(user *User) notify.Recipient {
+	r := notify.Recipient{
+		UserID:     user.ID.Hex(),
+		Name:       user.FullName,
+		Email:      user.EmailAddress,
+		Phone:      user.PhoneNumber,
+		PushTokens: user.PushTokens,
+	}
+	// Bounced or complaining addresses get no more email
+	if user.emailUndeliverable() {
+		r.Email = ""
+	}
+	// Contact-only numbers cannot receive SMS
+	if user.phoneUnreachable() || !user.phoneIsMobile() {
+		r.Phone = ""
+	}
+	if user.phoneIsMobile() {
+		r.WhatsApp = user.PhoneNumber
+	}
+	if len(user.NotificationPrefs) > 0 {
+		r.Preferences = make(map[notify.Kind][]notify.Channel, len(user.NotificationPrefs))
+		for kind, channels := range user.NotificationPrefs {
+			for _, ch := range channels {
+				r.Preferences[notify.Kind(kind)] = append(r.Preferences[notify.Kind(kind)], notify.Channel(ch))
+			}
+		}
+	}
+	return r
+}
+
+// notifyUser renders and dispatches a notification in the background so
+// provider latency and retries never hold up the RPC.
+func (s *userService) notifyUser(user *User, kind notify.Kind, data map[string]string) {
+	if data == nil {
+		data = make(map[string]string)
+	}
+	data["name"] = user.FullName
+
+	msg, err := notify.Render(kind, data)
+	if err != nil {
+		log.Printf("Failed to render %s notification: %v", kind, err)
+		return
+	}
+
+	recipient := recipientFor(user)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+		defer cancel()
+		if err := s.notifier.Dispatch(ctx, recipient, msg); err != nil {
+			log.Printf("Failed to deliver %s notification to user %s: %v", kind, recipient.UserID, err)
+		}
+	}()
+}
+
+// sendEmailVerification issues a fresh verification code and emails it
+func (s *userService) sendEmailVerification(ctx context.Context, user *User) {
+	code, err := randomDigits(verificationCodeDigits)
+	if err != nil {
+		log.Printf("Failed to generate verification code: %v", err)
+		return
+	}
+
+	collection := s.collection(ctx, "email_verifications")
+	now := time.Now()
+	_, err = collection.InsertOne(ctx, EmailVerification{
+		UserID:    user.ID,
+		CodeHash:  hashCode(code),
+		CreatedAt: now,
+		ExpiresAt: now.Add(emailVerificationTTL),
+	})
+	if err != nil {
+		log.Printf("Failed to store verification code: %v", err)
+		return
+	}
+
+	s.notifyUser(user, notify.KindVerifyEmail, map[string]string{
+		"code":       code,
+		"expires_in": "24 hours",
+	})
+}
+
+// VerifyEmail confirms an email address with the code sent at registration
+func (s *userService) VerifyEmail(ctx context.Context, req *pb.VerifyEmailMessageRequest) (*pb.VerifyEmailMessageResponse, error) {
+	if strings.TrimSpace(req.GetEmail()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	var user User
+	err := s.collection(ctx, "users").FindOne(ctx, bson.M{"email": strings.TrimSpace(req.GetEmail())}, findCaseInsensitive()).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired verification code")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if user.EmailVerifiedAt != nil {
+		return &pb.VerifyEmailMessageResponse{Message: "Email already verified", Success: true}, nil
+	}
+
+	res, err := s.collection(ctx, "email_verifications").DeleteOne(ctx, bson.M{
+		"user_id":    user.ID,
+		"code_hash":  hashCode(strings.TrimSpace(req.GetCode())),
+		"expires_at": bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired verification code")
+	}
+
+	now := time.Now()
+	_, err = s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$set": bson.M{"email_verified_at": now, "updated_at": now},
+	})
+	if err != nil {
+		log.Printf("Failed to mark email verified: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	s.collection(ctx, "email_verifications").DeleteMany(ctx, bson.M{"user_id": user.ID})
+
+	return &pb.VerifyEmailMessageResponse{Message: "Email verified", Success: true}, nil
+}
+
+var notificationKinds = map[notify.Kind]bool{
+	notify.KindVerifyEmail:       true,
+	notify.KindNewLogin:          true,
+	notify.KindPasswordChanged:   true,
+	notify.KindDeletionPending:   true,
+	notify.KindDeletionReminder:  true,
+	notify.KindDeletionCancelled: true,
+	notify.KindOrgInvite:         true,
+	notify.KindOTP:               true,
+
+	notify.KindRecoveryContactChanged: true,
+	notify.KindAccountRecoveryStarted: true,
+}
+
+var notificationChannels = map[notify.Channel]bool{
+	notify.ChannelEmail:    true,
+	notify.ChannelSMS:      true,
+	notify.ChannelPush:     true,
+	notify.ChannelWhatsApp: true,
+}
+
+// otpChannels are the channels a user may choose for one-time codes. Email
+// is only ever a fallback, see sendOTP.
+var otpChannels = map[notify.Channel]bool{
+	notify.ChannelSMS:      true,
+	notify.ChannelWhatsApp: true,
+}
+
+// SetNotificationPreferences replaces the channels a user wants per notification kind
+func (s *userService) SetNotificationPreferences(ctx context.Context, req *pb.SetNotificationPreferencesMessageRequest) (*pb.SetNotificationPreferencesMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := make(map[string][]string)
+	for _, p := range req.GetPreferences() {
+		kind := notify.Kind(strings.ToLower(strings.TrimSpace(p.GetKind())))
+		if !notificationKinds[kind] {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown notification kind %q", p.GetKind())
+		}
+		channels := []string{}
+		for _, ch := range p.GetChannels() {
+			channel := notify.Channel(strings.ToLower(strings.TrimSpace(ch)))
+			if !notificationChannels[channel] {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown notification channel %q", ch)
+			}
+			if kind == notify.KindOTP && !otpChannels[channel] {
+				return nil, status.Errorf(codes.InvalidArgument, "codes cannot be sent by %s", ch)
+			}
+			channels = append(channels, string(channel))
+		}
+		prefs[string(kind)] = channels
+	}
+
+	collection := s.collection(ctx, "users")
+	res, err := collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{"notification_prefs": prefs, "updated_at": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Failed to update notification preferences: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update notification preferences")
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	return &pb.SetNotificationPreferencesMessageResponse{Message: "Notification preferences updated", Success: true}, nil
+}
+
+// RegisterPushToken attaches a device push token to a user, keeping the most recent tokens
+func (s *userService) RegisterPushToken(ctx context.Context, req *pb.RegisterPushTokenMessageRequest) (*pb.RegisterPushTokenMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	token := strings.TrimSpace(req.GetToken())
+	if token == "" {
+		return nil, status.Error(codes.InvalidArgument, "push token is required")
+	}
+
+	collection := s.collection(ctx, "users")
+	if _, err := collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$pull": bson.M{"push_tokens": token}}); err != nil {
+		log.Printf("Failed to update push tokens: %v", err)
+		return nil, status.Error(codes.Internal, "failed to register push token")
+	}
+	res, err := collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$push": bson.M{"push_tokens": bson.M{"$each": []string{token}, "$slice": -maxPushTokensPerUser}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Failed to update push tokens: %v", err)
+		return nil, status.Error(codes.Internal, "failed to register push token")
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	return &pb.RegisterPushTokenMessageResponse{Message: "Push token registered", Success: true}, nil
+}
+
+func randomDigits(n int) (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < n; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	v, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", n, v), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	operationNamePrefix     = "operations/"
+	operationPollInterval   = 5 * time.Second
+	operationLease          = 2 * time.Minute
+	defaultOperationsListed = 50
+	maxOperationsListed     = 200
+)
+
+// Operation statuses
+const (
+	operationQueued    = "queued"
+	operationRunning   = "running"
+	operationSucceeded = "succeeded"
+	operationFailed    = "failed"
+	operationCancelled = "cancelled"
+)
+
+// errOperationCancelled is returned by checkpointOperation once a cancel was
+// requested, so runners stop at the next batch boundary
+var errOperationCancelled = errors.New("operation cancelled")
+
+// Operation is a long-running job started by an RPC that returns straight
+// away. Runners advance in batches and checkpoint Cursor and Progress, so an
+// operation interrupted by a restart is resumed by another worker once its
+// lease expires.
+type Operation struct {
+	ID              primitive.ObjectID  `bson:"_id,omitempty"`
+	Kind            string              `bson:"kind"`
+	Status          string              `bson:"status"`
+	Params          bson.Raw            `bson:"params,omitempty"`
+	Cursor          *primitive.ObjectID `bson:"cursor,omitempty"`
+	Progress        map[string]int64    `bson:"progress,omitempty"`
+	Result          map[string]string   `bson:"result,omitempty"`
+	Error           string              `bson:"error,omitempty"`
+	CancelRequested bool                `bson:"cancel_requested,omitempty"`
+	RequestedBy     string              `bson:"requested_by,omitempty"`
+	LeaseUntil      *time.Time          `bson:"lease_until,omitempty"`
+	CreatedAt       time.Time           `bson:"created_at"`
+	UpdatedAt       time.Time           `bson:"updated_at"`
+	StartedAt       *time.Time          `bson:"started_at,omitempty"`
+	FinishedAt      *time.Time          `bson:"finished_at,omitempty"`
+}
+
+// operationRunner executes one kind of operation and returns its result
+type operationRunner func(s *userService, ctx context.Context, op *Operation) (map[string]string, error)
+
+// operationRunners maps each operation kind to the code that runs it
+var operationRunners = map[string]operationRunner{}
+
+func operationName(id primitive.ObjectID) string {
+	return operationNamePrefix + id.Hex()
+}
+
+func parseOperationName(name string) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(strings.TrimPrefix(name, operationNamePrefix))
+	if err != nil {
+		return primitive.NilObjectID, status.Error(codes.InvalidArgument, "invalid operation name")
+	}
+	return id, nil
+}
+
+func operationToProto(op *Operation) *pb.Operation {
+	o := &pb.Operation{
+		Name:          operationName(op.ID),
+		Kind:          op.Kind,
+		Status:        op.Status,
+		Done:          op.FinishedAt != nil,
+		Progress:      op.Progress,
+		Result:        op.Result,
+		Error:         op.Error,
+		RequestedBy:   op.RequestedBy,
+		CreatedAtUnix: op.CreatedAt.Unix(),
+	}
+	if op.StartedAt != nil {
+		o.StartedAtUnix = op.StartedAt.Unix()
+	}
+	if op.FinishedAt != nil {
+		o.FinishedAtUnix = op.FinishedAt.Unix()
+	}
+	return o
+}
+
+// startOperation queues an operation of the given kind for the worker
+func (s *userService) startOperation(ctx context.Context, kind string, params interface{}) (*Operation, error) {
+	raw, err := bson.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	op := Operation{
+		Kind:      kind,
+		Status:    operationQueued,
+		Params:    raw,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if client := clientFromContext(ctx); client != nil {
+		op.RequestedBy = client.Service
+	}
+
+	res, err := s.collection(ctx, "operations").InsertOne(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	op.ID = res.InsertedID.(primitive.ObjectID)
+	log.Printf("Queued %s operation %s by %s", kind, op.ID.Hex(), op.RequestedBy)
+	return &op, nil
+}
+
+// checkpointOperation saves the runner position and renews the lease. It
+// returns errOperationCancelled when a cancel was requested meanwhile.
+func (s *userService) checkpointOperation(ctx context.Context, op *Operation) error {
+	now := time.Now()
+	var current Operation
+	err := s.collection(ctx, "operations").FindOneAndUpdate(ctx,
+		bson.M{"_id": op.ID},
+		bson.M{"$set": bson.M{
+			"cursor":      op.Cursor,
+			"progress":    op.Progress,
+			"lease_until": now.Add(operationLease),
+			"updated_at":  now,
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&current)
+	if err != nil {
+		return err
+	}
+	if current.CancelRequested {
+		return errOperationCancelled
+	}
+	return nil
+}
+
+func (s *userService) runOperationWorker(ctx context.Context) {
+	ticker := time.NewTicker(operationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if !s.config.inMaintenance() {
+			for s.claimAndRunOperation(ctx) {
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// claimAndRunOperation takes the oldest queued operation, or a running one
+// whose worker stopped renewing its lease, and runs it. It reports whether
+// an operation was found.
+func (s *userService) claimAndRunOperation(ctx context.Context) bool {
+	collection := s.collection(ctx, "operations")
+	now := time.Now()
+
+	var op Operation
+	err := collection.FindOneAndUpdate(ctx,
+		bson.M{"$or": bson.A{
+			bson.M{"status": operationQueued},
+			bson.M{"status": operationRunning, "lease_until": bson.M{"$lt": now}},
+		}},
+		bson.M{"$set": bson.M{"status": operationRunning, "lease_until": now.Add(operationLease), "updated_at": now}},
+		options.FindOneAndUpdate().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetReturnDocument(options.After),
+	).Decode(&op)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.Printf("Failed to claim operation: %v", err)
+		}
+		return false
+	}
+	if op.StartedAt == nil {
+		collection.UpdateOne(ctx, bson.M{"_id": op.ID}, bson.M{"$set": bson.M{"started_at": now}})
+	}
+
+	set := bson.M{}
+	run, ok := operationRunners[op.Kind]
+	if !ok {
+		set["status"], set["error"] = operationFailed, "unknown operation kind "+op.Kind
+	} else if op.CancelRequested {
+		set["status"] = operationCancelled
+	} else {
+		result, err := run(s, ctx, &op)
+		switch {
+		case errors.Is(err, errOperationCancelled):
+			set["status"] = operationCancelled
+		case err != nil:
+			log.Printf("Operation %s (%s) failed: %v", op.ID.Hex(), op.Kind, err)
+			set["status"], set["error"] = operationFailed, err.Error()
+		default:
+			set["status"], set["result"] = operationSucceeded, result
+		}
+		set["cursor"], set["progress"] = op.Cursor, op.Progress
+	}
+
+	finished := time.Now()
+	set["finished_at"], set["updated_at"] = finished, finished
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": op.ID}, bson.M{"$set": set, "$unset": bson.M{"lease_until": ""}})
+	if err != nil {
+		log.Printf("Failed to finish operation %s: %v", op.ID.Hex(), err)
+	}
+	log.Printf("Operation %s (%s) %s", op.ID.Hex(), op.Kind, set["status"])
+	return true
+}
+
+// GetOperation returns the latest state of an operation
+func (s *userService) GetOperation(ctx context.Context, req *pb.GetOperationMessageRequest) (*pb.GetOperationMessageResponse, error) {
+	id, err := parseOperationName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var op Operation
+	err = s.collection(ctx, "operations").FindOne(ctx, bson.M{"_id": id}).Decode(&op)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "operation not found")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load operation")
+	}
+	return &pb.GetOperationMessageResponse{Operation: operationToProto(&op)}, nil
+}
+
+// ListOperations pages through operations, newest first. The page token is
+// the name of the last operation of the previous page.
+func (s *userService) ListOperations(ctx context.Context, req *pb.ListOperationsMessageRequest) (*pb.ListOperationsMessageResponse, error) {
+	limit := int64(req.GetPageSize())
+	if limit <= 0 {
+		limit = defaultOperationsListed
+	}
+	if limit > maxOperationsListed {
+		limit = maxOperationsListed
+	}
+
+	filter := bson.M{}
+	if req.GetKind() != "" {
+		filter["kind"] = req.GetKind()
+	}
+	if req.GetStatus() != "" {
+		filter["status"] = req.GetStatus()
+	}
+	if req.GetPageToken() != "" {
+		after, err := parseOperationName(req.GetPageToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		filter["_id"] = bson.M{"$lt": after}
+	}
+
+	collection := s.collection(ctx, "operations")
+	cursor, err := collection.Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{{Key: "_id", Value: -1}}).
+			SetLimit(limit).
+			SetProjection(bson.M{"params": 0}),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list operations")
+	}
+	var ops []Operation
+	if err := cursor.All(ctx, &ops); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list operations")
+	}
+
+	resp := &pb.ListOperationsMessageResponse{}
+	for i := range ops {
+		resp.Operations = append(resp.Operations, operationToProto(&ops[i]))
+	}
+	if int64(len(ops)) == limit {
+		resp.NextPageToken = operationName(ops[len(ops)-1].ID)
+	}
+	return resp, nil
+}
+
+// CancelOperation stops an operation. Queued operations are cancelled at
+// once; running ones stop at their next checkpoint, keeping the batches
+// already applied.
+func (s *userService) CancelOperation(ctx context.Context, req *pb.CancelOperationMessageRequest) (*pb.CancelOperationMessageResponse, error) {
+	id, err := parseOperationName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "operations")
+	now := time.Now()
+	res, err := collection.UpdateOne(ctx,
+		bson.M{"_id": id, "status": operationQueued},
+		bson.M{"$set": bson.M{"status": operationCancelled, "cancel_requested": true, "finished_at": now, "updated_at": now}},
+	)
+	if err == nil && res.MatchedCount == 0 {
+		res, err = collection.UpdateOne(ctx,
+			bson.M{"_id": id, "status": operationRunning},
+			bson.M{"$set": bson.M{"cancel_requested": true, "updated_at": now}},
+		)
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to cancel operation")
+	}
+	if res.MatchedCount == 0 {
+		count, err := collection.CountDocuments(ctx, bson.M{"_id": id})
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to cancel operation")
+		}
+		if count == 0 {
+			return nil, status.Error(codes.NotFound, "operation not found")
+		}
+		return nil, status.Error(codes.FailedPrecondition, "operation already finished")
+	}
+
+	return &pb.CancelOperationMessageResponse{Message: "Cancellation requested", Success: true}, nil
+}
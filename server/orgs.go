@@ -0,0 +1,546 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserOrgJoined = "user.org_joined"
+	eventUserOrgLeft   = "user.org_left"
+
+	maxOrgNameLength   = 120
+	maxOrgMembers      = 500
+	maxOrgsPerUser     = 20
+	memberStatusActive = "active"
+	memberStatusInvite = "invited"
+)
+
+// Roles within an organization. Owners and admins manage members; only
+// owners grant the owner and admin roles. Purchasers place orders on the
+// company account and viewers only see its orders.
+const (
+	orgRoleOwner     = "owner"
+	orgRoleAdmin     = "admin"
+	orgRolePurchaser = "purchaser"
+	orgRoleViewer    = "viewer"
+)
+
+var orgRoles = map[string]bool{orgRoleOwner: true, orgRoleAdmin: true, orgRolePurchaser: true, orgRoleViewer: true}
+
+// Organization is a company account shared by several purchasing users
+type Organization struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Name         string             `bson:"name"`
+	BillingEmail string             `bson:"billing_email,omitempty"`
+	CreatedBy    primitive.ObjectID `bson:"created_by"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+}
+
+// OrgMember links a user to an organization. Invited members become active
+// once they accept.
+type OrgMember struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	OrgID     primitive.ObjectID `bson:"org_id"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Role      string             `bson:"role"`
+	Status    string             `bson:"status"`
+	InvitedBy primitive.ObjectID `bson:"invited_by,omitempty"`
+	InvitedAt time.Time          `bson:"invited_at"`
+	JoinedAt  *time.Time         `bson:"joined_at,omitempty"`
+}
+
+func organizationToProto(o *Organization) *pb.Organization {
+	return &pb.Organization{
+		Id:              o.ID.Hex(),
+		Name:            o.Name,
+		BillingEmail:    o.BillingEmail,
+		CreatedByUserId: o.CreatedBy.Hex(),
+		CreatedAtUnix:   o.CreatedAt.Unix(),
+	}
+}
+
+func orgMemberToProto(m *OrgMember, u *User) *pb.OrgMember {
+	msg := &pb.OrgMember{
+		UserId:        m.UserID.Hex(),
+		Role:          m.Role,
+		Status:        m.Status,
+		InvitedAtUnix: m.InvitedAt.Unix(),
+	}
+	if !m.InvitedBy.IsZero() {
+		msg.InvitedByUserId = m.InvitedBy.Hex()
+	}
+	if m.JoinedAt != nil {
+		msg.JoinedAtUnix = m.JoinedAt.Unix()
+	}
+	if u != nil {
+		msg.FullName = u.FullName
+		msg.UserName = u.UserName
+	}
+	return msg
+}
+
+func parseOrgID(orgID string) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(strings.TrimSpace(orgID))
+	if err != nil {
+		return primitive.NilObjectID, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	return id, nil
+}
+
+func parseOrgRole(role string) (string, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if !orgRoles[role] {
+		return "", status.Errorf(codes.InvalidArgument, "unknown organization role %q", role)
+	}
+	return role, nil
+}
+
+func (s *userService) findOrganization(ctx context.Context, id primitive.ObjectID) (*Organization, error) {
+	var org Organization
+	err := s.collection(ctx, "organizations").FindOne(ctx, bson.M{"_id": id}).Decode(&org)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "organization not found")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	return &org, nil
+}
+
+func (s *userService) findOrgMember(ctx context.Context, orgID, userID primitive.ObjectID) (*OrgMember, error) {
+	var member OrgMember
+	err := s.collection(ctx, "org_members").FindOne(ctx, bson.M{"org_id": orgID, "user_id": userID}).Decode(&member)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "membership not found")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	return &member, nil
+}
+
+// requireOrgManager returns the membership of the acting user if they are
+// an active owner or admin of the organization
+func (s *userService) requireOrgManager(ctx context.Context, orgID primitive.ObjectID, actorID string) (*OrgMember, error) {
+	actor, err := parseUserID(actorID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.findOrgMember(ctx, orgID, actor)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.PermissionDenied, "not a member of this organization")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if member.Status != memberStatusActive || (member.Role != orgRoleOwner && member.Role != orgRoleAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "only owners and admins can manage members")
+	}
+	return member, nil
+}
+
+// countOwners counts the active owners, which must never drop to zero
+func (s *userService) countOwners(ctx context.Context, orgID primitive.ObjectID) (int64, error) {
+	return s.collection(ctx, "org_members").CountDocuments(ctx, bson.M{
+		"org_id": orgID, "role": orgRoleOwner, "status": memberStatusActive,
+	})
+}
+
+// CreateOrganization creates a company account owned by the calling user
+func (s *userService) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationMessageRequest) (*pb.CreateOrganizationMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.GetName())
+	if name == "" || len(name) > maxOrgNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "organization name must be 1 to %d characters", maxOrgNameLength)
+	}
+	billingEmail := strings.TrimSpace(req.GetBillingEmail())
+	if billingEmail != "" && (!strings.Contains(billingEmail, "@") || !strings.Contains(billingEmail, ".")) {
+		return nil, status.Error(codes.InvalidArgument, "invalid billing email format")
+	}
+
+	count, err := s.collection(ctx, "org_members").CountDocuments(ctx, bson.M{"user_id": user.ID})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create organization")
+	}
+	if count >= maxOrgsPerUser {
+		return nil, status.Error(codes.ResourceExhausted, "too many organizations")
+	}
+
+	// 1. Create the organization
+	now := time.Now()
+	org := Organization{
+		ID:           primitive.NewObjectID(),
+		Name:         name,
+		BillingEmail: billingEmail,
+		CreatedBy:    user.ID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if _, err := s.collection(ctx, "organizations").InsertOne(ctx, org); err != nil {
+		log.Printf("Failed to create organization: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create organization")
+	}
+
+	// 2. Make the creator its first owner
+	_, err = s.collection(ctx, "org_members").InsertOne(ctx, OrgMember{
+		OrgID:     org.ID,
+		UserID:    user.ID,
+		Role:      orgRoleOwner,
+		Status:    memberStatusActive,
+		InvitedAt: now,
+		JoinedAt:  &now,
+	})
+	if err != nil {
+		log.Printf("Failed to add organization owner: %v", err)
+		s.collection(ctx, "organizations").DeleteOne(ctx, bson.M{"_id": org.ID})
+		return nil, status.Error(codes.Internal, "failed to create organization")
+	}
+	s.recordEvent(ctx, eventUserOrgJoined, user.ID, map[string]interface{}{"org_id": org.ID.Hex(), "role": orgRoleOwner})
+
+	return &pb.CreateOrganizationMessageResponse{
+		Organization: organizationToProto(&org),
+		Message:      "Organization created",
+		Success:      true,
+	}, nil
+}
+
+// InviteOrgMember invites a registered user by email. The invitation shows
+// up in their organizations until they accept it.
+func (s *userService) InviteOrgMember(ctx context.Context, req *pb.InviteOrgMemberMessageRequest) (*pb.InviteOrgMemberMessageResponse, error) {
+	orgID, err := parseOrgID(req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	role, err := parseOrgRole(req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.findOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.requireOrgManager(ctx, orgID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if (role == orgRoleOwner || role == orgRoleAdmin) && actor.Role != orgRoleOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owners can grant the owner or admin role")
+	}
+
+	// 1. Find the invitee
+	if strings.TrimSpace(req.GetEmailAddress()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	var invitee User
+	err = s.collection(ctx, "users").FindOne(ctx,
+		bson.M{"email": strings.TrimSpace(req.GetEmailAddress()), "deleted_at": nil},
+		findCaseInsensitive(),
+	).Decode(&invitee)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "no account with this email")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to invite member")
+	}
+	if invitee.ParentID != nil && invitee.SubAccount != nil && invitee.SubAccount.Relationship == "child" {
+		return nil, status.Error(codes.FailedPrecondition, "child accounts cannot join organizations")
+	}
+
+	count, err := s.collection(ctx, "org_members").CountDocuments(ctx, bson.M{"org_id": orgID})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to invite member")
+	}
+	if count >= maxOrgMembers {
+		return nil, status.Error(codes.ResourceExhausted, "organization has too many members")
+	}
+
+	// 2. Record the invitation
+	member := OrgMember{
+		OrgID:     orgID,
+		UserID:    invitee.ID,
+		Role:      role,
+		Status:    memberStatusInvite,
+		InvitedBy: actor.UserID,
+		InvitedAt: time.Now(),
+	}
+	if _, err := s.collection(ctx, "org_members").InsertOne(ctx, member); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "user is already a member or invited")
+		}
+		log.Printf("Failed to invite member: %v", err)
+		return nil, status.Error(codes.Internal, "failed to invite member")
+	}
+
+	inviter := "A colleague"
+	if u, err := s.findUserByID(ctx, actor.UserID.Hex()); err == nil {
+		inviter = u.FullName
+	}
+	s.notifyUser(&invitee, notify.KindOrgInvite, map[string]string{"org": org.Name, "inviter": inviter, "role": role})
+
+	return &pb.InviteOrgMemberMessageResponse{
+		Member:  orgMemberToProto(&member, &invitee),
+		Message: "Invitation sent",
+		Success: true,
+	}, nil
+}
+
+// AcceptOrgInvite activates a pending invitation of the calling user
+func (s *userService) AcceptOrgInvite(ctx context.Context, req *pb.AcceptOrgInviteMessageRequest) (*pb.AcceptOrgInviteMessageResponse, error) {
+	orgID, err := parseOrgID(req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var member OrgMember
+	err = s.collection(ctx, "org_members").FindOneAndUpdate(ctx,
+		bson.M{"org_id": orgID, "user_id": user.ID, "status": memberStatusInvite},
+		bson.M{"$set": bson.M{"status": memberStatusActive, "joined_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&member)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "invitation not found")
+		}
+		log.Printf("Failed to accept invitation: %v", err)
+		return nil, status.Error(codes.Internal, "failed to accept invitation")
+	}
+	s.recordEvent(ctx, eventUserOrgJoined, user.ID, map[string]interface{}{"org_id": orgID.Hex(), "role": member.Role})
+
+	return &pb.AcceptOrgInviteMessageResponse{
+		Member:  orgMemberToProto(&member, user),
+		Message: "Invitation accepted",
+		Success: true,
+	}, nil
+}
+
+// SetOrgMemberRole changes the role of a member. The last owner cannot be
+// demoted.
+func (s *userService) SetOrgMemberRole(ctx context.Context, req *pb.SetOrgMemberRoleMessageRequest) (*pb.SetOrgMemberRoleMessageResponse, error) {
+	orgID, err := parseOrgID(req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	memberID, err := parseUserID(req.GetMemberUserId())
+	if err != nil {
+		return nil, err
+	}
+	role, err := parseOrgRole(req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.requireOrgManager(ctx, orgID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.findOrgMember(ctx, orgID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	touchesPrivileged := role == orgRoleOwner || role == orgRoleAdmin || member.Role == orgRoleOwner || member.Role == orgRoleAdmin
+	if touchesPrivileged && actor.Role != orgRoleOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owners can change owner or admin roles")
+	}
+	if member.Role == orgRoleOwner && role != orgRoleOwner && member.Status == memberStatusActive {
+		owners, err := s.countOwners(ctx, orgID)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to update role")
+		}
+		if owners <= 1 {
+			return nil, status.Error(codes.FailedPrecondition, "an organization needs at least one owner")
+		}
+	}
+
+	_, err = s.collection(ctx, "org_members").UpdateOne(ctx,
+		bson.M{"_id": member.ID},
+		bson.M{"$set": bson.M{"role": role}},
+	)
+	if err != nil {
+		log.Printf("Failed to update member role: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update role")
+	}
+	member.Role = role
+
+	user, _ := s.findUserByID(ctx, memberID.Hex())
+	return &pb.SetOrgMemberRoleMessageResponse{
+		Member:  orgMemberToProto(member, user),
+		Message: "Role updated",
+		Success: true,
+	}, nil
+}
+
+// RemoveOrgMember removes a member or withdraws an invitation. Members may
+// always remove themselves, which is how a user leaves or declines.
+func (s *userService) RemoveOrgMember(ctx context.Context, req *pb.RemoveOrgMemberMessageRequest) (*pb.RemoveOrgMemberMessageResponse, error) {
+	orgID, err := parseOrgID(req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	memberID, err := parseUserID(req.GetMemberUserId())
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.findOrgMember(ctx, orgID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorID != memberID {
+		actor, err := s.requireOrgManager(ctx, orgID, req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		if (member.Role == orgRoleOwner || member.Role == orgRoleAdmin) && actor.Role != orgRoleOwner {
+			return nil, status.Error(codes.PermissionDenied, "only owners can remove owners or admins")
+		}
+	}
+	if member.Role == orgRoleOwner && member.Status == memberStatusActive {
+		owners, err := s.countOwners(ctx, orgID)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "failed to remove member")
+		}
+		if owners <= 1 {
+			return nil, status.Error(codes.FailedPrecondition, "an organization needs at least one owner")
+		}
+	}
+
+	if _, err := s.collection(ctx, "org_members").DeleteOne(ctx, bson.M{"_id": member.ID}); err != nil {
+		log.Printf("Failed to remove member: %v", err)
+		return nil, status.Error(codes.Internal, "failed to remove member")
+	}
+	if member.Status == memberStatusActive {
+		s.recordEvent(ctx, eventUserOrgLeft, memberID, map[string]interface{}{"org_id": orgID.Hex()})
+	}
+
+	return &pb.RemoveOrgMemberMessageResponse{Message: "Member removed", Success: true}, nil
+}
+
+// ListOrgMembers lists the members and pending invitations of an
+// organization to any of its active members
+func (s *userService) ListOrgMembers(ctx context.Context, req *pb.ListOrgMembersMessageRequest) (*pb.ListOrgMembersMessageResponse, error) {
+	orgID, err := parseOrgID(req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.findOrgMember(ctx, orgID, actorID)
+	if err != nil || actor.Status != memberStatusActive {
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		return nil, status.Error(codes.PermissionDenied, "not a member of this organization")
+	}
+
+	cursor, err := s.collection(ctx, "org_members").Find(ctx,
+		bson.M{"org_id": orgID},
+		options.Find().SetSort(bson.D{{Key: "invited_at", Value: 1}}).SetLimit(maxOrgMembers),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list members")
+	}
+	var members []OrgMember
+	if err := cursor.All(ctx, &members); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list members")
+	}
+
+	ids := make([]primitive.ObjectID, len(members))
+	for i := range members {
+		ids[i] = members[i].UserID
+	}
+	users := make(map[primitive.ObjectID]*User)
+	cursor, err = s.collection(ctx, "users").Find(ctx, bson.M{"_id": bson.M{"$in": ids}},
+		options.Find().SetProjection(bson.M{"full_name": 1, "user_name": 1}))
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list members")
+	}
+	var found []User
+	if err := cursor.All(ctx, &found); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list members")
+	}
+	for i := range found {
+		users[found[i].ID] = &found[i]
+	}
+
+	resp := &pb.ListOrgMembersMessageResponse{}
+	for i := range members {
+		resp.Members = append(resp.Members, orgMemberToProto(&members[i], users[members[i].UserID]))
+	}
+	return resp, nil
+}
+
+// ListUserOrganizations lists the organizations a user belongs to or has
+// been invited to
+func (s *userService) ListUserOrganizations(ctx context.Context, req *pb.ListUserOrganizationsMessageRequest) (*pb.ListUserOrganizationsMessageResponse, error) {
+	userID, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.collection(ctx, "org_members").Find(ctx, bson.M{"user_id": userID},
+		options.Find().SetLimit(maxOrgsPerUser))
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list organizations")
+	}
+	var members []OrgMember
+	if err := cursor.All(ctx, &members); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list organizations")
+	}
+
+	resp := &pb.ListUserOrganizationsMessageResponse{}
+	for i := range members {
+		org, err := s.findOrganization(ctx, members[i].OrgID)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp.Memberships = append(resp.Memberships, &pb.OrgMembership{
+			Organization: organizationToProto(org),
+			Role:         members[i].Role,
+			Status:       members[i].Status,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/config"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Event types written to the outbox
+const (
+	eventUserRegistered     = "user.registered"
+	eventUserBillingUpdated = "user.billing_updated"
+)
+
+const (
+	outboxPollInterval  = 2 * time.Second
+	outboxBatchSize     = 100
+	defaultOutboxLimit  = 50
+	maxOutboxLimit      = 500
+	aggregateTypeUser   = "user"
+	eventPublishTimeout = 10 * time.Second
+	maxOutboxBackoff    = 10 * time.Minute
+)
+
+// OutboxEvent is a change to a user waiting in the outbox until the relay
+// publishes it. Delivery from the outbox is at least once.
+type OutboxEvent struct {
+	ID            primitive.ObjectID     `bson:"_id,omitempty"`
+	AggregateID   string                 `bson:"aggregate_id"`
+	AggregateType string                 `bson:"aggregate_type"`
+	Type          string                 `bson:"type"`
+	Payload       map[string]interface{} `bson:"payload"`
+	CreatedAt     time.Time              `bson:"created_at"`
+	PublishedAt   *time.Time             `bson:"published_at"`
+	Attempts      int                    `bson:"attempts"`
+	LastError     string                 `bson:"last_error,omitempty"`
+	NextAttemptAt *time.Time             `bson:"next_attempt_at,omitempty"`
+
+	// Row images for the Debezium encoding, as relaxed extended JSON
+	Before string `bson:"before,omitempty"`
+	After  string `bson:"after,omitempty"`
+}
+
+// eventPublisher delivers outbox events to downstream consumers
+type eventPublisher interface {
+	Publish(ctx context.Context, event *OutboxEvent) error
+}
+
+// logPublisher is used when no broker is configured
+type logPublisher struct{}
+
+func (logPublisher) Publish(_ context.Context, event *OutboxEvent) error {
+	log.Printf("Event %s for %s %s", event.Type, event.AggregateType, event.AggregateID)
+	return nil
+}
+
+// webhookPublisher POSTs each event as JSON to EVENTS_WEBHOOK_URL
+type webhookPublisher struct {
+	url    string
+	client *http.Client
+	encode func(ctx context.Context, event *OutboxEvent) ([]byte, error)
+}
+
+// encodeNative is the service's own event encoding
+func encodeNative(_ context.Context, event *OutboxEvent) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"id":             event.ID.Hex(),
+		"aggregate_id":   event.AggregateID,
+		"aggregate_type": event.AggregateType,
+		"type":           event.Type,
+		"payload":        event.Payload,
+		"created_at":     event.CreatedAt,
+	})
+}
+
+func (p *webhookPublisher) Publish(ctx context.Context, event *OutboxEvent) error {
+	body, err := p.encode(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", event.ID.Hex())
+	req.Header.Set("X-Event-Type", event.Type)
+	req.Header.Set("X-Event-Key", event.AggregateID)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func newEventPublisher(webhookURL, format string, mongoCfg config.MongoConfig) (eventPublisher, error) {
+	encode := encodeNative
+	switch format {
+	case "", eventFormatNative:
+	case eventFormatDebezium:
+		encode = debeziumEncoder(mongoCfg)
+	default:
+		return nil, fmt.Errorf("unknown event format %q", format)
+	}
+	if webhookURL == "" {
+		return logPublisher{}, nil
+	}
+	return &webhookPublisher{url: webhookURL, client: &http.Client{Timeout: eventPublishTimeout}, encode: encode}, nil
+}
+
+// recordEvent appends an event for a user to the outbox after the change it
+// describes was stored. It is best effort: the insert is not part of the
+// change's write, and failures are logged rather than returned so the
+// primary write is never rolled back by them. An event lost that way is not
+// published.
+func (s *userService) recordEvent(ctx context.Context, eventType string, userID primitive.ObjectID, payload map[string]interface{}) {
+	event := OutboxEvent{
+		AggregateID:   userID.Hex(),
+		AggregateType: aggregateTypeUser,
+		Type:          eventType,
+		Payload:       payload,
+		CreatedAt:     time.Now(),
+	}
+	if s.cdcImages {
+		event.Before, event.After = s.captureRowImages(ctx, eventType, userID)
+	}
+
+	collection := s.collection(ctx, "outbox")
+	_, err := collection.InsertOne(ctx, event)
+	if err != nil {
+		log.Printf("Failed to record %s event: %v", eventType, err)
+	}
+}
+
+// outboxRelay publishes pending outbox events, retrying failures with
+// exponential backoff and dead-lettering events that exhaust their attempts.
+type outboxRelay struct {
+	svc         *userService
+	publisher   eventPublisher
+	maxAttempts int
+}
+
+// run publishes pending outbox events until ctx is cancelled
+func (r *outboxRelay) run(ctx context.Context) {
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		r.relayPendingEvents(ctx)
+		// The backlog gauge covers the default storage
+		if storageTenant(ctx) == "" {
+			r.svc.updateDeadLetterBacklog(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *outboxRelay) relayPendingEvents(ctx context.Context) {
+	collection := r.svc.collection(ctx, "outbox")
+	now := time.Now()
+	cursor, err := collection.Find(ctx,
+		bson.M{
+			"published_at": nil,
+			"$or": []bson.M{
+				{"next_attempt_at": nil},
+				{"next_attempt_at": bson.M{"$lte": now}},
+			},
+		},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetLimit(outboxBatchSize),
+	)
+	if err != nil {
+		log.Printf("Failed to load outbox events: %v", err)
+		return
+	}
+
+	var events []OutboxEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		log.Printf("Failed to decode outbox events: %v", err)
+		return
+	}
+
+	for i := range events {
+		event := &events[i]
+		err := r.publisher.Publish(ctx, event)
+		if err == nil {
+			_, err = collection.UpdateOne(ctx, bson.M{"_id": event.ID}, bson.M{
+				"$inc":   bson.M{"attempts": 1},
+				"$set":   bson.M{"published_at": time.Now(), "last_error": ""},
+				"$unset": bson.M{"next_attempt_at": ""},
+			})
+			if err != nil {
+				log.Printf("Failed to mark event %s as published: %v", event.ID.Hex(), err)
+			}
+			continue
+		}
+
+		log.Printf("Failed to publish event %s: %v", event.ID.Hex(), err)
+		outboxPublishFailures.WithLabelValues(event.Type).Inc()
+		event.Attempts++
+		event.LastError = err.Error()
+
+		if event.Attempts >= r.maxAttempts {
+			r.svc.deadLetterEvent(ctx, event, "retries exhausted")
+			continue
+		}
+
+		_, err = collection.UpdateOne(ctx, bson.M{"_id": event.ID}, bson.M{
+			"$set": bson.M{
+				"attempts":        event.Attempts,
+				"last_error":      event.LastError,
+				"next_attempt_at": time.Now().Add(outboxBackoff(event.Attempts)),
+			},
+		})
+		if err != nil {
+			log.Printf("Failed to schedule retry for event %s: %v", event.ID.Hex(), err)
+		}
+	}
+}
+
+// outboxBackoff doubles the retry delay per attempt, capped at maxOutboxBackoff
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxPollInterval
+	for i := 1; i < attempts && delay < maxOutboxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxOutboxBackoff {
+		delay = maxOutboxBackoff
+	}
+	return delay
+}
+
+// outboxFilter converts the admin filter into a Mongo query
+func outboxFilter(f *pb.OutboxEventFilter) bson.M {
+	filter := bson.M{}
+	if f.GetAggregateId() != "" {
+		filter["aggregate_id"] = f.GetAggregateId()
+	}
+	if f.GetType() != "" {
+		filter["type"] = f.GetType()
+	}
+	created := bson.M{}
+	if f.GetFromUnix() > 0 {
+		created["$gte"] = time.Unix(f.GetFromUnix(), 0)
+	}
+	if f.GetToUnix() > 0 {
+		created["$lt"] = time.Unix(f.GetToUnix(), 0)
+	}
+	if len(created) > 0 {
+		filter["created_at"] = created
+	}
+	if f.GetUnpublishedOnly() {
+		filter["published_at"] = nil
+	}
+	return filter
+}
+
+// ListOutboxEvents lets operators inspect recorded events, newest first
+func (s *userService) ListOutboxEvents(ctx context.Context, req *pb.ListOutboxEventsMessageRequest) (*pb.ListOutboxEventsMessageResponse, error) {
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultOutboxLimit
+	}
+	if limit > maxOutboxLimit {
+		limit = maxOutboxLimit
+	}
+
+	collection := s.collection(ctx, "outbox")
+	cursor, err := collection.Find(ctx, outboxFilter(req.GetFilter()),
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(limit),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list events")
+	}
+
+	var events []OutboxEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list events")
+	}
+
+	resp := &pb.ListOutboxEventsMessageResponse{}
+	for i := range events {
+		msg, err := outboxEventToProto(&events[i])
+		if err != nil {
+			log.Printf("Failed to encode event %s: %v", events[i].ID.Hex(), err)
+			return nil, status.Error(codes.Internal, "failed to list events")
+		}
+		resp.Events = append(resp.Events, msg)
+	}
+	return resp, nil
+}
+
+// RepublishOutboxEvents marks matching events as unpublished so the relay
+// delivers them again. A filter on aggregate ID or time range is required.
+func (s *userService) RepublishOutboxEvents(ctx context.Context, req *pb.RepublishOutboxEventsMessageRequest) (*pb.RepublishOutboxEventsMessageResponse, error) {
+	f := req.GetFilter()
+	if f.GetAggregateId() == "" && f.GetFromUnix() == 0 && f.GetToUnix() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "aggregate id or time range is required")
+	}
+
+	collection := s.collection(ctx, "outbox")
+	res, err := collection.UpdateMany(ctx, outboxFilter(f), bson.M{
+		"$set":   bson.M{"published_at": nil, "attempts": 0, "last_error": ""},
+		"$unset": bson.M{"next_attempt_at": ""},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to requeue events")
+	}
+
+	log.Printf("Requeued %d outbox events for republishing", res.ModifiedCount)
+	return &pb.RepublishOutboxEventsMessageResponse{Requeued: res.ModifiedCount}, nil
+}
+
+func outboxEventToProto(e *OutboxEvent) (*pb.OutboxEvent, error) {
+	payload, err := json.Marshal(e.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.OutboxEvent{
+		Id:            e.ID.Hex(),
+		AggregateId:   e.AggregateID,
+		AggregateType: e.AggregateType,
+		Type:          e.Type,
+		Payload:       string(payload),
+		CreatedAtUnix: e.CreatedAt.Unix(),
+		Attempts:      int32(e.Attempts),
+		LastError:     e.LastError,
+	}
+	if e.PublishedAt != nil {
+		msg.PublishedAtUnix = e.PublishedAt.Unix()
+	}
+	return msg, nil
+}
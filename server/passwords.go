@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bruceoaudo/userService/internal/password"
+)
+
+const (
+	defaultBcryptCost = 12
+	minBcryptCost     = 10
+	maxBcryptCost     = 16
+)
+
+// newPasswordRegistry hashes new passwords with PASSWORD_HASH_SCHEME,
+// bcrypt by default so stored hashes stay verifiable by standard bcrypt
+// libraries. BCRYPT_COST and ARGON2_MEMORY_KIB, ARGON2_ITERATIONS and
+// ARGON2_PARALLELISM tune the schemes. Hashes of every scheme, and
+// plaintext values written before hashing was introduced, keep verifying
+// and are rehashed with the preferred scheme on the next login.
+func newPasswordRegistry() (*password.Registry, error) {
+	cost, err := envUint("BCRYPT_COST", defaultBcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	if cost < minBcryptCost || cost > maxBcryptCost {
+		return nil, fmt.Errorf("BCRYPT_COST must be between %d and %d", minBcryptCost, maxBcryptCost)
+	}
+	bcryptScheme := password.Bcrypt{Cost: int(cost)}
+
+	argon := password.DefaultArgon2id
+	memory, err := envUint("ARGON2_MEMORY_KIB", uint64(argon.Memory))
+	if err != nil {
+		return nil, err
+	}
+	iterations, err := envUint("ARGON2_ITERATIONS", uint64(argon.Iterations))
+	if err != nil {
+		return nil, err
+	}
+	parallelism, err := envUint("ARGON2_PARALLELISM", uint64(argon.Parallelism))
+	if err != nil {
+		return nil, err
+	}
+	if memory < 19*1024 || iterations < 1 || parallelism < 1 || parallelism > 255 {
+		return nil, fmt.Errorf("argon2id needs at least 19456 KiB of memory, one iteration and 1 to 255 threads")
+	}
+	argon.Memory, argon.Iterations, argon.Parallelism = uint32(memory), uint32(iterations), uint8(parallelism)
+
+	switch strings.ToLower(os.Getenv("PASSWORD_HASH_SCHEME")) {
+	case "", "bcrypt":
+		return password.NewRegistry(bcryptScheme, argon, password.DefaultScrypt).AllowLegacyPlaintext(), nil
+	case "argon2id":
+		return password.NewRegistry(argon, bcryptScheme, password.DefaultScrypt).AllowLegacyPlaintext(), nil
+	default:
+		return nil, fmt.Errorf("unknown PASSWORD_HASH_SCHEME %q", os.Getenv("PASSWORD_HASH_SCHEME"))
+	}
+}
+
+func envUint(name string, fallback uint64) (uint64, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a positive integer", name)
+	}
+	return n, nil
+}
+
+// checkNewPassword applies the password policy to a new password, and the
+// length limit of the preferred hash scheme if it has one
+func (s *userService) checkNewPassword(plain string) error {
+	if err := s.config.get().PasswordPolicy.check(plain); err != nil {
+		return err
+	}
+	if max := s.passwords.MaxLength(); max > 0 && len(plain) > max {
+		return fmt.Errorf("password must be at most %d bytes", max)
+	}
+	return nil
+}
+
+// checkPassword verifies a password against the stored hash and transparently
+// upgrades hashes produced by an older scheme or with weaker parameters.
+func (s *userService) checkPassword(ctx context.Context, user *User, plain string) error {
+	needsRehash, err := s.passwords.Verify(plain, user.PasswordHash)
+	if err != nil {
+		return err
+	}
+	if !needsRehash {
+		return nil
+	}
+
+	hash, err := s.passwords.Hash(plain)
+	if err != nil {
+		log.Printf("Failed to rehash password for user %s: %v", user.ID.Hex(), err)
+		return nil
+	}
+	if err := s.users.SetPasswordHash(ctx, user.ID, user.PasswordHash, hash); err != nil {
+		log.Printf("Failed to store upgraded password hash for user %s: %v", user.ID.Hex(), err)
+		return nil
+	}
+	user.PasswordHash = hash
+	return nil
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// verifyDummyPassword spends the time checking a password takes, so logins
+// naming no account are not answered faster than wrong passwords
+func (s *userService) verifyDummyPassword(plain string) {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = s.passwords.Hash("not a password")
+	})
+	s.passwords.Verify(plain, dummyHash)
+}
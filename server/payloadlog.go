@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	redactedValue      = "[REDACTED]"
+	maxLoggedPayload   = 4096
+	payloadLogTruncate = "...(truncated)"
+)
+
+// Fields are redacted when a word of their name is listed here, or for the
+// longer secrets, when the name merely contains it
+var (
+	secretWords     = map[string]bool{"pin": true, "otp": true, "code": true, "key": true, "signature": true, "ciphertext": true}
+	contactWords    = map[string]bool{"email": true, "phone": true, "msisdn": true}
+	secretFragments = []string{"password", "token", "secret"}
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	phonePattern = regexp.MustCompile(`\+?\d[\d \-]{7,}\d`)
+)
+
+var payloadLogJSON = protojson.MarshalOptions{EmitUnpopulated: false}
+
+// fieldWords splits a camelCase or snake_case name into lowercase words
+func fieldWords(name string) []string {
+	var words []string
+	start := 0
+	for i := 1; i <= len(name); i++ {
+		if i == len(name) || name[i] == '_' || (name[i] >= 'A' && name[i] <= 'Z') {
+			if word := strings.Trim(name[start:i], "_"); word != "" {
+				words = append(words, strings.ToLower(word))
+			}
+			start = i
+		}
+	}
+	return words
+}
+
+func sensitiveField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, fragment := range secretFragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+	for _, word := range fieldWords(name) {
+		if secretWords[word] || contactWords[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubString masks emails and phone numbers inside free text
+func scrubString(v string) string {
+	v = emailPattern.ReplaceAllString(v, redactedValue)
+	return phonePattern.ReplaceAllString(v, redactedValue)
+}
+
+// redactMessage blanks sensitive fields of m in place, recursing into nested
+// messages, lists and maps. Bytes fields are always dropped.
+func redactMessage(m protoreflect.Message) {
+	type field struct {
+		fd protoreflect.FieldDescriptor
+		v  protoreflect.Value
+	}
+	var fields []field
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, field{fd, v})
+		return true
+	})
+
+	for _, f := range fields {
+		sensitive := sensitiveField(string(f.fd.Name()))
+		switch {
+		case f.fd.IsMap():
+			redactMap(f.fd, f.v.Map(), sensitive)
+		case f.fd.IsList():
+			list := f.v.List()
+			for i := 0; i < list.Len(); i++ {
+				if nv, ok := redactValue(f.fd, list.Get(i), sensitive); ok {
+					list.Set(i, nv)
+				}
+			}
+		default:
+			if nv, ok := redactValue(f.fd, f.v, sensitive); ok {
+				m.Set(f.fd, nv)
+			}
+		}
+	}
+}
+
+func redactMap(fd protoreflect.FieldDescriptor, mp protoreflect.Map, sensitive bool) {
+	vd := fd.MapValue()
+	var keys []protoreflect.MapKey
+	mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	for _, k := range keys {
+		if nv, ok := redactValue(vd, mp.Get(k), sensitive || sensitiveField(k.String())); ok {
+			mp.Set(k, nv)
+		}
+	}
+}
+
+// redactValue returns the replacement for a scalar value, or recurses into a
+// message and reports false
+func redactValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, sensitive bool) (protoreflect.Value, bool) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		redactMessage(v.Message())
+		return v, false
+	case protoreflect.StringKind:
+		if sensitive {
+			return protoreflect.ValueOfString(redactedValue), true
+		}
+		return protoreflect.ValueOfString(scrubString(v.String())), true
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(nil), true
+	}
+	return v, false
+}
+
+// redactedJSON renders a redacted copy of a message for the log
+func redactedJSON(v interface{}) string {
+	msg, ok := v.(proto.Message)
+	if !ok || !msg.ProtoReflect().IsValid() {
+		return "null"
+	}
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect())
+	out, err := payloadLogJSON.Marshal(clone)
+	if err != nil {
+		return "unencodable"
+	}
+	if len(out) > maxLoggedPayload {
+		return string(out[:maxLoggedPayload]) + payloadLogTruncate
+	}
+	return string(out)
+}
+
+// payloadLogInterceptor logs full request and response payloads for a
+// sample of unary calls. Secrets and contact details are redacted first so
+// the logs are safe to ship. The rate is a runtime tunable and defaults to 0.
+func payloadLogInterceptor(rc *runtimeConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rate := rc.get().PayloadLogSampleRate
+		if rate <= 0 || rand.Float64() >= rate {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		log.Printf("Payload %s caller=%s code=%s duration=%s request=%s response=%s",
+			info.FullMethod, callerKey(ctx), status.Code(err), time.Since(start).Round(time.Microsecond),
+			redactedJSON(req), redactedJSON(resp))
+		return resp, err
+	}
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/mpesa"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserPayoutVerified = "user.payout_verified"
+
+	defaultPayoutVerificationAmount = 10
+	payoutCallbackTimeout           = 10 * time.Second
+	maxPayoutCallbackBytes          = 1 << 20
+)
+
+// Payout verification states
+const (
+	payoutPending      = "pending"
+	payoutVerified     = "verified"
+	payoutNameMismatch = "name_mismatch"
+	payoutFailed       = "failed"
+)
+
+// PayoutVerification records whether the seller's phone is an M-Pesa
+// account registered in their name. Only a masked form of the M-Pesa name
+// is kept.
+type PayoutVerification struct {
+	Phone          string     `bson:"phone"`
+	Status         string     `bson:"status"`
+	ConversationID string     `bson:"conversation_id"`
+	MaskedName     string     `bson:"masked_name,omitempty"`
+	Reason         string     `bson:"reason,omitempty"`
+	RequestedAt    time.Time  `bson:"requested_at"`
+	CompletedAt    *time.Time `bson:"completed_at,omitempty"`
+}
+
+// payoutVerifier confirms account ownership by sending a small B2C payment
+// and reading the receiver's registered name from the Daraja result.
+type payoutVerifier struct {
+	client        *mpesa.Client
+	shortCode     string
+	initiator     string
+	credential    string
+	amount        int
+	resultURL     string
+	timeoutURL    string
+	callbackToken string
+}
+
+// newPayoutVerifier configures Daraja from MPESA_* variables. Payout
+// verification is disabled when MPESA_CONSUMER_KEY is unset.
+func newPayoutVerifier() (*payoutVerifier, error) {
+	key := os.Getenv("MPESA_CONSUMER_KEY")
+	if key == "" {
+		return nil, nil
+	}
+
+	baseURL := os.Getenv("MPESA_API_URL")
+	if baseURL == "" {
+		baseURL = mpesa.SandboxURL
+	}
+	v := &payoutVerifier{
+		client: &mpesa.Client{
+			BaseURL:        baseURL,
+			ConsumerKey:    key,
+			ConsumerSecret: os.Getenv("MPESA_CONSUMER_SECRET"),
+		},
+		shortCode:     os.Getenv("MPESA_SHORT_CODE"),
+		initiator:     os.Getenv("MPESA_INITIATOR_NAME"),
+		credential:    os.Getenv("MPESA_SECURITY_CREDENTIAL"),
+		amount:        defaultPayoutVerificationAmount,
+		callbackToken: os.Getenv("MPESA_CALLBACK_TOKEN"),
+	}
+	if v.shortCode == "" || v.initiator == "" || v.credential == "" || v.callbackToken == "" {
+		return nil, fmt.Errorf("MPESA_SHORT_CODE, MPESA_INITIATOR_NAME, MPESA_SECURITY_CREDENTIAL and MPESA_CALLBACK_TOKEN are required")
+	}
+	if raw := os.Getenv("MPESA_VERIFICATION_AMOUNT"); raw != "" {
+		amount, err := strconv.Atoi(raw)
+		if err != nil || amount < 1 {
+			return nil, fmt.Errorf("invalid MPESA_VERIFICATION_AMOUNT %q", raw)
+		}
+		v.amount = amount
+	}
+
+	// Daraja does not sign callbacks, so the URLs carry a shared token
+	base := strings.TrimRight(os.Getenv("PUBLIC_BASE_URL"), "/") + "/webhooks/mpesa/b2c"
+	query := "?token=" + url.QueryEscape(v.callbackToken)
+	v.resultURL = base + "/result" + query
+	v.timeoutURL = base + "/timeout" + query
+	return v, nil
+}
+
+// VerifyPayoutAccount starts confirming that a verified seller's phone
+// belongs to an M-Pesa account in their name. The result arrives
+// asynchronously; poll GetPayoutVerification.
+func (s *userService) VerifyPayoutAccount(ctx context.Context, req *pb.VerifyPayoutAccountMessageRequest) (*pb.VerifyPayoutAccountMessageResponse, error) {
+	if s.payouts == nil {
+		return nil, status.Error(codes.FailedPrecondition, "payout verification is not configured")
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.SellerStatus != sellerStatusVerified {
+		return nil, status.Error(codes.FailedPrecondition, "seller must pass KYC review before verifying a payout account")
+	}
+	if !user.phoneIsMobile() {
+		return nil, status.Error(codes.FailedPrecondition, "payout accounts need a mobile number")
+	}
+	phone := normalizePhoneNumber(user.PhoneNumber)
+	if p := user.Payout; p != nil && p.Phone == phone && (p.Status == payoutVerified || p.Status == payoutPending) {
+		return &pb.VerifyPayoutAccountMessageResponse{Status: p.Status, Message: "Payout account verification already " + p.Status}, nil
+	}
+
+	resp, err := s.payouts.client.B2C(ctx, mpesa.B2CRequest{
+		OriginatorConversationID: "payout-verify-" + user.ID.Hex() + "-" + strconv.FormatInt(time.Now().Unix(), 10),
+		InitiatorName:            s.payouts.initiator,
+		SecurityCredential:       s.payouts.credential,
+		CommandID:                "BusinessPayment",
+		Amount:                   s.payouts.amount,
+		PartyA:                   s.payouts.shortCode,
+		PartyB:                   phone,
+		Remarks:                  "AI-Shop payout account check",
+		QueueTimeOutURL:          s.payouts.timeoutURL,
+		ResultURL:                s.payouts.resultURL,
+		Occasion:                 "payout verification",
+	})
+	if err != nil {
+		log.Printf("Failed to start M-Pesa verification: %v", err)
+		return nil, status.Error(codes.Unavailable, "M-Pesa is unavailable, try again later")
+	}
+
+	verification := PayoutVerification{
+		Phone:          phone,
+		Status:         payoutPending,
+		ConversationID: resp.ConversationID,
+		RequestedAt:    time.Now(),
+	}
+	_, err = s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$set": bson.M{"payout_verification": verification, "updated_at": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start payout verification")
+	}
+
+	return &pb.VerifyPayoutAccountMessageResponse{Status: payoutPending, Message: "Payout account verification started"}, nil
+}
+
+// GetPayoutVerification reports the payout account check of a seller
+func (s *userService) GetPayoutVerification(ctx context.Context, req *pb.GetPayoutVerificationMessageRequest) (*pb.GetPayoutVerificationMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	p := user.Payout
+	if p == nil {
+		return &pb.GetPayoutVerificationMessageResponse{Status: "not_started"}, nil
+	}
+
+	resp := &pb.GetPayoutVerificationMessageResponse{
+		Status:            p.Status,
+		Phone:             maskValue(p.Phone, 3),
+		MaskedAccountName: p.MaskedName,
+		Reason:            p.Reason,
+	}
+	if p.CompletedAt != nil {
+		resp.CompletedAtUnix = p.CompletedAt.Unix()
+	}
+	return resp, nil
+}
+
+// payoutCallbackHandler receives B2C results and queue timeouts from Daraja
+func (s *userService) payoutCallbackHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		token := r.URL.Query().Get("token")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.payouts.callbackToken)) != 1 {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxPayoutCallbackBytes))
+		if err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		result, err := mpesa.ParseB2CResult(body)
+		if err != nil {
+			log.Printf("Failed to parse M-Pesa callback: %v", err)
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), payoutCallbackTimeout)
+		defer cancel()
+		timedOut := strings.HasSuffix(r.URL.Path, "/timeout")
+		if err := s.applyPayoutResult(ctx, result, timedOut); err != nil {
+			log.Printf("Failed to record M-Pesa result for %s: %v", result.ConversationID, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		// Daraja expects this acknowledgement body
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ResultCode":0,"ResultDesc":"Accepted"}`))
+	})
+}
+
+func (s *userService) applyPayoutResult(ctx context.Context, result *mpesa.B2CResult, timedOut bool) error {
+	collection := s.collection(ctx, "users")
+	filter := bson.M{"payout_verification.conversation_id": result.ConversationID, "payout_verification.status": payoutPending}
+
+	var user User
+	if err := collection.FindOne(ctx, filter).Decode(&user); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	set := bson.M{"payout_verification.completed_at": now, "updated_at": now}
+	switch {
+	case timedOut:
+		set["payout_verification.status"] = payoutFailed
+		set["payout_verification.reason"] = "request timed out"
+	case result.ResultCode != 0:
+		set["payout_verification.status"] = payoutFailed
+		set["payout_verification.reason"] = result.ResultDesc
+	default:
+		name := result.ReceiverName()
+		set["payout_verification.masked_name"] = maskName(name)
+		if namesMatch(user.FullName, name) {
+			set["payout_verification.status"] = payoutVerified
+		} else {
+			set["payout_verification.status"] = payoutNameMismatch
+			set["payout_verification.reason"] = "M-Pesa account is registered to a different name"
+		}
+	}
+	if _, err := collection.UpdateOne(ctx, filter, bson.M{"$set": set}); err != nil {
+		return err
+	}
+
+	if set["payout_verification.status"] == payoutVerified {
+		s.recordEvent(ctx, eventUserPayoutVerified, user.ID, map[string]interface{}{"verified_at": now})
+	}
+	return nil
+}
+
+func nameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
+
+// namesMatch accepts the M-Pesa name when at least two of its names, or its
+// only name, appear in the profile name. Safaricom often registers first and
+// last names only, or in a different order.
+func namesMatch(profileName, mpesaName string) bool {
+	have := make(map[string]bool)
+	for _, t := range nameTokens(profileName) {
+		have[t] = true
+	}
+	tokens := nameTokens(mpesaName)
+	matched := 0
+	for _, t := range tokens {
+		if have[t] {
+			matched++
+		}
+	}
+	return len(tokens) > 0 && (matched >= 2 || matched == len(tokens))
+}
+
+// maskName keeps the first letter of each name, e.g. "J*** K*****"
+func maskName(name string) string {
+	tokens := nameTokens(name)
+	for i, t := range tokens {
+		r := []rune(t)
+		tokens[i] = string(r[0]) + strings.Repeat("*", len(r)-1)
+	}
+	return strings.Join(tokens, " ")
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/status"
+)
+
+// Credentials a caller can present
+const (
+	credentialAPIKey = "api_key"
+	credentialMTLS   = "mtls"
+	credentialNone   = "none"
+)
+
+// apiKeyFingerprintLength hex digits of the key hash identify an API key in
+// logs and audit records without revealing it
+const apiKeyFingerprintLength = 12
+
+// maxSeenPeerCalls bounds the first-call log so a caller cycling through
+// identities cannot grow it without limit
+const maxSeenPeerCalls = 10000
+
+var peerCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "userservice_peer_calls_total",
+	Help: "Calls by internal services and calls to API-key-only methods, by calling service, credential " +
+		"(api_key, mtls, none), method and status code. Service is \"unregistered\" for a verified " +
+		"certificate that is not in MTLS_CLIENTS. Alert on new service and method pairs.",
+}, []string{"service", "credential", "method", "code"})
+
+const peerServiceUnregistered = "unregistered"
+
+// peerIdentity is what a caller proved about itself on this call
+type peerIdentity struct {
+	Credential string
+	// ID is the SPIFFE ID or common name of the client certificate, or the
+	// fingerprint of the API key
+	ID string
+	// Service is the registered service the credential maps to, empty when
+	// it maps to none
+	Service string
+}
+
+type peerIdentityKey struct{}
+
+func withPeerIdentity(ctx context.Context, id peerIdentity) context.Context {
+	return context.WithValue(ctx, peerIdentityKey{}, id)
+}
+
+// peerFromContext returns the identity authenticate resolved for the call
+func peerFromContext(ctx context.Context) peerIdentity {
+	id, ok := ctx.Value(peerIdentityKey{}).(peerIdentity)
+	if !ok {
+		return peerIdentity{Credential: credentialNone}
+	}
+	return id
+}
+
+func apiKeyFingerprint(keyHash string) string {
+	return keyHash[:apiKeyFingerprintLength]
+}
+
+// seenPeerCalls remembers which identity called which method, so only the
+// first call of each pair is logged
+var seenPeerCalls = struct {
+	sync.Mutex
+	calls map[string]bool
+}{calls: make(map[string]bool)}
+
+// observePeerCall counts a call by the identity in ctx and logs the first
+// call of each identity to each method, and the first rejection. Anonymous
+// calls are only counted for methods that need an API key, where they are
+// always refused.
+func observePeerCall(ctx context.Context, method string, err error) {
+	id := peerFromContext(ctx)
+	if id.Credential == credentialNone {
+		if _, scoped := methodScopes[method]; !scoped {
+			return
+		}
+	}
+	service := id.Service
+	switch {
+	case service != "":
+	case id.Credential == credentialNone:
+		service = actorGateway
+	default:
+		service = peerServiceUnregistered
+	}
+	code := status.Code(err)
+	peerCalls.WithLabelValues(service, id.Credential, method, code.String()).Inc()
+
+	if id.Credential == credentialNone {
+		return
+	}
+	key := id.Credential + "|" + id.ID + "|" + method
+	if err != nil {
+		key += "|" + code.String()
+	}
+	seenPeerCalls.Lock()
+	seen := seenPeerCalls.calls[key] || len(seenPeerCalls.calls) >= maxSeenPeerCalls
+	if !seen {
+		seenPeerCalls.calls[key] = true
+	}
+	seenPeerCalls.Unlock()
+	if !seen {
+		log.Printf("First call to %s by %s %s (service %s): %s", method, id.Credential, id.ID, service, code)
+	}
+}
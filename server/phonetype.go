@@ -0,0 +1,54 @@
+package main
+
+import "strings"
+
+// Phone number types, detected from the Kenyan numbering plan
+const (
+	phoneTypeMobile   = "mobile"
+	phoneTypeLandline = "landline"
+	phoneTypeTollFree = "toll_free"
+	phoneTypePremium  = "premium"
+	phoneTypeUnknown  = "unknown"
+)
+
+// detectPhoneType classifies a normalized 254XXXXXXXXX number by its
+// national prefix: 07 and 010/011 are mobile, 0800 is toll free, 0900 is
+// premium rate and the geographic area codes 02, 04, 05 and 06 are fixed
+// lines
+func detectPhoneType(phone string) string {
+	if len(phone) != 12 || !strings.HasPrefix(phone, "254") {
+		return phoneTypeUnknown
+	}
+	national := phone[3:]
+	switch {
+	case national[0] == '7', strings.HasPrefix(national, "10"), strings.HasPrefix(national, "11"):
+		return phoneTypeMobile
+	case strings.HasPrefix(national, "800"):
+		return phoneTypeTollFree
+	case strings.HasPrefix(national, "900"):
+		return phoneTypePremium
+	case strings.ContainsRune("2456", rune(national[0])):
+		return phoneTypeLandline
+	default:
+		return phoneTypeUnknown
+	}
+}
+
+// phoneType is the stored type of the phone number, detected for records
+// written before types were stored
+func (u *User) phoneType() string {
+	if u.PhoneNumber == "" {
+		return ""
+	}
+	if u.PhoneType != "" {
+		return u.PhoneType
+	}
+	return detectPhoneType(u.PhoneNumber)
+}
+
+// phoneIsMobile reports whether the phone number can receive SMS, which
+// every one-time code depends on. Other numbers are kept as contact numbers
+// only.
+func (u *User) phoneIsMobile() bool {
+	return u.phoneType() == phoneTypeMobile
+}
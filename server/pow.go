@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"math/bits"
+	"os"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	powChallengeTTL     = 10 * time.Minute
+	powSignatureSize    = 16
+	powNonceSize        = 12
+	maxPowBits          = 28
+	maxPowSolutionBytes = 64
+)
+
+var (
+	errPowInvalid  = errors.New("invalid or expired proof-of-work challenge")
+	errPowSolution = errors.New("proof-of-work solution is incorrect")
+	errPowReplayed = errors.New("proof-of-work challenge already used")
+)
+
+// PowBand asks registrations scoring at least MinScore to solve a challenge
+// of Bits leading zero bits. The band with the highest MinScore not above
+// the score applies; each extra bit doubles the expected work.
+type PowBand struct {
+	MinScore float64 `yaml:"min_score"`
+	Bits     int     `yaml:"bits"`
+}
+
+func validatePowBands(bands []PowBand) error {
+	for _, band := range bands {
+		if band.Bits < 1 || band.Bits > maxPowBits {
+			return fmt.Errorf("proof_of_work bits must be between 1 and %d", maxPowBits)
+		}
+		if band.MinScore < 0 || band.MinScore > 100 {
+			return fmt.Errorf("proof_of_work min_score must be between 0 and 100")
+		}
+	}
+	return nil
+}
+
+// powBits returns the difficulty required at a risk score, or 0 when no
+// proof of work is needed
+func (t *Tunables) powBits(score float64) int {
+	required := 0
+	best := -1.0
+	for _, band := range t.ProofOfWork {
+		if score >= band.MinScore && band.MinScore > best {
+			best, required = band.MinScore, band.Bits
+		}
+	}
+	return required
+}
+
+// powChallenge is a verified challenge token
+type powChallenge struct {
+	Bits      int
+	Nonce     []byte
+	ExpiresAt time.Time
+}
+
+// proofOfWork issues and verifies registration challenges. The subject
+// binds a challenge to one registration, so it cannot be solved once and
+// reused for other addresses.
+type proofOfWork interface {
+	Issue(bits int, subject string, now time.Time) (string, error)
+	Verify(token, solution, subject string, now time.Time) (*powChallenge, error)
+}
+
+// hashcash is a Hashcash-style proof of work: the client searches for a
+// solution whose SHA-256 with the challenge, as "<challenge>:<solution>",
+// starts with the required number of zero bits. Challenges are signed, so
+// issuing one stores nothing.
+type hashcash struct {
+	key []byte
+}
+
+// newHashcash reads POW_SIGNING_KEY, falling back to a random key that
+// invalidates outstanding challenges on restart
+func newHashcash() (*hashcash, error) {
+	key := []byte(os.Getenv("POW_SIGNING_KEY"))
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+	return &hashcash{key: key}, nil
+}
+
+func (h *hashcash) mac(payload []byte) []byte {
+	m := hmac.New(sha256.New, h.key)
+	m.Write(payload)
+	return m.Sum(nil)[:powSignatureSize]
+}
+
+func powSubject(subject string) []byte {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(subject))))
+	return sum[:8]
+}
+
+// A token is the difficulty, expiry, subject hash and a random nonce,
+// followed by a truncated HMAC
+func (h *hashcash) Issue(bits int, subject string, now time.Time) (string, error) {
+	payload := make([]byte, 1+8+8+powNonceSize)
+	payload[0] = byte(bits)
+	binary.BigEndian.PutUint64(payload[1:], uint64(now.Add(powChallengeTTL).Unix()))
+	copy(payload[9:], powSubject(subject))
+	if _, err := rand.Read(payload[17:]); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(append(payload, h.mac(payload)...)), nil
+}
+
+func (h *hashcash) Verify(token, solution, subject string, now time.Time) (*powChallenge, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(token))
+	if err != nil || len(raw) != 1+8+8+powNonceSize+powSignatureSize {
+		return nil, errPowInvalid
+	}
+	payload, sig := raw[:len(raw)-powSignatureSize], raw[len(raw)-powSignatureSize:]
+	if !hmac.Equal(sig, h.mac(payload)) {
+		return nil, errPowInvalid
+	}
+	c := &powChallenge{
+		Bits:      int(payload[0]),
+		ExpiresAt: time.Unix(int64(binary.BigEndian.Uint64(payload[1:])), 0),
+		Nonce:     payload[17:],
+	}
+	if now.After(c.ExpiresAt) || !hmac.Equal(payload[9:17], powSubject(subject)) {
+		return nil, errPowInvalid
+	}
+	if solution == "" || len(solution) > maxPowSolutionBytes {
+		return nil, errPowSolution
+	}
+	sum := sha256.Sum256([]byte(strings.TrimSpace(token) + ":" + solution))
+	if leadingZeroBits(sum[:]) < c.Bits {
+		return nil, errPowSolution
+	}
+	return c, nil
+}
+
+func leadingZeroBits(b []byte) int {
+	n := 0
+	for _, x := range b {
+		if x != 0 {
+			return n + bits.LeadingZeros8(x)
+		}
+		n += 8
+	}
+	return n
+}
+
+// checkProofOfWork verifies the solution sent with a registration that
+// needs one and marks its challenge used. It returns errPowInvalid or
+// errPowReplayed when a new challenge should be issued instead.
+func (s *userService) checkProofOfWork(ctx context.Context, token, solution, subject string) error {
+	if strings.TrimSpace(token) == "" {
+		return errPowInvalid
+	}
+	c, err := s.pow.Verify(token, solution, subject, time.Now())
+	if err != nil {
+		return err
+	}
+	_, err = s.collection(ctx, "pow_redemptions").InsertOne(ctx, bson.M{
+		"_id":        base64.RawURLEncoding.EncodeToString(c.Nonce),
+		"expires_at": c.ExpiresAt,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return errPowReplayed
+	}
+	if err != nil {
+		// Failing open keeps registration working while the store is down
+		log.Printf("Failed to record proof-of-work redemption: %v", err)
+	}
+	return nil
+}
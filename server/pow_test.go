@@ -0,0 +1,122 @@
+package main
+
+import (
+	"crypto/sha256"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// solvePow finds a solution to a challenge token of bits difficulty
+func solvePow(token string, bits int) string {
+	for i := 0; ; i++ {
+		solution := strconv.Itoa(i)
+		sum := sha256.Sum256([]byte(token + ":" + solution))
+		if leadingZeroBits(sum[:]) >= bits {
+			return solution
+		}
+	}
+}
+
+// failPow finds a solution that does not solve the challenge
+func failPow(token string, bits int) string {
+	for i := 0; ; i++ {
+		solution := "x" + strconv.Itoa(i)
+		sum := sha256.Sum256([]byte(token + ":" + solution))
+		if leadingZeroBits(sum[:]) < bits {
+			return solution
+		}
+	}
+}
+
+func TestLeadingZeroBits(t *testing.T) {
+	tests := []struct {
+		in   []byte
+		want int
+	}{
+		{[]byte{0x80}, 0},
+		{[]byte{0x01}, 7},
+		{[]byte{0x00, 0x40}, 9},
+		{[]byte{0x00, 0x00, 0x0f}, 20},
+		{[]byte{0x00, 0x00}, 16},
+		{nil, 0},
+	}
+	for _, tt := range tests {
+		if got := leadingZeroBits(tt.in); got != tt.want {
+			t.Errorf("leadingZeroBits(%x) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHashcashVerify(t *testing.T) {
+	const bits = 8
+	issued := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	h := &hashcash{key: []byte("test pow signing key")}
+	token, err := h.Issue(bits, "amina@example.com", issued)
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	solution := solvePow(token, bits)
+
+	tests := []struct {
+		name     string
+		token    string
+		solution string
+		subject  string
+		now      time.Time
+		err      error
+	}{
+		{name: "solved", solution: solution},
+		{name: "subject differing in case and spaces", solution: solution, subject: " Amina@Example.com "},
+		{name: "other subject", solution: solution, subject: "brian@example.com", err: errPowInvalid},
+		{name: "expired", solution: solution, now: issued.Add(powChallengeTTL + time.Second), err: errPowInvalid},
+		{name: "wrong solution", solution: failPow(token, bits), err: errPowSolution},
+		{name: "missing solution", err: errPowSolution},
+		{name: "solution too long", solution: strings.Repeat("1", maxPowSolutionBytes+1), err: errPowSolution},
+		{name: "tampered token", token: "A" + token[1:], solution: solution, err: errPowInvalid},
+		{name: "not a token", token: "garbage", solution: solution, err: errPowInvalid},
+		{name: "signed with another key", token: otherKeyToken(t, bits, issued), solution: solution, err: errPowInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tok, subject, now := tt.token, tt.subject, tt.now
+			if tok == "" {
+				tok = token
+			}
+			if subject == "" {
+				subject = "amina@example.com"
+			}
+			if now.IsZero() {
+				now = issued.Add(time.Minute)
+			}
+			c, err := h.Verify(tok, tt.solution, subject, now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.err)
+			}
+			if err == nil && (c.Bits != bits || !c.ExpiresAt.Equal(issued.Add(powChallengeTTL))) {
+				t.Errorf("challenge bits %d expiry %v", c.Bits, c.ExpiresAt)
+			}
+		})
+	}
+}
+
+func otherKeyToken(t *testing.T, bits int, now time.Time) string {
+	t.Helper()
+	token, err := (&hashcash{key: []byte("another signing key")}).Issue(bits, "amina@example.com", now)
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	return token
+}
+
+func TestHashcashNoncesDiffer(t *testing.T) {
+	h := &hashcash{key: []byte("test pow signing key")}
+	now := time.Now()
+	a, _ := h.Issue(8, "amina@example.com", now)
+	b, _ := h.Issue(8, "amina@example.com", now)
+	if a == b {
+		t.Error("two challenges for one subject are equal, so one redemption would block both")
+	}
+}
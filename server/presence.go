@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// presenceTTL is how long a heartbeat keeps a user online. Chat clients
+	// are expected to call UpdatePresence about every 30 seconds.
+	presenceTTL     = 90 * time.Second
+	lastSeenTTL     = 90 * 24 * time.Hour
+	maxPresenceKeys = 200
+)
+
+var presenceStates = map[string]bool{
+	"online":  true,
+	"away":    true,
+	"offline": true,
+}
+
+func presenceKey(userID string) string { return "presence:" + userID }
+func lastSeenKey(userID string) string { return "last_seen:" + userID }
+
+// newRedisClient connects to REDIS_URL, e.g. redis://:password@host:6379/0.
+// Presence is disabled when it is unset.
+func newRedisClient() (*redis.Client, error) {
+	raw := os.Getenv("REDIS_URL")
+	if raw == "" {
+		return nil, nil
+	}
+	opts, err := redis.ParseURL(raw)
+	if err != nil {
+		return nil, err
+	}
+	return redis.NewClient(opts), nil
+}
+
+// UpdatePresence records a heartbeat from the chat client. Going offline
+// clears the online marker straight away but keeps the last-seen time.
+func (s *userService) UpdatePresence(ctx context.Context, req *pb.UpdatePresenceMessageRequest) (*pb.UpdatePresenceMessageResponse, error) {
+	if s.redis == nil {
+		return nil, status.Error(codes.FailedPrecondition, "presence is not configured")
+	}
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	state := strings.ToLower(strings.TrimSpace(req.GetStatus()))
+	if state == "" {
+		state = "online"
+	}
+	if !presenceStates[state] {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown presence status %q", req.GetStatus())
+	}
+
+	userID := id.Hex()
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	_, err = s.redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		if state == "offline" {
+			p.Del(ctx, presenceKey(userID))
+		} else {
+			p.Set(ctx, presenceKey(userID), state, presenceTTL)
+		}
+		p.Set(ctx, lastSeenKey(userID), now, lastSeenTTL)
+		return nil
+	})
+	if err != nil {
+		log.Printf("Redis error: %v", err)
+		return nil, status.Error(codes.Unavailable, "failed to update presence")
+	}
+	return &pb.UpdatePresenceMessageResponse{Success: true}, nil
+}
+
+// GetPresence returns online status and last-seen times for a batch of users
+func (s *userService) GetPresence(ctx context.Context, req *pb.GetPresenceMessageRequest) (*pb.GetPresenceMessageResponse, error) {
+	if s.redis == nil {
+		return nil, status.Error(codes.FailedPrecondition, "presence is not configured")
+	}
+	ids := req.GetUserIds()
+	if len(ids) == 0 {
+		return &pb.GetPresenceMessageResponse{}, nil
+	}
+	if len(ids) > maxPresenceKeys {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d users per request", maxPresenceKeys)
+	}
+
+	keys := make([]string, 0, 2*len(ids))
+	for _, raw := range ids {
+		id, err := parseUserID(raw)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, presenceKey(id.Hex()), lastSeenKey(id.Hex()))
+	}
+	values, err := s.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		log.Printf("Redis error: %v", err)
+		return nil, status.Error(codes.Unavailable, "failed to load presence")
+	}
+
+	resp := &pb.GetPresenceMessageResponse{}
+	for i, raw := range ids {
+		p := &pb.UserPresence{UserId: strings.TrimSpace(raw), Status: "offline"}
+		if state, ok := values[2*i].(string); ok {
+			p.Status = state
+			p.Online = true
+		}
+		if seen, ok := values[2*i+1].(string); ok {
+			p.LastSeenUnix, _ = strconv.ParseInt(seen, 10, 64)
+		}
+		resp.Presences = append(resp.Presences, p)
+	}
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+)
+
+// GetUserProfile returns the account details shown to the signed-in user
+func (s *userService) GetUserProfile(ctx context.Context, req *pb.GetUserProfileMessageRequest) (*pb.GetUserProfileMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetUserProfileMessageResponse{
+		UserId:         user.ID.Hex(),
+		FullName:       user.FullName,
+		UserName:       user.UserName,
+		EmailAddress:   user.EmailAddress,
+		PhoneNumber:    user.PhoneNumber,
+		EmailVerified:  user.EmailVerifiedAt != nil,
+		EmailStatus:    emailStatusDeliverable,
+		CreatedAtUnix:  user.CreatedAt.Unix(),
+		PhoneVerified:  user.PhoneVerifiedAt != nil,
+		PhoneReachable: user.phoneIsMobile() && !user.phoneUnreachable(),
+		PhoneType:      user.phoneType(),
+	}
+	if d := user.EmailDeliverability; d != nil {
+		resp.EmailStatus = d.Status
+		resp.EmailStatusReason = d.Reason
+	}
+	score, missing := user.profileCompleteness()
+	resp.CompletenessScore = int32(score)
+	resp.MissingFields = missing
+
+	profile, err := s.publicProfile(ctx, user, user.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	resp.AvatarUrl = profile.AvatarUrl
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"path"
+	"time"
+
+	"github.com/bruceoaudo/userService/internal/scan"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	eventUserUploadQuarantined     = "user.upload_quarantined"
+	securityEventUploadQuarantined = "upload_quarantined"
+)
+
+// Uploads that go through the virus scanner
+const (
+	uploadKindAvatar = "avatar"
+	uploadKindKYC    = "kyc_document"
+)
+
+// QuarantinedUpload is an upload that failed its virus scan. The file is
+// kept under quarantine/ for the security team, is never served, and goes
+// with the account on erasure.
+type QuarantinedUpload struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	UserID        primitive.ObjectID `bson:"user_id"`
+	Kind          string             `bson:"kind"`
+	FileName      string             `bson:"file_name"`
+	ContentType   string             `bson:"content_type"`
+	Key           string             `bson:"key,omitempty"`
+	Size          int64              `bson:"size"`
+	SHA256        string             `bson:"sha256"`
+	Signature     string             `bson:"signature"`
+	QuarantinedAt time.Time          `bson:"quarantined_at"`
+}
+
+// quarantineUpload stores an infected upload out of reach and flags the
+// user. Failures are logged; the upload is rejected either way.
+func (s *userService) quarantineUpload(ctx context.Context, user *User, kind, fileName, contentType string, data []byte, scanErr error) {
+	id := primitive.NewObjectID()
+	sum := sha256.Sum256(data)
+	upload := QuarantinedUpload{
+		ID:            id,
+		UserID:        user.ID,
+		Kind:          kind,
+		FileName:      path.Base(fileName),
+		ContentType:   contentType,
+		Key:           "quarantine/" + user.ID.Hex() + "/" + id.Hex(),
+		Size:          int64(len(data)),
+		SHA256:        hex.EncodeToString(sum[:]),
+		Signature:     scan.Signature(scanErr),
+		QuarantinedAt: time.Now(),
+	}
+	// Stored without its content type so it is never rendered if fetched
+	if err := s.store.Put(ctx, upload.Key, data, "application/octet-stream"); err != nil {
+		log.Printf("Failed to store quarantined upload %s: %v", id.Hex(), err)
+		upload.Key = ""
+	}
+	if _, err := s.collection(ctx, "quarantined_uploads").InsertOne(ctx, upload); err != nil {
+		log.Printf("Failed to record quarantined upload %s: %v", id.Hex(), err)
+	}
+
+	s.recordSecurityEvent(ctx, user.ID, securityEventUploadQuarantined, "", kind+": "+upload.Signature)
+	s.recordEvent(ctx, eventUserUploadQuarantined, user.ID, map[string]interface{}{
+		"kind":      kind,
+		"signature": upload.Signature,
+		"sha256":    upload.SHA256,
+	})
+	log.Printf("Quarantined infected %s upload from user %s: %s", kind, user.ID.Hex(), upload.Signature)
+}
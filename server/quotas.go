@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Quota periods, in UTC
+const (
+	quotaPeriodDaily   = "daily"
+	quotaPeriodMonthly = "monthly"
+)
+
+// Quota caps the calls one client service makes per UTC day and month.
+// Zero leaves a period unlimited.
+type Quota struct {
+	Daily   int64 `yaml:"daily"`
+	Monthly int64 `yaml:"monthly"`
+}
+
+func (q Quota) limit(period string) int64 {
+	if period == quotaPeriodDaily {
+		return q.Daily
+	}
+	return q.Monthly
+}
+
+func validateQuotas(quotas map[string]Quota) error {
+	for service, q := range quotas {
+		if q.Daily < 0 || q.Monthly < 0 {
+			return fmt.Errorf("quota for %s must not be negative", service)
+		}
+	}
+	return nil
+}
+
+// quotaFor returns the quota of service, falling back to "*"
+func (t *Tunables) quotaFor(service string) (Quota, bool) {
+	if q, ok := t.Quotas[service]; ok {
+		return q, true
+	}
+	q, ok := t.Quotas[defaultRateLimitKey]
+	return q, ok
+}
+
+var (
+	quotaUsage = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "userservice_quota_usage",
+		Help: "Calls counted against the quota of a client service in the current period (daily, monthly). " +
+			"Cluster-wide value last seen by this pod; take max across pods, not sum.",
+	}, []string{"service", "period"})
+
+	quotaLimit = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "userservice_quota_limit",
+		Help: "Configured quota of a client service per period (daily, monthly). " +
+			"Divide userservice_quota_usage by it for the fraction used.",
+	}, []string{"service", "period"})
+
+	quotaRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "userservice_quota_rejections_total",
+		Help: "Calls rejected by this pod because a client service ran out of quota, by service and period. " +
+			"Sum across pods.",
+	}, []string{"service", "period"})
+)
+
+// QuotaCounter is the Mongo record of one service's calls in one period,
+// used when Redis is not configured
+type QuotaCounter struct {
+	ID        string    `bson:"_id"`
+	Service   string    `bson:"service"`
+	Period    string    `bson:"period"`
+	Count     int64     `bson:"count"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// quotaWindow returns the start and end of the period containing now
+func quotaWindow(period string, now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	if period == quotaPeriodDaily {
+		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1)
+	}
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+func quotaKey(service, period string, start time.Time) string {
+	return "quota:" + service + ":" + period + ":" + start.Format("20060102")
+}
+
+// countQuota adds delta calls to the counter of service in the period
+// containing now and returns the new count. A delta of 0 only reads it.
+func (s *userService) countQuota(ctx context.Context, service, period string, now time.Time, delta int64) (int64, error) {
+	start, end := quotaWindow(period, now)
+	key := quotaKey(service, period, start)
+
+	if s.redis != nil {
+		if delta == 0 {
+			n, err := s.redis.Get(ctx, key).Int64()
+			if err == redis.Nil {
+				return 0, nil
+			}
+			return n, err
+		}
+		n, err := s.redis.IncrBy(ctx, key, delta).Result()
+		if err != nil {
+			return 0, err
+		}
+		if n == delta {
+			// Counters outlive their period a little for late readers
+			s.redis.ExpireAt(ctx, key, end.Add(time.Hour))
+		}
+		return n, nil
+	}
+
+	collection := s.collection(ctx, "quota_counters")
+	var counter QuotaCounter
+	if delta == 0 {
+		err := collection.FindOne(ctx, bson.M{"_id": key}).Decode(&counter)
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		return counter.Count, err
+	}
+	err := collection.FindOneAndUpdate(ctx, bson.M{"_id": key}, bson.M{
+		"$inc":         bson.M{"count": delta},
+		"$setOnInsert": bson.M{"service": service, "period": period, "expires_at": end.Add(time.Hour)},
+	}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)).Decode(&counter)
+	return counter.Count, err
+}
+
+// checkQuota counts a call by the authenticated client service and rejects
+// it once a period is used up. Calls over quota count too, so a client that
+// keeps retrying stays blocked until the period resets. Callers without an
+// API key are not metered, and storage failures fail open.
+func (s *userService) checkQuota(ctx context.Context, fullMethod string) error {
+	client := clientFromContext(ctx)
+	if client == nil || !strings.HasPrefix(fullMethod, "/"+pb.UserService_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+	quota, ok := s.config.get().quotaFor(client.Service)
+	if !ok {
+		return nil
+	}
+	now := time.Now()
+	for _, period := range []string{quotaPeriodDaily, quotaPeriodMonthly} {
+		limit := quota.limit(period)
+		if limit == 0 {
+			continue
+		}
+		used, err := s.countQuota(ctx, client.Service, period, now, 1)
+		if err != nil {
+			log.Printf("Failed to count quota of %s: %v", client.Service, err)
+			return nil
+		}
+		quotaUsage.WithLabelValues(client.Service, period).Set(float64(used))
+		quotaLimit.WithLabelValues(client.Service, period).Set(float64(limit))
+		if used > limit {
+			quotaRejections.WithLabelValues(client.Service, period).Inc()
+			return status.Errorf(codes.ResourceExhausted, "%s quota of %d calls exceeded for %s", period, limit, client.Service)
+		}
+	}
+	return nil
+}
+
+// quotaInterceptor enforces client quotas on unary calls
+func quotaInterceptor(s *userService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := s.checkQuota(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// quotaStreamInterceptor counts each new stream as one call
+func quotaStreamInterceptor(s *userService) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := s.checkQuota(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// GetQuotaUsage reports how much of its quotas a client service used. A
+// service sees its own usage; other services need the admin metrics scope.
+func (s *userService) GetQuotaUsage(ctx context.Context, req *pb.GetQuotaUsageMessageRequest) (*pb.GetQuotaUsageMessageResponse, error) {
+	client := clientFromContext(ctx)
+	if client == nil {
+		return nil, status.Error(codes.Unauthenticated, "API key or client certificate required")
+	}
+	service := strings.TrimSpace(req.GetService())
+	if service == "" {
+		service = client.Service
+	}
+	if service != client.Service && !client.hasScope(scopeAdminMetrics) {
+		return nil, status.Errorf(codes.PermissionDenied, "missing scope %s", scopeAdminMetrics)
+	}
+
+	quota, _ := s.config.get().quotaFor(service)
+	now := time.Now()
+	resp := &pb.GetQuotaUsageMessageResponse{Service: service}
+	for _, period := range []string{quotaPeriodDaily, quotaPeriodMonthly} {
+		used, err := s.countQuota(ctx, service, period, now, 0)
+		if err != nil {
+			log.Printf("Failed to read quota of %s: %v", service, err)
+			return nil, status.Error(codes.Unavailable, "failed to load quota usage")
+		}
+		_, end := quotaWindow(period, now)
+		resp.Periods = append(resp.Periods, &pb.QuotaPeriodUsage{
+			Period:       period,
+			Used:         used,
+			Limit:        quota.limit(period),
+			ResetsAtUnix: end.Unix(),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	// freshAuthWindow is how long after proving their password a user may
+	// view full PII without confirming it again
+	freshAuthWindow = 5 * time.Minute
+)
+
+// bearerSubject returns the user whose valid bearer token the request
+// carries, or "" without one
+func (s *userService) bearerSubject(ctx context.Context) string {
+	raw := strings.TrimPrefix(metadataValue(ctx, authorizationHeader), "Bearer ")
+	if raw == "" {
+		return ""
+	}
+	claims, err := s.parseAccessToken(ctx, raw)
+	if err != nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+// requireFreshAuth checks that the request carries a bearer token for
+// userID whose auth_time falls inside freshAuthWindow.
+func (s *userService) requireFreshAuth(ctx context.Context, userID string) error {
+	raw := strings.TrimPrefix(metadataValue(ctx, authorizationHeader), "Bearer ")
+	if raw == "" {
+		return status.Error(codes.Unauthenticated, "recent authentication required")
+	}
+	claims, err := s.parseAccessToken(ctx, raw)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	if claims.Subject != userID {
+		return status.Error(codes.PermissionDenied, "token does not belong to user")
+	}
+	if !claims.AuthenticatedWithin(freshAuthWindow, time.Now()) {
+		return status.Error(codes.Unauthenticated, "recent authentication required")
+	}
+	return nil
+}
+
+// ReAuthenticate upgrades an existing session after the user confirms their
+// password, returning a token that passes fresh-auth checks.
+func (s *userService) ReAuthenticate(ctx context.Context, req *pb.ReAuthenticateMessageRequest) (*pb.ReAuthenticateMessageResponse, error) {
+	// 1. Validate the current session
+	claims, err := s.parseAccessToken(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	// 2. Confirm the password
+	user, err := s.findUserByID(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPassword(ctx, user, req.GetPassword()); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	if user.PasswordResetRequired {
+		return nil, status.Error(codes.FailedPrecondition, "password reset required")
+	}
+	s.resetDevicePINFailures(ctx, user.ID)
+
+	// 3. Reissue the token with a fresh auth time
+	signed, upgraded, err := s.tokens.Reauthenticate(claims)
+	if err != nil {
+		log.Printf("Failed to reissue token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+
+	return &pb.ReAuthenticateMessageResponse{
+		AccessToken:   signed,
+		ExpiresAtUnix: upgraded.ExpiresAt.Unix(),
+		AuthTimeUnix:  upgraded.AuthTime.Unix(),
+	}, nil
+}
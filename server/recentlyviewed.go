@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRecentlyViewed     = 50
+	defaultRecentlyViewed = 20
+	recentlyViewedTTL     = 90 * 24 * time.Hour
+	maxProductIDLength    = 64
+)
+
+func recentlyViewedKey(userID string) string { return "recently_viewed:" + userID }
+
+// ViewedProduct is one entry of a user's recently viewed list
+type ViewedProduct struct {
+	ProductID string    `bson:"product_id"`
+	ViewedAt  time.Time `bson:"viewed_at"`
+}
+
+// RecentlyViewed is the Mongo fallback used when Redis is not configured.
+// Items are kept newest first, without duplicates.
+type RecentlyViewed struct {
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Items     []ViewedProduct    `bson:"items"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// RecordProductView moves a product to the front of the user's recently
+// viewed list, trimming it to the newest 50 products
+func (s *userService) RecordProductView(ctx context.Context, req *pb.RecordProductViewMessageRequest) (*pb.RecordProductViewMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	productID := strings.TrimSpace(req.GetProductId())
+	if productID == "" || len(productID) > maxProductIDLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid product id")
+	}
+	now := time.Now()
+
+	if s.redis != nil {
+		// A sorted set scored by view time dedupes and orders in one step
+		key := recentlyViewedKey(id.Hex())
+		_, err := s.redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixMilli()), Member: productID})
+			p.ZRemRangeByRank(ctx, key, 0, -maxRecentlyViewed-1)
+			p.Expire(ctx, key, recentlyViewedTTL)
+			return nil
+		})
+		if err != nil {
+			log.Printf("Redis error: %v", err)
+			return nil, status.Error(codes.Unavailable, "failed to record view")
+		}
+		return &pb.RecordProductViewMessageResponse{Success: true}, nil
+	}
+
+	// The pipeline update drops the old entry and prepends the new one
+	// atomically, so concurrent views cannot duplicate a product
+	collection := s.collection(ctx, "recently_viewed")
+	item := bson.M{"product_id": productID, "viewed_at": now}
+	_, err = collection.UpdateOne(ctx, bson.M{"user_id": id}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"items": bson.M{"$slice": bson.A{
+				bson.M{"$concatArrays": bson.A{
+					bson.A{item},
+					bson.M{"$filter": bson.M{
+						"input": bson.M{"$ifNull": bson.A{"$items", bson.A{}}},
+						"cond":  bson.M{"$ne": bson.A{"$$this.product_id", productID}},
+					}},
+				}},
+				maxRecentlyViewed,
+			}},
+			"updated_at": now,
+		}}},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		log.Printf("Failed to record product view: %v", err)
+		return nil, status.Error(codes.Internal, "failed to record view")
+	}
+	return &pb.RecordProductViewMessageResponse{Success: true}, nil
+}
+
+// GetRecentlyViewed returns the products a user viewed, newest first
+func (s *userService) GetRecentlyViewed(ctx context.Context, req *pb.GetRecentlyViewedMessageRequest) (*pb.GetRecentlyViewedMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultRecentlyViewed
+	}
+	if limit > maxRecentlyViewed {
+		limit = maxRecentlyViewed
+	}
+
+	resp := &pb.GetRecentlyViewedMessageResponse{}
+	if s.redis != nil {
+		entries, err := s.redis.ZRevRangeWithScores(ctx, recentlyViewedKey(id.Hex()), 0, int64(limit-1)).Result()
+		if err != nil {
+			log.Printf("Redis error: %v", err)
+			return nil, status.Error(codes.Unavailable, "failed to load recently viewed products")
+		}
+		for _, e := range entries {
+			member, _ := e.Member.(string)
+			resp.Products = append(resp.Products, &pb.ViewedProduct{ProductId: member, ViewedAtUnix: int64(e.Score) / 1000})
+		}
+		return resp, nil
+	}
+
+	var doc RecentlyViewed
+	err = s.collection(ctx, "recently_viewed").FindOne(ctx, bson.M{"user_id": id},
+		options.FindOne().SetProjection(bson.M{"items": bson.M{"$slice": limit}})).Decode(&doc)
+	if err != nil && err != mongo.ErrNoDocuments {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load recently viewed products")
+	}
+	for _, item := range doc.Items {
+		resp.Products = append(resp.Products, &pb.ViewedProduct{ProductId: item.ProductID, ViewedAtUnix: item.ViewedAt.Unix()})
+	}
+	return resp, nil
+}
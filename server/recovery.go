@@ -0,0 +1,510 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserAccountRecoveryStarted   = "user.account_recovery_started"
+	eventUserAccountRecoveryCancelled = "user.account_recovery_cancelled"
+	eventUserAccountRecovered         = "user.account_recovered"
+
+	recoveryCodeTTL = 15 * time.Minute
+	// Recovered accounts stay locked to the attacker for this long, giving
+	// the owner time to react to the notification on their primary channels
+	recoveryWaitingPeriod = 72 * time.Hour
+	recoveryTokenTTL      = 7 * 24 * time.Hour
+	recoveryResendAfter   = time.Minute
+	maxRecoveryAttempts   = 5
+)
+
+// Channels a recovery contact can use
+const (
+	recoveryChannelEmail = "email"
+	recoveryChannelPhone = "phone"
+)
+
+// Account recovery states
+const (
+	recoveryPendingCode = "pending_code"
+	recoveryWaiting     = "waiting"
+	recoveryCompleted   = "completed"
+	recoveryCancelled   = "cancelled"
+)
+
+// RecoveryContacts are a secondary email and phone, distinct from the
+// primary ones, used only to recover an account
+type RecoveryContacts struct {
+	Email           string     `bson:"email,omitempty"`
+	EmailVerifiedAt *time.Time `bson:"email_verified_at,omitempty"`
+	Phone           string     `bson:"phone,omitempty"`
+	PhoneVerifiedAt *time.Time `bson:"phone_verified_at,omitempty"`
+	UpdatedAt       time.Time  `bson:"updated_at"`
+}
+
+// RecoveryVerification is a code proving ownership of a recovery contact
+type RecoveryVerification struct {
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Channel   string             `bson:"channel"`
+	Target    string             `bson:"target"`
+	CodeHash  string             `bson:"code_hash"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+}
+
+// AccountRecovery is one attempt to regain an account through a recovery
+// contact. The code sent to the contact unlocks a token that resets the
+// password once the waiting period has passed.
+type AccountRecovery struct {
+	RecoveryID  string             `bson:"recovery_id"`
+	UserID      primitive.ObjectID `bson:"user_id"`
+	Channel     string             `bson:"channel"`
+	Status      string             `bson:"status"`
+	CodeHash    string             `bson:"code_hash"`
+	Attempts    int                `bson:"attempts"`
+	TokenHash   string             `bson:"token_hash,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	AvailableAt *time.Time         `bson:"available_at,omitempty"`
+	ExpiresAt   time.Time          `bson:"expires_at"`
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// sendRecoveryCode delivers a one-time code to a recovery contact rather
+// than the primary channels of the user
+func (s *userService) sendRecoveryCode(user *User, channel, target, code string) error {
+	msg, err := notify.Render(notify.KindOTP, map[string]string{"code": code, "expires_in": "15 minutes"})
+	if err != nil {
+		return err
+	}
+	recipient := notify.Recipient{UserID: user.ID.Hex(), Name: user.FullName}
+	via := notify.ChannelEmail
+	if channel == recoveryChannelPhone {
+		recipient.Phone = target
+		via = notify.ChannelSMS
+	} else {
+		recipient.Email = target
+	}
+	recipient.Preferences = map[notify.Kind][]notify.Channel{notify.KindOTP: {via}}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+		defer cancel()
+		if err := s.notifier.Dispatch(ctx, recipient, msg); err != nil {
+			log.Printf("Failed to deliver recovery code to user %s: %v", recipient.UserID, err)
+		}
+	}()
+	return nil
+}
+
+// issueRecoveryVerification sends a code confirming a new recovery contact
+func (s *userService) issueRecoveryVerification(ctx context.Context, user *User, channel, target string) error {
+	code, err := randomDigits(verificationCodeDigits)
+	if err != nil {
+		return err
+	}
+	collection := s.collection(ctx, "recovery_verifications")
+	if _, err := collection.DeleteMany(ctx, bson.M{"user_id": user.ID, "channel": channel}); err != nil {
+		return err
+	}
+	_, err = collection.InsertOne(ctx, RecoveryVerification{
+		UserID:    user.ID,
+		Channel:   channel,
+		Target:    target,
+		CodeHash:  hashCode(code),
+		ExpiresAt: time.Now().Add(recoveryCodeTTL),
+	})
+	if err != nil {
+		return err
+	}
+	return s.sendRecoveryCode(user, channel, target, code)
+}
+
+// SetRecoveryContact replaces the recovery email and phone of a recently
+// authenticated user. New contacts receive a verification code and the
+// primary channels are told about the change.
+func (s *userService) SetRecoveryContact(ctx context.Context, req *pb.SetRecoveryContactMessageRequest) (*pb.SetRecoveryContactMessageResponse, error) {
+	if err := s.requireFreshAuth(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Validate the contacts
+	email := strings.TrimSpace(req.GetEmail())
+	phone := normalizePhoneNumber(req.GetPhone())
+	if email != "" {
+		if !strings.Contains(email, "@") || !strings.Contains(email, ".") {
+			return nil, status.Error(codes.InvalidArgument, "invalid email format")
+		}
+		if strings.EqualFold(email, user.EmailAddress) {
+			return nil, status.Error(codes.InvalidArgument, "recovery email must differ from the account email")
+		}
+	}
+	if phone != "" && phone == user.PhoneNumber {
+		return nil, status.Error(codes.InvalidArgument, "recovery phone must differ from the account phone")
+	}
+	if phone != "" && detectPhoneType(phone) != phoneTypeMobile {
+		return nil, status.Error(codes.InvalidArgument, "recovery phone must be a mobile number")
+	}
+
+	// 2. Keep the verification of contacts that did not change
+	now := time.Now()
+	contacts := RecoveryContacts{Email: email, Phone: phone, UpdatedAt: now}
+	old := user.Recovery
+	if old != nil && old.Email != "" && strings.EqualFold(old.Email, email) {
+		contacts.EmailVerifiedAt = old.EmailVerifiedAt
+	}
+	if old != nil && old.Phone != "" && old.Phone == phone {
+		contacts.PhoneVerifiedAt = old.PhoneVerifiedAt
+	}
+
+	update := bson.M{"$set": bson.M{"recovery": contacts, "updated_at": now}}
+	if email == "" && phone == "" {
+		update = bson.M{"$unset": bson.M{"recovery": ""}, "$set": bson.M{"updated_at": now}}
+	}
+	if _, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, update); err != nil {
+		log.Printf("Failed to update recovery contacts: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update recovery contacts")
+	}
+
+	// 3. Verify new contacts and alert the primary channels
+	for channel, target := range map[string]string{recoveryChannelEmail: email, recoveryChannelPhone: phone} {
+		verified := contacts.EmailVerifiedAt
+		if channel == recoveryChannelPhone {
+			verified = contacts.PhoneVerifiedAt
+		}
+		if target == "" || verified != nil {
+			continue
+		}
+		if err := s.issueRecoveryVerification(ctx, user, channel, target); err != nil {
+			log.Printf("Failed to send recovery contact verification: %v", err)
+			return nil, status.Error(codes.Internal, "failed to send verification code")
+		}
+	}
+	s.notifyUser(user, notify.KindRecoveryContactChanged, map[string]string{"time": now.UTC().Format(time.RFC1123)})
+
+	return &pb.SetRecoveryContactMessageResponse{Message: "Recovery contacts updated", Success: true}, nil
+}
+
+// VerifyRecoveryContact confirms a recovery contact with the code sent to it
+func (s *userService) VerifyRecoveryContact(ctx context.Context, req *pb.VerifyRecoveryContactMessageRequest) (*pb.VerifyRecoveryContactMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	channel := strings.ToLower(strings.TrimSpace(req.GetChannel()))
+	target := ""
+	if user.Recovery != nil {
+		switch channel {
+		case recoveryChannelEmail:
+			target = user.Recovery.Email
+		case recoveryChannelPhone:
+			target = user.Recovery.Phone
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown recovery channel %q", req.GetChannel())
+		}
+	}
+	if target == "" {
+		return nil, status.Error(codes.FailedPrecondition, "no recovery contact on this channel")
+	}
+
+	res, err := s.collection(ctx, "recovery_verifications").DeleteOne(ctx, bson.M{
+		"user_id":    user.ID,
+		"channel":    channel,
+		"target":     target,
+		"code_hash":  hashCode(strings.TrimSpace(req.GetCode())),
+		"expires_at": bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired verification code")
+	}
+
+	now := time.Now()
+	_, err = s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID, "recovery." + channel: target}, bson.M{
+		"$set": bson.M{"recovery." + channel + "_verified_at": now, "updated_at": now},
+	})
+	if err != nil {
+		log.Printf("Failed to mark recovery contact verified: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	return &pb.VerifyRecoveryContactMessageResponse{Message: "Recovery contact verified", Success: true}, nil
+}
+
+// StartAccountRecovery sends a recovery code to the verified recovery
+// contact of the account named by identifier. The response is the same
+// whether or not the account exists, so it cannot be used to probe for
+// accounts.
+func (s *userService) StartAccountRecovery(ctx context.Context, req *pb.StartAccountRecoveryMessageRequest) (*pb.StartAccountRecoveryMessageResponse, error) {
+	identifier := strings.TrimSpace(req.GetIdentifier())
+	if identifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "identifier is required")
+	}
+	recoveryID, err := randomHex(16)
+	if err != nil {
+		log.Printf("Failed to generate recovery id: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start recovery")
+	}
+	resp := &pb.StartAccountRecoveryMessageResponse{
+		RecoveryId: recoveryID,
+		Message:    "If the account has a verified recovery contact, a code has been sent to it",
+		Success:    true,
+	}
+
+	// 1. Find the account and its verified recovery contact
+	var user User
+	err = s.collection(ctx, "users").FindOne(ctx, bson.M{"deleted_at": nil, "$or": bson.A{
+		bson.M{"email": identifier},
+		bson.M{"user_name": identifier},
+		bson.M{"phone": normalizePhoneNumber(identifier)},
+	}}, findCaseInsensitive()).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return resp, nil
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start recovery")
+	}
+
+	channel, target := strings.ToLower(strings.TrimSpace(req.GetChannel())), ""
+	if r := user.Recovery; r != nil {
+		if r.EmailVerifiedAt != nil && channel != recoveryChannelPhone {
+			channel, target = recoveryChannelEmail, r.Email
+		} else if r.PhoneVerifiedAt != nil && channel != recoveryChannelEmail {
+			channel, target = recoveryChannelPhone, r.Phone
+		}
+	}
+	if target == "" {
+		return resp, nil
+	}
+
+	// 2. Refuse to resend within a minute of the last code
+	collection := s.collection(ctx, "account_recoveries")
+	now := time.Now()
+	recent, err := collection.CountDocuments(ctx, bson.M{"user_id": user.ID, "created_at": bson.M{"$gt": now.Add(-recoveryResendAfter)}})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start recovery")
+	}
+	if recent > 0 {
+		return resp, nil
+	}
+
+	code, err := randomDigits(verificationCodeDigits)
+	if err != nil {
+		log.Printf("Failed to generate recovery code: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start recovery")
+	}
+	_, err = collection.InsertOne(ctx, AccountRecovery{
+		RecoveryID: recoveryID,
+		UserID:     user.ID,
+		Channel:    channel,
+		Status:     recoveryPendingCode,
+		CodeHash:   hashCode(code),
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(recoveryCodeTTL),
+	})
+	if err != nil {
+		log.Printf("Failed to store account recovery: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start recovery")
+	}
+	if err := s.sendRecoveryCode(&user, channel, target, code); err != nil {
+		log.Printf("Failed to send recovery code: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start recovery")
+	}
+	return resp, nil
+}
+
+// ConfirmAccountRecovery checks the recovery code and starts the waiting
+// period. The primary channels are notified so the owner can cancel.
+func (s *userService) ConfirmAccountRecovery(ctx context.Context, req *pb.ConfirmAccountRecoveryMessageRequest) (*pb.ConfirmAccountRecoveryMessageResponse, error) {
+	collection := s.collection(ctx, "account_recoveries")
+	now := time.Now()
+
+	// 1. Check the code, counting wrong guesses
+	var recovery AccountRecovery
+	err := collection.FindOne(ctx, bson.M{
+		"recovery_id": strings.TrimSpace(req.GetRecoveryId()),
+		"status":      recoveryPendingCode,
+		"expires_at":  bson.M{"$gt": now},
+		"attempts":    bson.M{"$lt": maxRecoveryAttempts},
+	}).Decode(&recovery)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired recovery code")
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if hashCode(strings.TrimSpace(req.GetCode())) != recovery.CodeHash {
+		collection.UpdateOne(ctx, bson.M{"recovery_id": recovery.RecoveryID}, bson.M{"$inc": bson.M{"attempts": 1}})
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired recovery code")
+	}
+
+	// 2. Start the waiting period
+	token, err := randomHex(32)
+	if err != nil {
+		log.Printf("Failed to generate recovery token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to confirm recovery")
+	}
+	availableAt := now.Add(recoveryWaitingPeriod)
+	res, err := collection.UpdateOne(ctx, bson.M{"recovery_id": recovery.RecoveryID, "status": recoveryPendingCode}, bson.M{"$set": bson.M{
+		"status":       recoveryWaiting,
+		"token_hash":   hashCode(token),
+		"available_at": availableAt,
+		"expires_at":   availableAt.Add(recoveryTokenTTL),
+	}})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to confirm recovery")
+	}
+	if res.ModifiedCount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired recovery code")
+	}
+
+	// 3. Warn the owner on their primary channels
+	var user User
+	if err := s.collection(ctx, "users").FindOne(ctx, bson.M{"_id": recovery.UserID}).Decode(&user); err == nil {
+		s.notifyUser(&user, notify.KindAccountRecoveryStarted, map[string]string{
+			"date": availableAt.UTC().Format(time.RFC1123),
+		})
+	} else {
+		log.Printf("Failed to load user for recovery notification: %v", err)
+	}
+	s.recordEvent(ctx, eventUserAccountRecoveryStarted, recovery.UserID, map[string]interface{}{
+		"channel":      recovery.Channel,
+		"available_at": availableAt,
+	})
+
+	return &pb.ConfirmAccountRecoveryMessageResponse{
+		RecoveryToken:   token,
+		AvailableAtUnix: availableAt.Unix(),
+		Message:         "Recovery confirmed, the account can be recovered after the waiting period",
+		Success:         true,
+	}, nil
+}
+
+// CompleteAccountRecovery sets a new password once the waiting period has
+// passed and turns off two-factor login, which the owner has lost
+func (s *userService) CompleteAccountRecovery(ctx context.Context, req *pb.CompleteAccountRecoveryMessageRequest) (*pb.CompleteAccountRecoveryMessageResponse, error) {
+	collection := s.collection(ctx, "account_recoveries")
+	now := time.Now()
+
+	var recovery AccountRecovery
+	err := collection.FindOne(ctx, bson.M{
+		"token_hash": hashCode(strings.TrimSpace(req.GetRecoveryToken())),
+		"status":     recoveryWaiting,
+		"expires_at": bson.M{"$gt": now},
+	}).Decode(&recovery)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired recovery token")
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if recovery.AvailableAt == nil || now.Before(*recovery.AvailableAt) {
+		return nil, status.Error(codes.FailedPrecondition, "the recovery waiting period has not ended")
+	}
+	if err := s.checkNewPassword(req.GetNewPassword()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	hash, err := s.passwords.Hash(req.GetNewPassword())
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Error(codes.Internal, "failed to recover account")
+	}
+
+	// 1. Claim the recovery so the token works once
+	res, err := collection.UpdateOne(ctx, bson.M{"recovery_id": recovery.RecoveryID, "status": recoveryWaiting}, bson.M{
+		"$set": bson.M{"status": recoveryCompleted},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to recover account")
+	}
+	if res.ModifiedCount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired recovery token")
+	}
+
+	// 2. Reset the credentials
+	users := s.collection(ctx, "users")
+	_, err = users.UpdateOne(ctx, bson.M{"_id": recovery.UserID, "deleted_at": nil}, bson.M{
+		"$set": bson.M{
+			"password_hash":           hash,
+			"password_changed_at":     now,
+			"password_reset_required": false,
+			"updated_at":              now,
+		},
+		"$unset": bson.M{"two_factor_enabled_at": ""},
+	})
+	if err != nil {
+		log.Printf("Failed to reset credentials: %v", err)
+		return nil, status.Error(codes.Internal, "failed to recover account")
+	}
+
+	// PINs and device keys set while the account was compromised must not
+	// outlive it
+	for _, name := range []string{"device_pins", "device_keys"} {
+		if _, err := s.collection(ctx, name).DeleteMany(ctx, bson.M{"user_id": recovery.UserID}); err != nil {
+			log.Printf("Failed to remove %s: %v", name, err)
+		}
+	}
+	if err := s.refreshTokens.RevokeUser(ctx, recovery.UserID, now); err != nil {
+		log.Printf("Failed to revoke refresh tokens: %v", err)
+	}
+
+	var user User
+	if err := users.FindOne(ctx, bson.M{"_id": recovery.UserID}).Decode(&user); err == nil {
+		s.notifyUser(&user, notify.KindPasswordChanged, map[string]string{"time": now.UTC().Format(time.RFC1123)})
+	}
+	s.recordEvent(ctx, eventUserAccountRecovered, recovery.UserID, map[string]interface{}{
+		"channel":      recovery.Channel,
+		"recovered_at": now,
+	})
+	return &pb.CompleteAccountRecoveryMessageResponse{Message: "Account recovered", Success: true}, nil
+}
+
+// CancelAccountRecovery stops every pending recovery of the signed-in user
+func (s *userService) CancelAccountRecovery(ctx context.Context, req *pb.CancelAccountRecoveryMessageRequest) (*pb.CancelAccountRecoveryMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.collection(ctx, "account_recoveries").UpdateMany(ctx,
+		bson.M{"user_id": user.ID, "status": bson.M{"$in": bson.A{recoveryPendingCode, recoveryWaiting}}},
+		bson.M{"$set": bson.M{"status": recoveryCancelled}},
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to cancel recovery")
+	}
+	if res.ModifiedCount == 0 {
+		return &pb.CancelAccountRecoveryMessageResponse{Message: "No recovery in progress", Success: true}, nil
+	}
+	s.recordEvent(ctx, eventUserAccountRecoveryCancelled, user.ID, map[string]interface{}{"cancelled": res.ModifiedCount})
+	return &pb.CancelAccountRecoveryMessageResponse{Message: "Account recovery cancelled", Success: true}, nil
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Errors a userRepository reports independent of the store behind it
+var (
+	errUserNotFound = errors.New("user not found")
+	errUserExists   = errors.New("user already exists")
+	// errUSSDPINLocked is returned by ReserveUSSDPINAttempt for a PIN that
+	// is locked or has used up its attempts
+	errUSSDPINLocked = errors.New("USSD PIN locked")
+)
+
+// userRepository is the user store that sign-up, sign-in, password and
+// device changes, USSD PINs and billing profiles work against, so their
+// logic does not depend on MongoDB. Deleted accounts are never found. The
+// other profile handlers, bulk jobs and erasure still use the users
+// collection directly.
+type userRepository interface {
+	FindByID(ctx context.Context, id primitive.ObjectID) (*User, error)
+	// FindByEmail matches the address case-insensitively
+	FindByEmail(ctx context.Context, email string) (*User, error)
+	// ExistsByEmailUsernamePhone reports whether any account uses one of the
+	// values; empty values are skipped
+	ExistsByEmailUsernamePhone(ctx context.Context, email, userName, phone string) (bool, error)
+	// Create stores a new user and sets its ID
+	Create(ctx context.Context, user *User) error
+	// RecordLogin stores the time of a successful login and, if set, the
+	// risk assessment of it. Updates of a missing user report
+	// errUserNotFound.
+	RecordLogin(ctx context.Context, id primitive.ObjectID, at time.Time, risk *RiskAssessment) error
+	SetRisk(ctx context.Context, id primitive.ObjectID, risk *RiskAssessment) error
+	// SetPasswordHash replaces the password hash of a user while it is still
+	// current, so a concurrent password change is never overwritten. A hash
+	// that changed meanwhile reports errUserNotFound.
+	SetPasswordHash(ctx context.Context, id primitive.ObjectID, current, hash string) error
+	// AddDeviceFingerprint moves a fingerprint to the end of the user's
+	// devices, keeping the last limit
+	AddDeviceFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string, limit int) error
+	// FindUSSDByPhone returns the USSD account on a phone number
+	FindUSSDByPhone(ctx context.Context, phone string) (*User, error)
+	// ReserveUSSDPINAttempt counts an attempt against the PIN of a USSD
+	// account before it is checked and returns the account with it
+	// counted, so concurrent guesses can't check more than limit PINs
+	// between two lockouts
+	ReserveUSSDPINAttempt(ctx context.Context, id primitive.ObjectID, limit int, now time.Time) (*USSDAccount, error)
+	// LockUSSDPIN locks a PIN that used up its limit attempts until until
+	// and counts the lockout. A PIN locked or reset meanwhile reports
+	// errUserNotFound.
+	LockUSSDPIN(ctx context.Context, id primitive.ObjectID, limit int, until time.Time) error
+	// ResetUSSDPINFailures clears the attempts, lockouts and lock of a PIN
+	ResetUSSDPINFailures(ctx context.Context, id primitive.ObjectID) error
+	// SetUSSDPIN replaces the PIN hash of a USSD account
+	SetUSSDPIN(ctx context.Context, id primitive.ObjectID, hash string, at time.Time) error
+	// SetBillingProfile replaces the billing profile of a user
+	SetBillingProfile(ctx context.Context, id primitive.ObjectID, billing *BillingProfile, at time.Time) error
+}
+
+// mongoUserRepository keeps users in the users collection
+type mongoUserRepository struct {
+	collection collectionFunc
+}
+
+func newMongoUserRepository(collection collectionFunc) *mongoUserRepository {
+	return &mongoUserRepository{collection: collection}
+}
+
+func (r *mongoUserRepository) users(ctx context.Context) *mongo.Collection {
+	return r.collection(ctx, "users")
+}
+
+func (r *mongoUserRepository) findOne(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (*User, error) {
+	var user User
+	err := r.users(ctx).FindOne(ctx, filter, opts...).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, errUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *mongoUserRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*User, error) {
+	return r.findOne(ctx, bson.M{"_id": id, "deleted_at": nil})
+}
+
+func (r *mongoUserRepository) FindByEmail(ctx context.Context, email string) (*User, error) {
+	if email == "" {
+		return nil, errUserNotFound
+	}
+	return r.findOne(ctx, bson.M{"email": email, "deleted_at": nil}, findCaseInsensitive())
+}
+
+func (r *mongoUserRepository) ExistsByEmailUsernamePhone(ctx context.Context, email, userName, phone string) (bool, error) {
+	var or []bson.M
+	if email != "" {
+		or = append(or, bson.M{"email": email})
+	}
+	if userName != "" {
+		or = append(or, bson.M{"user_name": userName})
+	}
+	if phone != "" {
+		or = append(or, bson.M{"phone": phone})
+	}
+	if len(or) == 0 {
+		return false, nil
+	}
+	err := r.users(ctx).FindOne(ctx, bson.M{"$or": or}, findCaseInsensitive()).Err()
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mongoUserRepository) Create(ctx context.Context, user *User) error {
+	res, err := r.users(ctx).InsertOne(ctx, user)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errUserExists
+		}
+		return err
+	}
+	user.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *mongoUserRepository) RecordLogin(ctx context.Context, id primitive.ObjectID, at time.Time, risk *RiskAssessment) error {
+	set := bson.M{"last_login_at": at}
+	if risk != nil {
+		set["risk"] = risk
+	}
+	res, err := r.users(ctx).UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	return nil
+}
+
+func (r *mongoUserRepository) SetRisk(ctx context.Context, id primitive.ObjectID, risk *RiskAssessment) error {
+	res, err := r.users(ctx).UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"risk": risk}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	return nil
+}
+
+func (r *mongoUserRepository) SetPasswordHash(ctx context.Context, id primitive.ObjectID, current, hash string) error {
+	res, err := r.users(ctx).UpdateOne(ctx,
+		bson.M{"_id": id, "password_hash": current},
+		bson.M{"$set": bson.M{"password_hash": hash, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	return nil
+}
+
+func (r *mongoUserRepository) AddDeviceFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string, limit int) error {
+	collection := r.users(ctx)
+	res, err := collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$pull": bson.M{"device_fingerprints": fingerprint}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$push": bson.M{"device_fingerprints": bson.M{"$each": []string{fingerprint}, "$slice": -limit}},
+	})
+	return err
+}
+
+func (r *mongoUserRepository) FindUSSDByPhone(ctx context.Context, phone string) (*User, error) {
+	return r.findOne(ctx, bson.M{"phone": phone, "ussd": bson.M{"$exists": true}, "deleted_at": nil})
+}
+
+func (r *mongoUserRepository) ReserveUSSDPINAttempt(ctx context.Context, id primitive.ObjectID, limit int, now time.Time) (*USSDAccount, error) {
+	var user User
+	// Both fields are left out while unset, which $lt and $lte wouldn't match
+	err := r.users(ctx).FindOneAndUpdate(ctx,
+		bson.M{
+			"_id":                  id,
+			"ussd":                 bson.M{"$exists": true},
+			"ussd.locked_until":    bson.M{"$not": bson.M{"$gt": now}},
+			"ussd.failed_attempts": bson.M{"$not": bson.M{"$gte": limit}},
+		},
+		bson.M{"$inc": bson.M{"ussd.failed_attempts": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, errUSSDPINLocked
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user.USSD, nil
+}
+
+func (r *mongoUserRepository) LockUSSDPIN(ctx context.Context, id primitive.ObjectID, limit int, until time.Time) error {
+	res, err := r.users(ctx).UpdateOne(ctx,
+		bson.M{"_id": id, "ussd.failed_attempts": bson.M{"$gte": limit}},
+		bson.M{
+			"$set": bson.M{"ussd.locked_until": until, "ussd.failed_attempts": 0},
+			"$inc": bson.M{"ussd.lockouts": 1},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	return nil
+}
+
+func (r *mongoUserRepository) ResetUSSDPINFailures(ctx context.Context, id primitive.ObjectID) error {
+	res, err := r.users(ctx).UpdateOne(ctx, bson.M{"_id": id, "ussd": bson.M{"$exists": true}}, bson.M{
+		"$set":   bson.M{"ussd.failed_attempts": 0, "ussd.lockouts": 0},
+		"$unset": bson.M{"ussd.locked_until": ""},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	return nil
+}
+
+func (r *mongoUserRepository) SetUSSDPIN(ctx context.Context, id primitive.ObjectID, hash string, at time.Time) error {
+	return r.set(ctx, bson.M{"_id": id, "ussd": bson.M{"$exists": true}},
+		bson.M{"ussd.pin_hash": hash, "ussd.pin_changed_at": at, "updated_at": at})
+}
+
+func (r *mongoUserRepository) SetBillingProfile(ctx context.Context, id primitive.ObjectID, billing *BillingProfile, at time.Time) error {
+	return r.set(ctx, bson.M{"_id": id}, bson.M{"billing": billing, "updated_at": at})
+}
+
+// set updates the fields of the user filter matches, reporting
+// errUserNotFound when it matches none
+func (r *mongoUserRepository) set(ctx context.Context, filter, fields bson.M) error {
+	res, err := r.users(ctx).UpdateOne(ctx, filter, bson.M{"$set": fields})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	return nil
+}
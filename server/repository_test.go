@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bruceoaudo/userService/internal/config"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"github.com/bruceoaudo/userService/internal/password"
+	"github.com/bruceoaudo/userService/internal/token"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/time/rate"
+)
+
+// memoryUserRepository keeps users in a map. Setting err makes every call
+// fail with it, like an unreachable database.
+type memoryUserRepository struct {
+	mu    sync.Mutex
+	users map[primitive.ObjectID]*User
+	err   error
+	// createErr fails only Create, e.g. with errUserExists for a lost race
+	createErr error
+}
+
+func newMemoryUserRepository() *memoryUserRepository {
+	return &memoryUserRepository{users: make(map[primitive.ObjectID]*User)}
+}
+
+func (r *memoryUserRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	u, ok := r.users[id]
+	if !ok || u.DeletedAt != nil {
+		return nil, errUserNotFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (r *memoryUserRepository) FindByEmail(ctx context.Context, email string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, u := range r.users {
+		if email != "" && strings.EqualFold(u.EmailAddress, email) && u.DeletedAt == nil {
+			copied := *u
+			return &copied, nil
+		}
+	}
+	return nil, errUserNotFound
+}
+
+func (r *memoryUserRepository) ExistsByEmailUsernamePhone(ctx context.Context, email, userName, phone string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return false, r.err
+	}
+	for _, u := range r.users {
+		if (email != "" && strings.EqualFold(u.EmailAddress, email)) ||
+			(userName != "" && strings.EqualFold(u.UserName, userName)) ||
+			(phone != "" && u.PhoneNumber == phone) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryUserRepository) Create(ctx context.Context, user *User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	if r.createErr != nil {
+		return r.createErr
+	}
+	user.ID = primitive.NewObjectID()
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+func (r *memoryUserRepository) RecordLogin(ctx context.Context, id primitive.ObjectID, at time.Time, risk *RiskAssessment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok {
+		return errUserNotFound
+	}
+	u.LastLoginAt = &at
+	if risk != nil {
+		u.Risk = risk
+	}
+	return nil
+}
+
+func (r *memoryUserRepository) SetRisk(ctx context.Context, id primitive.ObjectID, risk *RiskAssessment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok {
+		return errUserNotFound
+	}
+	u.Risk = risk
+	return nil
+}
+
+func (r *memoryUserRepository) SetPasswordHash(ctx context.Context, id primitive.ObjectID, current, hash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok || u.PasswordHash != current {
+		return errUserNotFound
+	}
+	u.PasswordHash = hash
+	return nil
+}
+
+func (r *memoryUserRepository) AddDeviceFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string, limit int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok {
+		return errUserNotFound
+	}
+	devices := []string{}
+	for _, d := range u.DeviceFingerprints {
+		if d != fingerprint {
+			devices = append(devices, d)
+		}
+	}
+	devices = append(devices, fingerprint)
+	if len(devices) > limit {
+		devices = devices[len(devices)-limit:]
+	}
+	u.DeviceFingerprints = devices
+	return nil
+}
+
+func (r *memoryUserRepository) FindUSSDByPhone(ctx context.Context, phone string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, u := range r.users {
+		if u.PhoneNumber == phone && u.USSD != nil && u.DeletedAt == nil {
+			copied := *u
+			account := *u.USSD
+			copied.USSD = &account
+			return &copied, nil
+		}
+	}
+	return nil, errUserNotFound
+}
+
+func (r *memoryUserRepository) ReserveUSSDPINAttempt(ctx context.Context, id primitive.ObjectID, limit int, now time.Time) (*USSDAccount, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	u, ok := r.users[id]
+	if !ok || u.USSD == nil {
+		return nil, errUSSDPINLocked
+	}
+	if until := u.USSD.LockedUntil; (until != nil && until.After(now)) || u.USSD.FailedAttempts >= limit {
+		return nil, errUSSDPINLocked
+	}
+	u.USSD.FailedAttempts++
+	account := *u.USSD
+	return &account, nil
+}
+
+func (r *memoryUserRepository) LockUSSDPIN(ctx context.Context, id primitive.ObjectID, limit int, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok || u.USSD == nil || u.USSD.FailedAttempts < limit {
+		return errUserNotFound
+	}
+	u.USSD.LockedUntil = &until
+	u.USSD.FailedAttempts = 0
+	u.USSD.Lockouts++
+	return nil
+}
+
+func (r *memoryUserRepository) ResetUSSDPINFailures(ctx context.Context, id primitive.ObjectID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok || u.USSD == nil {
+		return errUserNotFound
+	}
+	u.USSD.FailedAttempts = 0
+	u.USSD.Lockouts = 0
+	u.USSD.LockedUntil = nil
+	return nil
+}
+
+func (r *memoryUserRepository) SetUSSDPIN(ctx context.Context, id primitive.ObjectID, hash string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok || u.USSD == nil {
+		return errUserNotFound
+	}
+	u.USSD.PINHash = hash
+	u.USSD.PINChangedAt = at
+	u.UpdatedAt = at
+	return nil
+}
+
+func (r *memoryUserRepository) SetBillingProfile(ctx context.Context, id primitive.ObjectID, billing *BillingProfile, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	u, ok := r.users[id]
+	if !ok {
+		return errUserNotFound
+	}
+	copied := *billing
+	u.Billing = &copied
+	u.UpdatedAt = at
+	return nil
+}
+
+// memoryRefreshTokenStore keeps refresh tokens in a map
+type memoryRefreshTokenStore struct {
+	mu       sync.Mutex
+	sessions map[string]*RefreshSession
+	err      error
+}
+
+func newMemoryRefreshTokenStore() *memoryRefreshTokenStore {
+	return &memoryRefreshTokenStore{sessions: make(map[string]*RefreshSession)}
+}
+
+func (m *memoryRefreshTokenStore) Store(ctx context.Context, session *RefreshSession) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	copied := *session
+	m.sessions[session.ID] = &copied
+	return nil
+}
+
+func (m *memoryRefreshTokenStore) Use(ctx context.Context, id string, at time.Time) (*RefreshSession, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	s, ok := m.sessions[id]
+	if !ok || s.UsedAt != nil || s.RevokedAt != nil {
+		return nil, errRefreshTokenNotFound
+	}
+	s.UsedAt = &at
+	copied := *s
+	return &copied, nil
+}
+
+func (m *memoryRefreshTokenStore) Find(ctx context.Context, id string) (*RefreshSession, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, errRefreshTokenNotFound
+	}
+	copied := *s
+	return &copied, nil
+}
+
+func (m *memoryRefreshTokenStore) revoke(match func(*RefreshSession) bool, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	for _, s := range m.sessions {
+		if match(s) && s.RevokedAt == nil {
+			s.RevokedAt = &at
+		}
+	}
+	return nil
+}
+
+func (m *memoryRefreshTokenStore) RevokeSession(ctx context.Context, sessionID string, at time.Time) error {
+	return m.revoke(func(s *RefreshSession) bool { return s.SessionID == sessionID }, at)
+}
+
+func (m *memoryRefreshTokenStore) RevokeUser(ctx context.Context, userID primitive.ObjectID, at time.Time) error {
+	return m.revoke(func(s *RefreshSession) bool { return s.UserID == userID }, at)
+}
+
+// memoryDevicePINStore keeps device PINs in a map. reserved counts the
+// attempts ReserveAttempt let through.
+type memoryDevicePINStore struct {
+	mu       sync.Mutex
+	pins     map[string]*DevicePIN
+	reserved int
+}
+
+func newMemoryDevicePINStore() *memoryDevicePINStore {
+	return &memoryDevicePINStore{pins: make(map[string]*DevicePIN)}
+}
+
+func devicePINKey(userID primitive.ObjectID, device string) string {
+	return userID.Hex() + "/" + device
+}
+
+func (m *memoryDevicePINStore) Set(ctx context.Context, pin *DevicePIN) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *pin
+	m.pins[devicePINKey(pin.UserID, pin.DeviceID)] = &copied
+	return nil
+}
+
+func (m *memoryDevicePINStore) Remove(ctx context.Context, userID primitive.ObjectID, device string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.pins, devicePINKey(userID, device))
+	return nil
+}
+
+func (m *memoryDevicePINStore) ReserveAttempt(ctx context.Context, userID primitive.ObjectID, device string, limit int) (*DevicePIN, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pin, ok := m.pins[devicePINKey(userID, device)]
+	if !ok {
+		return nil, errDevicePINNotFound
+	}
+	if pin.FailedAttempts >= limit {
+		return nil, errDevicePINDisabled
+	}
+	pin.FailedAttempts++
+	m.reserved++
+	copied := *pin
+	return &copied, nil
+}
+
+func (m *memoryDevicePINStore) RecordSuccess(ctx context.Context, userID primitive.ObjectID, device string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if pin, ok := m.pins[devicePINKey(userID, device)]; ok {
+		pin.FailedAttempts = 0
+		pin.LastUsedAt = &at
+	}
+	return nil
+}
+
+func (m *memoryDevicePINStore) ResetFailures(ctx context.Context, userID primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, pin := range m.pins {
+		if pin.UserID == userID {
+			pin.FailedAttempts = 0
+		}
+	}
+	return nil
+}
+
+// newTestService returns a service on in-memory stores. The side effects
+// still written to MongoDB directly, like outbox events and security
+// events, go to an unreachable server, fail fast and are only logged.
+func newTestService(t *testing.T) (*userService, *memoryUserRepository, *memoryRefreshTokenStore) {
+	t.Helper()
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI("mongodb://127.0.0.1:1/?serverSelectionTimeoutMS=20&connectTimeoutMS=20"))
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+
+	tokens, err := token.NewIssuer(token.DefaultConfig(), []token.Key{{ID: "test", Secret: bytes.Repeat([]byte("k"), 32)}})
+	if err != nil {
+		t.Fatalf("token issuer: %v", err)
+	}
+	tunables := &runtimeConfig{limiters: make(map[string]*rate.Limiter)}
+	tunables.current.Store(defaultTunables())
+
+	users, sessions := newMemoryUserRepository(), newMemoryRefreshTokenStore()
+	svc := &userService{
+		db:            client,
+		mongo:         config.Default().Mongo,
+		users:         users,
+		refreshTokens: sessions,
+		devicePINs:    newMemoryDevicePINStore(),
+		notifier:      notify.NewDispatcher(),
+		passwords:     password.NewRegistry(password.Bcrypt{Cost: 4}),
+		tokens:        tokens,
+		config:        tunables,
+		risk:          newRiskEngine(),
+	}
+	return svc, users, sessions
+}
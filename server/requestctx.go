@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+)
+
+// Locale headers, the explicit one first. Gateways forward the language the
+// end user's client asked for as accept-language.
+const (
+	localeHeader         = "x-locale"
+	acceptLanguageHeader = "accept-language"
+)
+
+// requestInfo is what the caller's metadata says about a request. Handlers
+// read it with requestFromContext instead of parsing headers themselves.
+type requestInfo struct {
+	// Tenant is the storefront the request is for, empty in single-store mode
+	Tenant string
+	// Locale is a canonical BCP 47 tag, empty when the client sent none or
+	// an invalid one
+	Locale string
+	// AppVersion is the version of the end user's client app
+	AppVersion string
+}
+
+type requestInfoKey struct{}
+
+// resolveRequest reads the request metadata of ctx
+func resolveRequest(ctx context.Context) requestInfo {
+	return requestInfo{
+		Tenant:     strings.TrimSpace(metadataValue(ctx, tenantHeader)),
+		Locale:     requestLocale(ctx),
+		AppVersion: strings.TrimSpace(metadataValue(ctx, appVersionHeader)),
+	}
+}
+
+// requestLocale takes x-locale, else the first language of accept-language
+func requestLocale(ctx context.Context) string {
+	raw := metadataValue(ctx, localeHeader)
+	if raw == "" {
+		raw = metadataValue(ctx, acceptLanguageHeader)
+		// "sw-KE,sw;q=0.9,en;q=0.8" lists languages by preference
+		raw, _, _ = strings.Cut(raw, ",")
+		raw, _, _ = strings.Cut(raw, ";")
+	}
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "*" {
+		return ""
+	}
+	tag, err := language.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return tag.String()
+}
+
+// requestFromContext returns the request info resolved by the interceptors.
+// Contexts that never went through them, like those of background jobs and
+// tests, have it resolved from their metadata on each read.
+func requestFromContext(ctx context.Context) requestInfo {
+	if info, ok := ctx.Value(requestInfoKey{}).(requestInfo); ok {
+		return info
+	}
+	return resolveRequest(ctx)
+}
+
+// requestContextInterceptor resolves the request info once per call
+func requestContextInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(context.WithValue(ctx, requestInfoKey{}, resolveRequest(ctx)), req)
+	}
+}
+
+// requestContextStreamInterceptor does the same for streams
+func requestContextStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := context.WithValue(ss.Context(), requestInfoKey{}, resolveRequest(ss.Context()))
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	// Bundle the timezone database so slim container images resolve zones
+	_ "time/tzdata"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserBirthdayReward    = "user.birthday_reward"
+	eventUserAnniversaryReward = "user.anniversary_reward"
+
+	// The scan runs hourly so every timezone is covered shortly after its
+	// local midnight
+	rewardScanInterval = time.Hour
+	defaultTimezone    = "Africa/Nairobi"
+)
+
+// marketingConsents are the purposes under which reward events may be sent
+var marketingConsents = []string{consentMarketingEmail, consentMarketingSMS, consentMarketingPush}
+
+// userLocation returns the user's timezone, falling back to East Africa Time
+func userLocation(user *User) *time.Location {
+	name := user.Timezone
+	if name == "" {
+		name = defaultTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc, _ = time.LoadLocation(defaultTimezone)
+	}
+	if loc == nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// sameDay reports whether an annual date falls on local's calendar day.
+// February 29 is celebrated on February 28 outside leap years.
+func sameDay(month time.Month, day int, local time.Time) bool {
+	if month == time.February && day == 29 && !isLeapYear(local.Year()) {
+		day = 28
+	}
+	return local.Month() == month && local.Day() == day
+}
+
+func isLeapYear(y int) bool {
+	return y%4 == 0 && (y%100 != 0 || y%400 == 0)
+}
+
+// annualDateFilter matches documents whose date field falls on one of the
+// calendar days around now, leaving the exact timezone check to the caller
+func annualDateFilter(field string, now time.Time) bson.M {
+	var days []bson.M
+	for _, offset := range []int{-1, 0, 1} {
+		d := now.UTC().AddDate(0, 0, offset)
+		match := func(month time.Month, day int) bson.M {
+			return bson.M{"$and": bson.A{
+				bson.M{"$eq": bson.A{bson.M{"$month": "$" + field}, int(month)}},
+				bson.M{"$eq": bson.A{bson.M{"$dayOfMonth": "$" + field}, day}},
+			}}
+		}
+		days = append(days, match(d.Month(), d.Day()))
+		if d.Month() == time.February && d.Day() == 28 && !isLeapYear(d.Year()) {
+			days = append(days, match(time.February, 29))
+		}
+	}
+	return bson.M{"$expr": bson.M{"$or": days}}
+}
+
+func (s *userService) runRewardScheduler(ctx context.Context) {
+	ticker := time.NewTicker(rewardScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if !s.config.inMaintenance() {
+			s.emitAnnualRewards(ctx, time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// emitAnnualRewards records BirthdayReward and AnniversaryReward events for
+// users celebrating today in their own timezone. Only users who consented to
+// marketing qualify, and each reward is claimed once per year so replicas
+// and hourly rescans do not duplicate it.
+func (s *userService) emitAnnualRewards(ctx context.Context, now time.Time) {
+	consented := bson.A{}
+	for _, purpose := range marketingConsents {
+		consented = append(consented, bson.M{"consents." + purpose: true})
+	}
+	base := bson.M{"deleted_at": nil, "$or": consented}
+
+	s.emitAnnualReward(ctx, now, mergeFilters(base, bson.M{"date_of_birth": bson.M{"$ne": nil}}, annualDateFilter("date_of_birth", now)),
+		eventUserBirthdayReward, "last_birthday_reward_year",
+		func(user *User, local time.Time) (bool, map[string]interface{}) {
+			dob := user.DateOfBirth.UTC()
+			return sameDay(dob.Month(), dob.Day(), local), map[string]interface{}{"year": local.Year()}
+		})
+
+	s.emitAnnualReward(ctx, now, mergeFilters(base, annualDateFilter("created_at", now)),
+		eventUserAnniversaryReward, "last_anniversary_reward_year",
+		func(user *User, local time.Time) (bool, map[string]interface{}) {
+			joined := user.CreatedAt.In(local.Location())
+			years := local.Year() - joined.Year()
+			return years > 0 && sameDay(joined.Month(), joined.Day(), local), map[string]interface{}{"year": local.Year(), "years": years}
+		})
+}
+
+func (s *userService) emitAnnualReward(ctx context.Context, now time.Time, filter bson.M, eventType, claimField string,
+	due func(user *User, local time.Time) (bool, map[string]interface{})) {
+	collection := s.collection(ctx, "users")
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to find %s candidates: %v", eventType, err)
+		return
+	}
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Printf("Failed to load %s candidates: %v", eventType, err)
+		return
+	}
+
+	sent := 0
+	for i := range users {
+		local := now.In(userLocation(&users[i]))
+		ok, payload := due(&users[i], local)
+		if !ok {
+			continue
+		}
+
+		// Claim this year's reward before emitting it
+		res, err := collection.UpdateOne(ctx,
+			bson.M{"_id": users[i].ID, claimField: bson.M{"$not": bson.M{"$gte": local.Year()}}},
+			bson.M{"$set": bson.M{claimField: local.Year()}},
+		)
+		if err != nil {
+			log.Printf("Failed to claim %s for user %s: %v", eventType, users[i].ID.Hex(), err)
+			continue
+		}
+		if res.ModifiedCount == 0 {
+			continue
+		}
+		payload["timezone"] = local.Location().String()
+		s.recordEvent(ctx, eventType, users[i].ID, payload)
+		sent++
+	}
+	if sent > 0 {
+		log.Printf("Recorded %d %s events", sent, eventType)
+	}
+}
+
+// SetTimezone stores the IANA timezone used for a user's local dates
+func (s *userService) SetTimezone(ctx context.Context, req *pb.SetTimezoneMessageRequest) (*pb.SetTimezoneMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.GetTimezone())
+	if name == "" || name == "Local" {
+		return nil, status.Error(codes.InvalidArgument, "timezone is required")
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown timezone %q", name)
+	}
+
+	res, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": id, "deleted_at": nil}, bson.M{
+		"$set": bson.M{"timezone": name, "updated_at": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update timezone")
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	// Digests follow the user to the new timezone
+	if user, err := s.findUserByID(ctx, id.Hex()); err == nil {
+		if err := s.rescheduleDigests(ctx, user); err != nil {
+			log.Printf("Failed to reschedule digests for user %s: %v", id.Hex(), err)
+		}
+	}
+	return &pb.SetTimezoneMessageResponse{Message: "Timezone updated", Success: true}, nil
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	riskEventRegistration = "registration"
+	riskEventLogin        = "login"
+
+	// Set by the API gateway from the end user's connection
+	clientIPHeader      = "x-client-ip"
+	clientCountryHeader = "x-client-country"
+
+	riskVelocityWindow = time.Hour
+	maxVelocityKeys    = 10000
+	maxDeviceAccounts  = 10
+)
+
+// Actions a risk band can map to. Challenged calls succeed but report the
+// action so the gateway can ask for a CAPTCHA or one-time code.
+const (
+	riskActionAllow     = "allow"
+	riskActionChallenge = "challenge"
+	riskActionBlock     = "block"
+)
+
+var riskActions = map[string]bool{riskActionAllow: true, riskActionChallenge: true, riskActionBlock: true}
+
+// A few well-known throwaway providers; DISPOSABLE_EMAIL_DOMAINS extends the list
+var defaultDisposableDomains = []string{
+	"mailinator.com", "guerrillamail.com", "10minutemail.com", "tempmail.com",
+	"temp-mail.org", "yopmail.com", "trashmail.com", "sharklasers.com",
+	"getnada.com", "dispostable.com", "maildrop.cc", "throwawaymail.com",
+}
+
+// RiskBand maps scores of at least MinScore to an action. The band with the
+// highest MinScore not above the score applies.
+type RiskBand struct {
+	MinScore float64 `yaml:"min_score"`
+	Action   string  `yaml:"action"`
+}
+
+// RiskSignals describe a registration or login attempt to a riskScorer
+type RiskSignals struct {
+	Event           string
+	Email           string
+	ClientIP        string
+	ClientCountry   string
+	HomeCountry     string
+	DisposableEmail bool
+	// RecentAttempts counts attempts of the same event from the client IP
+	// within the velocity window, this one included
+	RecentAttempts int
+	// DeviceAccounts counts other accounts seen on the device fingerprint
+	DeviceAccounts int
+}
+
+// riskScorer turns signals into a score between 0 and 100 and the reasons
+// behind it
+type riskScorer interface {
+	Score(ctx context.Context, signals RiskSignals) (float64, []string, error)
+}
+
+// RiskAssessment is the outcome of the latest scoring, kept on the user
+type RiskAssessment struct {
+	Score      float64   `bson:"score"`
+	Reasons    []string  `bson:"reasons,omitempty"`
+	Action     string    `bson:"action"`
+	Event      string    `bson:"event"`
+	AssessedAt time.Time `bson:"assessed_at"`
+}
+
+// ruleScorer adds fixed weights per signal. It is used until a scoring
+// service is plugged in.
+type ruleScorer struct {
+	disposable map[string]bool
+}
+
+func newRuleScorer() *ruleScorer {
+	sc := &ruleScorer{disposable: make(map[string]bool)}
+	domains := append([]string{}, defaultDisposableDomains...)
+	domains = append(domains, strings.Split(os.Getenv("DISPOSABLE_EMAIL_DOMAINS"), ",")...)
+	for _, d := range domains {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			sc.disposable[d] = true
+		}
+	}
+	return sc
+}
+
+func (sc *ruleScorer) isDisposable(email string) bool {
+	at := strings.LastIndex(email, "@")
+	return at >= 0 && sc.disposable[strings.ToLower(strings.TrimSpace(email[at+1:]))]
+}
+
+func (sc *ruleScorer) Score(_ context.Context, signals RiskSignals) (float64, []string, error) {
+	var score float64
+	var reasons []string
+	if signals.DisposableEmail {
+		score += 40
+		reasons = append(reasons, "disposable_email")
+	}
+	if signals.RecentAttempts > 3 {
+		score += min(float64(signals.RecentAttempts-3)*10, 30)
+		reasons = append(reasons, "velocity")
+	}
+	if signals.DeviceAccounts > 0 {
+		score += min(10+float64(signals.DeviceAccounts)*5, 30)
+		reasons = append(reasons, "device_reuse")
+	}
+	if signals.ClientCountry != "" && signals.HomeCountry != "" && signals.ClientCountry != signals.HomeCountry {
+		score += 20
+		reasons = append(reasons, "geo_mismatch")
+	}
+	return min(score, 100), reasons, nil
+}
+
+// velocityCounter counts attempts per key over a sliding window
+type velocityCounter struct {
+	window time.Duration
+
+	mu   sync.Mutex
+	hits map[string][]time.Time
+}
+
+func newVelocityCounter(window time.Duration) *velocityCounter {
+	return &velocityCounter{window: window, hits: make(map[string][]time.Time)}
+}
+
+// add records an attempt and returns the attempts inside the window
+func (v *velocityCounter) add(key string, now time.Time) int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if _, ok := v.hits[key]; !ok && len(v.hits) >= maxVelocityKeys {
+		v.hits = make(map[string][]time.Time)
+	}
+	cutoff := now.Add(-v.window)
+	kept := v.hits[key][:0]
+	for _, t := range v.hits[key] {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	v.hits[key] = append(kept, now)
+	return len(v.hits[key])
+}
+
+// riskEngine gathers signals, scores them and maps the score to an action
+type riskEngine struct {
+	scorer   riskScorer
+	rules    *ruleScorer
+	velocity *velocityCounter
+}
+
+func newRiskEngine() *riskEngine {
+	rules := newRuleScorer()
+	return &riskEngine{scorer: rules, rules: rules, velocity: newVelocityCounter(riskVelocityWindow)}
+}
+
+func (t *Tunables) riskAction(score float64) string {
+	action := riskActionAllow
+	best := -1.0
+	for _, band := range t.RiskBands {
+		if score >= band.MinScore && band.MinScore > best {
+			best, action = band.MinScore, band.Action
+		}
+	}
+	return action
+}
+
+func validateRiskBands(bands []RiskBand) error {
+	for _, band := range bands {
+		if !riskActions[band.Action] {
+			return fmt.Errorf("unknown risk band action %q", band.Action)
+		}
+		if band.MinScore < 0 || band.MinScore > 100 {
+			return fmt.Errorf("risk band min_score must be between 0 and 100")
+		}
+	}
+	return nil
+}
+
+// assessRisk scores an attempt by user, whose ID is unset before
+// registration. Scoring errors fail open so an outage of the scorer never
+// locks shoppers out.
+func (s *userService) assessRisk(ctx context.Context, event string, user *User, fingerprint string) *RiskAssessment {
+	now := time.Now()
+	signals := RiskSignals{
+		Event:           event,
+		Email:           user.EmailAddress,
+		ClientIP:        strings.TrimSpace(metadataValue(ctx, clientIPHeader)),
+		ClientCountry:   strings.ToUpper(strings.TrimSpace(metadataValue(ctx, clientCountryHeader))),
+		DisposableEmail: s.risk.rules.isDisposable(user.EmailAddress),
+	}
+	if user.Billing != nil {
+		signals.HomeCountry = user.Billing.Address.Country
+	}
+	if signals.ClientIP != "" {
+		signals.RecentAttempts = s.risk.velocity.add(event+"|"+signals.ClientIP, now)
+	}
+	if fingerprint = strings.TrimSpace(fingerprint); fingerprint != "" {
+		filter := bson.M{"device_fingerprints": fingerprint, "deleted_at": nil}
+		if user.ID != primitive.NilObjectID {
+			filter["_id"] = bson.M{"$ne": user.ID}
+		}
+		n, err := s.collection(ctx, "users").CountDocuments(ctx, filter, options.Count().SetLimit(maxDeviceAccounts))
+		if err != nil {
+			log.Printf("Failed to count device accounts: %v", err)
+		}
+		signals.DeviceAccounts = int(n)
+	}
+
+	score, reasons, err := s.risk.scorer.Score(ctx, signals)
+	if err != nil {
+		log.Printf("Risk scoring failed: %v", err)
+		return &RiskAssessment{Action: riskActionAllow, Event: event, AssessedAt: now}
+	}
+	sort.Strings(reasons)
+	assessment := &RiskAssessment{
+		Score:      score,
+		Reasons:    reasons,
+		Action:     s.config.get().riskAction(score),
+		Event:      event,
+		AssessedAt: now,
+	}
+	if assessment.Action != riskActionAllow {
+		log.Printf("Risk %s on %s: score=%.0f reasons=%s", assessment.Action, event, score, strings.Join(reasons, ","))
+	}
+	return assessment
+}
+
+func errRiskBlocked(event string) error {
+	return status.Errorf(codes.PermissionDenied, "%s blocked, contact support if this is a mistake", event)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const schemaBackfillBatch = 500
+
+// userUpgrade migrates a user document from the previous schema version to
+// Version. Apply updates the decoded user in place and returns the fields
+// to $set.
+type userUpgrade struct {
+	Version int
+	Apply   func(u *User) bson.M
+}
+
+// userUpgrades run in order. Documents written before versioning have no
+// schema_version and count as version 0. Append new upgrades here when the
+// shape of User changes; currentUserSchemaVersion follows the last one.
+var userUpgrades = []userUpgrade{
+	// Autocomplete keys for users created before search existed
+	{1, func(u *User) bson.M {
+		if len(u.SearchKeys) > 0 {
+			return bson.M{}
+		}
+		u.SearchKeys = searchKeys(u)
+		return bson.M{"search_keys": u.SearchKeys}
+	}},
+	// Phone types for users registered before they were detected
+	{2, func(u *User) bson.M {
+		if u.PhoneNumber == "" || u.PhoneType != "" {
+			return bson.M{}
+		}
+		u.PhoneType = detectPhoneType(u.PhoneNumber)
+		return bson.M{"phone_type": u.PhoneType}
+	}},
+}
+
+var currentUserSchemaVersion = userUpgrades[len(userUpgrades)-1].Version
+
+// schemaVersionFilter matches documents still at version
+func schemaVersionFilter(version int) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{nil, 0}}
+	}
+	return version
+}
+
+// applyUserUpgrades brings u to the current version and returns the update
+// that persists it, or nil when it is already current
+func applyUserUpgrades(u *User) bson.M {
+	if u.SchemaVersion >= currentUserSchemaVersion {
+		return nil
+	}
+	set := bson.M{}
+	for _, up := range userUpgrades {
+		if up.Version <= u.SchemaVersion {
+			continue
+		}
+		for k, v := range up.Apply(u) {
+			set[k] = v
+		}
+	}
+	u.SchemaVersion = currentUserSchemaVersion
+	set["schema_version"] = currentUserSchemaVersion
+	return bson.M{"$set": set}
+}
+
+// upgradeUser lazily migrates a user read in the old shape. The write only
+// applies if nobody upgraded the document in the meantime; failures are
+// logged and the upgraded copy is still returned. During maintenance the
+// copy is upgraded in memory only.
+func (s *userService) upgradeUser(ctx context.Context, u *User) {
+	from := u.SchemaVersion
+	update := applyUserUpgrades(u)
+	if update == nil || s.config.inMaintenance() {
+		return
+	}
+	_, err := s.collection(ctx, "users").UpdateOne(ctx,
+		bson.M{"_id": u.ID, "schema_version": schemaVersionFilter(from)}, update)
+	if err != nil {
+		log.Printf("Failed to upgrade user %s to schema version %d: %v", u.ID.Hex(), currentUserSchemaVersion, err)
+	}
+}
+
+// backfillSchemaVersions upgrades every user that was not read since the
+// last schema change. It works in batches and is safe to run on every
+// replica.
+func (s *userService) backfillSchemaVersions(ctx context.Context) {
+	collection := s.collection(ctx, "users")
+	upgraded := 0
+	for {
+		cursor, err := collection.Find(ctx,
+			bson.M{"schema_version": bson.M{"$not": bson.M{"$gte": currentUserSchemaVersion}}, "deleted_at": nil},
+			options.Find().SetLimit(schemaBackfillBatch),
+		)
+		if err != nil {
+			log.Printf("Failed to load users for schema backfill: %v", err)
+			return
+		}
+		var users []User
+		if err := cursor.All(ctx, &users); err != nil {
+			log.Printf("Failed to decode users for schema backfill: %v", err)
+			return
+		}
+		if len(users) == 0 {
+			break
+		}
+
+		models := make([]mongo.WriteModel, 0, len(users))
+		for i := range users {
+			from := users[i].SchemaVersion
+			update := applyUserUpgrades(&users[i])
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": users[i].ID, "schema_version": schemaVersionFilter(from)}).
+				SetUpdate(update))
+		}
+		if _, err := collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+			log.Printf("Failed to backfill schema versions: %v", err)
+			return
+		}
+		upgraded += len(users)
+	}
+	if upgraded > 0 {
+		log.Printf("Upgraded %d users to schema version %d", upgraded, currentUserSchemaVersion)
+	}
+}
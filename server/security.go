@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+)
+
+const (
+	// Passwords older than this are flagged for rotation
+	maxPasswordAge = 365 * 24 * time.Hour
+	// Challenged or blocked sign-ins within this window are flagged
+	riskySignInWindow = 30 * 24 * time.Hour
+)
+
+// Severity of a security weak point
+const (
+	securitySeverityHigh   = "high"
+	securitySeverityMedium = "medium"
+	securitySeverityLow    = "low"
+)
+
+// securityCheck is one weak point GetSecurityStatus looks for. Penalties are
+// taken off a score of 100.
+type securityCheck struct {
+	Code           string
+	Recommendation string
+	Severity       string
+	Penalty        int
+	Failing        func(u *User, now time.Time) bool
+}
+
+var securityChecks = []securityCheck{
+	{"no_two_factor", "enable_two_factor", securitySeverityHigh, 30, func(u *User, now time.Time) bool {
+		return u.TwoFactorEnabledAt == nil
+	}},
+	{"password_reset_required", "change_password", securitySeverityHigh, 30, func(u *User, now time.Time) bool {
+		return u.PasswordResetRequired
+	}},
+	{"old_password", "change_password", securitySeverityMedium, 15, func(u *User, now time.Time) bool {
+		return !u.PasswordResetRequired && now.Sub(u.passwordChangedAt()) > maxPasswordAge
+	}},
+	{"risky_sign_in", "review_recent_activity", securitySeverityHigh, 20, func(u *User, now time.Time) bool {
+		r := u.Risk
+		return r != nil && r.Event == riskEventLogin && r.Action != riskActionAllow && now.Sub(r.AssessedAt) < riskySignInWindow
+	}},
+	{"email_unverified", "verify_email", securitySeverityMedium, 10, func(u *User, now time.Time) bool {
+		return u.EmailVerifiedAt == nil
+	}},
+	{"email_undeliverable", "update_email", securitySeverityMedium, 10, func(u *User, now time.Time) bool {
+		return u.emailUndeliverable()
+	}},
+	{"no_phone", "add_phone", securitySeverityLow, 10, func(u *User, now time.Time) bool {
+		return u.PhoneNumber == ""
+	}},
+	{"phone_unverified", "verify_phone", securitySeverityLow, 10, func(u *User, now time.Time) bool {
+		return u.phoneIsMobile() && u.PhoneVerifiedAt == nil
+	}},
+	{"phone_not_mobile", "update_phone", securitySeverityLow, 10, func(u *User, now time.Time) bool {
+		return u.PhoneNumber != "" && !u.phoneIsMobile()
+	}},
+	{"phone_unreachable", "update_phone", securitySeverityLow, 10, func(u *User, now time.Time) bool {
+		return u.PhoneNumber != "" && u.phoneUnreachable()
+	}},
+}
+
+// passwordChangedAt falls back to the registration time for accounts that
+// never changed their password
+func (u *User) passwordChangedAt() time.Time {
+	if u.PasswordChangedAt != nil {
+		return *u.PasswordChangedAt
+	}
+	return u.CreatedAt
+}
+
+// GetSecurityStatus scores the account's security from 0 to 100 and lists
+// its weak points, most severe first, with a recommendation code the
+// account security page can turn into an action
+func (s *userService) GetSecurityStatus(ctx context.Context, req *pb.GetSecurityStatusMessageRequest) (*pb.GetSecurityStatusMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &pb.GetSecurityStatusMessageResponse{
+		Score:                 100,
+		TwoFactorEnabled:      user.TwoFactorEnabledAt != nil,
+		PasswordChangedAtUnix: user.passwordChangedAt().Unix(),
+	}
+	if user.Risk != nil && user.Risk.Event == riskEventLogin {
+		resp.LastSignInRisk = user.Risk.Action
+	}
+	for _, severity := range []string{securitySeverityHigh, securitySeverityMedium, securitySeverityLow} {
+		for _, c := range securityChecks {
+			if c.Severity != severity || !c.Failing(user, now) {
+				continue
+			}
+			resp.Score -= int32(c.Penalty)
+			resp.Issues = append(resp.Issues, &pb.SecurityIssue{
+				Code:           c.Code,
+				Recommendation: c.Recommendation,
+				Severity:       c.Severity,
+			})
+		}
+	}
+	if resp.Score < 0 {
+		resp.Score = 0
+	}
+	return resp, nil
+}
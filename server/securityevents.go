@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	securityEventRetention    = 365 * 24 * time.Hour
+	defaultSecurityExportDays = 90
+	maxSecurityExportRows     = 10000
+	securityExportChunkBytes  = 32 << 10
+)
+
+// Sign-in activity kept in the security_events collection
+const (
+	securityEventLogin        = "login"
+	securityEventLoginBlocked = "login_blocked"
+	securityEventLoginFailed  = "login_failed"
+)
+
+// securityOutboxEvents are the account events also listed in a security export
+var securityOutboxEvents = []string{
+	eventUserPhoneVerified,
+	eventUserPhoneUnreachable,
+	eventUserEmailUndeliverable,
+	eventUserPasswordResetRequired,
+	eventUserIdentityVerified,
+	eventUserIdentityFailed,
+}
+
+// SecurityEvent is one sign-in attempt shown in the user's security history
+type SecurityEvent struct {
+	UserID  primitive.ObjectID `bson:"user_id"`
+	Type    string             `bson:"type"`
+	IP      string             `bson:"ip,omitempty"`
+	Country string             `bson:"country,omitempty"`
+	Device  string             `bson:"device,omitempty"`
+	Detail  string             `bson:"detail,omitempty"`
+	At      time.Time          `bson:"at"`
+}
+
+// recordSecurityEvent stores a sign-in attempt with the client details set
+// by the gateway. Failures are logged and do not fail the call.
+func (s *userService) recordSecurityEvent(ctx context.Context, userID primitive.ObjectID, eventType, device, detail string) {
+	event := SecurityEvent{
+		UserID:  userID,
+		Type:    eventType,
+		IP:      metadataValue(ctx, clientIPHeader),
+		Country: metadataValue(ctx, clientCountryHeader),
+		Device:  strings.TrimSpace(device),
+		Detail:  detail,
+		At:      time.Now(),
+	}
+	if _, err := s.collection(ctx, "security_events").InsertOne(ctx, event); err != nil {
+		log.Printf("Failed to record %s security event: %v", eventType, err)
+	}
+}
+
+// csvCell stops spreadsheet applications from treating a value as a formula
+func csvCell(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// ExportSecurityEvents streams the user's sign-ins and account security
+// events between fromUnix and toUnix as CSV, defaulting to the last 90 days.
+// It needs a recent password confirmation, like the access report.
+func (s *userService) ExportSecurityEvents(req *pb.ExportSecurityEventsMessageRequest, stream grpc.ServerStreamingServer[pb.ExportSecurityEventsChunk]) error {
+	ctx := stream.Context()
+	if err := s.requireFreshAuth(ctx, req.GetUserId()); err != nil {
+		return err
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// 1. Resolve the window
+	to := time.Now()
+	if req.GetToUnix() > 0 {
+		to = time.Unix(req.GetToUnix(), 0)
+	}
+	from := to.AddDate(0, 0, -defaultSecurityExportDays)
+	if req.GetFromUnix() > 0 {
+		from = time.Unix(req.GetFromUnix(), 0)
+	}
+	if !from.Before(to) {
+		return status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	if to.Sub(from) > securityEventRetention {
+		return status.Error(codes.InvalidArgument, "window must not exceed one year")
+	}
+
+	// 2. Load sign-ins and account events in the window
+	cursor, err := s.collection(ctx, "security_events").Find(ctx,
+		bson.M{"user_id": user.ID, "at": bson.M{"$gte": from, "$lt": to}},
+		options.Find().SetSort(bson.D{{Key: "at", Value: 1}}).SetLimit(maxSecurityExportRows),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return status.Error(codes.Internal, "failed to export security events")
+	}
+	var events []SecurityEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		log.Printf("Database error: %v", err)
+		return status.Error(codes.Internal, "failed to export security events")
+	}
+
+	cursor, err = s.collection(ctx, "outbox").Find(ctx,
+		bson.M{"aggregate_id": user.ID.Hex(), "type": bson.M{"$in": securityOutboxEvents}, "created_at": bson.M{"$gte": from, "$lt": to}},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetLimit(maxSecurityExportRows),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return status.Error(codes.Internal, "failed to export security events")
+	}
+	var account []OutboxEvent
+	if err := cursor.All(ctx, &account); err != nil {
+		log.Printf("Database error: %v", err)
+		return status.Error(codes.Internal, "failed to export security events")
+	}
+	for _, e := range account {
+		events = append(events, SecurityEvent{Type: strings.TrimPrefix(e.Type, "user."), At: e.CreatedAt})
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At.Before(events[j].At) })
+	if len(events) > maxSecurityExportRows {
+		events = events[:maxSecurityExportRows]
+	}
+
+	// 3. Stream the CSV in chunks
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"time", "event", "ip", "country", "device", "detail"})
+	for _, e := range events {
+		w.Write([]string{
+			e.At.UTC().Format(time.RFC3339),
+			e.Type,
+			csvCell(e.IP),
+			csvCell(e.Country),
+			csvCell(e.Device),
+			csvCell(e.Detail),
+		})
+		w.Flush()
+		if buf.Len() >= securityExportChunkBytes {
+			if err := stream.Send(&pb.ExportSecurityEventsChunk{Data: buf.Bytes()}); err != nil {
+				return err
+			}
+			buf.Reset()
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.Printf("Failed to encode security events: %v", err)
+		return status.Error(codes.Internal, "failed to export security events")
+	}
+	if buf.Len() > 0 {
+		return stream.Send(&pb.ExportSecurityEventsChunk{Data: buf.Bytes()})
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+)
+
+const (
+	defaultTier    = "standard"
+	newUserWindow  = 30 * 24 * time.Hour
+	segmentNewUser = "new_user"
+)
+
+// GetUserSegments exposes the personalization view of a user to the
+// recommendation engine. Only coarse attributes are returned, never raw PII
+// such as names, contact details or the exact date of birth.
+func (s *userService) GetUserSegments(ctx context.Context, req *pb.GetUserSegmentsMessageRequest) (*pb.GetUserSegmentsMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	tier := user.Tier
+	if tier == "" {
+		tier = defaultTier
+	}
+
+	demographics := userDemographics(user)
+
+	return &pb.GetUserSegmentsMessageResponse{
+		UserId:       user.ID.Hex(),
+		Segments:     computeSegments(user, tier, demographics, time.Now()),
+		Tags:         user.Tags,
+		Tier:         tier,
+		Locale:       user.Locale,
+		Demographics: demographics,
+	}, nil
+}
+
+// userDemographics returns the coarse demographic view of a user
+func userDemographics(user *User) *pb.Demographics {
+	d := &pb.Demographics{
+		AgeBand: ageBand(user.DateOfBirth, time.Now()),
+		Gender:  user.Gender,
+	}
+	if user.Billing != nil {
+		d.Country = user.Billing.Address.Country
+		d.Region = user.Billing.Address.Region
+	}
+	return d
+}
+
+// computeSegments derives rule-based segments from the stored attributes
+func computeSegments(user *User, tier string, d *pb.Demographics, now time.Time) []string {
+	segments := []string{"tier:" + tier}
+
+	if now.Sub(user.CreatedAt) < newUserWindow {
+		segments = append(segments, segmentNewUser)
+	}
+	if d.AgeBand != "" {
+		segments = append(segments, "age:"+d.AgeBand)
+	}
+	if d.Country != "" {
+		segments = append(segments, "country:"+d.Country)
+	}
+	if user.Locale != "" {
+		segments = append(segments, "locale:"+user.Locale)
+	}
+
+	sort.Strings(segments)
+	return segments
+}
+
+// ageBand buckets a date of birth so the exact age never leaves the service
+func ageBand(dob *time.Time, now time.Time) string {
+	if dob == nil || dob.IsZero() {
+		return ""
+	}
+
+	age := now.Year() - dob.Year()
+	if now.Month() < dob.Month() || (now.Month() == dob.Month() && now.Day() < dob.Day()) {
+		age--
+	}
+
+	switch {
+	case age < 18:
+		return "under_18"
+	case age < 25:
+		return "18-24"
+	case age < 35:
+		return "25-34"
+	case age < 45:
+		return "35-44"
+	case age < 55:
+		return "45-54"
+	case age < 65:
+		return "55-64"
+	default:
+		return "65+"
+	}
+}
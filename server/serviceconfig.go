@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+)
+
+// Client resilience defaults published as a gRPC service config
+const (
+	serviceConfigTimeout       = 5 * time.Second
+	serviceConfigUploadTimeout = time.Minute
+	serviceConfigExportTimeout = 2 * time.Minute
+	serviceConfigHedgeDelay    = 150 * time.Millisecond
+	serviceConfigMaxAttempts   = 3
+)
+
+// retryableCodes are the codes a call can be repeated after. The readiness
+// and maintenance interceptors answer UNAVAILABLE before a handler runs, so
+// repeating a write after one does not apply it twice. RESOURCE_EXHAUSTED
+// is left out on purpose: a quota or rate limit does not clear within the
+// backoff of a retry.
+var retryableCodes = []string{"UNAVAILABLE"}
+
+// slowMethods build reports or exports and get a longer deadline than other
+// calls
+var slowMethods = map[string]bool{
+	"GenerateAccessReport":    true,
+	"ExportComplianceRecords": true,
+}
+
+// hedgeable reports whether a unary method only reads, so a slow call can
+// be raced by a second copy without side effects
+func hedgeable(method string) bool {
+	switch method {
+	case "ValidateToken", "SuggestUsers":
+		return true
+	case "GetDueDigests", "GetAssignments":
+		// These claim digests and store experiment assignments as they read
+		return false
+	}
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List")
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type hedgingPolicy struct {
+	MaxAttempts         int      `json:"maxAttempts"`
+	HedgingDelay        string   `json:"hedgingDelay"`
+	NonFatalStatusCodes []string `json:"nonFatalStatusCodes"`
+}
+
+// methodConfig is one methodConfig entry. gRPC allows either a retry or a
+// hedging policy per method, never both.
+type methodConfig struct {
+	Name          []methodName   `json:"name"`
+	Timeout       string         `json:"timeout,omitempty"`
+	RetryPolicy   *retryPolicy   `json:"retryPolicy,omitempty"`
+	HedgingPolicy *hedgingPolicy `json:"hedgingPolicy,omitempty"`
+}
+
+type retryThrottling struct {
+	MaxTokens  int     `json:"maxTokens"`
+	TokenRatio float64 `json:"tokenRatio"`
+}
+
+type serviceConfig struct {
+	MethodConfig    []methodConfig  `json:"methodConfig"`
+	RetryThrottling retryThrottling `json:"retryThrottling"`
+}
+
+// durationJSON writes a duration the way service configs expect, in
+// seconds with an "s" suffix
+func durationJSON(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
+
+// userServiceConfig is the service config clients of the UserService should
+// dial with:
+//
+//   - read-only unary methods (Get*, List*, ValidateToken, SuggestUsers) are
+//     hedged: a second copy goes out when the first has not answered within
+//     serviceConfigHedgeDelay, and a third after another delay
+//   - every other unary method is retried with backoff on UNAVAILABLE
+//   - unary calls time out after serviceConfigTimeout, reports and exports
+//     after serviceConfigExportTimeout, uploads after
+//     serviceConfigUploadTimeout; WatchUserMetrics runs until cancelled
+//   - retry throttling stops retries and hedges once failures of a client
+//     outpace its successes, so retries never pile onto an outage
+//
+// Methods without an entry fall back to the service-wide one. grpc-go
+// ignores hedging policies, so Go clients hedge through
+// rpcclient.HedgePolicy instead; Java and C++ clients apply them as is.
+func userServiceConfig() serviceConfig {
+	service := pb.UserService_ServiceDesc.ServiceName
+	retry := &retryPolicy{
+		MaxAttempts:          serviceConfigMaxAttempts,
+		InitialBackoff:       durationJSON(100 * time.Millisecond),
+		MaxBackoff:           durationJSON(time.Second),
+		BackoffMultiplier:    2,
+		RetryableStatusCodes: retryableCodes,
+	}
+	hedge := &hedgingPolicy{
+		MaxAttempts:         serviceConfigMaxAttempts,
+		HedgingDelay:        durationJSON(serviceConfigHedgeDelay),
+		NonFatalStatusCodes: retryableCodes,
+	}
+
+	hedged := methodConfig{Timeout: durationJSON(serviceConfigTimeout), HedgingPolicy: hedge}
+	slow := methodConfig{Timeout: durationJSON(serviceConfigExportTimeout), RetryPolicy: retry}
+	for _, m := range pb.UserService_ServiceDesc.Methods {
+		name := methodName{Service: service, Method: m.MethodName}
+		switch {
+		case slowMethods[m.MethodName]:
+			slow.Name = append(slow.Name, name)
+		case hedgeable(m.MethodName):
+			hedged.Name = append(hedged.Name, name)
+		}
+	}
+
+	uploads := methodConfig{Timeout: durationJSON(serviceConfigUploadTimeout)}
+	exports := methodConfig{Timeout: durationJSON(serviceConfigExportTimeout)}
+	watches := methodConfig{}
+	for _, st := range pb.UserService_ServiceDesc.Streams {
+		name := methodName{Service: service, Method: st.StreamName}
+		switch {
+		case st.ClientStreams:
+			uploads.Name = append(uploads.Name, name)
+		case st.StreamName == "WatchUserMetrics":
+			watches.Name = append(watches.Name, name)
+		default:
+			exports.Name = append(exports.Name, name)
+		}
+	}
+
+	cfg := serviceConfig{RetryThrottling: retryThrottling{MaxTokens: 10, TokenRatio: 0.1}}
+	for _, mc := range []methodConfig{hedged, slow, uploads, exports, watches} {
+		if len(mc.Name) > 0 {
+			cfg.MethodConfig = append(cfg.MethodConfig, mc)
+		}
+	}
+	cfg.MethodConfig = append(cfg.MethodConfig, methodConfig{
+		Name:        []methodName{{Service: service}},
+		Timeout:     durationJSON(serviceConfigTimeout),
+		RetryPolicy: retry,
+	})
+	return cfg
+}
+
+// serviceConfigHandler publishes userServiceConfig for clients to pass to
+// grpc.WithDefaultServiceConfig. With ?format=dns it answers the value of
+// the _grpc_config TXT record the DNS resolver reads, so the config can be
+// rolled out without touching clients.
+func serviceConfigHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg := userServiceConfig()
+		if r.URL.Query().Get("format") != "dns" {
+			writeJSON(w, http.StatusOK, cfg)
+			return
+		}
+		record, err := json.Marshal([]map[string]interface{}{{"serviceConfig": cfg}})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("grpc_config=" + string(record) + "\n"))
+	})
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/token"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const securityEventRefreshTokenReused = "refresh_token_reused"
+
+// RefreshSession is an issued refresh token, stored in refresh_tokens under
+// its jti. Each one can be exchanged once; presenting a used one again means
+// it leaked, and the whole session is revoked.
+type RefreshSession struct {
+	ID        string             `bson:"_id"`
+	SessionID string             `bson:"session_id"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Client    string             `bson:"client"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	UsedAt    *time.Time         `bson:"used_at,omitempty"`
+	RevokedAt *time.Time         `bson:"revoked_at,omitempty"`
+}
+
+// errRefreshTokenNotFound is returned for unknown refresh tokens and, by
+// Use, for ones already used or revoked
+var errRefreshTokenNotFound = errors.New("refresh token not found")
+
+// refreshTokenStore keeps issued refresh tokens. Revoking stops tokens from
+// being exchanged; access tokens already issued stay valid until they
+// expire.
+type refreshTokenStore interface {
+	Store(ctx context.Context, session *RefreshSession) error
+	// Use marks an unused, unrevoked token as used and returns it
+	Use(ctx context.Context, id string, at time.Time) (*RefreshSession, error)
+	Find(ctx context.Context, id string) (*RefreshSession, error)
+	RevokeSession(ctx context.Context, sessionID string, at time.Time) error
+	RevokeUser(ctx context.Context, userID primitive.ObjectID, at time.Time) error
+}
+
+// mongoRefreshTokenStore keeps refresh tokens in refresh_tokens
+type mongoRefreshTokenStore struct {
+	collection collectionFunc
+}
+
+func newMongoRefreshTokenStore(collection collectionFunc) *mongoRefreshTokenStore {
+	return &mongoRefreshTokenStore{collection: collection}
+}
+
+func (m *mongoRefreshTokenStore) tokens(ctx context.Context) *mongo.Collection {
+	return m.collection(ctx, "refresh_tokens")
+}
+
+func (m *mongoRefreshTokenStore) Store(ctx context.Context, session *RefreshSession) error {
+	_, err := m.tokens(ctx).InsertOne(ctx, session)
+	return err
+}
+
+func (m *mongoRefreshTokenStore) Use(ctx context.Context, id string, at time.Time) (*RefreshSession, error) {
+	var session RefreshSession
+	err := m.tokens(ctx).FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "used_at": nil, "revoked_at": nil},
+		bson.M{"$set": bson.M{"used_at": at}},
+	).Decode(&session)
+	if err == mongo.ErrNoDocuments {
+		return nil, errRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (m *mongoRefreshTokenStore) Find(ctx context.Context, id string) (*RefreshSession, error) {
+	var session RefreshSession
+	err := m.tokens(ctx).FindOne(ctx, bson.M{"_id": id}).Decode(&session)
+	if err == mongo.ErrNoDocuments {
+		return nil, errRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (m *mongoRefreshTokenStore) revoke(ctx context.Context, filter bson.M, at time.Time) error {
+	filter["revoked_at"] = nil
+	_, err := m.tokens(ctx).UpdateMany(ctx, filter, bson.M{"$set": bson.M{"revoked_at": at}})
+	return err
+}
+
+func (m *mongoRefreshTokenStore) RevokeSession(ctx context.Context, sessionID string, at time.Time) error {
+	return m.revoke(ctx, bson.M{"session_id": sessionID}, at)
+}
+
+func (m *mongoRefreshTokenStore) RevokeUser(ctx context.Context, userID primitive.ObjectID, at time.Time) error {
+	return m.revoke(ctx, bson.M{"user_id": userID}, at)
+}
+
+// storeRefreshToken records a refresh token so it can be exchanged
+func (s *userService) storeRefreshToken(ctx context.Context, userID primitive.ObjectID, claims *token.Claims) error {
+	return s.refreshTokens.Store(ctx, &RefreshSession{
+		ID:        claims.ID,
+		SessionID: claims.SessionID,
+		UserID:    userID,
+		Client:    claims.Client,
+		CreatedAt: claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+	})
+}
+
+// revokeSession ends a session whose refresh token leaked
+func (s *userService) revokeSession(ctx context.Context, sessionID string) {
+	if err := s.refreshTokens.RevokeSession(ctx, sessionID, time.Now()); err != nil {
+		log.Printf("Failed to revoke session %s: %v", sessionID, err)
+	}
+}
+
+// RefreshToken exchanges a refresh token from LoginUser or an earlier
+// refresh for a new access and refresh token. Roles, tier and scopes are
+// read again, so changes to the account reach the next access token. A
+// session bound to a client can only be refreshed from that client.
+func (s *userService) RefreshToken(ctx context.Context, req *pb.RefreshTokenMessageRequest) (*pb.RefreshTokenMessageResponse, error) {
+	// 1. Validate the refresh token
+	claims, err := s.tokens.ParseRefreshToken(req.GetRefreshToken(), requestFromContext(ctx).Tenant)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	client := clientContext(ctx)
+	if err := s.checkBinding(ctx, claims, client); err != nil {
+		// Someone else holds the session's refresh token; end it for both
+		s.revokeSession(ctx, claims.SessionID)
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	// 2. Use it up
+	_, err = s.refreshTokens.Use(ctx, claims.ID, time.Now())
+	if errors.Is(err, errRefreshTokenNotFound) {
+		session, err := s.refreshTokens.Find(ctx, claims.ID)
+		if err == nil && session.RevokedAt == nil {
+			log.Printf("Refresh token reused for user %s, revoking session %s", session.UserID.Hex(), session.SessionID)
+			s.revokeSession(ctx, session.SessionID)
+			s.recordSecurityEvent(ctx, session.UserID, securityEventRefreshTokenReused, "", session.Client)
+		} else if err != nil && !errors.Is(err, errRefreshTokenNotFound) {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// 3. Issue the new pair for the current state of the account
+	user, err := s.findUserByID(ctx, claims.Subject)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+		return nil, err
+	}
+	if user.PasswordResetRequired {
+		return nil, status.Error(codes.FailedPrecondition, "password reset required")
+	}
+	pair, err := s.tokens.Refresh(claims, tokenSubject(user), s.tokens.Binding(client))
+	if err != nil {
+		if errors.Is(err, token.ErrUnknownClient) || errors.Is(err, token.ErrScopeNotAllowed) {
+			return nil, status.Error(codes.Unauthenticated, "session is no longer valid, sign in again")
+		}
+		log.Printf("Failed to refresh token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+	if err := s.storeRefreshToken(ctx, user.ID, pair.RefreshClaims); err != nil {
+		log.Printf("Failed to store refresh token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+
+	return &pb.RefreshTokenMessageResponse{
+		AccessToken:          pair.Access,
+		ExpiresAtUnix:        pair.AccessClaims.ExpiresAt.Unix(),
+		RefreshToken:         pair.Refresh,
+		RefreshExpiresAtUnix: pair.RefreshClaims.ExpiresAt.Unix(),
+		Scopes:               pair.AccessClaims.Scopes(),
+	}, nil
+}
@@ -0,0 +1,478 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bruceoaudo/userService/internal/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	shadowQueueSize = 1024
+	shadowWorkers   = 4
+	shadowTimeout   = 5 * time.Second
+)
+
+// Outcomes of a mirrored call
+const (
+	shadowMatch    = "match"
+	shadowMismatch = "mismatch"
+	shadowMissing  = "missing"
+	shadowError    = "error"
+	shadowDropped  = "dropped"
+	shadowStale    = "stale"
+)
+
+// shadowComparedFields are the user fields only the repository writes. The
+// rest of the document is also updated by handlers writing the users
+// collection directly, which the shadow store never sees.
+var shadowComparedFields = []string{"_id", "user_name", "email", "phone", "created_at", "last_login_at", "risk"}
+
+var shadowCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "userservice_shadow_calls_total",
+	Help: "User repository calls mirrored to the shadow store, by operation and result (match, mismatch, " +
+		"missing, stale, error, dropped). missing is a user the shadow store does not have yet, expected until it " +
+		"is backfilled; stale is a user the primary store no longer finds, like a deleted account; dropped " +
+		"means the mirror queue was full.",
+}, []string{"operation", "result"})
+
+// shadowUserRepository serves every call from the primary store and mirrors
+// it to a shadow store in the background: writes are repeated, reads are
+// repeated for a sample of calls and their shadowComparedFields compared.
+// The shadow store never affects callers, so a new backend can take
+// production traffic before the migration to it.
+type shadowUserRepository struct {
+	primary userRepository
+	shadow  userRepository
+	// readSample is the share of reads that are compared, from 0 to 1
+	readSample float64
+	queue      chan func(context.Context)
+	workers    sync.WaitGroup
+
+	// mu guards closing queue against mirror sending to it
+	mu     sync.RWMutex
+	closed bool
+
+	// client is the shadow deployment, nil when the caller owns it
+	client *mongo.Client
+	// stopIndexes ends the index build of the shadow store
+	stopIndexes context.CancelFunc
+}
+
+// newShadowUserRepository wraps primary when SHADOW_MONGODB_URI names a
+// secondary deployment, e.g. the cluster users are moving to. Reads are
+// compared for the share in SHADOW_READ_SAMPLE, 1 by default. Any store
+// implementing userRepository can be the shadow; MongoDB is the one built in.
+// The shadow users collections get the same indexes as the primary ones, so
+// both stores refuse the same duplicates. The returned close function, nil
+// without a shadow store, stops mirroring and disconnects it.
+func newShadowUserRepository(primary userRepository, mongoCfg config.MongoConfig) (userRepository, func(context.Context) error, error) {
+	uri := os.Getenv("SHADOW_MONGODB_URI")
+	if uri == "" {
+		return primary, nil, nil
+	}
+	sample := 1.0
+	if v := os.Getenv("SHADOW_READ_SAMPLE"); v != "" {
+		var err error
+		sample, err = strconv.ParseFloat(v, 64)
+		if err != nil || sample < 0 || sample > 1 {
+			return nil, nil, fmt.Errorf("SHADOW_READ_SAMPLE must be between 0 and 1")
+		}
+	}
+	// The shadow deployment keeps the collection names and tenant layout of
+	// the primary one
+	if v := os.Getenv("SHADOW_MONGODB_DATABASE"); v != "" {
+		mongoCfg.Database = v
+	}
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI(uri).
+		SetConnectTimeout(mongoCfg.ConnectTimeout).
+		SetServerSelectionTimeout(mongoCfg.ServerSelectionTimeout))
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Printf("Mirroring user repository calls to shadow database %s, comparing %.0f%% of reads", mongoCfg.Database, sample*100)
+	collection := mongoCollections(client, mongoCfg)
+	r := newShadow(primary, newMongoUserRepository(collection), sample)
+	r.client = client
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.stopIndexes = cancel
+	go retryStartup(ctx, "build shadow indexes", func(ctx context.Context) error {
+		return eachStorage(ctx, mongoCfg, collection, func(ctx context.Context, collection collectionFunc) error {
+			return ensureIndexSpecs(ctx, collection, userIndexSpecs())
+		})
+	})
+	return r, r.Close, nil
+}
+
+// userIndexSpecs are the indexSpecs of the users collection
+func userIndexSpecs() []collectionIndexes {
+	var specs []collectionIndexes
+	for _, spec := range indexSpecs {
+		if spec.collection == "users" {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+func newShadow(primary, shadow userRepository, readSample float64) *shadowUserRepository {
+	r := &shadowUserRepository{
+		primary:    primary,
+		shadow:     shadow,
+		readSample: readSample,
+		queue:      make(chan func(context.Context), shadowQueueSize),
+	}
+	r.workers.Add(shadowWorkers)
+	for i := 0; i < shadowWorkers; i++ {
+		go r.work()
+	}
+	return r
+}
+
+// Close stops mirroring, waits until ctx ends for the queued calls and
+// disconnects the shadow deployment
+func (r *shadowUserRepository) Close(ctx context.Context) error {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	if r.stopIndexes != nil {
+		r.stopIndexes()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		r.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Printf("Dropping %d queued shadow calls", len(r.queue))
+	}
+	if r.client == nil {
+		return nil
+	}
+	return r.client.Disconnect(ctx)
+}
+
+func (r *shadowUserRepository) work() {
+	defer r.workers.Done()
+	for job := range r.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), shadowTimeout)
+		job(ctx)
+		cancel()
+	}
+}
+
+// mirror queues a call to the shadow store, dropping it when the queue is
+// full so a slow shadow store never slows the primary path. The job runs
+// against the storage of the tenant the call was for.
+func (r *shadowUserRepository) mirror(ctx context.Context, operation string, job func(context.Context) string) {
+	tenant := storageTenant(ctx)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		shadowCalls.WithLabelValues(operation, shadowDropped).Inc()
+		return
+	}
+	select {
+	case r.queue <- func(jobCtx context.Context) {
+		shadowCalls.WithLabelValues(operation, job(withStorageTenant(jobCtx, tenant))).Inc()
+	}:
+	default:
+		shadowCalls.WithLabelValues(operation, shadowDropped).Inc()
+	}
+}
+
+// comparable reports whether a read that ended with err is compared. Reads
+// the primary store failed have nothing to compare against.
+func (r *shadowUserRepository) comparable(err error) bool {
+	if err != nil && !errors.Is(err, errUserNotFound) {
+		return false
+	}
+	return r.readSample >= 1 || rand.Float64() < r.readSample
+}
+
+// compareUsers reports the outcome of a shadow read against the primary
+// result. Only the names of differing fields are logged, never their values.
+func compareUsers(operation string, want *User, wantErr error, got *User, gotErr error) string {
+	switch {
+	case wantErr != nil:
+		// The primary found nothing. Deletions are not mirrored, so a user
+		// the shadow store still finds is stale rather than a difference.
+		if got != nil {
+			return shadowStale
+		}
+		return shadowMatch
+	case errors.Is(gotErr, errUserNotFound):
+		return shadowMissing
+	case gotErr != nil:
+		log.Printf("Shadow %s failed: %v", operation, gotErr)
+		return shadowError
+	}
+	fields, err := differingFields(want, got)
+	if err != nil {
+		log.Printf("Failed to compare shadow %s result: %v", operation, err)
+		return shadowError
+	}
+	if len(fields) > 0 {
+		log.Printf("Shadow %s returned a different user %s, fields %v", operation, want.ID.Hex(), fields)
+		return shadowMismatch
+	}
+	return shadowMatch
+}
+
+// differingFields compares the shadowComparedFields of two users by their
+// stored form, so both stores are held to the same document and time
+// precision
+func differingFields(a, b *User) ([]string, error) {
+	docA, err := storedForm(a)
+	if err != nil {
+		return nil, err
+	}
+	docB, err := storedForm(b)
+	if err != nil {
+		return nil, err
+	}
+	var fields []string
+	for _, k := range shadowComparedFields {
+		if !reflect.DeepEqual(docA[k], docB[k]) {
+			fields = append(fields, k)
+		}
+	}
+	return fields, nil
+}
+
+// cloneUser deep-copies a user before it is compared or written in the
+// background, since the caller may still change it
+func cloneUser(u *User) *User {
+	if u == nil {
+		return nil
+	}
+	raw, err := bson.Marshal(u)
+	if err != nil {
+		return nil
+	}
+	var copied User
+	if err := bson.Unmarshal(raw, &copied); err != nil {
+		return nil
+	}
+	return &copied
+}
+
+func storedForm(u *User) (bson.M, error) {
+	raw, err := bson.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.M
+	err = bson.Unmarshal(raw, &doc)
+	return doc, err
+}
+
+// compareWrite reports whether the shadow store took a write like the
+// primary did
+func compareWrite(operation string, err error) string {
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return shadowMissing
+		}
+		log.Printf("Shadow %s failed: %v", operation, err)
+		return shadowError
+	}
+	return shadowMatch
+}
+
+func (r *shadowUserRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*User, error) {
+	user, err := r.primary.FindByID(ctx, id)
+	if r.comparable(err) {
+		want := cloneUser(user)
+		r.mirror(ctx, "find_by_id", func(ctx context.Context) string {
+			got, gotErr := r.shadow.FindByID(ctx, id)
+			return compareUsers("find_by_id", want, err, got, gotErr)
+		})
+	}
+	return user, err
+}
+
+func (r *shadowUserRepository) FindByEmail(ctx context.Context, email string) (*User, error) {
+	user, err := r.primary.FindByEmail(ctx, email)
+	if r.comparable(err) {
+		want := cloneUser(user)
+		r.mirror(ctx, "find_by_email", func(ctx context.Context) string {
+			got, gotErr := r.shadow.FindByEmail(ctx, email)
+			return compareUsers("find_by_email", want, err, got, gotErr)
+		})
+	}
+	return user, err
+}
+
+func (r *shadowUserRepository) ExistsByEmailUsernamePhone(ctx context.Context, email, userName, phone string) (bool, error) {
+	exists, err := r.primary.ExistsByEmailUsernamePhone(ctx, email, userName, phone)
+	if r.comparable(err) {
+		r.mirror(ctx, "exists", func(ctx context.Context) string {
+			got, gotErr := r.shadow.ExistsByEmailUsernamePhone(ctx, email, userName, phone)
+			switch {
+			case gotErr != nil:
+				log.Printf("Shadow exists check failed: %v", gotErr)
+				return shadowError
+			case got == exists:
+				return shadowMatch
+			case exists:
+				return shadowMissing
+			}
+			// Erasure rewrites the identity fields in the primary store only
+			return shadowStale
+		})
+	}
+	return exists, err
+}
+
+// Create mirrors the user with the ID the primary store assigned, so both
+// stores agree on it
+func (r *shadowUserRepository) Create(ctx context.Context, user *User) error {
+	if err := r.primary.Create(ctx, user); err != nil {
+		return err
+	}
+	if copied := cloneUser(user); copied != nil {
+		r.mirror(ctx, "create", func(ctx context.Context) string {
+			err := r.shadow.Create(ctx, copied)
+			if errors.Is(err, errUserExists) {
+				log.Printf("Shadow create found user %s already stored", copied.ID.Hex())
+				return shadowMismatch
+			}
+			return compareWrite("create", err)
+		})
+	}
+	return nil
+}
+
+func (r *shadowUserRepository) RecordLogin(ctx context.Context, id primitive.ObjectID, at time.Time, risk *RiskAssessment) error {
+	if err := r.primary.RecordLogin(ctx, id, at, risk); err != nil {
+		return err
+	}
+	r.mirror(ctx, "record_login", func(ctx context.Context) string {
+		return compareWrite("record_login", r.shadow.RecordLogin(ctx, id, at, risk))
+	})
+	return nil
+}
+
+func (r *shadowUserRepository) SetRisk(ctx context.Context, id primitive.ObjectID, risk *RiskAssessment) error {
+	if err := r.primary.SetRisk(ctx, id, risk); err != nil {
+		return err
+	}
+	r.mirror(ctx, "set_risk", func(ctx context.Context) string {
+		return compareWrite("set_risk", r.shadow.SetRisk(ctx, id, risk))
+	})
+	return nil
+}
+
+func (r *shadowUserRepository) SetPasswordHash(ctx context.Context, id primitive.ObjectID, current, hash string) error {
+	if err := r.primary.SetPasswordHash(ctx, id, current, hash); err != nil {
+		return err
+	}
+	r.mirror(ctx, "set_password_hash", func(ctx context.Context) string {
+		return compareWrite("set_password_hash", r.shadow.SetPasswordHash(ctx, id, current, hash))
+	})
+	return nil
+}
+
+func (r *shadowUserRepository) AddDeviceFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string, limit int) error {
+	if err := r.primary.AddDeviceFingerprint(ctx, id, fingerprint, limit); err != nil {
+		return err
+	}
+	r.mirror(ctx, "add_device_fingerprint", func(ctx context.Context) string {
+		return compareWrite("add_device_fingerprint", r.shadow.AddDeviceFingerprint(ctx, id, fingerprint, limit))
+	})
+	return nil
+}
+
+func (r *shadowUserRepository) FindUSSDByPhone(ctx context.Context, phone string) (*User, error) {
+	user, err := r.primary.FindUSSDByPhone(ctx, phone)
+	if r.comparable(err) {
+		want := cloneUser(user)
+		r.mirror(ctx, "find_ussd_by_phone", func(ctx context.Context) string {
+			got, gotErr := r.shadow.FindUSSDByPhone(ctx, phone)
+			return compareUsers("find_ussd_by_phone", want, err, got, gotErr)
+		})
+	}
+	return user, err
+}
+
+// ReserveUSSDPINAttempt counts the attempt in the shadow store too, but
+// only the primary decides whether the PIN is locked
+func (r *shadowUserRepository) ReserveUSSDPINAttempt(ctx context.Context, id primitive.ObjectID, limit int, now time.Time) (*USSDAccount, error) {
+	account, err := r.primary.ReserveUSSDPINAttempt(ctx, id, limit, now)
+	if err != nil {
+		return nil, err
+	}
+	r.mirror(ctx, "reserve_ussd_pin_attempt", func(ctx context.Context) string {
+		_, err := r.shadow.ReserveUSSDPINAttempt(ctx, id, limit, now)
+		if errors.Is(err, errUSSDPINLocked) {
+			return shadowMismatch
+		}
+		return compareWrite("reserve_ussd_pin_attempt", err)
+	})
+	return account, nil
+}
+
+func (r *shadowUserRepository) LockUSSDPIN(ctx context.Context, id primitive.ObjectID, limit int, until time.Time) error {
+	if err := r.primary.LockUSSDPIN(ctx, id, limit, until); err != nil {
+		return err
+	}
+	r.mirror(ctx, "lock_ussd_pin", func(ctx context.Context) string {
+		return compareWrite("lock_ussd_pin", r.shadow.LockUSSDPIN(ctx, id, limit, until))
+	})
+	return nil
+}
+
+func (r *shadowUserRepository) ResetUSSDPINFailures(ctx context.Context, id primitive.ObjectID) error {
+	if err := r.primary.ResetUSSDPINFailures(ctx, id); err != nil {
+		return err
+	}
+	r.mirror(ctx, "reset_ussd_pin_failures", func(ctx context.Context) string {
+		return compareWrite("reset_ussd_pin_failures", r.shadow.ResetUSSDPINFailures(ctx, id))
+	})
+	return nil
+}
+
+func (r *shadowUserRepository) SetUSSDPIN(ctx context.Context, id primitive.ObjectID, hash string, at time.Time) error {
+	if err := r.primary.SetUSSDPIN(ctx, id, hash, at); err != nil {
+		return err
+	}
+	r.mirror(ctx, "set_ussd_pin", func(ctx context.Context) string {
+		return compareWrite("set_ussd_pin", r.shadow.SetUSSDPIN(ctx, id, hash, at))
+	})
+	return nil
+}
+
+func (r *shadowUserRepository) SetBillingProfile(ctx context.Context, id primitive.ObjectID, billing *BillingProfile, at time.Time) error {
+	if err := r.primary.SetBillingProfile(ctx, id, billing, at); err != nil {
+		return err
+	}
+	copied := *billing
+	copied.TaxIDs = append([]TaxIdentifier(nil), billing.TaxIDs...)
+	r.mirror(ctx, "set_billing_profile", func(ctx context.Context) string {
+		return compareWrite("set_billing_profile", r.shadow.SetBillingProfile(ctx, id, &copied, at))
+	})
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserShadowBanned     = "user.shadow_banned"
+	eventUserShadowBanLifted  = "user.shadow_ban_lifted"
+	maxPublicProfilesPerBatch = 100
+)
+
+// ShadowBan records who shadow-banned an account and why. It is never shown
+// to the account holder.
+type ShadowBan struct {
+	Moderator string    `bson:"moderator"`
+	Reason    string    `bson:"reason"`
+	At        time.Time `bson:"at"`
+}
+
+// hiddenFrom reports whether the public interactions of u, such as reviews
+// and questions, are hidden from viewerID. Shadow-banned users still see
+// their own content so the ban goes unnoticed.
+func (u *User) hiddenFrom(viewerID string) bool {
+	return u.ShadowBanned && strings.TrimSpace(viewerID) != u.ID.Hex()
+}
+
+// SetShadowBan hides or reveals the public interactions of an account while
+// leaving it fully usable by its owner
+func (s *userService) SetShadowBan(ctx context.Context, req *pb.SetShadowBanMessageRequest) (*pb.SetShadowBanMessageResponse, error) {
+	moderator := strings.TrimSpace(req.GetModerator())
+	if moderator == "" {
+		return nil, status.Error(codes.InvalidArgument, "moderator is required")
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if req.GetBanned() && reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.ShadowBanned == req.GetBanned() {
+		return &pb.SetShadowBanMessageResponse{Message: "Shadow ban unchanged", Success: true}, nil
+	}
+
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"shadow_banned": true,
+		"shadow_ban":    ShadowBan{Moderator: moderator, Reason: reason, At: now},
+		"updated_at":    now,
+	}}
+	eventType := eventUserShadowBanned
+	if !req.GetBanned() {
+		update = bson.M{
+			"$set":   bson.M{"updated_at": now},
+			"$unset": bson.M{"shadow_banned": "", "shadow_ban": ""},
+		}
+		eventType = eventUserShadowBanLifted
+	}
+
+	collection := s.collection(ctx, "users")
+	if _, err := collection.UpdateOne(ctx, bson.M{"_id": user.ID}, update); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update shadow ban")
+	}
+
+	// Review and Q&A services re-index the author's content on these events
+	s.recordEvent(ctx, eventType, user.ID, map[string]interface{}{"moderator": moderator, "reason": reason, "at": now})
+	log.Printf("Shadow ban for user %s set to %t by %s", user.ID.Hex(), req.GetBanned(), moderator)
+
+	message := "Shadow ban lifted"
+	if req.GetBanned() {
+		message = "User shadow-banned"
+	}
+	return &pb.SetShadowBanMessageResponse{Message: message, Success: true}, nil
+}
+
+// GetPublicProfiles returns the public view of up to 100 users, for
+// services rendering lists of reviews or questions. Unknown and deleted
+// users are left out.
+func (s *userService) GetPublicProfiles(ctx context.Context, req *pb.GetPublicProfilesMessageRequest) (*pb.GetPublicProfilesMessageResponse, error) {
+	if len(req.GetUserIds()) > maxPublicProfilesPerBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d user ids per call", maxPublicProfilesPerBatch)
+	}
+	ids := make([]primitive.ObjectID, 0, len(req.GetUserIds()))
+	for _, raw := range req.GetUserIds() {
+		id, err := parseUserID(raw)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	resp := &pb.GetPublicProfilesMessageResponse{}
+	if len(ids) == 0 {
+		return resp, nil
+	}
+	cursor, err := s.collection(ctx, "users").Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "deleted_at": nil})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load profiles")
+	}
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load profiles")
+	}
+
+	byID := make(map[primitive.ObjectID]*User, len(users))
+	for i := range users {
+		byID[users[i].ID] = &users[i]
+	}
+	// Profiles come back in the order they were asked for
+	for _, id := range ids {
+		user, ok := byID[id]
+		if !ok {
+			continue
+		}
+		profile, err := s.publicProfile(ctx, user, req.GetViewerId())
+		if err != nil {
+			return nil, err
+		}
+		resp.Profiles = append(resp.Profiles, profile)
+		delete(byID, id)
+	}
+	return resp, nil
+}
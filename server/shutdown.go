@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// mongoDisconnectTimeout bounds closing the MongoDB pool once calls drained
+const mongoDisconnectTimeout = 10 * time.Second
+
+// jobStopTimeout bounds waiting for background jobs to return once their
+// context is cancelled
+const jobStopTimeout = 10 * time.Second
+
+// stoppable is a gRPC server, plain or xDS-managed
+type stoppable interface {
+	GracefulStop()
+	Stop()
+}
+
+// drainer stops every server of the process when it is asked to exit,
+// including the metrics and admin endpoints. Health checks fail before the
+// drain starts, so probes see the service go unready first.
+type drainer struct {
+	mu   sync.Mutex
+	grpc []stoppable
+	http []*http.Server
+	// closers release connections the servers used, once they are drained
+	closers []closer
+}
+
+type closer struct {
+	name  string
+	close func(context.Context) error
+}
+
+func (d *drainer) addGRPC(srv stoppable) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.grpc = append(d.grpc, srv)
+}
+
+func (d *drainer) addHTTP(srv *http.Server) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.http = append(d.http, srv)
+}
+
+func (d *drainer) addCloser(name string, close func(context.Context) error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.closers = append(d.closers, closer{name, close})
+}
+
+// closeAll runs the closers in the order they were added
+func (d *drainer) closeAll(ctx context.Context) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, c := range d.closers {
+		if err := c.close(ctx); err != nil {
+			log.Printf("Failed to close %s: %v", c.name, err)
+		}
+	}
+}
+
+// drain stops accepting new calls and waits up to grace for the ones in
+// flight. Calls still running after that, like WatchUserMetrics streams,
+// are cut off.
+func (d *drainer) drain(grace time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, srv := range d.grpc {
+		wg.Add(1)
+		go func(srv stoppable) {
+			defer wg.Done()
+			done := make(chan struct{})
+			go func() {
+				srv.GracefulStop()
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-ctx.Done():
+				log.Printf("Grace period over, closing remaining gRPC calls")
+				srv.Stop()
+			}
+		}(srv)
+	}
+	for _, srv := range d.http {
+		wg.Add(1)
+		go func(srv *http.Server) {
+			defer wg.Done()
+			if err := srv.Shutdown(ctx); err != nil {
+				log.Printf("Grace period over, closing remaining HTTP requests on %s", srv.Addr)
+				srv.Close()
+			}
+		}(srv)
+	}
+	wg.Wait()
+}
+
+// shutdown fails health checks, drains every server, stops the background
+// jobs and closes the database connections, those of the drainer's closers
+// first
+func (s *userService) shutdown(d *drainer, grace time.Duration) {
+	log.Printf("Shutting down, draining in-flight calls for up to %s", grace)
+	s.draining.Store(true)
+	// Shutdown holds every service at NOT_SERVING, whatever the health
+	// monitor reports afterwards
+	s.health.Shutdown()
+	d.drain(grace)
+	s.stopBackgroundJobs()
+
+	ctx, cancel := context.WithTimeout(context.Background(), mongoDisconnectTimeout)
+	defer cancel()
+	d.closeAll(ctx)
+	if err := s.db.Disconnect(ctx); err != nil {
+		log.Printf("Failed to disconnect from MongoDB: %v", err)
+	}
+	if s.redis != nil {
+		if err := s.redis.Close(); err != nil {
+			log.Printf("Failed to close Redis client: %v", err)
+		}
+	}
+	log.Printf("Shutdown complete")
+}
+
+// stopBackgroundJobs cancels the jobs started with startJob and waits for
+// them, so none is cut off halfway by the database connections closing
+func (s *userService) stopBackgroundJobs() {
+	s.stopJobs()
+	done := make(chan struct{})
+	go func() {
+		s.jobsDone.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(jobStopTimeout):
+		log.Printf("Background jobs still running after %s, closing connections anyway", jobStopTimeout)
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	notificationpb "github.com/bruceoaudo/userService/gen/notification"
+	orderspb "github.com/bruceoaudo/userService/gen/orders"
+	"github.com/bruceoaudo/userService/internal/config"
+	"github.com/bruceoaudo/userService/internal/rpcclient"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+)
+
+// Sibling services called from event reactions
+const (
+	siblingNotification = "notification"
+	siblingOrders       = "orders"
+)
+
+const siblingHedgeDelay = 250 * time.Millisecond
+
+var siblingRPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "userservice_sibling_rpc_duration_seconds",
+	Help:    "Latency of calls to sibling services, by service, method and status code.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"service", "method", "code"})
+
+// siblingTargets maps each sibling to the variable holding its dial target,
+// e.g. dns:///orders:50051 or xds:///orders
+var siblingTargets = map[string]string{
+	siblingNotification: "NOTIFICATION_SERVICE_TARGET",
+	siblingOrders:       "ORDERS_SERVICE_TARGET",
+}
+
+// siblingHedgedMethods are idempotent, so a slow call can safely be raced
+var siblingHedgedMethods = map[string][]string{
+	siblingNotification: {notificationpb.NotificationService_SendNotification_FullMethodName},
+	siblingOrders:       {orderspb.OrderService_AnonymizeCustomer_FullMethodName},
+}
+
+// newSiblingCredentials uses TLS when SIBLING_TLS_CA_FILE is set, presenting
+// the server certificate so siblings can authenticate this service
+func newSiblingCredentials(files config.TLSConfig) (credentials.TransportCredentials, error) {
+	caFile := os.Getenv("SIBLING_TLS_CA_FILE")
+	if caFile == "" {
+		return nil, nil
+	}
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	cfg := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	if files.Enabled() {
+		cert, err := tls.LoadX509KeyPair(files.CertFile, files.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// newSiblingClients registers a client for every sibling with a configured
+// target. Siblings without one are skipped and their reactions disabled.
+func newSiblingClients(files config.TLSConfig) (*rpcclient.Manager, error) {
+	creds, err := newSiblingCredentials(files)
+	if err != nil {
+		return nil, err
+	}
+
+	manager := rpcclient.NewManager()
+	for name, env := range siblingTargets {
+		target := os.Getenv(env)
+		if target == "" {
+			continue
+		}
+		opts := rpcclient.DefaultOptions()
+		opts.Target = target
+		opts.Credentials = creds
+		opts.Hedge = rpcclient.HedgePolicy{Delay: siblingHedgeDelay, MaxAttempts: 2, Methods: siblingHedgedMethods[name]}
+		opts.Observe = func(service, method string, code codes.Code, elapsed time.Duration) {
+			siblingRPCDuration.WithLabelValues(service, method, code.String()).Observe(elapsed.Seconds())
+		}
+		if err := manager.Register(name, opts); err != nil {
+			return nil, err
+		}
+		log.Printf("Calling %s service at %s", name, target)
+	}
+	return manager, nil
+}
+
+// eventReaction performs follow-up work in a sibling service for an event
+type eventReaction func(ctx context.Context, event *OutboxEvent) error
+
+// reactingPublisher runs the reactions for an event before handing it to the
+// downstream publisher. A failed reaction fails the publish, so the outbox
+// retries it with backoff; reactions are keyed by event id and idempotent.
+type reactingPublisher struct {
+	next      eventPublisher
+	reactions map[string][]eventReaction
+}
+
+func (p *reactingPublisher) Publish(ctx context.Context, event *OutboxEvent) error {
+	for _, react := range p.reactions[event.Type] {
+		if err := react(ctx, event); err != nil {
+			return fmt.Errorf("reaction to %s: %w", event.Type, err)
+		}
+	}
+	return p.next.Publish(ctx, event)
+}
+
+// withSiblingReactions wraps publisher with the reactions of every
+// configured sibling. It returns publisher unchanged when there are none.
+func withSiblingReactions(publisher eventPublisher, siblings *rpcclient.Manager) eventPublisher {
+	reactions := make(map[string][]eventReaction)
+
+	if conn, err := siblings.Client(siblingOrders); err == nil {
+		orders := orderspb.NewOrderServiceClient(conn)
+		reactions[eventUserDeleted] = append(reactions[eventUserDeleted], func(ctx context.Context, event *OutboxEvent) error {
+			_, err := orders.AnonymizeCustomer(ctx, &orderspb.AnonymizeCustomerMessageRequest{
+				CustomerId: event.AggregateID,
+				Reason:     "account deleted",
+			})
+			return err
+		})
+	}
+
+	if conn, err := siblings.Client(siblingNotification); err == nil {
+		notifications := notificationpb.NewNotificationServiceClient(conn)
+		notifyOn := []string{eventUserKYCApproved, eventUserKYCRejected, eventUserPayoutVerified, eventUserIdentityFailed}
+		for _, eventType := range notifyOn {
+			reactions[eventType] = append(reactions[eventType], func(ctx context.Context, event *OutboxEvent) error {
+				_, err := notifications.SendNotification(ctx, &notificationpb.SendNotificationMessageRequest{
+					IdempotencyKey: event.ID.Hex(),
+					UserId:         event.AggregateID,
+					Kind:           event.Type,
+					Data:           stringPayload(event.Payload),
+				})
+				return err
+			})
+		}
+	}
+
+	if len(reactions) == 0 {
+		return publisher
+	}
+	types := make([]string, 0, len(reactions))
+	for eventType := range reactions {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	log.Printf("Sibling reactions enabled for %v", types)
+	return &reactingPublisher{next: publisher, reactions: reactions}
+}
+
+// stringPayload flattens an event payload for the notification templates
+func stringPayload(payload map[string]interface{}) map[string]string {
+	out := make(map[string]string, len(payload))
+	for k, v := range payload {
+		switch t := v.(type) {
+		case time.Time:
+			out[k] = t.UTC().Format(time.RFC3339)
+		case primitive.DateTime:
+			out[k] = t.Time().UTC().Format(time.RFC3339)
+		default:
+			out[k] = fmt.Sprint(v)
+		}
+	}
+	return out
+}
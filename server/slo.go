@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Objectives the error budget is measured against
+const (
+	availabilityObjective = 0.999
+	latencyObjective      = 0.99
+
+	defaultLatencyThreshold = 300 * time.Millisecond
+	sloBucketWidth          = 5 * time.Minute
+	sloBuckets              = 288 // 24 hours
+)
+
+// latencyThresholds override the default for methods that are slow by
+// design, like the ones hashing passwords
+var latencyThresholds = map[string]time.Duration{
+	pb.UserService_RegisterUser_FullMethodName:    time.Second,
+	pb.UserService_LoginUser_FullMethodName:       time.Second,
+	pb.UserService_BulkUpdateUsers_FullMethodName: time.Second,
+	pb.UserService_SuggestUsers_FullMethodName:    150 * time.Millisecond,
+
+	pb.UserService_RegisterUSSDUser_FullMethodName:      time.Second,
+	pb.UserService_LoginUSSDUser_FullMethodName:         time.Second,
+	pb.UserService_ChangeUSSDPIN_FullMethodName:         time.Second,
+	pb.UserService_SetDevicePIN_FullMethodName:          time.Second,
+	pb.UserService_ReAuthenticateWithPIN_FullMethodName: time.Second,
+}
+
+// sloWindows are reported by GetSLOStatus. The 1h window catches fast burns
+// worth paging for and the 24h window slow ones worth a ticket.
+var sloWindows = []struct {
+	name    string
+	buckets int
+}{
+	{"5m", 1},
+	{"1h", 12},
+	{"24h", sloBuckets},
+}
+
+// Each request is counted once per SLI, with the success criterion and its
+// verdict as labels, so alert rules need no knowledge of the thresholds:
+//
+//	sum(rate(userservice_sli_requests_total{sli="availability",good="false"}[1h]))
+//	  / sum(rate(userservice_sli_requests_total{sli="availability"}[1h])) > 14.4 * 0.001
+var sliRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "userservice_sli_requests_total",
+	Help: "Unary requests by method and SLI (availability, latency). criterion is the success " +
+		"criterion applied and good its verdict. Availability counts server-side failures as bad; " +
+		"latency counts answers slower than the method threshold as bad.",
+}, []string{"method", "sli", "criterion", "good"})
+
+// serverFault reports whether a code is the service's fault. Client errors
+// like NotFound or PermissionDenied do not spend the error budget.
+func serverFault(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unavailable, codes.Unknown, codes.DataLoss, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func latencyThreshold(method string) time.Duration {
+	if d, ok := latencyThresholds[method]; ok {
+		return d
+	}
+	return defaultLatencyThreshold
+}
+
+type sloBucket struct {
+	total, failed, slow int64
+}
+
+// methodSLI keeps 24 hours of outcomes for one method in 5 minute buckets
+type methodSLI struct {
+	buckets [sloBuckets]sloBucket
+	stamps  [sloBuckets]int64
+}
+
+// sloTracker records SLIs for GetSLOStatus. The counts belong to this pod
+// and start over when it restarts; the Prometheus series cover the fleet.
+type sloTracker struct {
+	mu      sync.Mutex
+	methods map[string]*methodSLI
+	since   time.Time
+}
+
+func newSLOTracker() *sloTracker {
+	return &sloTracker{methods: make(map[string]*methodSLI), since: time.Now()}
+}
+
+func (t *sloTracker) record(method string, failed, slow bool, now time.Time) {
+	slot := now.Unix() / int64(sloBucketWidth/time.Second)
+	i := slot % sloBuckets
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	m, ok := t.methods[method]
+	if !ok {
+		m = &methodSLI{}
+		t.methods[method] = m
+	}
+	if m.stamps[i] != slot {
+		m.stamps[i] = slot
+		m.buckets[i] = sloBucket{}
+	}
+	b := &m.buckets[i]
+	b.total++
+	if failed {
+		b.failed++
+	}
+	if slow {
+		b.slow++
+	}
+}
+
+// sum adds the buckets of the last n slots
+func (m *methodSLI) sum(n int, now time.Time) sloBucket {
+	slot := now.Unix() / int64(sloBucketWidth/time.Second)
+	var out sloBucket
+	for i := range m.buckets {
+		if slot-m.stamps[i] < int64(n) {
+			out.total += m.buckets[i].total
+			out.failed += m.buckets[i].failed
+			out.slow += m.buckets[i].slow
+		}
+	}
+	return out
+}
+
+// burnRate is how many times faster than sustainable the budget is spent
+func burnRate(bad, total int64, objective float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return (float64(bad) / float64(total)) / (1 - objective)
+}
+
+func ratio(good, total int64) float64 {
+	if total == 0 {
+		return 1
+	}
+	return float64(good) / float64(total)
+}
+
+func (t *sloTracker) status(filter string, now time.Time) []*pb.MethodSLOStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var out []*pb.MethodSLOStatus
+	for method, m := range t.methods {
+		if filter != "" && !strings.HasSuffix(method, "/"+filter) && method != filter {
+			continue
+		}
+		st := &pb.MethodSLOStatus{Method: method, LatencyThresholdMs: latencyThreshold(method).Milliseconds()}
+		for _, w := range sloWindows {
+			b := m.sum(w.buckets, now)
+			st.Windows = append(st.Windows, &pb.SLOWindow{
+				Window:               w.name,
+				Requests:             b.total,
+				Availability:         ratio(b.total-b.failed, b.total),
+				LatencyCompliance:    ratio(b.total-b.slow, b.total),
+				AvailabilityBurnRate: burnRate(b.failed, b.total, availabilityObjective),
+				LatencyBurnRate:      burnRate(b.slow, b.total, latencyObjective),
+			})
+		}
+		out = append(out, st)
+	}
+
+	// Worst hourly burn first, which is what on-call looks at
+	worst := func(st *pb.MethodSLOStatus) float64 {
+		w := st.Windows[1]
+		return max(w.AvailabilityBurnRate, w.LatencyBurnRate)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if wi, wj := worst(out[i]), worst(out[j]); wi != wj {
+			return wi > wj
+		}
+		return out[i].Method < out[j].Method
+	})
+	return out
+}
+
+// sloInterceptor records the availability and latency SLIs of unary calls.
+// It runs first so requests rejected by later interceptors, for instance
+// while the service is starting, are counted too.
+func sloInterceptor(t *sloTracker) grpc.UnaryServerInterceptor {
+	prefix := "/" + pb.UserService_ServiceDesc.ServiceName + "/"
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, prefix) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		elapsed := time.Since(start)
+
+		threshold := latencyThreshold(info.FullMethod)
+		failed := serverFault(status.Code(err))
+		slow := elapsed > threshold
+		method := strings.TrimPrefix(info.FullMethod, prefix)
+		sliRequests.WithLabelValues(method, "availability", "no_server_error", strconv.FormatBool(!failed)).Inc()
+		sliRequests.WithLabelValues(method, "latency", "under_"+strconv.FormatInt(threshold.Milliseconds(), 10)+"ms", strconv.FormatBool(!slow)).Inc()
+		t.record(info.FullMethod, failed, slow, start)
+
+		return resp, err
+	}
+}
+
+// GetSLOStatus summarizes availability, latency and error-budget burn per
+// method over the last 5 minutes, hour and day as seen by this pod
+func (s *userService) GetSLOStatus(ctx context.Context, req *pb.GetSLOStatusMessageRequest) (*pb.GetSLOStatusMessageResponse, error) {
+	return &pb.GetSLOStatusMessageResponse{
+		AvailabilityObjective: availabilityObjective,
+		LatencyObjective:      latencyObjective,
+		Methods:               s.slo.status(strings.TrimSpace(req.GetMethod()), time.Now()),
+		SinceUnix:             s.slo.since.Unix(),
+	}, nil
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/notify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserPhoneUnreachable = "user.phone_unreachable"
+	eventUserPhoneVerified    = "user.phone_verified"
+
+	smsReceiptTimeout         = 10 * time.Second
+	smsFailuresBeforeDisabled = 3
+	phoneOTPTTL               = 10 * time.Minute
+	phoneOTPResendInterval    = time.Minute
+)
+
+// Africa's Talking failure reasons that no retry will fix
+var permanentSMSFailures = map[string]bool{
+	"InvalidPhoneNumber": true,
+	"UserInBlackList":    true,
+	"UserInBlacklist":    true,
+}
+
+// PhoneReachability tracks SMS delivery receipts for the number on a user
+// record. SMS sends stop once the number is unreachable.
+type PhoneReachability struct {
+	Unreachable bool      `bson:"unreachable"`
+	Failures    int       `bson:"failures,omitempty"`
+	Reason      string    `bson:"reason,omitempty"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+type PhoneVerification struct {
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Phone     string             `bson:"phone"`
+	CodeHash  string             `bson:"code_hash"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+}
+
+func (u *User) phoneUnreachable() bool {
+	return u.PhoneReachability != nil && u.PhoneReachability.Unreachable
+}
+
+// smsReceiptHandler receives delivery reports from Africa's Talking. A
+// successful delivery resets the failure count of the number.
+func (s *userService) smsReceiptHandler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !webhookTokenValid(r, token) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		phone := normalizePhoneNumber(strings.TrimPrefix(strings.TrimSpace(r.PostForm.Get("phoneNumber")), "+"))
+		if phone == "" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), smsReceiptTimeout)
+		defer cancel()
+		if err := s.applySMSReceipt(ctx, phone, r.PostForm.Get("status"), r.PostForm.Get("failureReason")); err != nil {
+			log.Printf("Failed to record SMS receipt %s: %v", r.PostForm.Get("id"), err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// applySMSReceipt updates the reachability of the users on phone. Interim
+// states such as Sent or Buffered are ignored.
+func (s *userService) applySMSReceipt(ctx context.Context, phone, deliveryStatus, reason string) error {
+	collection := s.collection(ctx, "users")
+	now := time.Now()
+
+	switch deliveryStatus {
+	case "Success":
+		_, err := collection.UpdateMany(ctx, bson.M{"phone": phone, "phone_reachability": bson.M{"$exists": true}}, bson.M{
+			"$unset": bson.M{"phone_reachability": ""},
+			"$set":   bson.M{"updated_at": now},
+		})
+		return err
+	case "Failed", "Rejected":
+	default:
+		return nil
+	}
+
+	cursor, err := collection.Find(ctx, bson.M{"phone": phone, "deleted_at": nil})
+	if err != nil {
+		return err
+	}
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		return err
+	}
+	for i := range users {
+		user := &users[i]
+		failures := 1
+		if user.PhoneReachability != nil {
+			failures += user.PhoneReachability.Failures
+		}
+		unreachable := failures >= smsFailuresBeforeDisabled || permanentSMSFailures[reason]
+		_, err := collection.UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{"$set": bson.M{
+			"phone_reachability": PhoneReachability{Unreachable: unreachable, Failures: failures, Reason: reason, UpdatedAt: now},
+			"updated_at":         now,
+		}})
+		if err != nil {
+			return err
+		}
+		if unreachable && !user.phoneUnreachable() {
+			log.Printf("Paused SMS to user %s after %d failed deliveries", user.ID.Hex(), failures)
+			s.recordEvent(ctx, eventUserPhoneUnreachable, user.ID, map[string]interface{}{"reason": reason, "at": now})
+		}
+	}
+	return nil
+}
+
+// otpChannel is the channel the user chose for one-time codes, SMS unless
+// they prefer WhatsApp
+func (u *User) otpChannel() notify.Channel {
+	if channels := u.NotificationPrefs[string(notify.KindOTP)]; len(channels) > 0 && u.phoneIsMobile() {
+		if ch := notify.Channel(channels[0]); otpChannels[ch] {
+			return ch
+		}
+	}
+	return notify.ChannelSMS
+}
+
+// sendOTP delivers a one-time code by SMS, or by WhatsApp for users who
+// prefer it, in which case the dispatcher falls back to SMS when WhatsApp
+// fails. When SMS is the channel and the phone is unreachable,
+// emailFallback is set and the otp_email_fallback flag is on, the code goes
+// to the verified email address instead. Codes proving ownership of the
+// phone itself must not fall back to email. It returns the channel tried
+// first.
+func (s *userService) sendOTP(user *User, code, expiresIn string, emailFallback bool) (notify.Channel, error) {
+	channel := user.otpChannel()
+	if channel == notify.ChannelSMS && (!user.phoneIsMobile() || user.phoneUnreachable()) {
+		if !emailFallback || !s.config.featureEnabled("otp_email_fallback", false) || user.EmailVerifiedAt == nil || user.emailUndeliverable() {
+			return "", status.Error(codes.FailedPrecondition, "phone number cannot receive SMS")
+		}
+		channel = notify.ChannelEmail
+	}
+
+	msg, err := notify.Render(notify.KindOTP, map[string]string{"code": code, "expires_in": expiresIn})
+	if err != nil {
+		log.Printf("Failed to render %s notification: %v", notify.KindOTP, err)
+		return "", status.Error(codes.Internal, "failed to send code")
+	}
+	recipient := recipientFor(user)
+	recipient.Preferences = map[notify.Kind][]notify.Channel{notify.KindOTP: {channel}}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+		defer cancel()
+		if err := s.notifier.Dispatch(ctx, recipient, msg); err != nil {
+			log.Printf("Failed to deliver %s notification to user %s: %v", notify.KindOTP, recipient.UserID, err)
+		}
+	}()
+	return channel, nil
+}
+
+// SendPhoneVerification sends a code confirming the phone number on file
+func (s *userService) SendPhoneVerification(ctx context.Context, req *pb.SendPhoneVerificationMessageRequest) (*pb.SendPhoneVerificationMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.PhoneNumber == "" {
+		return nil, status.Error(codes.FailedPrecondition, "no phone number on file")
+	}
+	if user.PhoneVerifiedAt != nil {
+		return &pb.SendPhoneVerificationMessageResponse{Message: "Phone already verified", Success: true}, nil
+	}
+	if !user.phoneIsMobile() {
+		return nil, status.Error(codes.FailedPrecondition, "only mobile numbers can receive verification codes")
+	}
+	if user.phoneUnreachable() && user.otpChannel() == notify.ChannelSMS {
+		return nil, status.Error(codes.FailedPrecondition, "phone number cannot receive SMS")
+	}
+
+	collection := s.collection(ctx, "phone_verifications")
+	now := time.Now()
+	var last PhoneVerification
+	err = collection.FindOne(ctx, bson.M{"user_id": user.ID, "created_at": bson.M{"$gt": now.Add(-phoneOTPResendInterval)}}).Decode(&last)
+	if err == nil {
+		return nil, status.Error(codes.ResourceExhausted, "a code was sent recently, try again in a minute")
+	}
+	if err != mongo.ErrNoDocuments {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	code, err := randomDigits(verificationCodeDigits)
+	if err != nil {
+		log.Printf("Failed to generate verification code: %v", err)
+		return nil, status.Error(codes.Internal, "failed to send code")
+	}
+	expiresAt := now.Add(phoneOTPTTL)
+	_, err = collection.InsertOne(ctx, PhoneVerification{
+		UserID:    user.ID,
+		Phone:     user.PhoneNumber,
+		CodeHash:  hashCode(code),
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		log.Printf("Failed to store verification code: %v", err)
+		return nil, status.Error(codes.Internal, "failed to send code")
+	}
+
+	channel, err := s.sendOTP(user, code, "10 minutes", false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SendPhoneVerificationMessageResponse{
+		Channel:       string(channel),
+		ExpiresAtUnix: expiresAt.Unix(),
+		Message:       "Verification code sent",
+		Success:       true,
+	}, nil
+}
+
+// VerifyPhone confirms the phone number with a code from SendPhoneVerification
+func (s *userService) VerifyPhone(ctx context.Context, req *pb.VerifyPhoneMessageRequest) (*pb.VerifyPhoneMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.PhoneVerifiedAt != nil {
+		return &pb.VerifyPhoneMessageResponse{Message: "Phone already verified", Success: true}, nil
+	}
+
+	res, err := s.collection(ctx, "phone_verifications").DeleteOne(ctx, bson.M{
+		"user_id":    user.ID,
+		"phone":      user.PhoneNumber,
+		"code_hash":  hashCode(strings.TrimSpace(req.GetCode())),
+		"expires_at": bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired verification code")
+	}
+
+	now := time.Now()
+	_, err = s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{
+		"$set": bson.M{"phone_verified_at": now, "updated_at": now},
+	})
+	if err != nil {
+		log.Printf("Failed to mark phone verified: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	s.collection(ctx, "phone_verifications").DeleteMany(ctx, bson.M{"user_id": user.ID})
+	s.recordEvent(ctx, eventUserPhoneVerified, user.ID, map[string]interface{}{"verified_at": now})
+
+	return &pb.VerifyPhoneMessageResponse{Message: "Phone verified", Success: true}, nil
+}
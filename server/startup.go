@@ -0,0 +1,354 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bruceoaudo/userService/internal/config"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	startupRetryInitial   = time.Second
+	startupRetryMax       = 30 * time.Second
+	mongoPingTimeout      = 5 * time.Second
+	indexProgressInterval = 15 * time.Second
+	migrationLease        = 15 * time.Minute
+	migrationPollInterval = 2 * time.Second
+)
+
+// runStartup brings the service up in order: wait for MongoDB, apply pending
+// migrations, build missing indexes, then flip readiness. Each stage is
+// retried with backoff, so a database blip during a deploy delays the pod
+// instead of crash-looping it. gRPC health stays NOT_SERVING until the end.
+func (s *userService) runStartup(ctx context.Context) {
+	stages := []struct {
+		name string
+		run  func(context.Context) error
+	}{
+		{"connect to MongoDB", func(ctx context.Context) error {
+			pingCtx, cancel := context.WithTimeout(ctx, mongoPingTimeout)
+			defer cancel()
+			return s.db.Ping(pingCtx, nil)
+		}},
+		{"run migrations", func(ctx context.Context) error { return eachStorage(ctx, s.mongo, s.collection, runMigrations) }},
+		{"build indexes", func(ctx context.Context) error { return eachStorage(ctx, s.mongo, s.collection, ensureIndexes) }},
+	}
+
+	for _, stage := range stages {
+		started := time.Now()
+		if !retryStartup(ctx, stage.name, stage.run) {
+			return
+		}
+		log.Printf("Startup: %s done in %s", stage.name, time.Since(started).Round(time.Millisecond))
+	}
+
+	close(s.mongoReady)
+	s.setServing(true)
+	log.Printf("Startup complete, serving")
+}
+
+// eachStorage runs fn against the default storage of collection, then
+// against every tenant in cfg with storage of its own
+func eachStorage(ctx context.Context, cfg config.MongoConfig, collection collectionFunc, fn func(context.Context, collectionFunc) error) error {
+	if err := fn(withStorageTenant(ctx, ""), collection); err != nil {
+		return err
+	}
+	for _, tenant := range cfg.TenantIDs() {
+		if err := fn(withStorageTenant(ctx, tenant), collection); err != nil {
+			return fmt.Errorf("tenant %s: %w", tenant, err)
+		}
+	}
+	return nil
+}
+
+// retryStartup runs fn until it succeeds or ctx ends
+func retryStartup(ctx context.Context, name string, fn func(context.Context) error) bool {
+	backoff := startupRetryInitial
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			return true
+		}
+		log.Printf("Startup: %s failed (attempt %d), retrying in %s: %v", name, attempt, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, startupRetryMax)
+	}
+}
+
+// indexName is the name MongoDB gives an index created without one
+func indexName(model mongo.IndexModel) string {
+	if model.Options != nil && model.Options.Name != nil {
+		return *model.Options.Name
+	}
+	var parts []string
+	for _, key := range model.Keys.(bson.D) {
+		parts = append(parts, fmt.Sprintf("%s_%v", key.Key, key.Value))
+	}
+	return strings.Join(parts, "_")
+}
+
+// existingIndexes returns the index names of a collection
+func existingIndexes(ctx context.Context, collection *mongo.Collection) (map[string]bool, error) {
+	names := make(map[string]bool)
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Code == 26 { // NamespaceNotFound
+			return names, nil
+		}
+		return nil, err
+	}
+	var specs []struct {
+		Name string `bson:"name"`
+	}
+	if err := cursor.All(ctx, &specs); err != nil {
+		return nil, err
+	}
+	for _, spec := range specs {
+		names[spec.Name] = true
+	}
+	return names, nil
+}
+
+// ensureIndexes creates the indexes from indexSpecs that do not exist yet.
+// On a warm database this is one listIndexes per collection; builds that
+// are needed log their progress while they run.
+func ensureIndexes(ctx context.Context, collection collectionFunc) error {
+	return ensureIndexSpecs(ctx, collection, indexSpecs)
+}
+
+// ensureIndexSpecs creates the indexes from specs that do not exist yet
+func ensureIndexSpecs(ctx context.Context, collection collectionFunc, specs []collectionIndexes) error {
+	existing := make(map[string]map[string]bool)
+	var pending []collectionIndexes
+	for _, spec := range specs {
+		names, ok := existing[spec.collection]
+		if !ok {
+			var err error
+			if names, err = existingIndexes(ctx, collection(ctx, spec.collection)); err != nil {
+				return err
+			}
+			existing[spec.collection] = names
+		}
+
+		var missing []mongo.IndexModel
+		for _, model := range spec.models {
+			if !names[indexName(model)] {
+				missing = append(missing, model)
+			}
+		}
+		if len(missing) > 0 {
+			pending = append(pending, collectionIndexes{collection: spec.collection, models: missing})
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	monitorCtx, stopMonitor := context.WithCancel(ctx)
+	defer stopMonitor()
+	go logIndexBuildProgress(monitorCtx, collection(ctx, pending[0].collection).Database().Client())
+
+	for i, spec := range pending {
+		var names []string
+		for _, model := range spec.models {
+			names = append(names, indexName(model))
+		}
+		log.Printf("Startup: building indexes %d/%d on %s: %s", i+1, len(pending), spec.collection, strings.Join(names, ", "))
+		if _, err := collection(ctx, spec.collection).Indexes().CreateMany(ctx, spec.models); err != nil {
+			return fmt.Errorf("create indexes on %s: %w", spec.collection, err)
+		}
+	}
+	return nil
+}
+
+// logIndexBuildProgress reports the progress MongoDB publishes for running
+// index builds. It needs the inprog privilege and stays quiet without it.
+func logIndexBuildProgress(ctx context.Context, client *mongo.Client) {
+	ticker := time.NewTicker(indexProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var result struct {
+			InProg []struct {
+				Namespace string `bson:"ns"`
+				Msg       string `bson:"msg"`
+				Progress  struct {
+					Done  int64 `bson:"done"`
+					Total int64 `bson:"total"`
+				} `bson:"progress"`
+			} `bson:"inprog"`
+		}
+		err := client.Database("admin").RunCommand(ctx, bson.D{
+			{Key: "currentOp", Value: true},
+			{Key: "command.createIndexes", Value: bson.M{"$exists": true}},
+		}).Decode(&result)
+		if err != nil {
+			return
+		}
+		for _, op := range result.InProg {
+			if op.Progress.Total > 0 {
+				log.Printf("Startup: index build on %s %d/%d (%s)", op.Namespace, op.Progress.Done, op.Progress.Total, op.Msg)
+			}
+		}
+	}
+}
+
+// migration is a one-off data or schema change. Applied migrations are
+// recorded in schema_migrations and never run again; new ones are appended.
+type migration struct {
+	id          string
+	description string
+	run         func(ctx context.Context, collection collectionFunc) error
+}
+
+var migrations = []migration{
+	{"0001_collated_identity_indexes", "replace the binary email and user_name indexes with collated ones",
+		replaceUserIndexes([]string{"email_present_ci", "user_name_ci"}, "email_1", "user_name_1")},
+	{"0002_partial_phone_index", "replace the phone index with one ignoring accounts without a phone",
+		replaceUserIndexes([]string{"phone_present"}, "phone_1")},
+	{"0003_partial_email_index", "replace the email index with one ignoring accounts without an email",
+		replaceUserIndexes([]string{"email_present_ci"}, "email_ci")},
+}
+
+// MigrationRecord tracks a migration in schema_migrations
+type MigrationRecord struct {
+	ID          string     `bson:"_id"`
+	Description string     `bson:"description"`
+	StartedAt   time.Time  `bson:"started_at"`
+	LeaseUntil  time.Time  `bson:"lease_until"`
+	FinishedAt  *time.Time `bson:"finished_at,omitempty"`
+}
+
+// runMigrations applies pending migrations in order. Replicas starting at
+// the same time take turns: the first claims a migration, the others wait
+// for it to finish or for its lease to expire.
+func runMigrations(ctx context.Context, collection collectionFunc) error {
+	records := collection(ctx, "schema_migrations")
+	for _, m := range migrations {
+		for {
+			claimed, done, err := claimMigration(ctx, records, m)
+			if err != nil {
+				return err
+			}
+			if done {
+				break
+			}
+			if !claimed {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(migrationPollInterval):
+				}
+				continue
+			}
+
+			log.Printf("Startup: applying migration %s: %s", m.id, m.description)
+			if err := m.run(ctx, collection); err != nil {
+				records.UpdateOne(ctx, bson.M{"_id": m.id}, bson.M{"$set": bson.M{"lease_until": time.Time{}}})
+				return fmt.Errorf("migration %s: %w", m.id, err)
+			}
+			now := time.Now()
+			if _, err := records.UpdateOne(ctx, bson.M{"_id": m.id}, bson.M{"$set": bson.M{"finished_at": now}}); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// claimMigration takes the lease on a migration unless it is finished or
+// another replica holds it
+func claimMigration(ctx context.Context, collection *mongo.Collection, m migration) (claimed, done bool, err error) {
+	now := time.Now()
+	_, err = collection.InsertOne(ctx, MigrationRecord{
+		ID:          m.id,
+		Description: m.description,
+		StartedAt:   now,
+		LeaseUntil:  now.Add(migrationLease),
+	})
+	if err == nil {
+		return true, false, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return false, false, err
+	}
+
+	res, err := collection.UpdateOne(ctx,
+		bson.M{"_id": m.id, "finished_at": nil, "lease_until": bson.M{"$lt": now}},
+		bson.M{"$set": bson.M{"started_at": now, "lease_until": now.Add(migrationLease)}},
+	)
+	if err != nil {
+		return false, false, err
+	}
+	if res.ModifiedCount == 1 {
+		return true, false, nil
+	}
+
+	var record MigrationRecord
+	if err := collection.FindOne(ctx, bson.M{"_id": m.id}).Decode(&record); err != nil {
+		return false, false, err
+	}
+	return false, record.FinishedAt != nil, nil
+}
+
+// replaceUserIndexes builds the users indexes from indexSpecs named in
+// replacements before dropping the legacy ones, so the collection is never
+// without the unique constraints they enforce
+func replaceUserIndexes(replacements []string, legacy ...string) func(ctx context.Context, collection collectionFunc) error {
+	drop := dropUserIndexes(legacy...)
+	return func(ctx context.Context, collection collectionFunc) error {
+		var models []mongo.IndexModel
+		for _, spec := range indexSpecs {
+			if spec.collection != "users" {
+				continue
+			}
+			for _, model := range spec.models {
+				if slices.Contains(replacements, indexName(model)) {
+					models = append(models, model)
+				}
+			}
+		}
+		if len(models) != len(replacements) {
+			return fmt.Errorf("replacement indexes %v are not all in indexSpecs", replacements)
+		}
+		if _, err := collection(ctx, "users").Indexes().CreateMany(ctx, models); err != nil {
+			return fmt.Errorf("create replacement indexes: %w", err)
+		}
+		return drop(ctx, collection)
+	}
+}
+
+// dropUserIndexes drops users indexes that were replaced by ones with a new
+// name, such as the binary email and username indexes from before collation.
+// Indexes that are already gone are skipped.
+func dropUserIndexes(names ...string) func(ctx context.Context, collection collectionFunc) error {
+	return func(ctx context.Context, collection collectionFunc) error {
+		for _, legacy := range names {
+			_, err := collection(ctx, "users").Indexes().DropOne(ctx, legacy)
+			var cmdErr mongo.CommandError
+			if err != nil && !(errors.As(err, &cmdErr) && (cmdErr.Code == 26 || cmdErr.Code == 27)) { // NamespaceNotFound, IndexNotFound
+				return err
+			}
+		}
+		return nil
+	}
+}
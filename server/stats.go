@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultStatsDays = 30
+	maxStatsDays     = 366
+)
+
+// GetUserStats aggregates registration, verification, activity and deletion
+// figures for the admin dashboard over the last req.Days days.
+func (s *userService) GetUserStats(ctx context.Context, req *pb.GetUserStatsMessageRequest) (*pb.GetUserStatsMessageResponse, error) {
+	days := int(req.GetDays())
+	if days <= 0 {
+		days = defaultStatsDays
+	}
+	if days > maxStatsDays {
+		return nil, status.Errorf(codes.InvalidArgument, "days must not exceed %d", maxStatsDays)
+	}
+
+	now := time.Now().UTC()
+	since := now.AddDate(0, 0, -days)
+	collection := s.collection(ctx, "users")
+
+	resp := &pb.GetUserStatsMessageResponse{}
+
+	// 1. Registrations and verification conversion
+	var registrations []struct {
+		Daily  []periodCount `bson:"daily"`
+		Weekly []periodCount `bson:"weekly"`
+		Totals []struct {
+			Total    int64 `bson:"total"`
+			Verified int64 `bson:"verified"`
+		} `bson:"totals"`
+	}
+	err := aggregate(ctx, collection, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"created_at": bson.M{"$gte": since}}}},
+		{{Key: "$facet", Value: bson.M{
+			"daily":  groupByPeriod("$created_at", "%Y-%m-%d"),
+			"weekly": groupByPeriod("$created_at", "%G-W%V"),
+			"totals": mongo.Pipeline{
+				{{Key: "$group", Value: bson.M{
+					"_id":   nil,
+					"total": bson.M{"$sum": 1},
+					"verified": bson.M{"$sum": bson.M{
+						"$cond": bson.A{bson.M{"$gt": bson.A{"$email_verified_at", nil}}, 1, 0},
+					}},
+				}}},
+			},
+		}}},
+	}, &registrations)
+	if err != nil {
+		return nil, err
+	}
+	if len(registrations) > 0 {
+		r := registrations[0]
+		resp.DailyRegistrations = toPeriodCounts(r.Daily)
+		resp.WeeklyRegistrations = toPeriodCounts(r.Weekly)
+		if len(r.Totals) > 0 {
+			resp.TotalRegistrations = r.Totals[0].Total
+			resp.VerifiedRegistrations = r.Totals[0].Verified
+			if resp.TotalRegistrations > 0 {
+				resp.VerificationRate = float64(resp.VerifiedRegistrations) / float64(resp.TotalRegistrations)
+			}
+		}
+	}
+
+	// 2. Active users by last login
+	var active []struct {
+		Daily   int64 `bson:"daily"`
+		Weekly  int64 `bson:"weekly"`
+		Monthly int64 `bson:"monthly"`
+	}
+	err = aggregate(ctx, collection, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"last_login_at": bson.M{"$gte": now.AddDate(0, 0, -30)}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"daily":   activeSince("$last_login_at", now.AddDate(0, 0, -1)),
+			"weekly":  activeSince("$last_login_at", now.AddDate(0, 0, -7)),
+			"monthly": bson.M{"$sum": 1},
+		}}},
+	}, &active)
+	if err != nil {
+		return nil, err
+	}
+	if len(active) > 0 {
+		resp.DailyActiveUsers = active[0].Daily
+		resp.WeeklyActiveUsers = active[0].Weekly
+		resp.MonthlyActiveUsers = active[0].Monthly
+	}
+
+	// 3. Deletions
+	var deletions []periodCount
+	err = aggregate(ctx, collection, append(mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deleted_at": bson.M{"$gte": since}}}},
+	}, groupByPeriod("$deleted_at", "%Y-%m-%d")...), &deletions)
+	if err != nil {
+		return nil, err
+	}
+	resp.DailyDeletions = toPeriodCounts(deletions)
+	for _, d := range deletions {
+		resp.TotalDeletions += d.Count
+	}
+
+	return resp, nil
+}
+
+type periodCount struct {
+	Period string `bson:"_id"`
+	Count  int64  `bson:"count"`
+}
+
+func toPeriodCounts(in []periodCount) []*pb.PeriodCount {
+	out := make([]*pb.PeriodCount, 0, len(in))
+	for _, c := range in {
+		out = append(out, &pb.PeriodCount{Period: c.Period, Count: c.Count})
+	}
+	return out
+}
+
+// groupByPeriod counts documents per formatted date of field, oldest first
+func groupByPeriod(field, format string) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"$dateToString": bson.M{"format": format, "date": field}},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+}
+
+func activeSince(field string, since time.Time) bson.M {
+	return bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gte": bson.A{field, since}}, 1, 0}}}
+}
+
+// aggregate runs a pipeline and decodes all results, mapping failures to gRPC errors
+func aggregate(ctx context.Context, collection *mongo.Collection, pipeline mongo.Pipeline, results interface{}) error {
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Printf("Aggregation failed: %v", err)
+		return status.Error(codes.Internal, "failed to compute statistics")
+	}
+	if err := cursor.All(ctx, results); err != nil {
+		log.Printf("Aggregation decode failed: %v", err)
+		return status.Error(codes.Internal, "failed to compute statistics")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/bruceoaudo/userService/internal/storage"
+)
+
+// newObjectStore selects the object storage backend from STORAGE_BACKEND.
+// The file backend also returns the handler serving its signed downloads.
+func newObjectStore() (storage.Store, http.Handler, error) {
+	switch strings.ToLower(os.Getenv("STORAGE_BACKEND")) {
+	case "s3":
+		store := &storage.S3Store{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		}
+		if store.Endpoint == "" || store.Bucket == "" || store.Region == "" {
+			return nil, nil, fmt.Errorf("S3_ENDPOINT, S3_REGION and S3_BUCKET are required for the s3 backend")
+		}
+		return store, nil, nil
+
+	case "", "file":
+		dir := os.Getenv("STORAGE_DIR")
+		if dir == "" {
+			dir = "data/objects"
+		}
+		baseURL := os.Getenv("PUBLIC_BASE_URL")
+		if baseURL == "" {
+			baseURL = "http://localhost:8080"
+		}
+
+		key := []byte(os.Getenv("STORAGE_SIGNING_KEY"))
+		if len(key) == 0 {
+			log.Printf("STORAGE_SIGNING_KEY not set, download links will not survive a restart")
+			key = make([]byte, 32)
+			if _, err := rand.Read(key); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		store := &storage.FileStore{Dir: dir, BaseURL: strings.TrimRight(baseURL, "/") + "/files", SigningKey: key}
+		return store, http.StripPrefix("/files", store.Handler()), nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown STORAGE_BACKEND %q", os.Getenv("STORAGE_BACKEND"))
+	}
+}
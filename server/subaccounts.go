@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserSubAccountCreated = "user.sub_account_created"
+
+	maxSubAccountsPerParent = 10
+	maxRestrictedCategories = 50
+)
+
+var subAccountRelationships = map[string]bool{"child": true, "managed": true}
+
+// SubAccountSettings are set on the user document of a sub-account. With
+// SharedPayment the sub-account checks out with the billing profile of its
+// parent.
+type SubAccountSettings struct {
+	Relationship          string    `bson:"relationship"`
+	SharedPayment         bool      `bson:"shared_payment"`
+	RestrictedCategories  []string  `bson:"restricted_categories,omitempty"`
+	RestrictionsUpdatedAt time.Time `bson:"restrictions_updated_at"`
+}
+
+func subAccountToProto(u *User) *pb.SubAccount {
+	msg := &pb.SubAccount{
+		UserId:        u.ID.Hex(),
+		FullName:      u.FullName,
+		UserName:      u.UserName,
+		CreatedAtUnix: u.CreatedAt.Unix(),
+	}
+	if u.ParentID != nil {
+		msg.ParentUserId = u.ParentID.Hex()
+	}
+	if sa := u.SubAccount; sa != nil {
+		msg.Relationship = sa.Relationship
+		msg.Restrictions = &pb.SubAccountRestrictions{
+			SharedPayment:        sa.SharedPayment,
+			RestrictedCategories: sa.RestrictedCategories,
+		}
+		msg.RestrictionsUpdatedAtUnix = sa.RestrictionsUpdatedAt.Unix()
+	}
+	return msg
+}
+
+// normalizeCategories lowercases, dedupes and sorts category slugs
+func normalizeCategories(in []string) ([]string, error) {
+	seen := make(map[string]bool)
+	out := []string{}
+	for _, c := range in {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c == "" || seen[c] {
+			continue
+		}
+		seen[c] = true
+		out = append(out, c)
+	}
+	if len(out) > maxRestrictedCategories {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d restricted categories", maxRestrictedCategories)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func validateSubAccount(req *pb.CreateSubAccountMessageRequest) error {
+	if strings.TrimSpace(req.GetFullName()) == "" {
+		return errors.New("full name is required")
+	}
+	username := strings.TrimSpace(req.GetUserName())
+	if len(username) < 4 {
+		return errors.New("username must be at least 4 characters")
+	}
+	if !isAlphanumeric(username) {
+		return errors.New("username can only contain letters and numbers")
+	}
+	if isUSSDUserName(username) {
+		return errors.New("usernames of the form ussd and digits are reserved")
+	}
+	email := strings.TrimSpace(req.GetEmailAddress())
+	if !strings.Contains(email, "@") || !strings.Contains(email, ".") {
+		return errors.New("invalid email format")
+	}
+	// Children often have no phone of their own, so it is optional here
+	if req.GetPhoneNumber() != "" {
+		phone := normalizePhoneNumber(req.GetPhoneNumber())
+		if len(phone) != 12 || !strings.HasPrefix(phone, "254") {
+			return errors.New("phone must be in 254XXXXXXXXX format (12 digits)")
+		}
+		if detectPhoneType(phone) == phoneTypePremium {
+			return errors.New("premium-rate numbers cannot be used as a phone number")
+		}
+	}
+	if req.GetPassword() == "" {
+		return errors.New("password is required")
+	}
+	if !subAccountRelationships[strings.ToLower(strings.TrimSpace(req.GetRelationship()))] {
+		return errors.New("relationship must be child or managed")
+	}
+	return nil
+}
+
+// CreateSubAccount registers a child or managed account under a primary
+// account. Sub-accounts sign in with their own credentials but cannot have
+// sub-accounts of their own.
+func (s *userService) CreateSubAccount(ctx context.Context, req *pb.CreateSubAccountMessageRequest) (*pb.CreateSubAccountMessageResponse, error) {
+	// 1. Validate input
+	parent, err := s.findUserByID(ctx, req.GetParentUserId())
+	if err != nil {
+		return nil, err
+	}
+	if parent.ParentID != nil {
+		return nil, status.Error(codes.FailedPrecondition, "sub-accounts cannot have sub-accounts")
+	}
+	if err := validateSubAccount(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkNewPassword(req.GetPassword()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	categories, err := normalizeCategories(req.GetRestrictions().GetRestrictedCategories())
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "users")
+	count, err := collection.CountDocuments(ctx, bson.M{"parent_id": parent.ID, "deleted_at": nil})
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create sub-account")
+	}
+	if count >= maxSubAccountsPerParent {
+		return nil, status.Error(codes.ResourceExhausted, "too many sub-accounts on this account")
+	}
+
+	// 2. Create the user document
+	passwordHash, err := s.passwords.Hash(req.GetPassword())
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create sub-account")
+	}
+
+	now := time.Now()
+	user := User{
+		FullName:     strings.TrimSpace(req.GetFullName()),
+		UserName:     strings.TrimSpace(req.GetUserName()),
+		EmailAddress: strings.TrimSpace(req.GetEmailAddress()),
+		PasswordHash: passwordHash,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Locale:       parent.Locale,
+		Timezone:     parent.Timezone,
+		ParentID:     &parent.ID,
+		SubAccount: &SubAccountSettings{
+			Relationship:          strings.ToLower(strings.TrimSpace(req.GetRelationship())),
+			SharedPayment:         req.GetRestrictions().GetSharedPayment(),
+			RestrictedCategories:  categories,
+			RestrictionsUpdatedAt: now,
+		},
+	}
+	if req.GetPhoneNumber() != "" {
+		user.PhoneNumber = normalizePhoneNumber(req.GetPhoneNumber())
+		user.PhoneType = detectPhoneType(user.PhoneNumber)
+	}
+	user.SearchKeys = searchKeys(&user)
+	user.SchemaVersion = currentUserSchemaVersion
+
+	res, err := collection.InsertOne(ctx, user)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "user with this email, username or phone already exists")
+		}
+		log.Printf("Failed to create sub-account: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create sub-account")
+	}
+	user.ID = res.InsertedID.(primitive.ObjectID)
+
+	// 3. Publish it like any registration
+	s.recordEvent(ctx, eventUserSubAccountCreated, user.ID, map[string]interface{}{
+		"parent_id":    parent.ID.Hex(),
+		"relationship": user.SubAccount.Relationship,
+		"created_at":   now,
+	})
+	s.sendEmailVerification(ctx, &user)
+
+	return &pb.CreateSubAccountMessageResponse{
+		SubAccount: subAccountToProto(&user),
+		Message:    "Sub-account created",
+		Success:    true,
+	}, nil
+}
+
+// ListSubAccounts returns the sub-accounts of a primary account, oldest first
+func (s *userService) ListSubAccounts(ctx context.Context, req *pb.ListSubAccountsMessageRequest) (*pb.ListSubAccountsMessageResponse, error) {
+	parent, err := s.findUserByID(ctx, req.GetParentUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "users")
+	cursor, err := collection.Find(ctx,
+		bson.M{"parent_id": parent.ID, "deleted_at": nil},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetLimit(maxSubAccountsPerParent),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list sub-accounts")
+	}
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list sub-accounts")
+	}
+
+	resp := &pb.ListSubAccountsMessageResponse{}
+	for i := range users {
+		resp.SubAccounts = append(resp.SubAccounts, subAccountToProto(&users[i]))
+	}
+	return resp, nil
+}
+
+// SetSubAccountRestrictions replaces the payment sharing and category
+// restrictions of a sub-account. Only its parent may change them.
+func (s *userService) SetSubAccountRestrictions(ctx context.Context, req *pb.SetSubAccountRestrictionsMessageRequest) (*pb.SetSubAccountRestrictionsMessageResponse, error) {
+	parentID, err := parseUserID(req.GetParentUserId())
+	if err != nil {
+		return nil, err
+	}
+	subID, err := parseUserID(req.GetSubAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetRestrictions() == nil {
+		return nil, status.Error(codes.InvalidArgument, "restrictions are required")
+	}
+	categories, err := normalizeCategories(req.GetRestrictions().GetRestrictedCategories())
+	if err != nil {
+		return nil, err
+	}
+
+	collection := s.collection(ctx, "users")
+	now := time.Now()
+	var user User
+	err = collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": subID, "parent_id": parentID, "deleted_at": nil},
+		bson.M{"$set": bson.M{
+			"sub_account.shared_payment":          req.GetRestrictions().GetSharedPayment(),
+			"sub_account.restricted_categories":   categories,
+			"sub_account.restrictions_updated_at": now,
+			"updated_at":                          now,
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "sub-account not found")
+		}
+		log.Printf("Failed to update sub-account restrictions: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update restrictions")
+	}
+
+	return &pb.SetSubAccountRestrictionsMessageResponse{
+		SubAccount: subAccountToProto(&user),
+		Message:    "Restrictions updated",
+		Success:    true,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	searchKeysIndex     = "search_keys_prefix"
+	defaultSuggestLimit = 8
+	maxSuggestLimit     = 25
+	minSuggestQuery     = 2
+	suggestQueryTimeout = 250 * time.Millisecond
+)
+
+// searchKeys returns the lowercase values admin autocomplete matches by
+// prefix: the full name and each of its words, the username, the email and
+// its local part, and the phone digits.
+func searchKeys(user *User) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	add := func(v string) {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v != "" && !seen[v] {
+			seen[v] = true
+			keys = append(keys, v)
+		}
+	}
+
+	add(user.FullName)
+	for _, word := range strings.Fields(user.FullName) {
+		add(word)
+	}
+	add(user.UserName)
+	add(user.EmailAddress)
+	if at := strings.LastIndex(user.EmailAddress, "@"); at > 0 {
+		add(user.EmailAddress[:at])
+	}
+	add(strings.TrimPrefix(user.PhoneNumber, "+"))
+	return keys
+}
+
+// SuggestUsers returns the first few users whose name, username, email or
+// phone starts with the query. Unlike a full search it only reads the
+// search_keys index, so it stays cheap enough to call on every keystroke.
+func (s *userService) SuggestUsers(ctx context.Context, req *pb.SuggestUsersMessageRequest) (*pb.SuggestUsersMessageResponse, error) {
+	query := strings.ToLower(strings.TrimSpace(req.GetQuery()))
+	query = strings.TrimPrefix(query, "+")
+	if len(query) < minSuggestQuery {
+		return &pb.SuggestUsersMessageResponse{}, nil
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, suggestQueryTimeout)
+	defer cancel()
+
+	// An anchored, case-sensitive regex over lowercase keys is answered from
+	// the index bounds alone
+	collection := s.collection(ctx, "users")
+	cursor, err := collection.Find(ctx,
+		bson.M{
+			"search_keys": bson.M{"$regex": "^" + regexp.QuoteMeta(query)},
+			"deleted_at":  nil,
+		},
+		options.Find().
+			SetHint(searchKeysIndex).
+			SetLimit(limit).
+			SetProjection(bson.M{"full_name": 1, "user_name": 1, "email": 1}),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to suggest users")
+	}
+
+	var users []User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to suggest users")
+	}
+
+	resp := &pb.SuggestUsersMessageResponse{}
+	for i := range users {
+		resp.Suggestions = append(resp.Suggestions, &pb.UserSuggestion{
+			UserId:       users[i].ID.Hex(),
+			FullName:     users[i].FullName,
+			UserName:     users[i].UserName,
+			EmailAddress: users[i].EmailAddress,
+		})
+	}
+	return resp, nil
+}
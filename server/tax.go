@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserTaxProfileUpdated = "user.tax_profile_updated"
+
+	taxTypeKRAPIN          = "kra_pin"
+	maxTaxIdentifierLength = 32
+	maxBusinessNameLength  = 200
+)
+
+// VAT registration states of a tax profile
+const (
+	vatRegistered    = "registered"
+	vatNotRegistered = "not_registered"
+	vatExempt        = "exempt"
+)
+
+// KRA PINs are A (individuals) or P (companies), nine digits and a check letter
+var kraPINPattern = regexp.MustCompile(`^[AP][0-9]{9}[A-Z]$`)
+
+var otherTaxIDPattern = regexp.MustCompile(`^[A-Z0-9-]+$`)
+
+// TaxProfile holds the identifiers the billing service prints on B2B invoices
+type TaxProfile struct {
+	KRAPIN       string    `bson:"kra_pin,omitempty"`
+	VATStatus    string    `bson:"vat_status"`
+	BusinessName string    `bson:"business_name,omitempty"`
+	UpdatedAt    time.Time `bson:"updated_at"`
+}
+
+// validateTaxIdentifier checks the format of a normalized tax identifier
+func validateTaxIdentifier(taxType, value string) error {
+	if taxType == taxTypeKRAPIN {
+		if !kraPINPattern.MatchString(value) {
+			return errors.New("KRA PIN must be A or P, nine digits and a letter")
+		}
+		return nil
+	}
+	if len(value) > maxTaxIdentifierLength || !otherTaxIDPattern.MatchString(value) {
+		return errors.New("tax identifiers may only contain letters, digits and dashes")
+	}
+	return nil
+}
+
+// SetTaxProfile replaces the tax identifiers of a buyer or seller. A KRA PIN
+// is required for VAT-registered accounts.
+func (s *userService) SetTaxProfile(ctx context.Context, req *pb.SetTaxProfileMessageRequest) (*pb.SetTaxProfileMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Validate the identifiers
+	profile := TaxProfile{
+		KRAPIN:       strings.ToUpper(strings.TrimSpace(req.GetKraPin())),
+		VATStatus:    strings.ToLower(strings.TrimSpace(req.GetVatStatus())),
+		BusinessName: strings.TrimSpace(req.GetBusinessName()),
+		UpdatedAt:    time.Now(),
+	}
+	if profile.VATStatus == "" {
+		profile.VATStatus = vatNotRegistered
+	}
+	switch profile.VATStatus {
+	case vatRegistered, vatNotRegistered, vatExempt:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown VAT status %q", req.GetVatStatus())
+	}
+	if profile.KRAPIN != "" {
+		if err := validateTaxIdentifier(taxTypeKRAPIN, profile.KRAPIN); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else if profile.VATStatus == vatRegistered {
+		return nil, status.Error(codes.InvalidArgument, "VAT-registered accounts need a KRA PIN")
+	}
+	if len(profile.BusinessName) > maxBusinessNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "business name must be at most %d characters", maxBusinessNameLength)
+	}
+
+	// 2. Store the profile
+	update := bson.M{"$set": bson.M{"tax": profile, "updated_at": profile.UpdatedAt}}
+	if profile.KRAPIN == "" && profile.BusinessName == "" && profile.VATStatus == vatNotRegistered {
+		update = bson.M{"$unset": bson.M{"tax": ""}, "$set": bson.M{"updated_at": profile.UpdatedAt}}
+	}
+	if _, err := s.collection(ctx, "users").UpdateOne(ctx, bson.M{"_id": user.ID}, update); err != nil {
+		log.Printf("Failed to update tax profile: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update tax profile")
+	}
+	s.recordEvent(ctx, eventUserTaxProfileUpdated, user.ID, map[string]interface{}{
+		"vat_status": profile.VATStatus,
+		"has_pin":    profile.KRAPIN != "",
+	})
+
+	return &pb.SetTaxProfileMessageResponse{Message: "Tax profile updated", Success: true}, nil
+}
+
+// GetTaxProfile returns the tax identifiers of a user. As with billing
+// profiles, the PIN is masked unless the caller holds the billing.pii scope
+// and forwards a freshly authenticated user token.
+func (s *userService) GetTaxProfile(ctx context.Context, req *pb.GetTaxProfileMessageRequest) (*pb.GetTaxProfileMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user.Tax == nil {
+		return &pb.GetTaxProfileMessageResponse{VatStatus: vatNotRegistered}, nil
+	}
+
+	fullPII := clientFromContext(ctx).hasScope(scopeBillingPII) && s.requireFreshAuth(ctx, user.ID.Hex()) == nil
+	pin := user.Tax.KRAPIN
+	if !fullPII {
+		pin = maskValue(pin, 3)
+	}
+	return &pb.GetTaxProfileMessageResponse{
+		KraPin:        pin,
+		VatStatus:     user.Tax.VATStatus,
+		BusinessName:  user.Tax.BusinessName,
+		UpdatedAtUnix: user.Tax.UpdatedAt.Unix(),
+		Redacted:      !fullPII,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxTicketsListed = 100
+
+// supportSystems are the helpdesks tickets may come from
+var supportSystems = map[string]bool{
+	"zendesk":   true,
+	"freshdesk": true,
+	"jira":      true,
+	"email":     true,
+}
+
+// SupportTicket references a ticket held in an external helpdesk
+type SupportTicket struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	System     string             `bson:"system"`
+	ExternalID string             `bson:"external_id"`
+	Subject    string             `bson:"subject,omitempty"`
+	Status     string             `bson:"status,omitempty"`
+	URL        string             `bson:"url,omitempty"`
+	LinkedAt   time.Time          `bson:"linked_at"`
+	UpdatedAt  time.Time          `bson:"updated_at"`
+}
+
+func ticketToProto(t *SupportTicket) *pb.SupportTicket {
+	return &pb.SupportTicket{
+		Id:            t.ID.Hex(),
+		System:        t.System,
+		ExternalId:    t.ExternalID,
+		Subject:       t.Subject,
+		Status:        t.Status,
+		Url:           t.URL,
+		LinkedAtUnix:  t.LinkedAt.Unix(),
+		UpdatedAtUnix: t.UpdatedAt.Unix(),
+	}
+}
+
+// LinkTicket records or refreshes a helpdesk ticket against a user. Linking
+// the same ticket again updates its subject and status.
+func (s *userService) LinkTicket(ctx context.Context, req *pb.LinkTicketMessageRequest) (*pb.LinkTicketMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	system := strings.ToLower(strings.TrimSpace(req.GetSystem()))
+	if !supportSystems[system] {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown support system %q", req.GetSystem())
+	}
+	externalID := strings.TrimSpace(req.GetExternalId())
+	if externalID == "" {
+		return nil, status.Error(codes.InvalidArgument, "external ticket id is required")
+	}
+	ticketURL := strings.TrimSpace(req.GetUrl())
+	if ticketURL != "" {
+		if u, err := url.Parse(ticketURL); err != nil || u.Scheme != "https" {
+			return nil, status.Error(codes.InvalidArgument, "ticket url must be an https URL")
+		}
+	}
+
+	now := time.Now()
+	var ticket SupportTicket
+	err = s.collection(ctx, "support_tickets").FindOneAndUpdate(ctx,
+		bson.M{"system": system, "external_id": externalID},
+		bson.M{
+			"$set": bson.M{
+				"user_id":    user.ID,
+				"subject":    strings.TrimSpace(req.GetSubject()),
+				"status":     strings.ToLower(strings.TrimSpace(req.GetStatus())),
+				"url":        ticketURL,
+				"updated_at": now,
+			},
+			"$setOnInsert": bson.M{"linked_at": now},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&ticket)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to link ticket")
+	}
+
+	return &pb.LinkTicketMessageResponse{Ticket: ticketToProto(&ticket)}, nil
+}
+
+// ListTickets returns a customer's profile summary with their tickets,
+// most recently updated first, for the support console
+func (s *userService) ListTickets(ctx context.Context, req *pb.ListTicketsMessageRequest) (*pb.ListTicketsMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"user_id": user.ID}
+	if st := strings.ToLower(strings.TrimSpace(req.GetStatus())); st != "" {
+		filter["status"] = st
+	}
+	cursor, err := s.collection(ctx, "support_tickets").Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}}).SetLimit(maxTicketsListed),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list tickets")
+	}
+	var tickets []SupportTicket
+	if err := cursor.All(ctx, &tickets); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list tickets")
+	}
+
+	tier := user.Tier
+	if tier == "" {
+		tier = defaultTier
+	}
+	resp := &pb.ListTicketsMessageResponse{
+		Customer: &pb.SupportCustomer{
+			UserId:        user.ID.Hex(),
+			FullName:      user.FullName,
+			UserName:      user.UserName,
+			Email:         user.EmailAddress,
+			PhoneNumber:   user.PhoneNumber,
+			Tier:          tier,
+			EmailVerified: user.EmailVerifiedAt != nil,
+			SellerStatus:  user.SellerStatus,
+			CreatedAtUnix: user.CreatedAt.Unix(),
+		},
+	}
+	if user.LastLoginAt != nil {
+		resp.Customer.LastLoginAtUnix = user.LastLoginAt.Unix()
+	}
+	for i := range tickets {
+		resp.Tickets = append(resp.Tickets, ticketToProto(&tickets[i]))
+	}
+	return resp, nil
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"github.com/bruceoaudo/userService/internal/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newTokenIssuer loads claim and scope configuration from TOKEN_CONFIG_FILE
+// and the HMAC keys from TOKEN_SIGNING_KEYS, written as "id:secret,id:secret"
+// with the signing key first. A single TOKEN_SIGNING_KEY without an id is
+// still accepted.
+func newTokenIssuer() (*token.Issuer, error) {
+	cfg := token.DefaultConfig()
+	if path := os.Getenv("TOKEN_CONFIG_FILE"); path != "" {
+		var err error
+		if cfg, err = token.LoadConfig(path); err != nil {
+			return nil, err
+		}
+	}
+
+	keys, err := token.ParseKeys(os.Getenv("TOKEN_SIGNING_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		key := []byte(os.Getenv("TOKEN_SIGNING_KEY"))
+		if len(key) == 0 {
+			log.Printf("TOKEN_SIGNING_KEYS not set, issued tokens will not survive a restart")
+			key = make([]byte, 32)
+			if _, err := rand.Read(key); err != nil {
+				return nil, err
+			}
+		}
+		keys = []token.Key{{Secret: key}}
+	}
+	return token.NewIssuer(cfg, keys)
+}
+
+// tokenSubject maps a user to the claims that may be embedded in its tokens
+func tokenSubject(user *User) token.Subject {
+	tier := user.Tier
+	if tier == "" {
+		tier = defaultTier
+	}
+	return token.Subject{
+		UserID:   user.ID.Hex(),
+		Roles:    user.Roles,
+		Tier:     tier,
+		Tenant:   user.TenantID,
+		Segments: computeSegments(user, tier, userDemographics(user), time.Now()),
+		Locale:   user.Locale,
+	}
+}
+
+// IssueUserToken mints an access token for a user whose credentials the
+// caller already verified. Embedded claims and grantable scopes come from the
+// token configuration of the requesting client.
+func (s *userService) IssueUserToken(ctx context.Context, req *pb.IssueUserTokenMessageRequest) (*pb.IssueUserTokenMessageResponse, error) {
+	user, err := s.findUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	signed, claims, err := s.tokens.IssueUserToken(tokenSubject(user), req.GetClient(), req.GetScopes())
+	if err != nil {
+		if errors.Is(err, token.ErrUnknownClient) || errors.Is(err, token.ErrScopeNotAllowed) || errors.Is(err, token.ErrUnknownTenant) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("Failed to issue token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+
+	return &pb.IssueUserTokenMessageResponse{
+		AccessToken:   signed,
+		ExpiresAtUnix: claims.ExpiresAt.Unix(),
+		Scopes:        claims.Scopes(),
+	}, nil
+}
+
+const tenantHeader = "x-tenant-id"
+
+// ValidateToken lets downstream services check a token against the tenant
+// they serve, so tokens minted for one storefront are refused by another.
+// The tenant is taken from the request or the x-tenant-id header. Bound
+// tokens are checked against the end user's client described in the
+// request, or in the forwarded client headers when the request has none.
+func (s *userService) ValidateToken(ctx context.Context, req *pb.ValidateTokenMessageRequest) (*pb.ValidateTokenMessageResponse, error) {
+	tenant := req.GetTenant()
+	if tenant == "" {
+		tenant = requestFromContext(ctx).Tenant
+	}
+	if s.tokens.Config().MultiTenant() && tenant == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant is required in multi-store mode")
+	}
+
+	claims, err := s.tokens.Parse(req.GetAccessToken(), tenant)
+	if err != nil {
+		if errors.Is(err, token.ErrUnknownTenant) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &pb.ValidateTokenMessageResponse{Valid: false, Reason: err.Error()}, nil
+	}
+	client := clientContext(ctx)
+	if req.GetUserAgent() != "" || req.GetAppVersion() != "" || req.GetClientIp() != "" {
+		client = token.ClientContext{UserAgent: req.GetUserAgent(), AppVersion: req.GetAppVersion(), IP: req.GetClientIp()}
+	}
+	if err := s.checkBinding(ctx, claims, client); err != nil {
+		return &pb.ValidateTokenMessageResponse{Valid: false, Reason: err.Error()}, nil
+	}
+
+	return &pb.ValidateTokenMessageResponse{
+		Valid:         true,
+		UserId:        claims.Subject,
+		Client:        claims.Client,
+		Scopes:        claims.Scopes(),
+		Tenant:        claims.Tenant,
+		ExpiresAtUnix: claims.ExpiresAt.Unix(),
+	}, nil
+}
+
+// IssueServiceToken mints a short-lived token the calling internal service
+// presents to another AI-Shop service instead of a long-lived shared secret.
+// The caller is identified by its API key or mTLS client certificate.
+func (s *userService) IssueServiceToken(ctx context.Context, req *pb.IssueServiceTokenMessageRequest) (*pb.IssueServiceTokenMessageResponse, error) {
+	client := clientFromContext(ctx)
+	if client == nil {
+		return nil, status.Error(codes.Unauthenticated, "API key or client certificate required")
+	}
+	if req.GetAudience() == "" {
+		return nil, status.Error(codes.InvalidArgument, "audience is required")
+	}
+
+	ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+	signed, claims, err := s.tokens.IssueServiceToken(client.Service, req.GetAudience(), req.GetScopes(), ttl)
+	if err != nil {
+		if errors.Is(err, token.ErrUnknownService) {
+			return nil, status.Errorf(codes.PermissionDenied, "service %s may not request service tokens", client.Service)
+		}
+		if errors.Is(err, token.ErrAudienceNotAllowed) || errors.Is(err, token.ErrScopeNotAllowed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		log.Printf("Failed to issue service token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+
+	log.Printf("Issued service token for %s to call %s", client.Service, req.GetAudience())
+	return &pb.IssueServiceTokenMessageResponse{
+		AccessToken:   signed,
+		ExpiresAtUnix: claims.ExpiresAt.Unix(),
+		Scopes:        claims.Scopes(),
+	}, nil
+}
+
+// ExchangeToken is an RFC 8693 token exchange: the calling service trades
+// the access token a user sent it for a narrowly scoped token to call
+// another service on the user's behalf. The issued token names the user as
+// subject and the caller as actor.
+func (s *userService) ExchangeToken(ctx context.Context, req *pb.ExchangeTokenMessageRequest) (*pb.ExchangeTokenMessageResponse, error) {
+	client := clientFromContext(ctx)
+	if client == nil {
+		return nil, status.Error(codes.Unauthenticated, "API key or client certificate required")
+	}
+	if t := req.GetSubjectTokenType(); t != "" && t != token.TypeAccessToken {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported subject token type %s", t)
+	}
+	if req.GetAudience() == "" {
+		return nil, status.Error(codes.InvalidArgument, "audience is required")
+	}
+	tenant := req.GetTenant()
+	if tenant == "" {
+		tenant = requestFromContext(ctx).Tenant
+	}
+
+	subject, err := s.tokens.Parse(req.GetSubjectToken(), tenant)
+	if err != nil {
+		if errors.Is(err, token.ErrUnknownTenant) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid subject token")
+	}
+
+	signed, claims, err := s.tokens.Exchange(client.Service, subject, req.GetAudience(), req.GetScopes())
+	if err != nil {
+		if errors.Is(err, token.ErrUnknownService) {
+			return nil, status.Errorf(codes.PermissionDenied, "service %s may not exchange tokens", client.Service)
+		}
+		if errors.Is(err, token.ErrAudienceNotAllowed) || errors.Is(err, token.ErrScopeNotAllowed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, token.ErrWrongTokenUse) {
+			return nil, status.Error(codes.InvalidArgument, "subject token must be a user access token")
+		}
+		log.Printf("Failed to exchange token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue token")
+	}
+
+	log.Printf("Exchanged token of user %s for %s to call %s", subject.Subject, client.Service, req.GetAudience())
+	return &pb.ExchangeTokenMessageResponse{
+		AccessToken:     signed,
+		IssuedTokenType: token.TypeAccessToken,
+		ExpiresAtUnix:   claims.ExpiresAt.Unix(),
+		Scopes:          claims.Scopes(),
+	}, nil
+}
+
+// metadataValue returns the first value of an incoming metadata header
+func metadataValue(ctx context.Context, key string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
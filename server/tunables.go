@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"unicode"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	tunablesPollInterval = 5 * time.Second
+	maxRateLimiters      = 10000
+	defaultRateLimitKey  = "*"
+)
+
+// RateLimit is a token bucket per caller and method
+type RateLimit struct {
+	RPS   float64 `yaml:"rps"`
+	Burst int     `yaml:"burst"`
+}
+
+// PasswordPolicy is enforced when a password is set
+type PasswordPolicy struct {
+	MinLength     int  `yaml:"min_length"`
+	RequireUpper  bool `yaml:"require_upper"`
+	RequireLower  bool `yaml:"require_lower"`
+	RequireDigit  bool `yaml:"require_digit"`
+	RequireSymbol bool `yaml:"require_symbol"`
+}
+
+// Tunables are the settings that can change while the server runs. They are
+// read from RUNTIME_CONFIG_FILE and reloaded when the file changes or the
+// process receives SIGHUP.
+type Tunables struct {
+	LogLevel string `yaml:"log_level"`
+	// RateLimits are keyed by RPC method name, with "*" applying to methods
+	// not listed. Methods without a limit are not throttled.
+	RateLimits     map[string]RateLimit `yaml:"rate_limits"`
+	FeatureFlags   map[string]bool      `yaml:"feature_flags"`
+	PasswordPolicy PasswordPolicy       `yaml:"password_policy"`
+	// PayloadLogSampleRate is the fraction of unary calls whose redacted
+	// request and response are logged
+	PayloadLogSampleRate float64 `yaml:"payload_log_sample_rate"`
+	// RiskBands map fraud risk scores at registration and login to an
+	// action. Without bands every attempt is allowed.
+	RiskBands []RiskBand `yaml:"risk_bands"`
+	// RegistrationVelocity limits bursts of registrations from one device
+	// fingerprint or client subnet, replacing the defaults when set
+	RegistrationVelocity []VelocityLimit `yaml:"registration_velocity"`
+	// Experiments are keyed by experiment name. FlagRollouts turn flags on
+	// for a stable fraction of users.
+	Experiments  map[string]Experiment `yaml:"experiments"`
+	FlagRollouts map[string]float64    `yaml:"flag_rollouts"`
+	// AvatarFallback picks the avatar of users without an approved upload:
+	// generated initials (the default) or their Gravatar when one exists
+	AvatarFallback string `yaml:"avatar_fallback"`
+	// ProofOfWork bands ask risky registrations to solve a challenge before
+	// the account is created. Without bands none is asked for.
+	ProofOfWork []PowBand `yaml:"proof_of_work"`
+	// Maintenance turns the service read-only without a restart
+	Maintenance MaintenanceMode `yaml:"maintenance"`
+	// Quotas are keyed by client service name, with "*" applying to
+	// services not listed. Services without a quota are not metered.
+	Quotas map[string]Quota `yaml:"quotas"`
+}
+
+var logLevels = map[string]int{"debug": 0, "info": 1, "warn": 2, "error": 3}
+
+func defaultTunables() *Tunables {
+	return &Tunables{LogLevel: "info", RegistrationVelocity: defaultVelocityLimits}
+}
+
+func (t *Tunables) validate() error {
+	if _, ok := logLevels[t.LogLevel]; !ok {
+		return fmt.Errorf("unknown log_level %q", t.LogLevel)
+	}
+	for method, limit := range t.RateLimits {
+		if limit.RPS <= 0 {
+			return fmt.Errorf("rate limit for %s needs a positive rps", method)
+		}
+		if limit.Burst < 1 {
+			return fmt.Errorf("rate limit for %s needs a burst of at least 1", method)
+		}
+	}
+	if t.PasswordPolicy.MinLength < 0 || t.PasswordPolicy.MinLength > 72 {
+		return fmt.Errorf("password_policy.min_length must be between 0 and 72")
+	}
+	if t.PayloadLogSampleRate < 0 || t.PayloadLogSampleRate > 1 {
+		return fmt.Errorf("payload_log_sample_rate must be between 0 and 1")
+	}
+	if err := validateRiskBands(t.RiskBands); err != nil {
+		return err
+	}
+	if err := validateVelocityLimits(t.RegistrationVelocity); err != nil {
+		return err
+	}
+	if err := validateExperiments(t.Experiments, t.FlagRollouts); err != nil {
+		return err
+	}
+	if err := validatePowBands(t.ProofOfWork); err != nil {
+		return err
+	}
+	if err := validateQuotas(t.Quotas); err != nil {
+		return err
+	}
+	return validateAvatarFallback(t.AvatarFallback)
+}
+
+// loadTunables parses a runtime config file on top of the defaults
+func loadTunables(path string) (*Tunables, []byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	t := defaultTunables()
+	if err := yaml.Unmarshal(data, t); err != nil {
+		return nil, nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	t.LogLevel = strings.ToLower(strings.TrimSpace(t.LogLevel))
+	if err := t.validate(); err != nil {
+		return nil, nil, err
+	}
+	return t, data, nil
+}
+
+// runtimeConfig holds the current tunables and the state derived from them
+type runtimeConfig struct {
+	path    string
+	current atomic.Pointer[Tunables]
+	raw     []byte
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// newRuntimeConfig loads RUNTIME_CONFIG_FILE, or the defaults when it is unset
+func newRuntimeConfig() (*runtimeConfig, error) {
+	rc := &runtimeConfig{path: os.Getenv("RUNTIME_CONFIG_FILE"), limiters: make(map[string]*rate.Limiter)}
+	if rc.path == "" {
+		rc.current.Store(defaultTunables())
+		return rc, nil
+	}
+	t, raw, err := loadTunables(rc.path)
+	if err != nil {
+		return nil, err
+	}
+	rc.current.Store(t)
+	rc.raw = raw
+	return rc, nil
+}
+
+func (rc *runtimeConfig) get() *Tunables {
+	return rc.current.Load()
+}
+
+// reload swaps in the file contents when they changed. Invalid files are
+// rejected and the previous settings stay active.
+func (rc *runtimeConfig) reload() {
+	if rc.path == "" {
+		return
+	}
+	t, raw, err := loadTunables(rc.path)
+	if err != nil {
+		log.Printf("Runtime config not reloaded: %v", err)
+		return
+	}
+	if bytes.Equal(raw, rc.raw) {
+		return
+	}
+	rc.raw = raw
+	rc.current.Store(t)
+
+	rc.mu.Lock()
+	rc.limiters = make(map[string]*rate.Limiter)
+	rc.mu.Unlock()
+	log.Printf("Runtime config reloaded from %s (log level %s)", rc.path, t.LogLevel)
+}
+
+// watch reloads on SIGHUP and whenever the file contents change
+func (rc *runtimeConfig) watch(ctx context.Context) {
+	if rc.path == "" {
+		return
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(tunablesPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			log.Printf("SIGHUP received, reloading runtime config")
+		case <-ticker.C:
+		}
+		rc.reload()
+	}
+}
+
+// logEnabled reports whether messages at level pass the configured log level
+func (rc *runtimeConfig) logEnabled(level string) bool {
+	return logLevels[level] >= logLevels[rc.get().LogLevel]
+}
+
+func (rc *runtimeConfig) debugf(format string, args ...interface{}) {
+	if rc.logEnabled("debug") {
+		log.Printf(format, args...)
+	}
+}
+
+// featureEnabled returns the configured flag, or def when it is not set
+func (rc *runtimeConfig) featureEnabled(name string, def bool) bool {
+	if v, ok := rc.get().FeatureFlags[name]; ok {
+		return v
+	}
+	return def
+}
+
+// check applies the password policy to a new password
+func (p PasswordPolicy) check(password string) error {
+	if len(password) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+	switch {
+	case p.RequireUpper && !upper:
+		return fmt.Errorf("password must contain an uppercase letter")
+	case p.RequireLower && !lower:
+		return fmt.Errorf("password must contain a lowercase letter")
+	case p.RequireDigit && !digit:
+		return fmt.Errorf("password must contain a digit")
+	case p.RequireSymbol && !symbol:
+		return fmt.Errorf("password must contain a symbol")
+	}
+	return nil
+}
+
+// allow takes a token from the bucket of the caller for method
+func (rc *runtimeConfig) allow(ctx context.Context, fullMethod string) bool {
+	limits := rc.get().RateLimits
+	if len(limits) == 0 {
+		return true
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	limit, ok := limits[method]
+	if !ok {
+		if limit, ok = limits[defaultRateLimitKey]; !ok {
+			return true
+		}
+	}
+
+	key := method + "|" + callerKey(ctx)
+	rc.mu.Lock()
+	limiter, ok := rc.limiters[key]
+	if !ok {
+		if len(rc.limiters) >= maxRateLimiters {
+			rc.limiters = make(map[string]*rate.Limiter)
+		}
+		limiter = rate.NewLimiter(rate.Limit(limit.RPS), limit.Burst)
+		rc.limiters[key] = limiter
+	}
+	rc.mu.Unlock()
+	return limiter.Allow()
+}
+
+// callerKey identifies the caller by service name or peer address. Callers
+// on the Unix socket share one key since they have no address.
+func callerKey(ctx context.Context) string {
+	if c := clientFromContext(ctx); c != nil {
+		return "service:" + c.Service
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if p.Addr.Network() == "unix" {
+			return "unix"
+		}
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "ip:" + host
+		}
+		return "ip:" + p.Addr.String()
+	}
+	return "unknown"
+}
+
+// rateLimitInterceptor rejects unary calls over the configured rate
+func rateLimitInterceptor(rc *runtimeConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !rc.allow(ctx, info.FullMethod) {
+			rc.debugf("Rate limited %s for %s", info.FullMethod, callerKey(ctx))
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitStreamInterceptor rejects new streams over the configured rate
+func rateLimitStreamInterceptor(rc *runtimeConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !rc.allow(ss.Context(), info.FullMethod) {
+			rc.debugf("Rate limited %s for %s", info.FullMethod, callerKey(ss.Context()))
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(srv, ss)
+	}
+}
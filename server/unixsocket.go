@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/local"
+)
+
+const defaultUnixSocketMode = 0660
+
+// listenUnix binds a Unix domain socket at path, replacing a socket left
+// behind by a previous run. Access is controlled by the file mode, taken
+// from GRPC_UNIX_SOCKET_MODE as an octal string.
+func listenUnix(path string) (net.Listener, error) {
+	mode := os.FileMode(defaultUnixSocketMode)
+	if v := os.Getenv("GRPC_UNIX_SOCKET_MODE"); v != "" {
+		parsed, err := strconv.ParseUint(v, 8, 32)
+		if err != nil {
+			return nil, fmt.Errorf("GRPC_UNIX_SOCKET_MODE must be an octal file mode: %w", err)
+		}
+		mode = os.FileMode(parsed)
+	}
+
+	if fi, err := os.Lstat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+// serveUnixSocket serves the gRPC API on GRPC_UNIX_SOCKET for a co-located
+// gateway or sidecar. It is a separate plain server because xDS servers only
+// bind TCP, and it uses local credentials since the socket never leaves the
+// host; callers still authenticate with their API key.
+func serveUnixSocket(path string, register func(grpc.ServiceRegistrar), d *drainer, opts ...grpc.ServerOption) {
+	lis, err := listenUnix(path)
+	if err != nil {
+		log.Fatalf("Failed to listen on unix socket %s: %v", path, err)
+	}
+	srv := grpc.NewServer(append([]grpc.ServerOption{grpc.Creds(local.NewCredentials())}, opts...)...)
+	register(srv)
+	d.addGRPC(srv)
+
+	log.Printf("gRPC server listening on unix socket: %s", path)
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("Failed to serve gRPC on unix socket: %v", err)
+	}
+}
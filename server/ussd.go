@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minPINDigits       = 4
+	maxPINDigits       = 6
+	maxUSSDPINFailures = 3
+	ussdPINLockout     = 30 * time.Minute
+	maxUSSDPINLockout  = 24 * time.Hour
+	ussdDevice         = "ussd"
+	ussdUserNamePrefix = "ussd"
+)
+
+// Sign-in activity of USSD accounts
+const (
+	securityEventPINFailed = "pin_failed"
+	securityEventPINLocked = "pin_locked"
+)
+
+// USSDAccount holds the PIN that feature-phone customers sign in with
+// through the USSD gateway. They have no email address or password.
+type USSDAccount struct {
+	PINHash        string     `bson:"pin_hash"`
+	PINChangedAt   time.Time  `bson:"pin_changed_at"`
+	FailedAttempts int        `bson:"failed_attempts,omitempty"`
+	LockedUntil    *time.Time `bson:"locked_until,omitempty"`
+	// Lockouts counts the lockouts since the last correct PIN
+	Lockouts     int       `bson:"lockouts,omitempty"`
+	RegisteredAt time.Time `bson:"registered_at"`
+}
+
+// validatePIN checks a numeric PIN, refusing ones like 1111 or 1234 that
+// are guessed first
+func validatePIN(pin string) error {
+	if len(pin) < minPINDigits || len(pin) > maxPINDigits {
+		return fmt.Errorf("PIN must be %d to %d digits", minPINDigits, maxPINDigits)
+	}
+	repeated, ascending, descending := true, true, true
+	for i := 0; i < len(pin); i++ {
+		if pin[i] < '0' || pin[i] > '9' {
+			return errors.New("PIN can only contain digits")
+		}
+		if i == 0 {
+			continue
+		}
+		repeated = repeated && pin[i] == pin[i-1]
+		ascending = ascending && pin[i] == pin[i-1]+1
+		descending = descending && pin[i] == pin[i-1]-1
+	}
+	if repeated || ascending || descending {
+		return errors.New("PIN is too easy to guess")
+	}
+	return nil
+}
+
+// ussdUserName derives the username of a USSD account from its phone
+// number, which is unique, so registration never asks for one
+func ussdUserName(phone string) string {
+	return ussdUserNamePrefix + strings.TrimPrefix(phone, "254")
+}
+
+// isUSSDUserName reports whether name has the form ussdUserName gives. Other
+// accounts can't take such names, or the owner of the number could no
+// longer register over USSD.
+func isUSSDUserName(name string) bool {
+	if len(name) <= len(ussdUserNamePrefix) || !strings.EqualFold(name[:len(ussdUserNamePrefix)], ussdUserNamePrefix) {
+		return false
+	}
+	for _, c := range name[len(ussdUserNamePrefix):] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// RegisterUSSDUser creates an account for a feature-phone customer from a
+// USSD session: a name, the phone number the mobile network reported for
+// the session and a PIN. The network vouches for the number, so it is
+// stored as verified.
+func (s *userService) RegisterUSSDUser(ctx context.Context, req *pb.RegisterUSSDUserMessageRequest) (*pb.RegisterUSSDUserMessageResponse, error) {
+	// 1. Validate input
+	fullName := strings.TrimSpace(req.GetFullName())
+	if fullName == "" {
+		return nil, status.Error(codes.InvalidArgument, "full name is required")
+	}
+	phone := normalizePhoneNumber(req.GetPhoneNumber())
+	if detectPhoneType(phone) != phoneTypeMobile {
+		return nil, status.Error(codes.InvalidArgument, "phone must be a Kenyan mobile number")
+	}
+	if err := validatePIN(req.GetPin()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 2. Check for an existing account on the number
+	exists, err := s.users.ExistsByEmailUsernamePhone(ctx, "", "", phone)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "an account with this phone number already exists")
+	}
+
+	// 3. Create the account
+	pinHash, err := s.passwords.Hash(req.GetPin())
+	if err != nil {
+		log.Printf("Failed to hash PIN: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create user")
+	}
+	now := time.Now()
+	user := User{
+		FullName:        fullName,
+		UserName:        ussdUserName(phone),
+		PhoneNumber:     phone,
+		PhoneType:       phoneTypeMobile,
+		PhoneVerifiedAt: &now,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		USSD:            &USSDAccount{PINHash: pinHash, PINChangedAt: now, RegisteredAt: now},
+	}
+	user.SearchKeys = searchKeys(&user)
+	user.SchemaVersion = currentUserSchemaVersion
+	user.Risk = s.assessRisk(ctx, riskEventRegistration, &user, "")
+	if user.Risk.Action == riskActionBlock {
+		return nil, errRiskBlocked(riskEventRegistration)
+	}
+
+	if err := s.users.Create(ctx, &user); err != nil {
+		if errors.Is(err, errUserExists) {
+			return nil, status.Error(codes.AlreadyExists, "an account with this phone number already exists")
+		}
+		log.Printf("Failed to create USSD user: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create user")
+	}
+
+	// 4. Publish the registration to downstream services
+	s.recordEvent(ctx, eventUserRegistered, user.ID, map[string]interface{}{
+		"user_name":  user.UserName,
+		"created_at": user.CreatedAt,
+		"channel":    ussdDevice,
+	})
+	s.grantWelcomeCoupon(ctx, user.ID)
+
+	return &pb.RegisterUSSDUserMessageResponse{
+		UserId:   user.ID.Hex(),
+		UserName: user.UserName,
+		Message:  "Registered successfully",
+		Success:  true,
+	}, nil
+}
+
+// ussdPINLockoutFor returns how long the nth lockout in a row lasts:
+// ussdPINLockout, doubling with each one up to maxUSSDPINLockout
+func ussdPINLockoutFor(lockouts int) time.Duration {
+	d := ussdPINLockout
+	for i := 1; i < lockouts && d < maxUSSDPINLockout; i++ {
+		d *= 2
+	}
+	if d > maxUSSDPINLockout {
+		d = maxUSSDPINLockout
+	}
+	return d
+}
+
+// verifyUSSDPIN checks the PIN of the USSD account on phone. Every attempt
+// is counted before the PIN is checked, and maxUSSDPINFailures wrong ones
+// lock the PIN for longer after each lockout in a row. A correct PIN
+// resets both counts.
+func (s *userService) verifyUSSDPIN(ctx context.Context, phone, pin string) (*User, error) {
+	user, err := s.users.FindUSSDByPhone(ctx, phone)
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid phone number or PIN")
+		}
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	now := time.Now()
+	account, err := s.users.ReserveUSSDPINAttempt(ctx, user.ID, maxUSSDPINFailures, now)
+	if err != nil {
+		if !errors.Is(err, errUSSDPINLocked) {
+			log.Printf("Database error: %v", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		// Attempts used up without a lock means locking failed last time
+		if until := user.USSD.LockedUntil; user.USSD.FailedAttempts >= maxUSSDPINFailures && (until == nil || !now.Before(*until)) {
+			s.lockUSSDPIN(ctx, user.ID, user.USSD.Lockouts, now)
+		}
+		return nil, status.Error(codes.ResourceExhausted, "too many wrong PINs, try again later")
+	}
+
+	if _, err := s.passwords.Verify(pin, account.PINHash); err != nil {
+		s.recordSecurityEvent(ctx, user.ID, securityEventPINFailed, ussdDevice, "")
+		if account.FailedAttempts < maxUSSDPINFailures {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid phone number or PIN, %d attempts left", maxUSSDPINFailures-account.FailedAttempts)
+		}
+		s.lockUSSDPIN(ctx, user.ID, account.Lockouts, now)
+		return nil, status.Error(codes.ResourceExhausted, "too many wrong PINs, try again later")
+	}
+
+	if err := s.users.ResetUSSDPINFailures(ctx, user.ID); err != nil {
+		log.Printf("Failed to reset PIN failures for user %s: %v", user.ID.Hex(), err)
+	}
+	return user, nil
+}
+
+// lockUSSDPIN locks a PIN that used up its attempts, for the lockout after
+// lockouts earlier ones
+func (s *userService) lockUSSDPIN(ctx context.Context, id primitive.ObjectID, lockouts int, now time.Time) {
+	err := s.users.LockUSSDPIN(ctx, id, maxUSSDPINFailures, now.Add(ussdPINLockoutFor(lockouts+1)))
+	if errors.Is(err, errUserNotFound) {
+		// Another attempt locked it first
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to lock PIN for user %s: %v", id.Hex(), err)
+		return
+	}
+	s.recordSecurityEvent(ctx, id, securityEventPINLocked, ussdDevice, "")
+}
+
+// LoginUSSDUser signs a feature-phone customer in with the phone number of
+// the USSD session and their PIN
+func (s *userService) LoginUSSDUser(ctx context.Context, req *pb.LoginUSSDUserMessageRequest) (*pb.LoginUSSDUserMessageResponse, error) {
+	phone := normalizePhoneNumber(req.GetPhoneNumber())
+	if phone == "" || req.GetPin() == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone number and PIN are required")
+	}
+	user, err := s.verifyUSSDPIN(ctx, phone, req.GetPin())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.users.RecordLogin(ctx, user.ID, now, nil); err != nil {
+		log.Printf("Failed to record login time: %v", err)
+	}
+	s.recordSecurityEvent(ctx, user.ID, securityEventLogin, ussdDevice, "")
+
+	return &pb.LoginUSSDUserMessageResponse{
+		UserId:   user.ID.Hex(),
+		FullName: user.FullName,
+		UserName: user.UserName,
+		Message:  "Login successful",
+		Success:  true,
+	}, nil
+}
+
+// ChangeUSSDPIN replaces the PIN of a USSD account after checking the
+// current one
+func (s *userService) ChangeUSSDPIN(ctx context.Context, req *pb.ChangeUSSDPINMessageRequest) (*pb.ChangeUSSDPINMessageResponse, error) {
+	phone := normalizePhoneNumber(req.GetPhoneNumber())
+	if phone == "" || req.GetCurrentPin() == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone number and PIN are required")
+	}
+	if err := validatePIN(req.GetNewPin()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetNewPin() == req.GetCurrentPin() {
+		return nil, status.Error(codes.InvalidArgument, "new PIN must differ from the current PIN")
+	}
+	user, err := s.verifyUSSDPIN(ctx, phone, req.GetCurrentPin())
+	if err != nil {
+		return nil, err
+	}
+
+	pinHash, err := s.passwords.Hash(req.GetNewPin())
+	if err != nil {
+		log.Printf("Failed to hash PIN: %v", err)
+		return nil, status.Error(codes.Internal, "failed to change PIN")
+	}
+	if err := s.users.SetUSSDPIN(ctx, user.ID, pinHash, time.Now()); err != nil {
+		log.Printf("Failed to change PIN: %v", err)
+		return nil, status.Error(codes.Internal, "failed to change PIN")
+	}
+
+	return &pb.ChangeUSSDPINMessageResponse{Message: "PIN changed", Success: true}, nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testUSSDPhone = "254712345678"
+
+// newUSSDTest seeds a USSD account with pin
+func newUSSDTest(t *testing.T, pin string) (*userService, *memoryUserRepository, primitive.ObjectID) {
+	t.Helper()
+	svc, users, _ := newTestService(t)
+	hash, err := svc.passwords.Hash(pin)
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	user := &User{
+		FullName:      "Amina Otieno",
+		UserName:      ussdUserName(testUSSDPhone),
+		PhoneNumber:   testUSSDPhone,
+		SchemaVersion: currentUserSchemaVersion,
+		USSD:          &USSDAccount{PINHash: hash},
+	}
+	if err := users.Create(context.Background(), user); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	return svc, users, user.ID
+}
+
+func loginUSSD(svc *userService, pin string) codes.Code {
+	_, err := svc.LoginUSSDUser(context.Background(), &pb.LoginUSSDUserMessageRequest{PhoneNumber: testUSSDPhone, Pin: pin})
+	return status.Code(err)
+}
+
+func TestLoginUSSDUser(t *testing.T) {
+	const pin = "4821"
+
+	tests := []struct {
+		name     string
+		attempts []string
+		code     codes.Code
+	}{
+		{
+			name:     "correct PIN",
+			attempts: []string{pin},
+			code:     codes.OK,
+		},
+		{
+			name:     "wrong PIN",
+			attempts: []string{"0000"},
+			code:     codes.Unauthenticated,
+		},
+		{
+			name:     "locked after too many wrong PINs",
+			attempts: []string{"0000", "0000", "0000"},
+			code:     codes.ResourceExhausted,
+		},
+		{
+			name:     "correct PIN refused while locked",
+			attempts: []string{"0000", "0000", "0000", pin},
+			code:     codes.ResourceExhausted,
+		},
+		{
+			name:     "correct PIN clears earlier failures",
+			attempts: []string{"0000", "0000", pin, "0000", "0000", pin},
+			code:     codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newUSSDTest(t, pin)
+			var code codes.Code
+			for _, attempt := range tt.attempts {
+				code = loginUSSD(svc, attempt)
+			}
+			if code != tt.code {
+				t.Fatalf("LoginUSSDUser() code = %v, want %v", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestUSSDPINLockoutEscalates(t *testing.T) {
+	svc, users, id := newUSSDTest(t, "4821")
+
+	for lockout := 1; lockout <= 3; lockout++ {
+		for i := 0; i < maxUSSDPINFailures; i++ {
+			loginUSSD(svc, "0000")
+		}
+		users.mu.Lock()
+		account := *users.users[id].USSD
+		users.mu.Unlock()
+		if account.Lockouts != lockout {
+			t.Fatalf("lockouts = %d, want %d", account.Lockouts, lockout)
+		}
+		if account.LockedUntil == nil {
+			t.Fatalf("lockout %d did not lock the PIN", lockout)
+		}
+		if got, want := time.Until(*account.LockedUntil), ussdPINLockoutFor(lockout); got > want || got < want-time.Minute {
+			t.Errorf("lockout %d lasts %v, want %v", lockout, got, want)
+		}
+		// Let the lock run out
+		users.mu.Lock()
+		expired := time.Now().Add(-time.Second)
+		users.users[id].USSD.LockedUntil = &expired
+		users.mu.Unlock()
+	}
+
+	if code := loginUSSD(svc, "4821"); code != codes.OK {
+		t.Fatalf("correct PIN after lockout code = %v, want %v", code, codes.OK)
+	}
+	users.mu.Lock()
+	defer users.mu.Unlock()
+	if account := users.users[id].USSD; account.Lockouts != 0 || account.FailedAttempts != 0 || account.LockedUntil != nil {
+		t.Errorf("correct PIN left lockouts %d, failed attempts %d, lock %v", account.Lockouts, account.FailedAttempts, account.LockedUntil)
+	}
+}
+
+func TestUSSDPINConcurrentGuesses(t *testing.T) {
+	const guesses = 5 * maxUSSDPINFailures
+	svc, users, id := newUSSDTest(t, "4821")
+
+	var wg sync.WaitGroup
+	seen := make(chan codes.Code, guesses)
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seen <- loginUSSD(svc, "0000")
+		}()
+	}
+	wg.Wait()
+	close(seen)
+
+	wrong := 0
+	for code := range seen {
+		if code == codes.Unauthenticated {
+			wrong++
+		}
+	}
+	// The guess that used up the attempts is answered with the lockout
+	if wrong > maxUSSDPINFailures-1 {
+		t.Errorf("%d guesses checked before the lockout, want at most %d", wrong, maxUSSDPINFailures-1)
+	}
+	users.mu.Lock()
+	defer users.mu.Unlock()
+	if got := users.users[id].USSD.Lockouts; got != 1 {
+		t.Errorf("lockouts = %d, want 1", got)
+	}
+}
+
+func TestUSSDPINLockoutFor(t *testing.T) {
+	tests := []struct {
+		lockouts int
+		want     time.Duration
+	}{
+		{1, ussdPINLockout},
+		{2, 2 * ussdPINLockout},
+		{3, 4 * ussdPINLockout},
+		{10, maxUSSDPINLockout},
+	}
+	for _, tt := range tests {
+		if got := ussdPINLockoutFor(tt.lockouts); got != tt.want {
+			t.Errorf("ussdPINLockoutFor(%d) = %v, want %v", tt.lockouts, got, tt.want)
+		}
+	}
+}
+
+func TestValidatePIN(t *testing.T) {
+	tests := []struct {
+		pin     string
+		wantErr bool
+	}{
+		{"4821", false},
+		{"482193", false},
+		{"1357", false},
+		{"482", true},
+		{"4821930", true},
+		{"48a1", true},
+		{"1111", true},
+		{"1234", true},
+		{"9876", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		if err := validatePIN(tt.pin); (err != nil) != tt.wantErr {
+			t.Errorf("validatePIN(%q) error = %v, want error %v", tt.pin, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserVelocityFlagged = "user.velocity_flagged"
+
+	velocityScopeDevice = "device"
+	velocityScopeSubnet = "subnet"
+
+	velocityActionFlag  = "flag"
+	velocityActionBlock = "block"
+
+	// Attempts older than this are dropped whatever the configured windows
+	maxVelocityWindow = 7 * 24 * time.Hour
+)
+
+// VelocityLimit caps registrations sharing a device fingerprint or client
+// subnet within a window. Bursts over Max are flagged for review or blocked.
+type VelocityLimit struct {
+	Scope  string        `yaml:"scope"`
+	Window time.Duration `yaml:"window"`
+	Max    int           `yaml:"max"`
+	Action string        `yaml:"action"`
+}
+
+// defaultVelocityLimits apply unless the runtime config lists its own
+var defaultVelocityLimits = []VelocityLimit{
+	{Scope: velocityScopeDevice, Window: time.Hour, Max: 3, Action: velocityActionFlag},
+	{Scope: velocityScopeDevice, Window: 24 * time.Hour, Max: 10, Action: velocityActionBlock},
+	{Scope: velocityScopeSubnet, Window: 10 * time.Minute, Max: 20, Action: velocityActionFlag},
+	{Scope: velocityScopeSubnet, Window: time.Hour, Max: 100, Action: velocityActionBlock},
+}
+
+func validateVelocityLimits(limits []VelocityLimit) error {
+	for _, l := range limits {
+		if l.Scope != velocityScopeDevice && l.Scope != velocityScopeSubnet {
+			return fmt.Errorf("unknown registration velocity scope %q", l.Scope)
+		}
+		if l.Action != velocityActionFlag && l.Action != velocityActionBlock {
+			return fmt.Errorf("unknown registration velocity action %q", l.Action)
+		}
+		if l.Window <= 0 || l.Window > maxVelocityWindow {
+			return fmt.Errorf("registration velocity window must be between 0 and %s", maxVelocityWindow)
+		}
+		if l.Max < 1 {
+			return fmt.Errorf("registration velocity max must be at least 1")
+		}
+	}
+	return nil
+}
+
+// RegistrationAttempt is the Mongo record of one registration, used when
+// Redis is not configured
+type RegistrationAttempt struct {
+	Scope string    `bson:"scope"`
+	Value string    `bson:"value"`
+	At    time.Time `bson:"at"`
+}
+
+func registrationVelocityKey(scope, value string) string {
+	return "registration_velocity:" + scope + ":" + value
+}
+
+// clientSubnet groups IPv4 clients by /24 and IPv6 clients by /64
+func clientSubnet(ip string) string {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return ""
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String() + "/24"
+	}
+	return parsed.Mask(net.CIDRMask(64, 128)).String() + "/64"
+}
+
+// checkRegistrationVelocity records a registration attempt against its
+// device and subnet and returns the limits it exceeds. A blocking limit is
+// returned as an error. Storage failures fail open.
+func (s *userService) checkRegistrationVelocity(ctx context.Context, fingerprint string) ([]string, error) {
+	values := map[string]string{
+		velocityScopeDevice: strings.TrimSpace(fingerprint),
+		velocityScopeSubnet: clientSubnet(metadataValue(ctx, clientIPHeader)),
+	}
+	limits := s.config.get().RegistrationVelocity
+	now := time.Now()
+
+	var flags []string
+	for scope, value := range values {
+		if value == "" {
+			continue
+		}
+		var windows []time.Duration
+		for _, l := range limits {
+			if l.Scope == scope {
+				windows = append(windows, l.Window)
+			}
+		}
+		if len(windows) == 0 {
+			continue
+		}
+
+		counts, err := s.recordRegistrationAttempt(ctx, scope, value, now, windows)
+		if err != nil {
+			log.Printf("Failed to check registration velocity: %v", err)
+			continue
+		}
+		for _, l := range limits {
+			if l.Scope != scope || counts[l.Window] <= int64(l.Max) {
+				continue
+			}
+			if l.Action == velocityActionBlock {
+				log.Printf("Blocked registration burst: %d registrations from one %s within %s", counts[l.Window], scope, l.Window)
+				return nil, status.Errorf(codes.ResourceExhausted, "too many registrations from this %s, try again later", scope)
+			}
+			flags = append(flags, "velocity_"+scope)
+		}
+	}
+	return dedupeStrings(flags), nil
+}
+
+// recordRegistrationAttempt stores the attempt and counts the attempts for
+// the same scope and value inside each window
+func (s *userService) recordRegistrationAttempt(ctx context.Context, scope, value string, now time.Time, windows []time.Duration) (map[time.Duration]int64, error) {
+	counts := make(map[time.Duration]int64, len(windows))
+
+	if s.redis != nil {
+		key := registrationVelocityKey(scope, value)
+		nowScore := float64(now.UnixNano())
+		cmds := make(map[time.Duration]*redis.IntCmd, len(windows))
+		_, err := s.redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.ZAdd(ctx, key, redis.Z{Score: nowScore, Member: primitive.NewObjectID().Hex()})
+			p.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-maxVelocityWindow).UnixNano(), 10))
+			p.Expire(ctx, key, maxVelocityWindow)
+			for _, w := range windows {
+				cmds[w] = p.ZCount(ctx, key, strconv.FormatInt(now.Add(-w).UnixNano(), 10), "+inf")
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for w, cmd := range cmds {
+			counts[w] = cmd.Val()
+		}
+		return counts, nil
+	}
+
+	collection := s.collection(ctx, "registration_attempts")
+	if _, err := collection.InsertOne(ctx, RegistrationAttempt{Scope: scope, Value: value, At: now}); err != nil {
+		return nil, err
+	}
+	for _, w := range windows {
+		if _, ok := counts[w]; ok {
+			continue
+		}
+		n, err := collection.CountDocuments(ctx, bson.M{"scope": scope, "value": value, "at": bson.M{"$gt": now.Add(-w)}})
+		if err != nil {
+			return nil, err
+		}
+		counts[w] = n
+	}
+	return counts, nil
+}
+
+func dedupeStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := values[:0]
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
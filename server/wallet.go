@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/bruceoaudo/userService/gen/user"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	eventUserWalletCredited = "user.wallet_credited"
+	eventUserWalletDebited  = "user.wallet_debited"
+
+	defaultWalletCurrency    = "KES"
+	defaultWalletHistorySize = 20
+	maxWalletHistorySize     = 200
+	maxIdempotencyKeyLength  = 128
+)
+
+// Ledger entry types
+const (
+	walletCredit = "credit"
+	walletDebit  = "debit"
+)
+
+// walletSources lists why money may enter or leave a wallet
+var walletSources = map[string]map[string]bool{
+	walletCredit: {"refund": true, "promo": true, "adjustment": true},
+	walletDebit:  {"purchase": true, "adjustment": true, "expiry": true},
+}
+
+var (
+	errInsufficientFunds = errors.New("insufficient wallet balance")
+	errCurrencyMismatch  = errors.New("currency does not match wallet")
+	errIdempotencyReuse  = errors.New("idempotency key reused with different parameters")
+)
+
+// Wallet holds a user's store credit in minor currency units
+type Wallet struct {
+	UserID    primitive.ObjectID `bson:"_id"`
+	Currency  string             `bson:"currency"`
+	Balance   int64              `bson:"balance"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// WalletTransaction is an append-only ledger entry. Entries are never
+// updated; corrections are booked as adjustments.
+type WalletTransaction struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	UserID         primitive.ObjectID `bson:"user_id"`
+	Type           string             `bson:"type"`
+	Amount         int64              `bson:"amount"`
+	Currency       string             `bson:"currency"`
+	BalanceAfter   int64              `bson:"balance_after"`
+	Source         string             `bson:"source"`
+	Reference      string             `bson:"reference,omitempty"`
+	Description    string             `bson:"description,omitempty"`
+	IdempotencyKey string             `bson:"idempotency_key"`
+	CreatedAt      time.Time          `bson:"created_at"`
+}
+
+type walletEntry struct {
+	userID         primitive.ObjectID
+	entryType      string
+	amount         int64
+	currency       string
+	source         string
+	reference      string
+	description    string
+	idempotencyKey string
+}
+
+func parseWalletEntry(entryType, userID string, amount int64, currency, source, reference, description, key string) (*walletEntry, error) {
+	id, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	key = strings.TrimSpace(key)
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key is required")
+	}
+	source = strings.ToLower(strings.TrimSpace(source))
+	if !walletSources[entryType][source] {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s source %q", entryType, source)
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = defaultWalletCurrency
+	}
+	if len(currency) != 3 {
+		return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter ISO 4217 code")
+	}
+	return &walletEntry{
+		userID:         id,
+		entryType:      entryType,
+		amount:         amount,
+		currency:       currency,
+		source:         source,
+		reference:      strings.TrimSpace(reference),
+		description:    strings.TrimSpace(description),
+		idempotencyKey: key,
+	}, nil
+}
+
+// applyWalletEntry books an entry and moves the balance in one transaction.
+// Replaying an idempotency key returns the original entry without booking it
+// again. Multi-document transactions need MongoDB running as a replica set.
+func (s *userService) applyWalletEntry(ctx context.Context, e *walletEntry) (*WalletTransaction, bool, error) {
+	if err := s.collection(ctx, "users").FindOne(ctx, bson.M{"_id": e.userID, "deleted_at": nil}).Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, false, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, false, err
+	}
+
+	session, err := s.db.StartSession()
+	if err != nil {
+		return nil, false, err
+	}
+	defer session.EndSession(ctx)
+
+	var (
+		booked   WalletTransaction
+		replayed bool
+	)
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		txns := s.collection(ctx, "wallet_transactions")
+		replayed = false
+
+		// 1. Return the original entry for a replayed key
+		err := txns.FindOne(sc, bson.M{"user_id": e.userID, "idempotency_key": e.idempotencyKey}).Decode(&booked)
+		if err == nil {
+			if booked.Type != e.entryType || booked.Amount != e.amount || booked.Currency != e.currency {
+				return nil, errIdempotencyReuse
+			}
+			replayed = true
+			return nil, nil
+		}
+		if err != mongo.ErrNoDocuments {
+			return nil, err
+		}
+
+		// 2. Move the balance
+		now := time.Now()
+		wallets := s.collection(ctx, "wallets")
+		var wallet Wallet
+		if e.entryType == walletCredit {
+			err = wallets.FindOneAndUpdate(sc,
+				bson.M{"_id": e.userID},
+				bson.M{
+					"$inc":         bson.M{"balance": e.amount},
+					"$set":         bson.M{"updated_at": now},
+					"$setOnInsert": bson.M{"currency": e.currency, "created_at": now},
+				},
+				options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+			).Decode(&wallet)
+		} else {
+			err = wallets.FindOneAndUpdate(sc,
+				bson.M{"_id": e.userID, "currency": e.currency, "balance": bson.M{"$gte": e.amount}},
+				bson.M{"$inc": bson.M{"balance": -e.amount}, "$set": bson.M{"updated_at": now}},
+				options.FindOneAndUpdate().SetReturnDocument(options.After),
+			).Decode(&wallet)
+			if err == mongo.ErrNoDocuments {
+				return nil, errInsufficientFunds
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if wallet.Currency != e.currency {
+			return nil, errCurrencyMismatch
+		}
+
+		// 3. Append the ledger entry
+		booked = WalletTransaction{
+			ID:             primitive.NewObjectID(),
+			UserID:         e.userID,
+			Type:           e.entryType,
+			Amount:         e.amount,
+			Currency:       e.currency,
+			BalanceAfter:   wallet.Balance,
+			Source:         e.source,
+			Reference:      e.reference,
+			Description:    e.description,
+			IdempotencyKey: e.idempotencyKey,
+			CreatedAt:      now,
+		}
+		_, err = txns.InsertOne(sc, booked)
+		return nil, err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !replayed {
+		eventType := eventUserWalletCredited
+		if e.entryType == walletDebit {
+			eventType = eventUserWalletDebited
+		}
+		s.recordEvent(ctx, eventType, e.userID, map[string]interface{}{
+			"transaction_id": booked.ID.Hex(),
+			"amount":         booked.Amount,
+			"currency":       booked.Currency,
+			"balance":        booked.BalanceAfter,
+			"source":         booked.Source,
+		})
+	}
+	return &booked, replayed, nil
+}
+
+func walletError(err error, action string) error {
+	switch {
+	case errors.Is(err, errInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errCurrencyMismatch), errors.Is(err, errIdempotencyReuse):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	log.Printf("Failed to %s wallet: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s wallet", action)
+}
+
+func walletTransactionToProto(t *WalletTransaction) *pb.WalletTransaction {
+	return &pb.WalletTransaction{
+		Id:                t.ID.Hex(),
+		Type:              t.Type,
+		AmountMinor:       t.Amount,
+		Currency:          t.Currency,
+		BalanceAfterMinor: t.BalanceAfter,
+		Source:            t.Source,
+		Reference:         t.Reference,
+		Description:       t.Description,
+		CreatedAtUnix:     t.CreatedAt.Unix(),
+	}
+}
+
+// CreditWallet adds store credit, e.g. for a refund or promotion
+func (s *userService) CreditWallet(ctx context.Context, req *pb.CreditWalletMessageRequest) (*pb.CreditWalletMessageResponse, error) {
+	entry, err := parseWalletEntry(walletCredit, req.GetUserId(), req.GetAmountMinor(), req.GetCurrency(),
+		req.GetSource(), req.GetReference(), req.GetDescription(), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+	txn, replayed, err := s.applyWalletEntry(ctx, entry)
+	if err != nil {
+		return nil, walletError(err, "credit")
+	}
+	return &pb.CreditWalletMessageResponse{
+		Transaction:  walletTransactionToProto(txn),
+		BalanceMinor: txn.BalanceAfter,
+		Replayed:     replayed,
+	}, nil
+}
+
+// DebitWallet spends store credit. It fails without booking anything when
+// the balance is too low.
+func (s *userService) DebitWallet(ctx context.Context, req *pb.DebitWalletMessageRequest) (*pb.DebitWalletMessageResponse, error) {
+	entry, err := parseWalletEntry(walletDebit, req.GetUserId(), req.GetAmountMinor(), req.GetCurrency(),
+		req.GetSource(), req.GetReference(), req.GetDescription(), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+	txn, replayed, err := s.applyWalletEntry(ctx, entry)
+	if err != nil {
+		return nil, walletError(err, "debit")
+	}
+	return &pb.DebitWalletMessageResponse{
+		Transaction:  walletTransactionToProto(txn),
+		BalanceMinor: txn.BalanceAfter,
+		Replayed:     replayed,
+	}, nil
+}
+
+// GetWallet returns the balance and the most recent ledger entries
+func (s *userService) GetWallet(ctx context.Context, req *pb.GetWalletMessageRequest) (*pb.GetWalletMessageResponse, error) {
+	id, err := parseUserID(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultWalletHistorySize
+	}
+	if limit > maxWalletHistorySize {
+		limit = maxWalletHistorySize
+	}
+
+	var wallet Wallet
+	err = s.collection(ctx, "wallets").FindOne(ctx, bson.M{"_id": id}).Decode(&wallet)
+	if err == mongo.ErrNoDocuments {
+		return &pb.GetWalletMessageResponse{Currency: defaultWalletCurrency}, nil
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load wallet")
+	}
+
+	cursor, err := s.collection(ctx, "wallet_transactions").Find(ctx, bson.M{"user_id": id},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(limit),
+	)
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load wallet")
+	}
+	var txns []WalletTransaction
+	if err := cursor.All(ctx, &txns); err != nil {
+		log.Printf("Database error: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load wallet")
+	}
+
+	resp := &pb.GetWalletMessageResponse{BalanceMinor: wallet.Balance, Currency: wallet.Currency}
+	for i := range txns {
+		resp.Transactions = append(resp.Transactions, walletTransactionToProto(&txns[i]))
+	}
+	return resp, nil
+}
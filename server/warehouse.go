@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bruceoaudo/userService/internal/warehouse"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultWarehouseInterval = time.Hour
+	warehouseBatchSize       = 1000
+)
+
+var (
+	warehouseRowsExported = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "userservice_warehouse_rows_exported_total",
+		Help: "User dimension rows written to the analytics warehouse.",
+	})
+
+	warehouseWatermark = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "userservice_warehouse_watermark_seconds",
+		Help: "Unix time of the last user change exported to the warehouse.",
+	})
+)
+
+// warehouseExporter ships user dimension rows changed since the stored
+// watermark to one warehouse sink
+type warehouseExporter struct {
+	sink     warehouse.Sink
+	key      []byte
+	interval time.Duration
+}
+
+// WarehouseWatermark is the position of the last exported change per sink.
+// Changes are ordered by updated_at and then _id.
+type WarehouseWatermark struct {
+	Sink      string             `bson:"_id"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+	LastID    primitive.ObjectID `bson:"last_id"`
+	RunAt     time.Time          `bson:"run_at"`
+}
+
+// newWarehouseExporter configures the sink named by WAREHOUSE_SINK. The
+// exporter is disabled when it is unset.
+func newWarehouseExporter() (*warehouseExporter, error) {
+	var sink warehouse.Sink
+	switch strings.ToLower(os.Getenv("WAREHOUSE_SINK")) {
+	case "":
+		return nil, nil
+
+	case "clickhouse":
+		ch := &warehouse.ClickHouse{
+			URL:      os.Getenv("CLICKHOUSE_URL"),
+			Database: os.Getenv("CLICKHOUSE_DATABASE"),
+			Table:    os.Getenv("CLICKHOUSE_TABLE"),
+			User:     os.Getenv("CLICKHOUSE_USER"),
+			Password: os.Getenv("CLICKHOUSE_PASSWORD"),
+		}
+		if ch.Database == "" {
+			ch.Database = "analytics"
+		}
+		if ch.Table == "" {
+			ch.Table = "user_dim"
+		}
+		if ch.URL == "" {
+			return nil, fmt.Errorf("CLICKHOUSE_URL is required for the clickhouse sink")
+		}
+		sink = ch
+
+	case "bigquery":
+		raw, err := os.ReadFile(os.Getenv("BIGQUERY_CREDENTIALS_FILE"))
+		if err != nil {
+			return nil, fmt.Errorf("read BIGQUERY_CREDENTIALS_FILE: %w", err)
+		}
+		account, err := warehouse.ParseServiceAccount(raw)
+		if err != nil {
+			return nil, err
+		}
+		bq := &warehouse.BigQuery{
+			Project: os.Getenv("BIGQUERY_PROJECT"),
+			Dataset: os.Getenv("BIGQUERY_DATASET"),
+			Table:   os.Getenv("BIGQUERY_TABLE"),
+			Account: account,
+		}
+		if bq.Table == "" {
+			bq.Table = "user_dim"
+		}
+		if bq.Project == "" || bq.Dataset == "" {
+			return nil, fmt.Errorf("BIGQUERY_PROJECT and BIGQUERY_DATASET are required for the bigquery sink")
+		}
+		sink = bq
+
+	default:
+		return nil, fmt.Errorf("unknown WAREHOUSE_SINK %q", os.Getenv("WAREHOUSE_SINK"))
+	}
+
+	// The pseudonym key must stay the same across deployments, otherwise the
+	// warehouse sees every user as new
+	key := os.Getenv("WAREHOUSE_PSEUDONYM_KEY")
+	if len(key) < 32 {
+		return nil, fmt.Errorf("WAREHOUSE_PSEUDONYM_KEY must be at least 32 characters")
+	}
+
+	interval := defaultWarehouseInterval
+	if v := os.Getenv("WAREHOUSE_EXPORT_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < time.Minute {
+			return nil, fmt.Errorf("invalid WAREHOUSE_EXPORT_INTERVAL %q", v)
+		}
+		interval = d
+	}
+	return &warehouseExporter{sink: sink, key: []byte(key), interval: interval}, nil
+}
+
+// pseudonym is a keyed hash of the user ID. Without the key it cannot be
+// linked back to the account.
+func (e *warehouseExporter) pseudonym(id primitive.ObjectID) string {
+	mac := hmac.New(sha256.New, e.key)
+	mac.Write(id[:])
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (e *warehouseExporter) dimension(user *User, now time.Time) warehouse.UserDimension {
+	row := warehouse.UserDimension{
+		UserKey:    e.pseudonym(user.ID),
+		SignupDate: user.CreatedAt.UTC().Format(time.DateOnly),
+		UpdatedAt:  user.UpdatedAt,
+		ExportedAt: now,
+	}
+	if user.DeletedAt != nil {
+		row.Deleted = true
+		return row
+	}
+
+	tier := user.Tier
+	if tier == "" {
+		tier = defaultTier
+	}
+	d := userDemographics(user)
+	row.Segments = computeSegments(user, tier, d, now)
+	row.Locale = user.Locale
+	row.Tier = tier
+	row.Country = d.Country
+	row.AgeBand = d.AgeBand
+	return row
+}
+
+func (s *userService) runWarehouseExporter(ctx context.Context) {
+	ticker := time.NewTicker(s.warehouse.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.exportToWarehouse(ctx); err != nil {
+			log.Printf("Warehouse export to %s failed: %v", s.warehouse.sink.Name(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// exportToWarehouse sends every user changed after the watermark, moving the
+// watermark forward after each batch the sink accepted. Erased accounts are
+// sent as deleted rows so the warehouse can drop them.
+func (s *userService) exportToWarehouse(ctx context.Context) error {
+	e := s.warehouse
+	watermarks := s.collection(ctx, "warehouse_watermarks")
+
+	var wm WarehouseWatermark
+	err := watermarks.FindOne(ctx, bson.M{"_id": e.sink.Name()}).Decode(&wm)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return err
+	}
+	wm.Sink = e.sink.Name()
+
+	users := s.collection(ctx, "users")
+	exported := 0
+	for {
+		filter := bson.M{"$or": bson.A{
+			bson.M{"updated_at": bson.M{"$gt": wm.UpdatedAt}},
+			bson.M{"updated_at": wm.UpdatedAt, "_id": bson.M{"$gt": wm.LastID}},
+		}}
+		cursor, err := users.Find(ctx, filter, options.Find().
+			SetSort(bson.D{{Key: "updated_at", Value: 1}, {Key: "_id", Value: 1}}).
+			SetLimit(warehouseBatchSize))
+		if err != nil {
+			return err
+		}
+		var batch []User
+		if err := cursor.All(ctx, &batch); err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		now := time.Now()
+		rows := make([]warehouse.UserDimension, len(batch))
+		for i := range batch {
+			rows[i] = e.dimension(&batch[i], now)
+		}
+		if err := e.sink.Write(ctx, rows); err != nil {
+			return err
+		}
+
+		last := batch[len(batch)-1]
+		wm.UpdatedAt, wm.LastID, wm.RunAt = last.UpdatedAt, last.ID, now
+		if _, err := watermarks.ReplaceOne(ctx, bson.M{"_id": wm.Sink}, wm, options.Replace().SetUpsert(true)); err != nil {
+			return err
+		}
+		exported += len(batch)
+		warehouseRowsExported.Add(float64(len(batch)))
+		warehouseWatermark.Set(float64(wm.UpdatedAt.Unix()))
+	}
+
+	if exported > 0 {
+		log.Printf("Exported %d user rows to %s", exported, e.sink.Name())
+	}
+	return nil
+}
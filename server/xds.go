@@ -0,0 +1,68 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc"
+	xdscreds "google.golang.org/grpc/credentials/xds"
+	"google.golang.org/grpc/xds"
+)
+
+// grpcServer is what main needs from either a plain or an xDS-managed server
+type grpcServer interface {
+	grpc.ServiceRegistrar
+	Serve(net.Listener) error
+	Stop()
+	GracefulStop()
+}
+
+// xdsEnabled reports whether GRPC_XDS_SERVER asks for a mesh-managed server
+func xdsEnabled() (bool, error) {
+	v := os.Getenv("GRPC_XDS_SERVER")
+	if v == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(v)
+}
+
+// newGRPCServer builds the gRPC server. With GRPC_XDS_SERVER=true the
+// listener, mTLS certificates and RBAC come from the xDS control plane
+// (Istio proxyless or Traffic Director) named in the GRPC_XDS_BOOTSTRAP file.
+// The TLS files configured for the plain server are used whenever the
+// control plane sends no security configuration. Importing the xds package
+// also registers the xds:/// resolver for outbound clients.
+func newGRPCServer(tlsConfig *tls.Config, opts ...grpc.ServerOption) (grpcServer, error) {
+	fallback := newServerCredentials(tlsConfig)
+	enabled, err := xdsEnabled()
+	if err != nil {
+		return nil, errors.New("GRPC_XDS_SERVER must be a boolean")
+	}
+	if !enabled {
+		return grpc.NewServer(append([]grpc.ServerOption{grpc.Creds(fallback)}, opts...)...), nil
+	}
+
+	if os.Getenv("GRPC_XDS_BOOTSTRAP") == "" && os.Getenv("GRPC_XDS_BOOTSTRAP_CONFIG") == "" {
+		return nil, errors.New("GRPC_XDS_BOOTSTRAP or GRPC_XDS_BOOTSTRAP_CONFIG is required for an xDS server")
+	}
+	creds, err := xdscreds.NewServerCredentials(xdscreds.ServerOptions{FallbackCreds: fallback})
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]grpc.ServerOption{
+		grpc.Creds(creds),
+		xds.ServingModeCallback(func(addr net.Addr, args xds.ServingModeChangeArgs) {
+			if args.Err != nil {
+				log.Printf("xDS listener %s is %s: %v", addr, args.Mode, args.Err)
+				return
+			}
+			log.Printf("xDS listener %s is %s", addr, args.Mode)
+		}),
+	}, opts...)
+	return xds.NewGRPCServer(opts...)
+}